@@ -0,0 +1,417 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: mesh/v1alpha1/circuit_breaker.proto
+
+package v1alpha1
+
+import (
+	fmt "fmt"
+	proto "github.com/golang/protobuf/proto"
+	duration "github.com/golang/protobuf/ptypes/duration"
+	wrappers "github.com/golang/protobuf/ptypes/wrappers"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
+
+// CircuitBreaker defines configuration for limiting connections and requests
+// to a service and for ejecting its misbehaving hosts.
+type CircuitBreaker struct {
+	// List of selectors to match dataplanes that are sources of traffic.
+	Sources []*Selector `protobuf:"bytes,1,rep,name=sources,proto3" json:"sources,omitempty"`
+	// List of selectors to match services that are destinations of traffic.
+	Destinations []*Selector `protobuf:"bytes,2,rep,name=destinations,proto3" json:"destinations,omitempty"`
+	// Configuration of CircuitBreaker.
+	Conf                 *CircuitBreaker_Conf `protobuf:"bytes,3,opt,name=conf,proto3" json:"conf,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *CircuitBreaker) Reset()         { *m = CircuitBreaker{} }
+func (m *CircuitBreaker) String() string { return proto.CompactTextString(m) }
+func (*CircuitBreaker) ProtoMessage()    {}
+func (*CircuitBreaker) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7e0ea95e09ad1355, []int{0}
+}
+
+func (m *CircuitBreaker) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CircuitBreaker.Unmarshal(m, b)
+}
+func (m *CircuitBreaker) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CircuitBreaker.Marshal(b, m, deterministic)
+}
+func (m *CircuitBreaker) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CircuitBreaker.Merge(m, src)
+}
+func (m *CircuitBreaker) XXX_Size() int {
+	return xxx_messageInfo_CircuitBreaker.Size(m)
+}
+func (m *CircuitBreaker) XXX_DiscardUnknown() {
+	xxx_messageInfo_CircuitBreaker.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CircuitBreaker proto.InternalMessageInfo
+
+func (m *CircuitBreaker) GetSources() []*Selector {
+	if m != nil {
+		return m.Sources
+	}
+	return nil
+}
+
+func (m *CircuitBreaker) GetDestinations() []*Selector {
+	if m != nil {
+		return m.Destinations
+	}
+	return nil
+}
+
+func (m *CircuitBreaker) GetConf() *CircuitBreaker_Conf {
+	if m != nil {
+		return m.Conf
+	}
+	return nil
+}
+
+// Conf defines configuration of a circuit breaker.
+type CircuitBreaker_Conf struct {
+	// Limits on connections and requests to a service.
+	Thresholds *CircuitBreaker_Conf_Thresholds `protobuf:"bytes,1,opt,name=thresholds,proto3" json:"thresholds,omitempty"`
+	// Configuration of ejecting misbehaving hosts of a service.
+	OutlierDetection     *CircuitBreaker_Conf_OutlierDetection `protobuf:"bytes,2,opt,name=outlier_detection,json=outlierDetection,proto3" json:"outlier_detection,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                              `json:"-"`
+	XXX_unrecognized     []byte                                `json:"-"`
+	XXX_sizecache        int32                                 `json:"-"`
+}
+
+func (m *CircuitBreaker_Conf) Reset()         { *m = CircuitBreaker_Conf{} }
+func (m *CircuitBreaker_Conf) String() string { return proto.CompactTextString(m) }
+func (*CircuitBreaker_Conf) ProtoMessage()    {}
+func (*CircuitBreaker_Conf) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7e0ea95e09ad1355, []int{0, 0}
+}
+
+func (m *CircuitBreaker_Conf) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CircuitBreaker_Conf.Unmarshal(m, b)
+}
+func (m *CircuitBreaker_Conf) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CircuitBreaker_Conf.Marshal(b, m, deterministic)
+}
+func (m *CircuitBreaker_Conf) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CircuitBreaker_Conf.Merge(m, src)
+}
+func (m *CircuitBreaker_Conf) XXX_Size() int {
+	return xxx_messageInfo_CircuitBreaker_Conf.Size(m)
+}
+func (m *CircuitBreaker_Conf) XXX_DiscardUnknown() {
+	xxx_messageInfo_CircuitBreaker_Conf.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CircuitBreaker_Conf proto.InternalMessageInfo
+
+func (m *CircuitBreaker_Conf) GetThresholds() *CircuitBreaker_Conf_Thresholds {
+	if m != nil {
+		return m.Thresholds
+	}
+	return nil
+}
+
+func (m *CircuitBreaker_Conf) GetOutlierDetection() *CircuitBreaker_Conf_OutlierDetection {
+	if m != nil {
+		return m.OutlierDetection
+	}
+	return nil
+}
+
+// Thresholds defines limits on connections and requests to a service.
+type CircuitBreaker_Conf_Thresholds struct {
+	// Maximum number of connections to all hosts of a service.
+	MaxConnections *wrappers.UInt32Value `protobuf:"bytes,1,opt,name=max_connections,json=maxConnections,proto3" json:"max_connections,omitempty"`
+	// Maximum number of requests waiting for a connection to a service.
+	MaxPendingRequests *wrappers.UInt32Value `protobuf:"bytes,2,opt,name=max_pending_requests,json=maxPendingRequests,proto3" json:"max_pending_requests,omitempty"`
+	// Maximum number of parallel requests to a service.
+	MaxRequests *wrappers.UInt32Value `protobuf:"bytes,3,opt,name=max_requests,json=maxRequests,proto3" json:"max_requests,omitempty"`
+	// Maximum number of parallel retries to a service.
+	MaxRetries           *wrappers.UInt32Value `protobuf:"bytes,4,opt,name=max_retries,json=maxRetries,proto3" json:"max_retries,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *CircuitBreaker_Conf_Thresholds) Reset()         { *m = CircuitBreaker_Conf_Thresholds{} }
+func (m *CircuitBreaker_Conf_Thresholds) String() string { return proto.CompactTextString(m) }
+func (*CircuitBreaker_Conf_Thresholds) ProtoMessage()    {}
+func (*CircuitBreaker_Conf_Thresholds) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7e0ea95e09ad1355, []int{0, 0, 0}
+}
+
+func (m *CircuitBreaker_Conf_Thresholds) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CircuitBreaker_Conf_Thresholds.Unmarshal(m, b)
+}
+func (m *CircuitBreaker_Conf_Thresholds) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CircuitBreaker_Conf_Thresholds.Marshal(b, m, deterministic)
+}
+func (m *CircuitBreaker_Conf_Thresholds) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CircuitBreaker_Conf_Thresholds.Merge(m, src)
+}
+func (m *CircuitBreaker_Conf_Thresholds) XXX_Size() int {
+	return xxx_messageInfo_CircuitBreaker_Conf_Thresholds.Size(m)
+}
+func (m *CircuitBreaker_Conf_Thresholds) XXX_DiscardUnknown() {
+	xxx_messageInfo_CircuitBreaker_Conf_Thresholds.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CircuitBreaker_Conf_Thresholds proto.InternalMessageInfo
+
+func (m *CircuitBreaker_Conf_Thresholds) GetMaxConnections() *wrappers.UInt32Value {
+	if m != nil {
+		return m.MaxConnections
+	}
+	return nil
+}
+
+func (m *CircuitBreaker_Conf_Thresholds) GetMaxPendingRequests() *wrappers.UInt32Value {
+	if m != nil {
+		return m.MaxPendingRequests
+	}
+	return nil
+}
+
+func (m *CircuitBreaker_Conf_Thresholds) GetMaxRequests() *wrappers.UInt32Value {
+	if m != nil {
+		return m.MaxRequests
+	}
+	return nil
+}
+
+func (m *CircuitBreaker_Conf_Thresholds) GetMaxRetries() *wrappers.UInt32Value {
+	if m != nil {
+		return m.MaxRetries
+	}
+	return nil
+}
+
+// OutlierDetection defines configuration for ejecting misbehaving hosts
+// of a service from the load balancing pool.
+type CircuitBreaker_Conf_OutlierDetection struct {
+	// Number of consecutive 5xx responses (or connection errors in case of
+	// TCP traffic) before a host gets ejected.
+	Consecutive_5Xx *wrappers.UInt32Value `protobuf:"bytes,1,opt,name=consecutive_5xx,json=consecutive5xx,proto3" json:"consecutive_5xx,omitempty"`
+	// Number of consecutive gateway failures (502, 503 and 504 responses)
+	// before a host gets ejected.
+	ConsecutiveGatewayFailure *wrappers.UInt32Value `protobuf:"bytes,2,opt,name=consecutive_gateway_failure,json=consecutiveGatewayFailure,proto3" json:"consecutive_gateway_failure,omitempty"`
+	// Configuration of success rate based ejection.
+	SuccessRate *CircuitBreaker_Conf_OutlierDetection_SuccessRate `protobuf:"bytes,3,opt,name=success_rate,json=successRate,proto3" json:"success_rate,omitempty"`
+	// Interval between consecutive ejection sweeps.
+	Interval *duration.Duration `protobuf:"bytes,4,opt,name=interval,proto3" json:"interval,omitempty"`
+	// Base amount of time a host is ejected for. The real amount of time is
+	// equal to the base time multiplied by the number of times the host has
+	// been ejected.
+	BaseEjectionTime *duration.Duration `protobuf:"bytes,5,opt,name=base_ejection_time,json=baseEjectionTime,proto3" json:"base_ejection_time,omitempty"`
+	// Maximum percentage of hosts of a service that can be ejected at the
+	// same time.
+	MaxEjectionPercent   *wrappers.UInt32Value `protobuf:"bytes,6,opt,name=max_ejection_percent,json=maxEjectionPercent,proto3" json:"max_ejection_percent,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *CircuitBreaker_Conf_OutlierDetection) Reset()         { *m = CircuitBreaker_Conf_OutlierDetection{} }
+func (m *CircuitBreaker_Conf_OutlierDetection) String() string { return proto.CompactTextString(m) }
+func (*CircuitBreaker_Conf_OutlierDetection) ProtoMessage()    {}
+func (*CircuitBreaker_Conf_OutlierDetection) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7e0ea95e09ad1355, []int{0, 0, 1}
+}
+
+func (m *CircuitBreaker_Conf_OutlierDetection) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CircuitBreaker_Conf_OutlierDetection.Unmarshal(m, b)
+}
+func (m *CircuitBreaker_Conf_OutlierDetection) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CircuitBreaker_Conf_OutlierDetection.Marshal(b, m, deterministic)
+}
+func (m *CircuitBreaker_Conf_OutlierDetection) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CircuitBreaker_Conf_OutlierDetection.Merge(m, src)
+}
+func (m *CircuitBreaker_Conf_OutlierDetection) XXX_Size() int {
+	return xxx_messageInfo_CircuitBreaker_Conf_OutlierDetection.Size(m)
+}
+func (m *CircuitBreaker_Conf_OutlierDetection) XXX_DiscardUnknown() {
+	xxx_messageInfo_CircuitBreaker_Conf_OutlierDetection.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CircuitBreaker_Conf_OutlierDetection proto.InternalMessageInfo
+
+func (m *CircuitBreaker_Conf_OutlierDetection) GetConsecutive_5Xx() *wrappers.UInt32Value {
+	if m != nil {
+		return m.Consecutive_5Xx
+	}
+	return nil
+}
+
+func (m *CircuitBreaker_Conf_OutlierDetection) GetConsecutiveGatewayFailure() *wrappers.UInt32Value {
+	if m != nil {
+		return m.ConsecutiveGatewayFailure
+	}
+	return nil
+}
+
+func (m *CircuitBreaker_Conf_OutlierDetection) GetSuccessRate() *CircuitBreaker_Conf_OutlierDetection_SuccessRate {
+	if m != nil {
+		return m.SuccessRate
+	}
+	return nil
+}
+
+func (m *CircuitBreaker_Conf_OutlierDetection) GetInterval() *duration.Duration {
+	if m != nil {
+		return m.Interval
+	}
+	return nil
+}
+
+func (m *CircuitBreaker_Conf_OutlierDetection) GetBaseEjectionTime() *duration.Duration {
+	if m != nil {
+		return m.BaseEjectionTime
+	}
+	return nil
+}
+
+func (m *CircuitBreaker_Conf_OutlierDetection) GetMaxEjectionPercent() *wrappers.UInt32Value {
+	if m != nil {
+		return m.MaxEjectionPercent
+	}
+	return nil
+}
+
+// SuccessRate defines configuration for ejecting hosts whose success
+// rate is significantly lower than the average success rate of all
+// hosts of a service.
+type CircuitBreaker_Conf_OutlierDetection_SuccessRate struct {
+	// Minimum number of hosts with enough requests that is required to
+	// perform success rate detection.
+	MinimumHosts *wrappers.UInt32Value `protobuf:"bytes,1,opt,name=minimum_hosts,json=minimumHosts,proto3" json:"minimum_hosts,omitempty"`
+	// Minimum number of requests a host must receive within an interval
+	// to be included into success rate detection.
+	RequestVolume *wrappers.UInt32Value `protobuf:"bytes,2,opt,name=request_volume,json=requestVolume,proto3" json:"request_volume,omitempty"`
+	// Factor used to determine the ejection threshold. A host is ejected
+	// if its success rate is lower than the average success rate minus
+	// this factor multiplied by the standard deviation. The factor is
+	// divided by 1000, e.g. 1900 stands for 1.9.
+	StdevFactor          *wrappers.UInt32Value `protobuf:"bytes,3,opt,name=stdev_factor,json=stdevFactor,proto3" json:"stdev_factor,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *CircuitBreaker_Conf_OutlierDetection_SuccessRate) Reset() {
+	*m = CircuitBreaker_Conf_OutlierDetection_SuccessRate{}
+}
+func (m *CircuitBreaker_Conf_OutlierDetection_SuccessRate) String() string {
+	return proto.CompactTextString(m)
+}
+func (*CircuitBreaker_Conf_OutlierDetection_SuccessRate) ProtoMessage() {}
+func (*CircuitBreaker_Conf_OutlierDetection_SuccessRate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7e0ea95e09ad1355, []int{0, 0, 1, 0}
+}
+
+func (m *CircuitBreaker_Conf_OutlierDetection_SuccessRate) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CircuitBreaker_Conf_OutlierDetection_SuccessRate.Unmarshal(m, b)
+}
+func (m *CircuitBreaker_Conf_OutlierDetection_SuccessRate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CircuitBreaker_Conf_OutlierDetection_SuccessRate.Marshal(b, m, deterministic)
+}
+func (m *CircuitBreaker_Conf_OutlierDetection_SuccessRate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CircuitBreaker_Conf_OutlierDetection_SuccessRate.Merge(m, src)
+}
+func (m *CircuitBreaker_Conf_OutlierDetection_SuccessRate) XXX_Size() int {
+	return xxx_messageInfo_CircuitBreaker_Conf_OutlierDetection_SuccessRate.Size(m)
+}
+func (m *CircuitBreaker_Conf_OutlierDetection_SuccessRate) XXX_DiscardUnknown() {
+	xxx_messageInfo_CircuitBreaker_Conf_OutlierDetection_SuccessRate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CircuitBreaker_Conf_OutlierDetection_SuccessRate proto.InternalMessageInfo
+
+func (m *CircuitBreaker_Conf_OutlierDetection_SuccessRate) GetMinimumHosts() *wrappers.UInt32Value {
+	if m != nil {
+		return m.MinimumHosts
+	}
+	return nil
+}
+
+func (m *CircuitBreaker_Conf_OutlierDetection_SuccessRate) GetRequestVolume() *wrappers.UInt32Value {
+	if m != nil {
+		return m.RequestVolume
+	}
+	return nil
+}
+
+func (m *CircuitBreaker_Conf_OutlierDetection_SuccessRate) GetStdevFactor() *wrappers.UInt32Value {
+	if m != nil {
+		return m.StdevFactor
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*CircuitBreaker)(nil), "kuma.mesh.v1alpha1.CircuitBreaker")
+	proto.RegisterType((*CircuitBreaker_Conf)(nil), "kuma.mesh.v1alpha1.CircuitBreaker.Conf")
+	proto.RegisterType((*CircuitBreaker_Conf_Thresholds)(nil), "kuma.mesh.v1alpha1.CircuitBreaker.Conf.Thresholds")
+	proto.RegisterType((*CircuitBreaker_Conf_OutlierDetection)(nil), "kuma.mesh.v1alpha1.CircuitBreaker.Conf.OutlierDetection")
+	proto.RegisterType((*CircuitBreaker_Conf_OutlierDetection_SuccessRate)(nil), "kuma.mesh.v1alpha1.CircuitBreaker.Conf.OutlierDetection.SuccessRate")
+}
+
+func init() {
+	proto.RegisterFile("mesh/v1alpha1/circuit_breaker.proto", fileDescriptor_7e0ea95e09ad1355)
+}
+
+var fileDescriptor_7e0ea95e09ad1355 = []byte{
+	// 605 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x94, 0xdd, 0x6e, 0xd3, 0x3e,
+	0x14, 0xc0, 0xb5, 0xee, 0xe3, 0xbf, 0xff, 0x69, 0x37, 0x86, 0xc5, 0x45, 0x56, 0x26, 0x34, 0xc1,
+	0x05, 0xbb, 0x4a, 0xb5, 0x4e, 0x43, 0x48, 0x08, 0x01, 0xeb, 0x3e, 0xe0, 0x06, 0xa6, 0x6c, 0xec,
+	0x02, 0x21, 0x45, 0x6e, 0x7a, 0xda, 0x9a, 0x25, 0x76, 0xf0, 0x47, 0x17, 0x9e, 0x87, 0x47, 0xe0,
+	0x29, 0x78, 0x01, 0xde, 0x06, 0x09, 0x39, 0x4e, 0xba, 0x76, 0x03, 0x61, 0x71, 0xe9, 0xe4, 0xfc,
+	0x7e, 0x3e, 0x3e, 0xe7, 0xd8, 0xf0, 0x28, 0x43, 0x35, 0xee, 0x4c, 0x76, 0x69, 0x9a, 0x8f, 0xe9,
+	0x6e, 0x27, 0x61, 0x32, 0x31, 0x4c, 0xc7, 0x7d, 0x89, 0xf4, 0x12, 0x65, 0x98, 0x4b, 0xa1, 0x05,
+	0x21, 0x97, 0x26, 0xa3, 0xa1, 0x8d, 0x0c, 0xeb, 0xc8, 0xf6, 0xd6, 0x3c, 0xa8, 0x30, 0xc5, 0x44,
+	0x8b, 0x8a, 0x68, 0x3f, 0x18, 0x09, 0x31, 0x4a, 0xb1, 0x53, 0xae, 0xfa, 0x66, 0xd8, 0x19, 0x18,
+	0x49, 0x35, 0x13, 0xfc, 0x4f, 0xff, 0xaf, 0x24, 0xcd, 0x73, 0x94, 0xca, 0xfd, 0x7f, 0xf8, 0x13,
+	0x60, 0xbd, 0xe7, 0x72, 0x39, 0x70, 0xa9, 0x90, 0x27, 0xf0, 0x9f, 0x12, 0x46, 0x26, 0xa8, 0x82,
+	0x85, 0xed, 0xc5, 0x9d, 0x66, 0x77, 0x2b, 0xbc, 0x9d, 0x56, 0x78, 0x56, 0xe5, 0x11, 0xd5, 0xc1,
+	0xe4, 0x25, 0xb4, 0x06, 0xa8, 0x34, 0xe3, 0xe5, 0xfe, 0x2a, 0x68, 0x78, 0xc0, 0x73, 0x04, 0x79,
+	0x06, 0x4b, 0x89, 0xe0, 0xc3, 0x60, 0x71, 0x7b, 0x61, 0xa7, 0xd9, 0x7d, 0xfc, 0x3b, 0x72, 0x3e,
+	0xd7, 0xb0, 0x27, 0xf8, 0x30, 0x2a, 0xa1, 0xf6, 0xb7, 0xff, 0x61, 0xc9, 0x2e, 0x49, 0x04, 0xa0,
+	0xc7, 0x12, 0xd5, 0x58, 0xa4, 0x03, 0x7b, 0x04, 0xeb, 0xea, 0x7a, 0xba, 0xc2, 0xf3, 0x29, 0x19,
+	0xcd, 0x58, 0x08, 0xc2, 0x5d, 0x61, 0x74, 0xca, 0x50, 0xc6, 0x03, 0xd4, 0x98, 0xd8, 0x7c, 0x83,
+	0x46, 0xa9, 0x7e, 0xea, 0xab, 0x7e, 0xe7, 0x04, 0x87, 0x35, 0x1f, 0x6d, 0x88, 0x1b, 0x5f, 0xda,
+	0x5f, 0x1b, 0x00, 0xd7, 0x19, 0x90, 0x23, 0xb8, 0x93, 0xd1, 0x22, 0x4e, 0x04, 0xe7, 0x2e, 0xa0,
+	0x3e, 0xce, 0x56, 0xe8, 0xda, 0x1a, 0xd6, 0x6d, 0x0d, 0xdf, 0xbf, 0xe1, 0x7a, 0xaf, 0x7b, 0x41,
+	0x53, 0x83, 0xd1, 0x7a, 0x46, 0x8b, 0xde, 0x35, 0x43, 0xde, 0xc2, 0x3d, 0xab, 0xc9, 0x91, 0x0f,
+	0x18, 0x1f, 0xc5, 0x12, 0x3f, 0x1b, 0x54, 0x5a, 0x05, 0x0d, 0x0f, 0x17, 0xc9, 0x68, 0x71, 0xea,
+	0xc0, 0xa8, 0xe2, 0xc8, 0x0b, 0x68, 0x59, 0xdf, 0xd4, 0xb3, 0xe8, 0xe1, 0x69, 0x66, 0xb4, 0x98,
+	0x0a, 0x9e, 0x43, 0xd3, 0x09, 0xb4, 0x64, 0xa8, 0x82, 0x25, 0x0f, 0x1e, 0x4a, 0xbe, 0x8c, 0x6f,
+	0x7f, 0x5f, 0x86, 0x8d, 0x9b, 0xc5, 0xb4, 0xb5, 0x4a, 0x04, 0x57, 0x98, 0x18, 0xcd, 0x26, 0x18,
+	0xef, 0x17, 0x85, 0x5f, 0xad, 0x66, 0xa0, 0xfd, 0xa2, 0x20, 0x1f, 0xe1, 0xfe, 0xac, 0x66, 0x44,
+	0x35, 0x5e, 0xd1, 0x2f, 0xf1, 0x90, 0xb2, 0xd4, 0x48, 0xf4, 0x2a, 0xd9, 0xe6, 0x8c, 0xe0, 0xc4,
+	0xf1, 0xc7, 0x0e, 0x27, 0x23, 0x68, 0x29, 0x93, 0x24, 0xa8, 0x54, 0x2c, 0xa9, 0xc6, 0xaa, 0x72,
+	0x87, 0xff, 0x3a, 0x41, 0xe1, 0x99, 0x93, 0x45, 0x54, 0x63, 0xd4, 0x54, 0xd7, 0x0b, 0xb2, 0x0f,
+	0xab, 0x8c, 0x6b, 0x94, 0x13, 0x9a, 0x56, 0xe5, 0xdd, 0xbc, 0x95, 0xf3, 0x61, 0xf5, 0x52, 0x44,
+	0xd3, 0x50, 0x72, 0x02, 0xa4, 0x4f, 0x15, 0xc6, 0xf8, 0xc9, 0x6d, 0x10, 0x6b, 0x96, 0x61, 0xb0,
+	0xfc, 0x37, 0xc1, 0x86, 0x85, 0x8e, 0x2a, 0xe6, 0x9c, 0x65, 0x58, 0x8f, 0xdc, 0xd4, 0x93, 0xa3,
+	0x4c, 0x90, 0xeb, 0x60, 0xc5, 0x73, 0xe4, 0x6a, 0xd9, 0xa9, 0xe3, 0xda, 0x3f, 0x16, 0xa0, 0x39,
+	0x73, 0x58, 0xf2, 0x0a, 0xd6, 0x32, 0xc6, 0x59, 0x66, 0xb2, 0x78, 0x2c, 0xec, 0x0c, 0xfa, 0xf4,
+	0xba, 0x55, 0x21, 0xaf, 0x2d, 0x41, 0x7a, 0xb0, 0x5e, 0x4d, 0x70, 0x3c, 0x11, 0xa9, 0xc9, 0xfc,
+	0x9a, 0xbb, 0x56, 0x31, 0x17, 0x25, 0x62, 0xaf, 0x82, 0xd2, 0x03, 0x9c, 0xc4, 0x43, 0x6a, 0xdf,
+	0x33, 0xbf, 0xab, 0x50, 0x12, 0xc7, 0x25, 0x70, 0x00, 0x1f, 0x56, 0xeb, 0x96, 0xf7, 0x57, 0xca,
+	0xf0, 0xbd, 0x5f, 0x03, 0x00, 0xda, 0x23, 0x5c, 0x5b, 0x2b, 0x06, 0x00, 0x00,
+}
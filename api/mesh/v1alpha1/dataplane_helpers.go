@@ -18,6 +18,10 @@ const (
 	// Optional tag that has a reserved meaning in Kuma.
 	// If absent, Kuma will treat application's protocol as opaque TCP.
 	ProtocolTag = "protocol"
+	// Optional tags that have a reserved meaning in Kuma.
+	// Used to determine locality of a dataplane.
+	RegionTag = "region"
+	ZoneTag   = "zone"
 )
 
 // ServiceTagValue represents the value of "service" tag.
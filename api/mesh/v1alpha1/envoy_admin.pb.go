@@ -0,0 +1,340 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: mesh/v1alpha1/envoy_admin.proto
+
+package v1alpha1
+
+import (
+	context "context"
+	fmt "fmt"
+	proto "github.com/golang/protobuf/proto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
+
+// Resource of the Envoy Admin API.
+type EnvoyAdminRequest_Resource int32
+
+const (
+	// Current Envoy configuration, i.e. `/config_dump`.
+	EnvoyAdminRequest_CONFIG_DUMP EnvoyAdminRequest_Resource = 0
+	// Upstream clusters and their hosts, i.e. `/clusters`.
+	EnvoyAdminRequest_CLUSTERS EnvoyAdminRequest_Resource = 1
+	// Envoy statistics, i.e. `/stats`.
+	EnvoyAdminRequest_STATS EnvoyAdminRequest_Resource = 2
+)
+
+var EnvoyAdminRequest_Resource_name = map[int32]string{
+	0: "CONFIG_DUMP",
+	1: "CLUSTERS",
+	2: "STATS",
+}
+
+var EnvoyAdminRequest_Resource_value = map[string]int32{
+	"CONFIG_DUMP": 0,
+	"CLUSTERS":    1,
+	"STATS":       2,
+}
+
+func (x EnvoyAdminRequest_Resource) String() string {
+	return proto.EnumName(EnvoyAdminRequest_Resource_name, int32(x))
+}
+
+func (EnvoyAdminRequest_Resource) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_7feab8b25e7b75e7, []int{0, 0}
+}
+
+// EnvoyAdminRequest is a request of the Control Plane to retrieve data
+// from the Envoy Admin API.
+type EnvoyAdminRequest struct {
+	// Unique id of a request that kuma-dp sends back in a response.
+	RequestId string `protobuf:"bytes,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	// Resource to retrieve.
+	Resource             EnvoyAdminRequest_Resource `protobuf:"varint,2,opt,name=resource,proto3,enum=kuma.mesh.v1alpha1.EnvoyAdminRequest_Resource" json:"resource,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                   `json:"-"`
+	XXX_unrecognized     []byte                     `json:"-"`
+	XXX_sizecache        int32                      `json:"-"`
+}
+
+func (m *EnvoyAdminRequest) Reset()         { *m = EnvoyAdminRequest{} }
+func (m *EnvoyAdminRequest) String() string { return proto.CompactTextString(m) }
+func (*EnvoyAdminRequest) ProtoMessage()    {}
+func (*EnvoyAdminRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7feab8b25e7b75e7, []int{0}
+}
+
+func (m *EnvoyAdminRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EnvoyAdminRequest.Unmarshal(m, b)
+}
+func (m *EnvoyAdminRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EnvoyAdminRequest.Marshal(b, m, deterministic)
+}
+func (m *EnvoyAdminRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EnvoyAdminRequest.Merge(m, src)
+}
+func (m *EnvoyAdminRequest) XXX_Size() int {
+	return xxx_messageInfo_EnvoyAdminRequest.Size(m)
+}
+func (m *EnvoyAdminRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_EnvoyAdminRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EnvoyAdminRequest proto.InternalMessageInfo
+
+func (m *EnvoyAdminRequest) GetRequestId() string {
+	if m != nil {
+		return m.RequestId
+	}
+	return ""
+}
+
+func (m *EnvoyAdminRequest) GetResource() EnvoyAdminRequest_Resource {
+	if m != nil {
+		return m.Resource
+	}
+	return EnvoyAdminRequest_CONFIG_DUMP
+}
+
+// EnvoyAdminResponse is either the first message of a stream that identifies
+// a Dataplane or a response to a request of the Control Plane.
+type EnvoyAdminResponse struct {
+	// Mesh of a Dataplane. Set only in the first message of a stream.
+	Mesh string `protobuf:"bytes,1,opt,name=mesh,proto3" json:"mesh,omitempty"`
+	// Name of a Dataplane. Set only in the first message of a stream.
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// Id of a request this response is for.
+	RequestId string `protobuf:"bytes,3,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	// Content returned by the Envoy Admin API.
+	Content []byte `protobuf:"bytes,4,opt,name=content,proto3" json:"content,omitempty"`
+	// Error that occurred while retrieving data from the Envoy Admin API.
+	Error                string   `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *EnvoyAdminResponse) Reset()         { *m = EnvoyAdminResponse{} }
+func (m *EnvoyAdminResponse) String() string { return proto.CompactTextString(m) }
+func (*EnvoyAdminResponse) ProtoMessage()    {}
+func (*EnvoyAdminResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7feab8b25e7b75e7, []int{1}
+}
+
+func (m *EnvoyAdminResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EnvoyAdminResponse.Unmarshal(m, b)
+}
+func (m *EnvoyAdminResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EnvoyAdminResponse.Marshal(b, m, deterministic)
+}
+func (m *EnvoyAdminResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EnvoyAdminResponse.Merge(m, src)
+}
+func (m *EnvoyAdminResponse) XXX_Size() int {
+	return xxx_messageInfo_EnvoyAdminResponse.Size(m)
+}
+func (m *EnvoyAdminResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_EnvoyAdminResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EnvoyAdminResponse proto.InternalMessageInfo
+
+func (m *EnvoyAdminResponse) GetMesh() string {
+	if m != nil {
+		return m.Mesh
+	}
+	return ""
+}
+
+func (m *EnvoyAdminResponse) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *EnvoyAdminResponse) GetRequestId() string {
+	if m != nil {
+		return m.RequestId
+	}
+	return ""
+}
+
+func (m *EnvoyAdminResponse) GetContent() []byte {
+	if m != nil {
+		return m.Content
+	}
+	return nil
+}
+
+func (m *EnvoyAdminResponse) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterEnum("kuma.mesh.v1alpha1.EnvoyAdminRequest_Resource", EnvoyAdminRequest_Resource_name, EnvoyAdminRequest_Resource_value)
+	proto.RegisterType((*EnvoyAdminRequest)(nil), "kuma.mesh.v1alpha1.EnvoyAdminRequest")
+	proto.RegisterType((*EnvoyAdminResponse)(nil), "kuma.mesh.v1alpha1.EnvoyAdminResponse")
+}
+
+func init() { proto.RegisterFile("mesh/v1alpha1/envoy_admin.proto", fileDescriptor_7feab8b25e7b75e7) }
+
+var fileDescriptor_7feab8b25e7b75e7 = []byte{
+	// 309 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x51, 0xcd, 0x4e, 0x32, 0x41,
+	0x10, 0x64, 0xf8, 0xe0, 0x73, 0xb7, 0x25, 0xba, 0x76, 0x3c, 0x6c, 0x4c, 0x8c, 0x64, 0x13, 0xcd,
+	0x9e, 0x06, 0x41, 0x5f, 0x00, 0x11, 0x0d, 0xc6, 0xbf, 0xcc, 0xc2, 0xc5, 0x0b, 0x19, 0xa1, 0x23,
+	0x44, 0x77, 0x06, 0x67, 0x17, 0x12, 0x13, 0xdf, 0xc0, 0xd7, 0xf1, 0x01, 0xcd, 0xfe, 0x29, 0x81,
+	0x83, 0xde, 0xba, 0x7b, 0xaa, 0x2a, 0x55, 0x35, 0x70, 0x10, 0x52, 0x34, 0x69, 0x2c, 0x9a, 0xf2,
+	0x65, 0x36, 0x91, 0xcd, 0x06, 0xa9, 0x85, 0x7e, 0x1b, 0xca, 0x71, 0x38, 0x55, 0x7c, 0x66, 0x74,
+	0xac, 0x11, 0x9f, 0xe7, 0xa1, 0xe4, 0x09, 0x8a, 0x17, 0x28, 0xef, 0x93, 0xc1, 0x4e, 0x37, 0x41,
+	0xb6, 0x13, 0xa0, 0xa0, 0xd7, 0x39, 0x45, 0x31, 0xee, 0x03, 0x98, 0x6c, 0x1c, 0x4e, 0xc7, 0x2e,
+	0xab, 0x33, 0xdf, 0x16, 0x76, 0x7e, 0xe9, 0x8d, 0xf1, 0x0a, 0x2c, 0x43, 0x91, 0x9e, 0x9b, 0x11,
+	0xb9, 0xe5, 0x3a, 0xf3, 0xb7, 0x5a, 0x9c, 0xaf, 0x6b, 0xf3, 0x35, 0x5d, 0x2e, 0x72, 0x96, 0xf8,
+	0xe6, 0x7b, 0xa7, 0x60, 0x15, 0x57, 0xdc, 0x86, 0xcd, 0xce, 0xdd, 0xed, 0x45, 0xef, 0x72, 0x78,
+	0x3e, 0xb8, 0xb9, 0x77, 0x4a, 0x58, 0x03, 0xab, 0x73, 0x3d, 0x08, 0xfa, 0x5d, 0x11, 0x38, 0x0c,
+	0x6d, 0xa8, 0x06, 0xfd, 0x76, 0x3f, 0x70, 0xca, 0xde, 0x07, 0x03, 0x5c, 0x96, 0x8f, 0x66, 0x5a,
+	0x45, 0x84, 0x08, 0x95, 0xc4, 0x42, 0xee, 0x38, 0x9d, 0x93, 0x9b, 0x92, 0x61, 0x66, 0xd4, 0x16,
+	0xe9, 0xbc, 0x92, 0xef, 0xdf, 0x6a, 0x3e, 0x17, 0x36, 0x46, 0x5a, 0xc5, 0xa4, 0x62, 0xb7, 0x52,
+	0x67, 0x7e, 0x4d, 0x14, 0x2b, 0xee, 0x42, 0x95, 0x8c, 0xd1, 0xc6, 0xad, 0xa6, 0x9c, 0x6c, 0x69,
+	0xbd, 0x2f, 0x77, 0x18, 0x90, 0x59, 0x4c, 0x47, 0x84, 0x4f, 0xe0, 0x04, 0xb1, 0x21, 0x19, 0xfe,
+	0x3c, 0xe1, 0xd1, 0x6f, 0x35, 0x65, 0x39, 0xf6, 0x0e, 0xff, 0x54, 0xa7, 0x57, 0xf2, 0xd9, 0x31,
+	0x3b, 0x83, 0x07, 0xab, 0xc0, 0x3c, 0xfe, 0x4f, 0x7f, 0xfa, 0xe4, 0x6b, 0x00, 0x40, 0xe8, 0x78,
+	0x3c, 0x0c, 0x02, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// EnvoyAdminServiceClient is the client API for EnvoyAdminService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type EnvoyAdminServiceClient interface {
+	// StreamEnvoyAdmin is a reverse stream opened by kuma-dp.
+	//
+	// kuma-dp identifies a Dataplane in the first message of a stream.
+	// After that, the Control Plane sends requests whenever it needs data
+	// from Envoy and kuma-dp replies with responses.
+	StreamEnvoyAdmin(ctx context.Context, opts ...grpc.CallOption) (EnvoyAdminService_StreamEnvoyAdminClient, error)
+}
+
+type envoyAdminServiceClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewEnvoyAdminServiceClient(cc *grpc.ClientConn) EnvoyAdminServiceClient {
+	return &envoyAdminServiceClient{cc}
+}
+
+func (c *envoyAdminServiceClient) StreamEnvoyAdmin(ctx context.Context, opts ...grpc.CallOption) (EnvoyAdminService_StreamEnvoyAdminClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_EnvoyAdminService_serviceDesc.Streams[0], "/kuma.mesh.v1alpha1.EnvoyAdminService/StreamEnvoyAdmin", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &envoyAdminServiceStreamEnvoyAdminClient{stream}
+	return x, nil
+}
+
+type EnvoyAdminService_StreamEnvoyAdminClient interface {
+	Send(*EnvoyAdminResponse) error
+	Recv() (*EnvoyAdminRequest, error)
+	grpc.ClientStream
+}
+
+type envoyAdminServiceStreamEnvoyAdminClient struct {
+	grpc.ClientStream
+}
+
+func (x *envoyAdminServiceStreamEnvoyAdminClient) Send(m *EnvoyAdminResponse) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *envoyAdminServiceStreamEnvoyAdminClient) Recv() (*EnvoyAdminRequest, error) {
+	m := new(EnvoyAdminRequest)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// EnvoyAdminServiceServer is the server API for EnvoyAdminService service.
+type EnvoyAdminServiceServer interface {
+	// StreamEnvoyAdmin is a reverse stream opened by kuma-dp.
+	//
+	// kuma-dp identifies a Dataplane in the first message of a stream.
+	// After that, the Control Plane sends requests whenever it needs data
+	// from Envoy and kuma-dp replies with responses.
+	StreamEnvoyAdmin(EnvoyAdminService_StreamEnvoyAdminServer) error
+}
+
+// UnimplementedEnvoyAdminServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedEnvoyAdminServiceServer struct {
+}
+
+func (*UnimplementedEnvoyAdminServiceServer) StreamEnvoyAdmin(srv EnvoyAdminService_StreamEnvoyAdminServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamEnvoyAdmin not implemented")
+}
+
+func RegisterEnvoyAdminServiceServer(s *grpc.Server, srv EnvoyAdminServiceServer) {
+	s.RegisterService(&_EnvoyAdminService_serviceDesc, srv)
+}
+
+func _EnvoyAdminService_StreamEnvoyAdmin_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(EnvoyAdminServiceServer).StreamEnvoyAdmin(&envoyAdminServiceStreamEnvoyAdminServer{stream})
+}
+
+type EnvoyAdminService_StreamEnvoyAdminServer interface {
+	Send(*EnvoyAdminRequest) error
+	Recv() (*EnvoyAdminResponse, error)
+	grpc.ServerStream
+}
+
+type envoyAdminServiceStreamEnvoyAdminServer struct {
+	grpc.ServerStream
+}
+
+func (x *envoyAdminServiceStreamEnvoyAdminServer) Send(m *EnvoyAdminRequest) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *envoyAdminServiceStreamEnvoyAdminServer) Recv() (*EnvoyAdminResponse, error) {
+	m := new(EnvoyAdminResponse)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+var _EnvoyAdminService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "kuma.mesh.v1alpha1.EnvoyAdminService",
+	HandlerType: (*EnvoyAdminServiceServer)(nil),
+	Methods:     []grpc.MethodDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamEnvoyAdmin",
+			Handler:       _EnvoyAdminService_StreamEnvoyAdmin_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+	},
+	Metadata: "mesh/v1alpha1/envoy_admin.proto",
+}
@@ -0,0 +1,246 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: mesh/v1alpha1/external_service.proto
+
+package v1alpha1
+
+import (
+	fmt "fmt"
+	proto "github.com/golang/protobuf/proto"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
+
+// ExternalService defines configuration of a service that is outside of the
+// mesh, e.g. a managed database or a third-party API. If mTLS is enabled
+// in a Mesh, dataplanes can reach an external service only if a
+// TrafficPermission allows it. Without mTLS, TrafficPermissions are not in
+// effect and every dataplane of the Mesh can reach an external service.
+type ExternalService struct {
+	// Networking of the external service.
+	Networking *ExternalService_Networking `protobuf:"bytes,1,opt,name=networking,proto3" json:"networking,omitempty"`
+	// Tags associated with the external service, e.g. service and protocol.
+	// An external service is ignored if its service is already provided by
+	// Dataplanes or by another external service with different TLS settings.
+	Tags                 map[string]string `protobuf:"bytes,2,rep,name=tags,proto3" json:"tags,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *ExternalService) Reset()         { *m = ExternalService{} }
+func (m *ExternalService) String() string { return proto.CompactTextString(m) }
+func (*ExternalService) ProtoMessage()    {}
+func (*ExternalService) Descriptor() ([]byte, []int) {
+	return fileDescriptor_71e5469053c43f96, []int{0}
+}
+
+func (m *ExternalService) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExternalService.Unmarshal(m, b)
+}
+func (m *ExternalService) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExternalService.Marshal(b, m, deterministic)
+}
+func (m *ExternalService) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExternalService.Merge(m, src)
+}
+func (m *ExternalService) XXX_Size() int {
+	return xxx_messageInfo_ExternalService.Size(m)
+}
+func (m *ExternalService) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExternalService.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExternalService proto.InternalMessageInfo
+
+func (m *ExternalService) GetNetworking() *ExternalService_Networking {
+	if m != nil {
+		return m.Networking
+	}
+	return nil
+}
+
+func (m *ExternalService) GetTags() map[string]string {
+	if m != nil {
+		return m.Tags
+	}
+	return nil
+}
+
+// Networking defines how to reach the external service.
+type ExternalService_Networking struct {
+	// Address of the external service in the form of host:port, where host
+	// is either a DNS name or an IP address.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// Configuration of TLS origination.
+	Tls                  *ExternalService_Networking_TLS `protobuf:"bytes,2,opt,name=tls,proto3" json:"tls,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                        `json:"-"`
+	XXX_unrecognized     []byte                          `json:"-"`
+	XXX_sizecache        int32                           `json:"-"`
+}
+
+func (m *ExternalService_Networking) Reset()         { *m = ExternalService_Networking{} }
+func (m *ExternalService_Networking) String() string { return proto.CompactTextString(m) }
+func (*ExternalService_Networking) ProtoMessage()    {}
+func (*ExternalService_Networking) Descriptor() ([]byte, []int) {
+	return fileDescriptor_71e5469053c43f96, []int{0, 0}
+}
+
+func (m *ExternalService_Networking) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExternalService_Networking.Unmarshal(m, b)
+}
+func (m *ExternalService_Networking) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExternalService_Networking.Marshal(b, m, deterministic)
+}
+func (m *ExternalService_Networking) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExternalService_Networking.Merge(m, src)
+}
+func (m *ExternalService_Networking) XXX_Size() int {
+	return xxx_messageInfo_ExternalService_Networking.Size(m)
+}
+func (m *ExternalService_Networking) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExternalService_Networking.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExternalService_Networking proto.InternalMessageInfo
+
+func (m *ExternalService_Networking) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *ExternalService_Networking) GetTls() *ExternalService_Networking_TLS {
+	if m != nil {
+		return m.Tls
+	}
+	return nil
+}
+
+// TLS defines configuration of TLS origination.
+type ExternalService_Networking_TLS struct {
+	// Whether TLS should be originated by dataplanes.
+	Enabled bool `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// Server name used for SNI and for verification of the certificate
+	// presented by the external service, by default the host of the
+	// address.
+	ServerName string `protobuf:"bytes,2,opt,name=server_name,json=serverName,proto3" json:"server_name,omitempty"`
+	// Name of a Secret with a PEM-encoded CA bundle used to verify the
+	// certificate presented by the external service. Required when TLS is
+	// enabled, since the certificate is always verified.
+	CaCertSecret string `protobuf:"bytes,3,opt,name=ca_cert_secret,json=caCertSecret,proto3" json:"ca_cert_secret,omitempty"`
+	// Name of a Secret with a PEM-encoded client certificate.
+	ClientCertSecret string `protobuf:"bytes,4,opt,name=client_cert_secret,json=clientCertSecret,proto3" json:"client_cert_secret,omitempty"`
+	// Name of a Secret with a PEM-encoded client key.
+	ClientKeySecret      string   `protobuf:"bytes,5,opt,name=client_key_secret,json=clientKeySecret,proto3" json:"client_key_secret,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ExternalService_Networking_TLS) Reset()         { *m = ExternalService_Networking_TLS{} }
+func (m *ExternalService_Networking_TLS) String() string { return proto.CompactTextString(m) }
+func (*ExternalService_Networking_TLS) ProtoMessage()    {}
+func (*ExternalService_Networking_TLS) Descriptor() ([]byte, []int) {
+	return fileDescriptor_71e5469053c43f96, []int{0, 0, 0}
+}
+
+func (m *ExternalService_Networking_TLS) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExternalService_Networking_TLS.Unmarshal(m, b)
+}
+func (m *ExternalService_Networking_TLS) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExternalService_Networking_TLS.Marshal(b, m, deterministic)
+}
+func (m *ExternalService_Networking_TLS) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExternalService_Networking_TLS.Merge(m, src)
+}
+func (m *ExternalService_Networking_TLS) XXX_Size() int {
+	return xxx_messageInfo_ExternalService_Networking_TLS.Size(m)
+}
+func (m *ExternalService_Networking_TLS) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExternalService_Networking_TLS.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExternalService_Networking_TLS proto.InternalMessageInfo
+
+func (m *ExternalService_Networking_TLS) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
+	}
+	return false
+}
+
+func (m *ExternalService_Networking_TLS) GetServerName() string {
+	if m != nil {
+		return m.ServerName
+	}
+	return ""
+}
+
+func (m *ExternalService_Networking_TLS) GetCaCertSecret() string {
+	if m != nil {
+		return m.CaCertSecret
+	}
+	return ""
+}
+
+func (m *ExternalService_Networking_TLS) GetClientCertSecret() string {
+	if m != nil {
+		return m.ClientCertSecret
+	}
+	return ""
+}
+
+func (m *ExternalService_Networking_TLS) GetClientKeySecret() string {
+	if m != nil {
+		return m.ClientKeySecret
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*ExternalService)(nil), "kuma.mesh.v1alpha1.ExternalService")
+	proto.RegisterMapType((map[string]string)(nil), "kuma.mesh.v1alpha1.ExternalService.TagsEntry")
+	proto.RegisterType((*ExternalService_Networking)(nil), "kuma.mesh.v1alpha1.ExternalService.Networking")
+	proto.RegisterType((*ExternalService_Networking_TLS)(nil), "kuma.mesh.v1alpha1.ExternalService.Networking.TLS")
+}
+
+func init() {
+	proto.RegisterFile("mesh/v1alpha1/external_service.proto", fileDescriptor_71e5469053c43f96)
+}
+
+var fileDescriptor_71e5469053c43f96 = []byte{
+	// 344 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x92, 0xcf, 0x4e, 0xea, 0x40,
+	0x14, 0xc6, 0x53, 0x0a, 0xf7, 0xc2, 0xe1, 0xe6, 0xc2, 0x9d, 0xdc, 0x45, 0xc3, 0x46, 0x62, 0x58,
+	0x10, 0xa3, 0x43, 0xc0, 0x85, 0xc6, 0x9d, 0x7f, 0x58, 0x49, 0x58, 0xb4, 0xac, 0xdc, 0x34, 0x87,
+	0x72, 0x02, 0xa4, 0xed, 0x40, 0x66, 0x06, 0xb4, 0x4f, 0xe1, 0xa3, 0xf8, 0x0a, 0x3e, 0x9a, 0x99,
+	0x29, 0x45, 0xd4, 0x8d, 0xee, 0x7a, 0xe6, 0xfc, 0xbe, 0xf3, 0x7d, 0xf9, 0x52, 0xe8, 0xa4, 0xa4,
+	0x16, 0xbd, 0x6d, 0x1f, 0x93, 0xf5, 0x02, 0xfb, 0x3d, 0x7a, 0xd2, 0x24, 0x05, 0x26, 0xa1, 0x22,
+	0xb9, 0x5d, 0x46, 0xc4, 0xd7, 0x72, 0xa5, 0x57, 0x8c, 0xc5, 0x9b, 0x14, 0xb9, 0x41, 0x79, 0x81,
+	0x1e, 0x3f, 0x97, 0xa1, 0x31, 0xdc, 0xe1, 0x41, 0x4e, 0xb3, 0x31, 0x80, 0x20, 0xfd, 0xb8, 0x92,
+	0xf1, 0x52, 0xcc, 0x3d, 0xa7, 0xed, 0x74, 0xeb, 0x03, 0xce, 0xbf, 0x8a, 0xf9, 0x27, 0x21, 0x1f,
+	0xef, 0x55, 0xfe, 0xc1, 0x05, 0x76, 0x0d, 0x65, 0x8d, 0x73, 0xe5, 0x95, 0xda, 0x6e, 0xb7, 0x3e,
+	0x38, 0xfb, 0xce, 0xa5, 0x09, 0xce, 0xd5, 0x50, 0x68, 0x99, 0xf9, 0x56, 0xda, 0x7a, 0x29, 0x01,
+	0xbc, 0x5f, 0x67, 0x1e, 0xfc, 0xc6, 0xd9, 0x4c, 0x92, 0x52, 0x36, 0x5e, 0xcd, 0x2f, 0x46, 0x76,
+	0x07, 0xae, 0x4e, 0x8c, 0x95, 0x09, 0x3d, 0xf8, 0x59, 0x68, 0x3e, 0x19, 0x05, 0xbe, 0x91, 0xb7,
+	0x5e, 0x1d, 0x70, 0x27, 0xa3, 0xc0, 0xf8, 0x90, 0xc0, 0x69, 0x42, 0x33, 0xeb, 0x53, 0xf5, 0x8b,
+	0x91, 0x1d, 0x41, 0xdd, 0x94, 0x4b, 0x32, 0x14, 0x98, 0x92, 0xf5, 0xab, 0xf9, 0x90, 0x3f, 0x8d,
+	0x31, 0x25, 0xd6, 0x81, 0xbf, 0x11, 0x86, 0x11, 0x49, 0x1d, 0x2a, 0x8a, 0x24, 0x69, 0xcf, 0xb5,
+	0xcc, 0x9f, 0x08, 0x6f, 0x49, 0xea, 0xc0, 0xbe, 0xb1, 0x53, 0x60, 0x51, 0xb2, 0x24, 0xa1, 0x3f,
+	0x90, 0x65, 0x4b, 0x36, 0xf3, 0xcd, 0x01, 0x7d, 0x02, 0xff, 0x76, 0x74, 0x4c, 0x59, 0x01, 0x57,
+	0x2c, 0xdc, 0xc8, 0x17, 0xf7, 0x94, 0xe5, 0x6c, 0xeb, 0x02, 0x6a, 0xfb, 0x12, 0x59, 0x13, 0xdc,
+	0x98, 0xb2, 0x5d, 0x57, 0xe6, 0x93, 0xfd, 0x87, 0xca, 0x16, 0x93, 0x4d, 0x91, 0x3c, 0x1f, 0xae,
+	0x4a, 0x97, 0xce, 0x0d, 0x3c, 0x54, 0x8b, 0xae, 0xa6, 0xbf, 0xec, 0x8f, 0x73, 0xfe, 0x36, 0x00,
+	0x55, 0x6f, 0x95, 0x07, 0x60, 0x02, 0x00, 0x00,
+}
@@ -0,0 +1,39 @@
+package v1alpha1
+
+import (
+	"net"
+
+	"github.com/pkg/errors"
+)
+
+// GetHostAndPort returns host and port of the external service.
+func (n *ExternalService_Networking) GetHostAndPort() (string, uint32, error) {
+	host, port, err := net.SplitHostPort(n.GetAddress())
+	if err != nil {
+		return "", 0, err
+	}
+	if host == "" {
+		return "", 0, errors.New("host must be non-empty")
+	}
+	iport, err := ParsePort(port)
+	if err != nil {
+		return "", 0, err
+	}
+	return host, iport, nil
+}
+
+func (e *ExternalService) GetService() string {
+	return e.GetTags()[ServiceTag]
+}
+
+func (e *ExternalService) MatchTags(selector TagSelector) bool {
+	return selector.Matches(e.GetTags())
+}
+
+func (e *ExternalService) TagSet() MultiValueTagSet {
+	tags := MultiValueTagSet{}
+	for tag, value := range e.GetTags() {
+		tags[tag] = map[string]bool{value: true}
+	}
+	return tags
+}
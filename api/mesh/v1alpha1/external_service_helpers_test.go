@@ -0,0 +1,84 @@
+package v1alpha1_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+
+	. "github.com/Kong/kuma/api/mesh/v1alpha1"
+)
+
+var _ = Describe("ExternalService_Networking", func() {
+
+	Describe("GetHostAndPort()", func() {
+
+		type testCase struct {
+			address      string
+			expectedHost string
+			expectedPort uint32
+		}
+
+		DescribeTable("should parse valid addresses",
+			func(given testCase) {
+				// given
+				networking := &ExternalService_Networking{Address: given.address}
+
+				// when
+				host, port, err := networking.GetHostAndPort()
+
+				// then
+				Expect(err).ToNot(HaveOccurred())
+				// and
+				Expect(host).To(Equal(given.expectedHost))
+				Expect(port).To(Equal(given.expectedPort))
+			},
+			Entry("DNS name", testCase{
+				address:      "api.example.com:443",
+				expectedHost: "api.example.com",
+				expectedPort: 443,
+			}),
+			Entry("IPv4 address", testCase{
+				address:      "192.168.0.1:5432",
+				expectedHost: "192.168.0.1",
+				expectedPort: 5432,
+			}),
+			Entry("IPv6 address", testCase{
+				address:      "[::1]:8080",
+				expectedHost: "::1",
+				expectedPort: 8080,
+			}),
+		)
+
+		type errorCase struct {
+			address     string
+			expectedErr string
+		}
+
+		DescribeTable("should fail on invalid addresses",
+			func(given errorCase) {
+				// given
+				networking := &ExternalService_Networking{Address: given.address}
+
+				// when
+				_, _, err := networking.GetHostAndPort()
+
+				// then
+				Expect(err).To(HaveOccurred())
+				// and
+				Expect(err.Error()).To(Equal(given.expectedErr))
+			},
+			Entry("no port", errorCase{
+				address:     "api.example.com",
+				expectedErr: "address api.example.com: missing port in address",
+			}),
+			Entry("no host", errorCase{
+				address:     ":443",
+				expectedErr: "host must be non-empty",
+			}),
+			Entry("port out of range", errorCase{
+				address:     "api.example.com:65536",
+				expectedErr: "port number must be in the range [1, 65535] but got 65536",
+			}),
+		)
+	})
+})
@@ -82,7 +82,15 @@ func (m *FaultInjection) GetConf() *FaultInjection_Conf {
 }
 
 // Conf defines several types of faults, at least one fault should be
-// specified
+// specified.
+//
+// Faults of TCP services (destinations tagged with `protocol: tcp`) are
+// injected on the level of connections: Delay postpones forwarding of data
+// sent over a new connection and Abort closes a new connection once it
+// sends data. Since a destination cannot tell sources of TCP connections
+// apart, faults of TCP services have to be injected on the `source` side.
+// ResponseBandwidth of TCP services limits the speed at which data is sent
+// back over a connection.
 type FaultInjection_Conf struct {
 	// Delay if specified then response from the destination will be delivered
 	// with a delay
@@ -90,10 +98,24 @@ type FaultInjection_Conf struct {
 	// Abort if specified makes source side to receive specified httpStatus code
 	Abort *FaultInjection_Conf_Abort `protobuf:"bytes,2,opt,name=abort,proto3" json:"abort,omitempty"`
 	// ResponseBandwidth if specified limits the speed of sending response body
-	ResponseBandwidth    *FaultInjection_Conf_ResponseBandwidth `protobuf:"bytes,3,opt,name=response_bandwidth,json=responseBandwidth,proto3" json:"response_bandwidth,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                               `json:"-"`
-	XXX_unrecognized     []byte                                 `json:"-"`
-	XXX_sizecache        int32                                  `json:"-"`
+	ResponseBandwidth *FaultInjection_Conf_ResponseBandwidth `protobuf:"bytes,3,opt,name=response_bandwidth,json=responseBandwidth,proto3" json:"response_bandwidth,omitempty"`
+	// Side defines where faults are injected. Possible values are
+	// `destination` (default) to inject faults on the inbound listener of a
+	// destination dataplane and `source` to inject faults on the outbound
+	// listener of a source dataplane, so that a destination fails only for
+	// the callers matched by sources. Faults of external services are always
+	// injected on the `source` side.
+	Side string `protobuf:"bytes,4,opt,name=side,proto3" json:"side,omitempty"`
+	// HeaderControlled if true makes faults of HTTP services injected only
+	// into requests that ask for them with Envoy fault headers:
+	// `x-envoy-fault-delay-request` (delay in milliseconds),
+	// `x-envoy-fault-abort-request` (any value, httpStatus is returned) and
+	// `x-envoy-fault-throughput-response` (limit in kbps). Percentages still
+	// apply, while value and limit must not be set.
+	HeaderControlled     bool     `protobuf:"varint,5,opt,name=headerControlled,proto3" json:"headerControlled,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *FaultInjection_Conf) Reset()         { *m = FaultInjection_Conf{} }
@@ -142,6 +164,20 @@ func (m *FaultInjection_Conf) GetResponseBandwidth() *FaultInjection_Conf_Respon
 	return nil
 }
 
+func (m *FaultInjection_Conf) GetSide() string {
+	if m != nil {
+		return m.Side
+	}
+	return ""
+}
+
+func (m *FaultInjection_Conf) GetHeaderControlled() bool {
+	if m != nil {
+		return m.HeaderControlled
+	}
+	return false
+}
+
 // Delay defines configuration of delaying a response from a destination
 type FaultInjection_Conf_Delay struct {
 	// Percentage of requests on which delay will be injected, has to be in
@@ -200,7 +236,8 @@ type FaultInjection_Conf_Abort struct {
 	// Percentage of requests on which abort will be injected, has to be in
 	// [0.0 - 100.0] range
 	Percentage *wrappers.DoubleValue `protobuf:"bytes,1,opt,name=percentage,proto3" json:"percentage,omitempty"`
-	// HTTP status code which will be returned to source side
+	// HTTP status code which will be returned to source side. Must not be
+	// set for TCP services
 	HttpStatus           *wrappers.UInt32Value `protobuf:"bytes,2,opt,name=httpStatus,proto3" json:"httpStatus,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
 	XXX_unrecognized     []byte                `json:"-"`
@@ -312,31 +349,33 @@ func init() {
 }
 
 var fileDescriptor_ff4d722195e1e7eb = []byte{
-	// 412 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x92, 0x4f, 0x8b, 0xd4, 0x40,
-	0x10, 0xc5, 0xc9, 0xee, 0x66, 0x95, 0x5a, 0x11, 0xb6, 0x4f, 0x31, 0x0c, 0xb2, 0xe8, 0xc1, 0xbd,
-	0xd8, 0x61, 0xb3, 0x20, 0x88, 0x7b, 0xd0, 0xdd, 0x45, 0x98, 0x6b, 0x0f, 0x7a, 0xf0, 0x32, 0x74,
-	0x92, 0xca, 0x1f, 0xed, 0xe9, 0x0e, 0xfd, 0x67, 0x06, 0x6f, 0x82, 0xf8, 0xe5, 0xfc, 0x54, 0x92,
-	0xa4, 0xc3, 0x38, 0x46, 0x61, 0x64, 0x8e, 0xe9, 0x7a, 0xbf, 0x57, 0xaf, 0x2a, 0x05, 0xcf, 0x57,
-	0x68, 0xea, 0x64, 0x7d, 0xc5, 0x45, 0x5b, 0xf3, 0xab, 0xa4, 0xe4, 0x4e, 0xd8, 0x65, 0x23, 0x3f,
-	0x63, 0x6e, 0x1b, 0x25, 0x69, 0xab, 0x95, 0x55, 0x84, 0x7c, 0x71, 0x2b, 0x4e, 0x3b, 0x25, 0x1d,
-	0x95, 0xf1, 0xd3, 0x4a, 0xa9, 0x4a, 0x60, 0xd2, 0x2b, 0x32, 0x57, 0x26, 0x85, 0xd3, 0x7c, 0xcb,
-	0xc4, 0xb3, 0x5d, 0x63, 0x83, 0x02, 0x73, 0xab, 0xb4, 0xaf, 0x4e, 0xe8, 0x8d, 0xe6, 0x6d, 0x8b,
-	0xda, 0x0c, 0xf5, 0x67, 0x3f, 0x4f, 0xe1, 0xf1, 0xfb, 0x2e, 0xcb, 0x7c, 0x8c, 0x42, 0x5e, 0xc1,
-	0x03, 0xa3, 0x9c, 0xce, 0xd1, 0x44, 0xc1, 0xc5, 0xf1, 0xe5, 0x59, 0x3a, 0xa3, 0xd3, 0x58, 0x74,
-	0xe1, 0xfb, 0xb0, 0x51, 0x4c, 0xde, 0xc2, 0xa3, 0x02, 0x8d, 0x6d, 0x64, 0x9f, 0xce, 0x44, 0x47,
-	0x7b, 0xc0, 0x3b, 0x04, 0x79, 0x03, 0x27, 0xb9, 0x92, 0x65, 0x74, 0x7c, 0x11, 0x5c, 0x9e, 0xa5,
-	0x2f, 0xfe, 0x46, 0xee, 0x66, 0xa5, 0x77, 0x4a, 0x96, 0xac, 0x87, 0xe2, 0x6f, 0x21, 0x9c, 0x74,
-	0x9f, 0xe4, 0x0e, 0xc2, 0x02, 0x05, 0xff, 0x1a, 0x05, 0xbd, 0xcd, 0xcb, 0x3d, 0x6d, 0xe8, 0x7d,
-	0x07, 0xb1, 0x81, 0xed, 0x4c, 0x78, 0xa6, 0xb4, 0x8d, 0x8e, 0xfe, 0xcf, 0xe4, 0x5d, 0x07, 0xb1,
-	0x81, 0x25, 0x35, 0x10, 0x8d, 0xa6, 0x55, 0xd2, 0xe0, 0x32, 0xe3, 0xb2, 0xd8, 0x34, 0x85, 0xad,
-	0xfd, 0x74, 0xaf, 0xf7, 0x75, 0x64, 0xde, 0xe1, 0x76, 0x34, 0x60, 0xe7, 0xfa, 0xcf, 0xa7, 0x78,
-	0x0d, 0x61, 0x1f, 0x9f, 0xdc, 0x00, 0xb4, 0xa8, 0x73, 0x94, 0x96, 0x57, 0xe8, 0x37, 0x30, 0xa3,
-	0xc3, 0x11, 0xd0, 0xf1, 0x08, 0xe8, 0xbd, 0x72, 0x99, 0xc0, 0x8f, 0x5c, 0x38, 0x64, 0xbf, 0xe9,
-	0x49, 0x02, 0xe1, 0xba, 0x7b, 0xf4, 0x53, 0x3f, 0x99, 0x82, 0xfe, 0xf6, 0xd8, 0xa0, 0x8b, 0xbf,
-	0x07, 0x10, 0xf6, 0x23, 0x1f, 0xd8, 0xf8, 0x06, 0xa0, 0xb6, 0xb6, 0x5d, 0x58, 0x6e, 0x9d, 0xf1,
-	0xdd, 0xa7, 0xf4, 0x87, 0xb9, 0xb4, 0xd7, 0xa9, 0xa7, 0xb7, 0xfa, 0xf8, 0x47, 0x00, 0xe7, 0x93,
-	0x35, 0x1d, 0x98, 0x28, 0x85, 0x50, 0x34, 0xab, 0xc6, 0xfe, 0x33, 0xcc, 0xc2, 0xea, 0x46, 0x56,
-	0x03, 0x38, 0x48, 0x6f, 0xe1, 0xd3, 0xc3, 0xf1, 0x57, 0x66, 0xa7, 0xbd, 0xf0, 0xfa, 0x57, 0x00,
-	0x00, 0x00, 0xff, 0xff, 0xeb, 0x09, 0x7e, 0x32, 0xf8, 0x03, 0x00, 0x00,
+	// 445 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x92, 0x4f, 0x6b, 0xd4, 0x40,
+	0x18, 0xc6, 0xc9, 0x76, 0x63, 0xeb, 0x5b, 0x11, 0x3b, 0xa7, 0x18, 0x16, 0x59, 0xf4, 0xe0, 0x22,
+	0x38, 0xa1, 0x5b, 0x10, 0xc4, 0x1e, 0xb4, 0x5b, 0x84, 0x5e, 0x67, 0xd1, 0x83, 0x97, 0x32, 0x49,
+	0xde, 0x4d, 0xa2, 0xb3, 0x33, 0x61, 0xe6, 0xcd, 0x16, 0xcf, 0xe2, 0xc5, 0x4f, 0xe5, 0x47, 0x93,
+	0xfc, 0xa3, 0xae, 0xb1, 0xb0, 0x65, 0x6f, 0xc9, 0x3b, 0xcf, 0xef, 0x99, 0x67, 0x5e, 0x1e, 0x78,
+	0xb1, 0x46, 0x97, 0x47, 0x9b, 0x53, 0xa9, 0xca, 0x5c, 0x9e, 0x46, 0x2b, 0x59, 0x29, 0xba, 0x2e,
+	0xf4, 0x57, 0x4c, 0xa8, 0x30, 0x9a, 0x97, 0xd6, 0x90, 0x61, 0xec, 0x5b, 0xb5, 0x96, 0xbc, 0x56,
+	0xf2, 0x5e, 0x19, 0x3e, 0xcb, 0x8c, 0xc9, 0x14, 0x46, 0x8d, 0x22, 0xae, 0x56, 0x51, 0x5a, 0x59,
+	0x79, 0xcb, 0x84, 0x93, 0x6d, 0x63, 0x87, 0x0a, 0x13, 0x32, 0xb6, 0x3b, 0x1d, 0xd0, 0x37, 0x56,
+	0x96, 0x25, 0x5a, 0xd7, 0x9e, 0x3f, 0xff, 0x75, 0x08, 0x8f, 0x3f, 0xd6, 0x59, 0xae, 0xfa, 0x28,
+	0xec, 0x0d, 0x1c, 0x3a, 0x53, 0xd9, 0x04, 0x5d, 0xe0, 0x4d, 0x0f, 0x66, 0xc7, 0xf3, 0x09, 0x1f,
+	0xc6, 0xe2, 0xcb, 0xee, 0x1e, 0xd1, 0x8b, 0xd9, 0x7b, 0x78, 0x94, 0xa2, 0xa3, 0x42, 0x37, 0xe9,
+	0x5c, 0x30, 0xda, 0x01, 0xde, 0x22, 0xd8, 0x3b, 0x18, 0x27, 0x46, 0xaf, 0x82, 0x83, 0xa9, 0x37,
+	0x3b, 0x9e, 0xbf, 0xfc, 0x1f, 0xb9, 0x9d, 0x95, 0x2f, 0x8c, 0x5e, 0x89, 0x06, 0x0a, 0x7f, 0xfb,
+	0x30, 0xae, 0x7f, 0xd9, 0x02, 0xfc, 0x14, 0x95, 0xfc, 0x1e, 0x78, 0x8d, 0xcd, 0xeb, 0x1d, 0x6d,
+	0xf8, 0x65, 0x0d, 0x89, 0x96, 0xad, 0x4d, 0x64, 0x6c, 0x2c, 0x05, 0xa3, 0xfb, 0x99, 0x7c, 0xa8,
+	0x21, 0xd1, 0xb2, 0x2c, 0x07, 0x66, 0xd1, 0x95, 0x46, 0x3b, 0xbc, 0x8e, 0xa5, 0x4e, 0x6f, 0x8a,
+	0x94, 0xf2, 0xee, 0x75, 0x6f, 0x77, 0x75, 0x14, 0x9d, 0xc3, 0x45, 0x6f, 0x20, 0x4e, 0xec, 0xbf,
+	0x23, 0xc6, 0x60, 0xec, 0x8a, 0x14, 0x83, 0xf1, 0xd4, 0x9b, 0x3d, 0x14, 0xcd, 0x37, 0x7b, 0x05,
+	0x4f, 0x72, 0x94, 0x29, 0xda, 0x85, 0xd1, 0x64, 0x8d, 0x52, 0x98, 0x06, 0xfe, 0xd4, 0x9b, 0x1d,
+	0x89, 0xc1, 0x3c, 0xdc, 0x80, 0xdf, 0x3c, 0x9f, 0x9d, 0x03, 0x94, 0x68, 0x13, 0xd4, 0x24, 0x33,
+	0xec, 0x36, 0x38, 0xe1, 0x6d, 0x89, 0x78, 0x5f, 0x22, 0x7e, 0x69, 0xaa, 0x58, 0xe1, 0x67, 0xa9,
+	0x2a, 0x14, 0x7f, 0xe9, 0x59, 0x04, 0xfe, 0xa6, 0x1e, 0x76, 0x5b, 0x7b, 0x3a, 0x04, 0xbb, 0xee,
+	0x8a, 0x56, 0x17, 0xfe, 0xf0, 0xc0, 0x6f, 0x56, 0xb6, 0xe7, 0xc5, 0xe7, 0x00, 0x39, 0x51, 0xb9,
+	0x24, 0x49, 0x95, 0x0b, 0x46, 0x77, 0xd0, 0x9f, 0xae, 0x34, 0x9d, 0xcd, 0x3b, 0xfa, 0x56, 0x1f,
+	0xfe, 0xf4, 0xe0, 0x64, 0xb0, 0xe6, 0x3d, 0x13, 0xcd, 0xc1, 0x57, 0xc5, 0xba, 0xa0, 0x3b, 0xc3,
+	0x2c, 0xc9, 0x16, 0x3a, 0x6b, 0xc1, 0x56, 0x7a, 0x01, 0x5f, 0x8e, 0xfa, 0x2a, 0xc4, 0x0f, 0x1a,
+	0xe1, 0xd9, 0x9f, 0x01, 0x00, 0xd4, 0xd4, 0x93, 0x72, 0x38, 0x04, 0x00, 0x00,
 }
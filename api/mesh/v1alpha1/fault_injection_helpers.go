@@ -6,3 +6,25 @@ func (m *FaultInjection) SourceTags() (setList []SingleValueTagSet) {
 	}
 	return
 }
+
+const (
+	// FaultInjectionSideDestination makes faults injected on the inbound listener of a destination dataplane.
+	FaultInjectionSideDestination = "destination"
+	// FaultInjectionSideSource makes faults injected on the outbound listener of a source dataplane.
+	FaultInjectionSideSource = "source"
+)
+
+// IsSourceSide returns true if faults should be injected on the outbound listener of a source dataplane.
+func (m *FaultInjection) IsSourceSide() bool {
+	return m.GetConf().GetSide() == FaultInjectionSideSource
+}
+
+// DestinationProtocol returns a protocol of destinations the faults are injected into.
+func (m *FaultInjection) DestinationProtocol() string {
+	for _, selector := range m.GetDestinations() {
+		if protocol, ok := selector.GetMatch()[ProtocolTag]; ok {
+			return protocol
+		}
+	}
+	return ""
+}
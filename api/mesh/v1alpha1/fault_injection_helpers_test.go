@@ -51,4 +51,49 @@ var _ = Describe("FaultInjectionHelper", func() {
 				},
 			}))
 	})
+
+	Describe("IsSourceSide", func() {
+		It("should be false by default", func() {
+			Expect((&FaultInjection{Conf: &FaultInjection_Conf{}}).IsSourceSide()).To(BeFalse())
+		})
+
+		It("should be true for the source side", func() {
+			// given
+			fi := &FaultInjection{
+				Conf: &FaultInjection_Conf{
+					Side: FaultInjectionSideSource,
+				},
+			}
+
+			// expect
+			Expect(fi.IsSourceSide()).To(BeTrue())
+		})
+	})
+
+	Describe("DestinationProtocol", func() {
+		It("should return protocol of destinations", func() {
+			// given
+			fi := &FaultInjection{
+				Destinations: []*Selector{
+					{Match: SingleValueTagSet{"service": "backend"}},
+					{Match: SingleValueTagSet{"service": "redis", "protocol": "tcp"}},
+				},
+			}
+
+			// expect
+			Expect(fi.DestinationProtocol()).To(Equal("tcp"))
+		})
+
+		It("should return empty string if protocol is not defined", func() {
+			// given
+			fi := &FaultInjection{
+				Destinations: []*Selector{
+					{Match: SingleValueTagSet{"service": "backend"}},
+				},
+			}
+
+			// expect
+			Expect(fi.DestinationProtocol()).To(Equal(""))
+		})
+	})
 })
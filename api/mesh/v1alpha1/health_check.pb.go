@@ -8,7 +8,7 @@ import (
 	_ "github.com/envoyproxy/protoc-gen-validate/validate"
 	proto "github.com/golang/protobuf/proto"
 	duration "github.com/golang/protobuf/ptypes/duration"
-	_ "github.com/golang/protobuf/ptypes/wrappers"
+	wrappers "github.com/golang/protobuf/ptypes/wrappers"
 	math "math"
 )
 
@@ -88,10 +88,14 @@ type HealthCheck_Conf struct {
 	// Configuration for active health checking.
 	ActiveChecks *HealthCheck_Conf_Active `protobuf:"bytes,1,opt,name=active_checks,json=activeChecks,proto3" json:"active_checks,omitempty"`
 	// Configuration for passive health checking.
-	PassiveChecks        *HealthCheck_Conf_Passive `protobuf:"bytes,2,opt,name=passive_checks,json=passiveChecks,proto3" json:"passive_checks,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                  `json:"-"`
-	XXX_unrecognized     []byte                    `json:"-"`
-	XXX_sizecache        int32                     `json:"-"`
+	PassiveChecks *HealthCheck_Conf_Passive `protobuf:"bytes,2,opt,name=passive_checks,json=passiveChecks,proto3" json:"passive_checks,omitempty"`
+	// Percentage of healthy hosts of a service below which health status is
+	// ignored and traffic is balanced across all hosts, by default 50.
+	// Zero value disables the panic mode.
+	HealthyPanicThreshold *wrappers.UInt32Value `protobuf:"bytes,3,opt,name=healthy_panic_threshold,json=healthyPanicThreshold,proto3" json:"healthy_panic_threshold,omitempty"`
+	XXX_NoUnkeyedLiteral  struct{}              `json:"-"`
+	XXX_unrecognized      []byte                `json:"-"`
+	XXX_sizecache         int32                 `json:"-"`
 }
 
 func (m *HealthCheck_Conf) Reset()         { *m = HealthCheck_Conf{} }
@@ -133,6 +137,13 @@ func (m *HealthCheck_Conf) GetPassiveChecks() *HealthCheck_Conf_Passive {
 	return nil
 }
 
+func (m *HealthCheck_Conf) GetHealthyPanicThreshold() *wrappers.UInt32Value {
+	if m != nil {
+		return m.HealthyPanicThreshold
+	}
+	return nil
+}
+
 // Active defines configuration for active health checking.
 type HealthCheck_Conf_Active struct {
 	// Interval between consecutive health checks.
@@ -143,10 +154,16 @@ type HealthCheck_Conf_Active struct {
 	// unhealthy.
 	UnhealthyThreshold uint32 `protobuf:"varint,3,opt,name=unhealthy_threshold,json=unhealthyThreshold,proto3" json:"unhealthy_threshold,omitempty"`
 	// Number of consecutive healthy checks before considering a host healthy.
-	HealthyThreshold     uint32   `protobuf:"varint,4,opt,name=healthy_threshold,json=healthyThreshold,proto3" json:"healthy_threshold,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	HealthyThreshold uint32 `protobuf:"varint,4,opt,name=healthy_threshold,json=healthyThreshold,proto3" json:"healthy_threshold,omitempty"`
+	// Configuration for HTTP health checking. If omitted, TCP health
+	// checking is used.
+	Http *HealthCheck_Conf_Active_Http `protobuf:"bytes,5,opt,name=http,proto3" json:"http,omitempty"`
+	// Interval between consecutive health checks of a service that has not
+	// received any traffic yet, by default 60s.
+	NoTrafficInterval    *duration.Duration `protobuf:"bytes,6,opt,name=no_traffic_interval,json=noTrafficInterval,proto3" json:"no_traffic_interval,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
 }
 
 func (m *HealthCheck_Conf_Active) Reset()         { *m = HealthCheck_Conf_Active{} }
@@ -202,6 +219,192 @@ func (m *HealthCheck_Conf_Active) GetHealthyThreshold() uint32 {
 	return 0
 }
 
+func (m *HealthCheck_Conf_Active) GetHttp() *HealthCheck_Conf_Active_Http {
+	if m != nil {
+		return m.Http
+	}
+	return nil
+}
+
+func (m *HealthCheck_Conf_Active) GetNoTrafficInterval() *duration.Duration {
+	if m != nil {
+		return m.NoTrafficInterval
+	}
+	return nil
+}
+
+// Http defines configuration for HTTP health checking.
+type HealthCheck_Conf_Active_Http struct {
+	// Path of the HTTP endpoint to check, by default "/".
+	Path string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	// Value of the Host header, by default the name of the service.
+	Host string `protobuf:"bytes,2,opt,name=host,proto3" json:"host,omitempty"`
+	// List of status ranges that are considered healthy, by default only
+	// 200.
+	ExpectedStatuses []*HealthCheck_Conf_Active_Http_StatusRange `protobuf:"bytes,3,rep,name=expected_statuses,json=expectedStatuses,proto3" json:"expected_statuses,omitempty"`
+	// List of headers to add to health check requests.
+	RequestHeaders       []*HealthCheck_Conf_Active_Http_Header `protobuf:"bytes,4,rep,name=request_headers,json=requestHeaders,proto3" json:"request_headers,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                               `json:"-"`
+	XXX_unrecognized     []byte                                 `json:"-"`
+	XXX_sizecache        int32                                  `json:"-"`
+}
+
+func (m *HealthCheck_Conf_Active_Http) Reset()         { *m = HealthCheck_Conf_Active_Http{} }
+func (m *HealthCheck_Conf_Active_Http) String() string { return proto.CompactTextString(m) }
+func (*HealthCheck_Conf_Active_Http) ProtoMessage()    {}
+func (*HealthCheck_Conf_Active_Http) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a4f9382814224e98, []int{0, 0, 0, 0}
+}
+
+func (m *HealthCheck_Conf_Active_Http) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_HealthCheck_Conf_Active_Http.Unmarshal(m, b)
+}
+func (m *HealthCheck_Conf_Active_Http) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_HealthCheck_Conf_Active_Http.Marshal(b, m, deterministic)
+}
+func (m *HealthCheck_Conf_Active_Http) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HealthCheck_Conf_Active_Http.Merge(m, src)
+}
+func (m *HealthCheck_Conf_Active_Http) XXX_Size() int {
+	return xxx_messageInfo_HealthCheck_Conf_Active_Http.Size(m)
+}
+func (m *HealthCheck_Conf_Active_Http) XXX_DiscardUnknown() {
+	xxx_messageInfo_HealthCheck_Conf_Active_Http.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HealthCheck_Conf_Active_Http proto.InternalMessageInfo
+
+func (m *HealthCheck_Conf_Active_Http) GetPath() string {
+	if m != nil {
+		return m.Path
+	}
+	return ""
+}
+
+func (m *HealthCheck_Conf_Active_Http) GetHost() string {
+	if m != nil {
+		return m.Host
+	}
+	return ""
+}
+
+func (m *HealthCheck_Conf_Active_Http) GetExpectedStatuses() []*HealthCheck_Conf_Active_Http_StatusRange {
+	if m != nil {
+		return m.ExpectedStatuses
+	}
+	return nil
+}
+
+func (m *HealthCheck_Conf_Active_Http) GetRequestHeaders() []*HealthCheck_Conf_Active_Http_Header {
+	if m != nil {
+		return m.RequestHeaders
+	}
+	return nil
+}
+
+// StatusRange defines a range of HTTP status codes, where start is
+// inclusive and end is exclusive.
+type HealthCheck_Conf_Active_Http_StatusRange struct {
+	// Start of the range (inclusive).
+	Start uint32 `protobuf:"varint,1,opt,name=start,proto3" json:"start,omitempty"`
+	// End of the range (exclusive).
+	End                  uint32   `protobuf:"varint,2,opt,name=end,proto3" json:"end,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *HealthCheck_Conf_Active_Http_StatusRange) Reset() {
+	*m = HealthCheck_Conf_Active_Http_StatusRange{}
+}
+func (m *HealthCheck_Conf_Active_Http_StatusRange) String() string { return proto.CompactTextString(m) }
+func (*HealthCheck_Conf_Active_Http_StatusRange) ProtoMessage()    {}
+func (*HealthCheck_Conf_Active_Http_StatusRange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a4f9382814224e98, []int{0, 0, 0, 0, 0}
+}
+
+func (m *HealthCheck_Conf_Active_Http_StatusRange) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_HealthCheck_Conf_Active_Http_StatusRange.Unmarshal(m, b)
+}
+func (m *HealthCheck_Conf_Active_Http_StatusRange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_HealthCheck_Conf_Active_Http_StatusRange.Marshal(b, m, deterministic)
+}
+func (m *HealthCheck_Conf_Active_Http_StatusRange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HealthCheck_Conf_Active_Http_StatusRange.Merge(m, src)
+}
+func (m *HealthCheck_Conf_Active_Http_StatusRange) XXX_Size() int {
+	return xxx_messageInfo_HealthCheck_Conf_Active_Http_StatusRange.Size(m)
+}
+func (m *HealthCheck_Conf_Active_Http_StatusRange) XXX_DiscardUnknown() {
+	xxx_messageInfo_HealthCheck_Conf_Active_Http_StatusRange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HealthCheck_Conf_Active_Http_StatusRange proto.InternalMessageInfo
+
+func (m *HealthCheck_Conf_Active_Http_StatusRange) GetStart() uint32 {
+	if m != nil {
+		return m.Start
+	}
+	return 0
+}
+
+func (m *HealthCheck_Conf_Active_Http_StatusRange) GetEnd() uint32 {
+	if m != nil {
+		return m.End
+	}
+	return 0
+}
+
+// Header defines an HTTP header.
+type HealthCheck_Conf_Active_Http_Header struct {
+	// Name of the header.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Value of the header.
+	Value                string   `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *HealthCheck_Conf_Active_Http_Header) Reset()         { *m = HealthCheck_Conf_Active_Http_Header{} }
+func (m *HealthCheck_Conf_Active_Http_Header) String() string { return proto.CompactTextString(m) }
+func (*HealthCheck_Conf_Active_Http_Header) ProtoMessage()    {}
+func (*HealthCheck_Conf_Active_Http_Header) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a4f9382814224e98, []int{0, 0, 0, 0, 1}
+}
+
+func (m *HealthCheck_Conf_Active_Http_Header) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_HealthCheck_Conf_Active_Http_Header.Unmarshal(m, b)
+}
+func (m *HealthCheck_Conf_Active_Http_Header) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_HealthCheck_Conf_Active_Http_Header.Marshal(b, m, deterministic)
+}
+func (m *HealthCheck_Conf_Active_Http_Header) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HealthCheck_Conf_Active_Http_Header.Merge(m, src)
+}
+func (m *HealthCheck_Conf_Active_Http_Header) XXX_Size() int {
+	return xxx_messageInfo_HealthCheck_Conf_Active_Http_Header.Size(m)
+}
+func (m *HealthCheck_Conf_Active_Http_Header) XXX_DiscardUnknown() {
+	xxx_messageInfo_HealthCheck_Conf_Active_Http_Header.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HealthCheck_Conf_Active_Http_Header proto.InternalMessageInfo
+
+func (m *HealthCheck_Conf_Active_Http_Header) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *HealthCheck_Conf_Active_Http_Header) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
+}
+
 // Passive defines configuration for passive health checking.
 type HealthCheck_Conf_Passive struct {
 	// Number of consecutive failed requests before considering a host
@@ -258,39 +461,58 @@ func init() {
 	proto.RegisterType((*HealthCheck)(nil), "kuma.mesh.v1alpha1.HealthCheck")
 	proto.RegisterType((*HealthCheck_Conf)(nil), "kuma.mesh.v1alpha1.HealthCheck.Conf")
 	proto.RegisterType((*HealthCheck_Conf_Active)(nil), "kuma.mesh.v1alpha1.HealthCheck.Conf.Active")
+	proto.RegisterType((*HealthCheck_Conf_Active_Http)(nil), "kuma.mesh.v1alpha1.HealthCheck.Conf.Active.Http")
+	proto.RegisterType((*HealthCheck_Conf_Active_Http_StatusRange)(nil), "kuma.mesh.v1alpha1.HealthCheck.Conf.Active.Http.StatusRange")
+	proto.RegisterType((*HealthCheck_Conf_Active_Http_Header)(nil), "kuma.mesh.v1alpha1.HealthCheck.Conf.Active.Http.Header")
 	proto.RegisterType((*HealthCheck_Conf_Passive)(nil), "kuma.mesh.v1alpha1.HealthCheck.Conf.Passive")
 }
 
 func init() { proto.RegisterFile("mesh/v1alpha1/health_check.proto", fileDescriptor_a4f9382814224e98) }
 
 var fileDescriptor_a4f9382814224e98 = []byte{
-	// 441 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x92, 0x41, 0x6f, 0xd3, 0x30,
-	0x14, 0xc7, 0xe7, 0x2e, 0x34, 0xd1, 0xeb, 0x0a, 0xc3, 0x1c, 0x28, 0xd1, 0x84, 0x22, 0xc4, 0x61,
-	0x1a, 0xc8, 0xd1, 0x06, 0x87, 0xdd, 0x60, 0x19, 0x07, 0xe0, 0x54, 0x65, 0x9c, 0xb8, 0x54, 0x5e,
-	0xe2, 0x2e, 0xd1, 0xdc, 0x38, 0xb2, 0x9d, 0xa0, 0x7d, 0x0d, 0x24, 0x6e, 0x7c, 0x02, 0x3e, 0x07,
-	0x9f, 0xaa, 0xe2, 0x80, 0x62, 0x3b, 0xd5, 0xca, 0x98, 0xd6, 0xdd, 0x62, 0xbd, 0xf7, 0xfb, 0xbd,
-	0xbf, 0xfd, 0x02, 0xd1, 0x82, 0xa9, 0x22, 0x6e, 0x0f, 0x29, 0xaf, 0x0b, 0x7a, 0x18, 0x17, 0x8c,
-	0x72, 0x5d, 0xcc, 0xb2, 0x82, 0x65, 0x97, 0xa4, 0x96, 0x42, 0x0b, 0x8c, 0x2f, 0x9b, 0x05, 0x25,
-	0x5d, 0x1b, 0xe9, 0xdb, 0xc2, 0xbd, 0x75, 0x4a, 0x31, 0xce, 0x32, 0x2d, 0xa4, 0x25, 0xc2, 0xe7,
-	0x17, 0x42, 0x5c, 0x70, 0x16, 0x9b, 0xd3, 0x79, 0x33, 0x8f, 0xf3, 0x46, 0x52, 0x5d, 0x8a, 0xea,
-	0xb6, 0xfa, 0x37, 0x49, 0xeb, 0x9a, 0x49, 0xe5, 0xea, 0x4f, 0x5b, 0xca, 0xcb, 0x9c, 0x6a, 0x16,
-	0xf7, 0x1f, 0xb6, 0xf0, 0xe2, 0xe7, 0x10, 0x46, 0x1f, 0x4d, 0xc2, 0xd3, 0x2e, 0x20, 0x7e, 0x0f,
-	0xbe, 0x12, 0x8d, 0xcc, 0x98, 0x9a, 0xa0, 0x68, 0x7b, 0x7f, 0x74, 0xb4, 0x47, 0x6e, 0x86, 0x25,
-	0x67, 0x2e, 0x5d, 0x12, 0x2c, 0x93, 0x07, 0xdf, 0xd1, 0x20, 0x40, 0x69, 0x8f, 0xe1, 0xcf, 0xb0,
-	0x93, 0x33, 0xa5, 0xcb, 0xca, 0xe4, 0x53, 0x93, 0xc1, 0xbd, 0x34, 0x6b, 0x2c, 0x3e, 0x06, 0x2f,
-	0x13, 0xd5, 0x7c, 0xb2, 0x1d, 0xa1, 0xfd, 0xd1, 0xd1, 0xcb, 0xff, 0x39, 0xae, 0x85, 0x27, 0xa7,
-	0xa2, 0x9a, 0xa7, 0x86, 0x08, 0x7f, 0x7b, 0xe0, 0x75, 0x47, 0x3c, 0x85, 0x31, 0xcd, 0x74, 0xd9,
-	0x32, 0xbb, 0x81, 0xee, 0x5a, 0x9d, 0xeb, 0xd5, 0x26, 0x2e, 0x72, 0x62, 0xc8, 0x74, 0xc7, 0x1a,
-	0x4c, 0x41, 0xe1, 0x33, 0x78, 0x58, 0x53, 0xa5, 0xae, 0x29, 0x07, 0x46, 0xf9, 0x7a, 0x23, 0xe5,
-	0xd4, 0xa2, 0xe9, 0xd8, 0x39, 0xac, 0x34, 0xfc, 0x83, 0x60, 0x68, 0xa7, 0xe1, 0x13, 0x08, 0xca,
-	0x4a, 0x33, 0xd9, 0x52, 0xee, 0xc2, 0x3e, 0x23, 0x76, 0xbd, 0xa4, 0x5f, 0x2f, 0xf9, 0xe0, 0xd6,
-	0x9f, 0xc0, 0x32, 0xf1, 0x7f, 0x21, 0x2f, 0x40, 0x07, 0x5b, 0xe9, 0x0a, 0xc3, 0xef, 0xc0, 0xd7,
-	0xe5, 0x82, 0x89, 0x46, 0xbb, 0x6c, 0x1b, 0x1a, 0x7a, 0x0a, 0x1f, 0xc3, 0x93, 0xa6, 0xb2, 0x7f,
-	0xee, 0xd5, 0x4c, 0x17, 0x92, 0xa9, 0x42, 0xf0, 0xdc, 0xec, 0x61, 0x9c, 0xf8, 0xcb, 0xc4, 0x3b,
-	0x18, 0x44, 0x5b, 0x29, 0x5e, 0xf5, 0x7c, 0xe9, 0x5b, 0xf0, 0x5b, 0x78, 0x7c, 0x93, 0xf3, 0xd6,
-	0xb9, 0xdd, 0x7f, 0xa9, 0xf0, 0x07, 0x02, 0xdf, 0xbd, 0xcc, 0x6d, 0xb3, 0xd1, 0xdd, 0xb3, 0xa7,
-	0xb0, 0x5b, 0xb3, 0x8a, 0x72, 0x7d, 0x35, 0x5b, 0xbd, 0xe0, 0xbd, 0xee, 0xff, 0xc8, 0xe1, 0x9f,
-	0x1c, 0x9d, 0xc0, 0xd7, 0xa0, 0x5f, 0xe5, 0xf9, 0xd0, 0xb0, 0x6f, 0xfe, 0x06, 0x00, 0x00, 0xff,
-	0xff, 0x55, 0xd7, 0x1e, 0xcf, 0xe0, 0x03, 0x00, 0x00,
+	// 695 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x93, 0x4f, 0x6f, 0xd3, 0x3e,
+	0x18, 0xc7, 0x97, 0x34, 0xfd, 0xb3, 0xa7, 0xeb, 0xd6, 0x79, 0xbf, 0x9f, 0x16, 0x42, 0x85, 0x2a,
+	0xc4, 0x61, 0x2a, 0x28, 0x65, 0x1b, 0x12, 0x93, 0x40, 0x82, 0x75, 0x3b, 0x6c, 0x48, 0x48, 0x95,
+	0x3b, 0x38, 0x20, 0xa1, 0xe0, 0x25, 0xee, 0x12, 0x2d, 0x4d, 0x42, 0xec, 0x14, 0xf6, 0x0a, 0xb8,
+	0x23, 0x71, 0x87, 0xeb, 0x5e, 0x09, 0x2f, 0x61, 0xaf, 0x65, 0x27, 0x14, 0xdb, 0x29, 0xad, 0xca,
+	0xb4, 0xf5, 0x66, 0xfb, 0xf1, 0xf7, 0xe3, 0xe7, 0xcf, 0xd7, 0xd0, 0x1e, 0x51, 0xe6, 0x77, 0xc7,
+	0xdb, 0x24, 0x4c, 0x7c, 0xb2, 0xdd, 0xf5, 0x29, 0x09, 0xb9, 0xef, 0xb8, 0x3e, 0x75, 0xcf, 0xed,
+	0x24, 0x8d, 0x79, 0x8c, 0xd0, 0x79, 0x36, 0x22, 0x76, 0x7e, 0xcd, 0x2e, 0xae, 0x59, 0xad, 0x59,
+	0x15, 0xa3, 0x21, 0x75, 0x79, 0x9c, 0x4a, 0x85, 0xf5, 0xe0, 0x2c, 0x8e, 0xcf, 0x42, 0xda, 0x15,
+	0xbb, 0xd3, 0x6c, 0xd8, 0xf5, 0xb2, 0x94, 0xf0, 0x20, 0x8e, 0x6e, 0x8a, 0x7f, 0x49, 0x49, 0x92,
+	0xd0, 0x94, 0xa9, 0xf8, 0xe6, 0x98, 0x84, 0x81, 0x47, 0x38, 0xed, 0x16, 0x0b, 0x19, 0x78, 0xf8,
+	0xb3, 0x0e, 0xf5, 0x23, 0x91, 0xe1, 0x41, 0x9e, 0x20, 0x7a, 0x0d, 0x55, 0x16, 0x67, 0xa9, 0x4b,
+	0x99, 0xa9, 0xb5, 0x4b, 0x5b, 0xf5, 0x9d, 0x96, 0x3d, 0x9f, 0xac, 0x3d, 0x50, 0xd9, 0xf5, 0x6a,
+	0xd7, 0xbd, 0xf2, 0x77, 0x4d, 0xaf, 0x69, 0xb8, 0x90, 0xa1, 0x37, 0xb0, 0xe2, 0x51, 0xc6, 0x83,
+	0x48, 0xe4, 0xc7, 0x4c, 0x7d, 0x21, 0xcc, 0x8c, 0x16, 0xed, 0x81, 0xe1, 0xc6, 0xd1, 0xd0, 0x2c,
+	0xb5, 0xb5, 0xad, 0xfa, 0xce, 0xa3, 0x7f, 0x31, 0xa6, 0x92, 0xb7, 0x0f, 0xe2, 0x68, 0x88, 0x85,
+	0xc2, 0xfa, 0xbd, 0x0c, 0x46, 0xbe, 0x45, 0x7d, 0x68, 0x10, 0x97, 0x07, 0x63, 0x2a, 0x27, 0x90,
+	0x97, 0x95, 0xb3, 0x1e, 0xdf, 0x85, 0x65, 0xef, 0x0b, 0x25, 0x5e, 0x91, 0x04, 0x11, 0x60, 0x68,
+	0x00, 0xab, 0x09, 0x61, 0x6c, 0x0a, 0xa9, 0x0b, 0xe4, 0x93, 0x3b, 0x21, 0xfb, 0x52, 0x8a, 0x1b,
+	0x8a, 0xa1, 0xa0, 0x1f, 0x61, 0x53, 0x1a, 0xe5, 0xc2, 0x49, 0x48, 0x14, 0xb8, 0x0e, 0xf7, 0x53,
+	0xca, 0xfc, 0x38, 0xf4, 0x54, 0xf1, 0x2d, 0x5b, 0x8e, 0xd8, 0x2e, 0x46, 0x6c, 0xbf, 0x3b, 0x8e,
+	0xf8, 0xee, 0xce, 0x7b, 0x12, 0x66, 0xb4, 0x57, 0xbd, 0xee, 0x19, 0x1d, 0xdd, 0xf4, 0xf0, 0xff,
+	0x8a, 0xd2, 0xcf, 0x21, 0x27, 0x05, 0xc3, 0xfa, 0x55, 0x81, 0x8a, 0x2c, 0x06, 0xed, 0x43, 0x2d,
+	0x88, 0x38, 0x4d, 0xc7, 0x24, 0x54, 0xbd, 0xb8, 0x37, 0x87, 0x3e, 0x54, 0xee, 0xea, 0xc1, 0x75,
+	0xaf, 0x7a, 0xa9, 0x19, 0x35, 0xad, 0xb3, 0x84, 0x27, 0x32, 0xf4, 0x0a, 0xaa, 0x3c, 0x18, 0xd1,
+	0x38, 0xe3, 0xa6, 0xbe, 0x08, 0xa1, 0x50, 0xa1, 0x3d, 0xd8, 0xc8, 0xa2, 0xa2, 0xde, 0xd9, 0x4a,
+	0x1b, 0xaa, 0x96, 0xf6, 0x12, 0x46, 0x93, 0x3b, 0x93, 0x42, 0xd0, 0x33, 0x58, 0x9f, 0xd7, 0x19,
+	0xb3, 0xba, 0xe6, 0x9c, 0xea, 0x10, 0x0c, 0x9f, 0xf3, 0xc4, 0x2c, 0x8b, 0x6c, 0x9f, 0x2e, 0x30,
+	0x7b, 0xfb, 0x88, 0xf3, 0x04, 0x0b, 0x35, 0x1a, 0xc0, 0x46, 0x14, 0x3b, 0x3c, 0x25, 0xc3, 0x61,
+	0xe0, 0x3a, 0x93, 0x26, 0x56, 0x6e, 0x6b, 0x41, 0xee, 0xee, 0x4b, 0x4d, 0xef, 0x2c, 0xe1, 0xf5,
+	0x28, 0x3e, 0x91, 0xf2, 0x63, 0xa5, 0xb6, 0xbe, 0x95, 0xc0, 0xc8, 0xdf, 0x40, 0x08, 0x8c, 0x84,
+	0x70, 0x5f, 0xcc, 0x64, 0x19, 0x8b, 0x75, 0x7e, 0xe6, 0xc7, 0x4c, 0x76, 0x79, 0x19, 0x8b, 0x35,
+	0x0a, 0x60, 0x9d, 0x7e, 0x4d, 0xa8, 0xcb, 0xa9, 0xe7, 0x30, 0x4e, 0x78, 0xc6, 0x28, 0x33, 0x4b,
+	0xe2, 0x93, 0xbd, 0x5c, 0xb4, 0x30, 0x7b, 0x20, 0x00, 0x98, 0x44, 0x67, 0x14, 0x37, 0x0b, 0xec,
+	0x40, 0x51, 0xd1, 0x27, 0x58, 0x4b, 0xe9, 0xe7, 0x8c, 0x32, 0xee, 0xf8, 0x94, 0x78, 0x34, 0x65,
+	0xa6, 0x21, 0x1e, 0x7a, 0xbe, 0xf0, 0x43, 0x47, 0x42, 0x8f, 0x57, 0x15, 0x4f, 0x6e, 0x99, 0xf5,
+	0x16, 0xea, 0x53, 0x29, 0xa0, 0x36, 0x94, 0x19, 0x27, 0x29, 0x17, 0x4d, 0x68, 0x08, 0xef, 0x74,
+	0xca, 0x5b, 0x5e, 0xf3, 0xca, 0xc0, 0x32, 0x80, 0x5a, 0x50, 0xa2, 0x91, 0x67, 0xea, 0x53, 0xf1,
+	0xb6, 0x67, 0x5e, 0x19, 0x38, 0x3f, 0xb6, 0x5e, 0x40, 0x45, 0x92, 0xd1, 0x7d, 0x30, 0x22, 0x32,
+	0xa2, 0xb2, 0x9b, 0xc2, 0x1a, 0xa9, 0xde, 0xd4, 0xb0, 0x38, 0x44, 0xff, 0x41, 0x79, 0x9c, 0x7f,
+	0x1b, 0xd5, 0x57, 0xb9, 0xb1, 0x7e, 0x68, 0x50, 0x55, 0xbf, 0xf3, 0x26, 0x83, 0x6a, 0xb7, 0x1b,
+	0xb4, 0x0f, 0xcd, 0x84, 0x46, 0x24, 0xe4, 0x17, 0x7f, 0x1d, 0xb2, 0xd0, 0x27, 0x59, 0x53, 0xf2,
+	0xc2, 0x21, 0x3d, 0xf8, 0x50, 0x2b, 0x7a, 0x7c, 0x5a, 0x11, 0xda, 0xdd, 0x3f, 0x03, 0x00, 0x1f,
+	0xbc, 0xf8, 0x24, 0x64, 0x06, 0x00, 0x00,
 }
@@ -183,6 +183,17 @@ func (m *HealthCheck_Conf) Validate() error {
 		}
 	}
 
+	if wrapper := m.GetHealthyPanicThreshold(); wrapper != nil {
+
+		if wrapper.GetValue() > 100 {
+			return HealthCheck_ConfValidationError{
+				field:  "HealthyPanicThreshold",
+				reason: "value must be less than or equal to 100",
+			}
+		}
+
+	}
+
 	return nil
 }
 
@@ -318,6 +329,37 @@ func (m *HealthCheck_Conf_Active) Validate() error {
 		}
 	}
 
+	if v, ok := interface{}(m.GetHttp()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return HealthCheck_Conf_ActiveValidationError{
+				field:  "Http",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if d := m.GetNoTrafficInterval(); d != nil {
+		dur, err := ptypes.Duration(d)
+		if err != nil {
+			return HealthCheck_Conf_ActiveValidationError{
+				field:  "NoTrafficInterval",
+				reason: "value is not a valid duration",
+				cause:  err,
+			}
+		}
+
+		gt := time.Duration(0*time.Second + 0*time.Nanosecond)
+
+		if dur <= gt {
+			return HealthCheck_Conf_ActiveValidationError{
+				field:  "NoTrafficInterval",
+				reason: "value must be greater than 0s",
+			}
+		}
+
+	}
+
 	return nil
 }
 
@@ -478,3 +520,264 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = HealthCheck_Conf_PassiveValidationError{}
+
+// Validate checks the field values on HealthCheck_Conf_Active_Http with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *HealthCheck_Conf_Active_Http) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for Path
+
+	// no validation rules for Host
+
+	for idx, item := range m.GetExpectedStatuses() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return HealthCheck_Conf_Active_HttpValidationError{
+					field:  fmt.Sprintf("ExpectedStatuses[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetRequestHeaders() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return HealthCheck_Conf_Active_HttpValidationError{
+					field:  fmt.Sprintf("RequestHeaders[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// HealthCheck_Conf_Active_HttpValidationError is the validation error returned
+// by HealthCheck_Conf_Active_Http.Validate if the designated constraints
+// aren't met.
+type HealthCheck_Conf_Active_HttpValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e HealthCheck_Conf_Active_HttpValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e HealthCheck_Conf_Active_HttpValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e HealthCheck_Conf_Active_HttpValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e HealthCheck_Conf_Active_HttpValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e HealthCheck_Conf_Active_HttpValidationError) ErrorName() string {
+	return "HealthCheck_Conf_Active_HttpValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e HealthCheck_Conf_Active_HttpValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sHealthCheck_Conf_Active_Http.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = HealthCheck_Conf_Active_HttpValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = HealthCheck_Conf_Active_HttpValidationError{}
+
+// Validate checks the field values on HealthCheck_Conf_Active_Http_StatusRange
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, an error is returned.
+func (m *HealthCheck_Conf_Active_Http_StatusRange) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if val := m.GetStart(); val < 100 || val >= 600 {
+		return HealthCheck_Conf_Active_Http_StatusRangeValidationError{
+			field:  "Start",
+			reason: "value must be inside range [100, 600)",
+		}
+	}
+
+	if val := m.GetEnd(); val <= 100 || val > 600 {
+		return HealthCheck_Conf_Active_Http_StatusRangeValidationError{
+			field:  "End",
+			reason: "value must be inside range (100, 600]",
+		}
+	}
+
+	return nil
+}
+
+// HealthCheck_Conf_Active_Http_StatusRangeValidationError is the validation
+// error returned by HealthCheck_Conf_Active_Http_StatusRange.Validate if the
+// designated constraints aren't met.
+type HealthCheck_Conf_Active_Http_StatusRangeValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e HealthCheck_Conf_Active_Http_StatusRangeValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e HealthCheck_Conf_Active_Http_StatusRangeValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e HealthCheck_Conf_Active_Http_StatusRangeValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e HealthCheck_Conf_Active_Http_StatusRangeValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e HealthCheck_Conf_Active_Http_StatusRangeValidationError) ErrorName() string {
+	return "HealthCheck_Conf_Active_Http_StatusRangeValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e HealthCheck_Conf_Active_Http_StatusRangeValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sHealthCheck_Conf_Active_Http_StatusRange.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = HealthCheck_Conf_Active_Http_StatusRangeValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = HealthCheck_Conf_Active_Http_StatusRangeValidationError{}
+
+// Validate checks the field values on HealthCheck_Conf_Active_Http_Header with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, an error is returned.
+func (m *HealthCheck_Conf_Active_Http_Header) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if utf8.RuneCountInString(m.GetName()) < 1 {
+		return HealthCheck_Conf_Active_Http_HeaderValidationError{
+			field:  "Name",
+			reason: "value length must be at least 1 runes",
+		}
+	}
+
+	// no validation rules for Value
+
+	return nil
+}
+
+// HealthCheck_Conf_Active_Http_HeaderValidationError is the validation error
+// returned by HealthCheck_Conf_Active_Http_Header.Validate if the designated
+// constraints aren't met.
+type HealthCheck_Conf_Active_Http_HeaderValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e HealthCheck_Conf_Active_Http_HeaderValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e HealthCheck_Conf_Active_Http_HeaderValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e HealthCheck_Conf_Active_Http_HeaderValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e HealthCheck_Conf_Active_Http_HeaderValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e HealthCheck_Conf_Active_Http_HeaderValidationError) ErrorName() string {
+	return "HealthCheck_Conf_Active_Http_HeaderValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e HealthCheck_Conf_Active_Http_HeaderValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sHealthCheck_Conf_Active_Http_Header.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = HealthCheck_Conf_Active_Http_HeaderValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = HealthCheck_Conf_Active_Http_HeaderValidationError{}
@@ -77,6 +77,33 @@ var _ = Describe("HealthCheck", func() {
                   passiveChecks:
                     unhealthyThreshold: 3
                     penaltyInterval: 5s
+`,
+			}),
+			Entry("conf with active HTTP health checks", testCase{
+				input: `
+                sources:
+                - match:
+                    service: web
+                destinations:
+                - match:
+                    service: backend
+                conf:
+                  activeChecks:
+                    interval: 10s
+                    timeout: 2s
+                    unhealthyThreshold: 3
+                    healthyThreshold: 1
+                    noTrafficInterval: 120s
+                    http:
+                      path: /health
+                      host: backend.internal
+                      expectedStatuses:
+                      - start: 200
+                        end: 300
+                      requestHeaders:
+                      - name: x-health-check
+                        value: "true"
+                  healthyPanicThreshold: 0
 `,
 			}),
 			Entry("no conf", testCase{
@@ -151,6 +178,43 @@ var _ = Describe("HealthCheck", func() {
 `,
 				expectedErr: `invalid HealthCheck.Conf: embedded message failed validation | caused by: invalid HealthCheck_Conf.PassiveChecks: embedded message failed validation | caused by: invalid HealthCheck_Conf_Passive.UnhealthyThreshold: value must be greater than 0`,
 			}),
+			Entry("invalid HTTP status range", testCase{
+				input: `
+                sources:
+                - match:
+                    service: web
+                destinations:
+                - match:
+                    service: backend
+                conf:
+                  activeChecks:
+                    interval: 10s
+                    timeout: 2s
+                    unhealthyThreshold: 3
+                    healthyThreshold: 1
+                    http:
+                      expectedStatuses:
+                      - start: 200
+                        end: 700
+`,
+				expectedErr: `invalid HealthCheck.Conf: embedded message failed validation | caused by: invalid HealthCheck_Conf.ActiveChecks: embedded message failed validation | caused by: invalid HealthCheck_Conf_Active.Http: embedded message failed validation | caused by: invalid HealthCheck_Conf_Active_Http.ExpectedStatuses[0]: embedded message failed validation | caused by: invalid HealthCheck_Conf_Active_Http_StatusRange.End: value must be inside range (100, 600]`,
+			}),
+			Entry("healthy panic threshold out of range", testCase{
+				input: `
+                sources:
+                - match:
+                    service: web
+                destinations:
+                - match:
+                    service: backend
+                conf:
+                  passiveChecks:
+                    unhealthyThreshold: 3
+                    penaltyInterval: 5s
+                  healthyPanicThreshold: 101
+`,
+				expectedErr: `invalid HealthCheck.Conf: embedded message failed validation | caused by: invalid HealthCheck_Conf.HealthyPanicThreshold: value must be less than or equal to 100`,
+			}),
 		)
 	})
 
@@ -6,6 +6,7 @@ package v1alpha1
 import (
 	fmt "fmt"
 	proto "github.com/golang/protobuf/proto"
+	duration "github.com/golang/protobuf/ptypes/duration"
 	wrappers "github.com/golang/protobuf/ptypes/wrappers"
 	math "math"
 )
@@ -38,7 +39,10 @@ type Mesh struct {
 	// Additionally, it is also possible to further customize this configuration
 	// for each dataplane individually using Dataplane resource.
 	// +optional
-	Metrics              *Metrics `protobuf:"bytes,4,opt,name=metrics,proto3" json:"metrics,omitempty"`
+	Metrics *Metrics `protobuf:"bytes,4,opt,name=metrics,proto3" json:"metrics,omitempty"`
+	// Routing settings of a Mesh.
+	// +optional
+	Routing              *Routing `protobuf:"bytes,5,opt,name=routing,proto3" json:"routing,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -97,6 +101,13 @@ func (m *Mesh) GetMetrics() *Metrics {
 	return nil
 }
 
+func (m *Mesh) GetRouting() *Routing {
+	if m != nil {
+		return m.Routing
+	}
+	return nil
+}
+
 // mTLS settings of a Mesh.
 type Mesh_Mtls struct {
 	// Certificate Authority of a Mesh.
@@ -148,6 +159,52 @@ func (m *Mesh_Mtls) GetEnabled() bool {
 	return false
 }
 
+// Routing defines configuration of routing between dataplanes of a Mesh.
+type Routing struct {
+	// If true, endpoints of a destination service are grouped into localities
+	// according to their `region` and `zone` tags. Endpoints in the same zone as
+	// a source dataplane are preferred, other zones of the same region are used
+	// as the first fallback and other regions as the last one.
+	// Failover relies on health of endpoints, so it should be combined with a
+	// HealthCheck or a CircuitBreaker policy.
+	LocalityAwareLoadBalancing bool     `protobuf:"varint,1,opt,name=locality_aware_load_balancing,json=localityAwareLoadBalancing,proto3" json:"locality_aware_load_balancing,omitempty"`
+	XXX_NoUnkeyedLiteral       struct{} `json:"-"`
+	XXX_unrecognized           []byte   `json:"-"`
+	XXX_sizecache              int32    `json:"-"`
+}
+
+func (m *Routing) Reset()         { *m = Routing{} }
+func (m *Routing) String() string { return proto.CompactTextString(m) }
+func (*Routing) ProtoMessage()    {}
+func (*Routing) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ae9b3cd8c92bbf6a, []int{1}
+}
+
+func (m *Routing) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Routing.Unmarshal(m, b)
+}
+func (m *Routing) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Routing.Marshal(b, m, deterministic)
+}
+func (m *Routing) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Routing.Merge(m, src)
+}
+func (m *Routing) XXX_Size() int {
+	return xxx_messageInfo_Routing.Size(m)
+}
+func (m *Routing) XXX_DiscardUnknown() {
+	xxx_messageInfo_Routing.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Routing proto.InternalMessageInfo
+
+func (m *Routing) GetLocalityAwareLoadBalancing() bool {
+	if m != nil {
+		return m.LocalityAwareLoadBalancing
+	}
+	return false
+}
+
 // CertificateAuthority defines configuration of a CA.
 type CertificateAuthority struct {
 	// Types that are valid to be assigned to Type:
@@ -163,7 +220,7 @@ func (m *CertificateAuthority) Reset()         { *m = CertificateAuthority{} }
 func (m *CertificateAuthority) String() string { return proto.CompactTextString(m) }
 func (*CertificateAuthority) ProtoMessage()    {}
 func (*CertificateAuthority) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ae9b3cd8c92bbf6a, []int{1}
+	return fileDescriptor_ae9b3cd8c92bbf6a, []int{2}
 }
 
 func (m *CertificateAuthority) XXX_Unmarshal(b []byte) error {
@@ -240,7 +297,7 @@ func (m *CertificateAuthority_Builtin) Reset()         { *m = CertificateAuthori
 func (m *CertificateAuthority_Builtin) String() string { return proto.CompactTextString(m) }
 func (*CertificateAuthority_Builtin) ProtoMessage()    {}
 func (*CertificateAuthority_Builtin) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ae9b3cd8c92bbf6a, []int{1, 0}
+	return fileDescriptor_ae9b3cd8c92bbf6a, []int{2, 0}
 }
 
 func (m *CertificateAuthority_Builtin) XXX_Unmarshal(b []byte) error {
@@ -272,7 +329,7 @@ func (m *CertificateAuthority_Provided) Reset()         { *m = CertificateAuthor
 func (m *CertificateAuthority_Provided) String() string { return proto.CompactTextString(m) }
 func (*CertificateAuthority_Provided) ProtoMessage()    {}
 func (*CertificateAuthority_Provided) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ae9b3cd8c92bbf6a, []int{1, 1}
+	return fileDescriptor_ae9b3cd8c92bbf6a, []int{2, 1}
 }
 
 func (m *CertificateAuthority_Provided) XXX_Unmarshal(b []byte) error {
@@ -308,7 +365,7 @@ func (m *Tracing) Reset()         { *m = Tracing{} }
 func (m *Tracing) String() string { return proto.CompactTextString(m) }
 func (*Tracing) ProtoMessage()    {}
 func (*Tracing) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ae9b3cd8c92bbf6a, []int{2}
+	return fileDescriptor_ae9b3cd8c92bbf6a, []int{3}
 }
 
 func (m *Tracing) XXX_Unmarshal(b []byte) error {
@@ -354,6 +411,9 @@ type TracingBackend struct {
 	Sampling *wrappers.DoubleValue `protobuf:"bytes,2,opt,name=sampling,proto3" json:"sampling,omitempty"`
 	// Types that are valid to be assigned to Type:
 	//	*TracingBackend_Zipkin_
+	//	*TracingBackend_Datadog_
+	//	*TracingBackend_Jaeger_
+	//	*TracingBackend_OpenCensus_
 	Type                 isTracingBackend_Type `protobuf_oneof:"type"`
 	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
 	XXX_unrecognized     []byte                `json:"-"`
@@ -364,7 +424,7 @@ func (m *TracingBackend) Reset()         { *m = TracingBackend{} }
 func (m *TracingBackend) String() string { return proto.CompactTextString(m) }
 func (*TracingBackend) ProtoMessage()    {}
 func (*TracingBackend) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ae9b3cd8c92bbf6a, []int{3}
+	return fileDescriptor_ae9b3cd8c92bbf6a, []int{4}
 }
 
 func (m *TracingBackend) XXX_Unmarshal(b []byte) error {
@@ -407,8 +467,26 @@ type TracingBackend_Zipkin_ struct {
 	Zipkin *TracingBackend_Zipkin `protobuf:"bytes,3,opt,name=zipkin,proto3,oneof"`
 }
 
+type TracingBackend_Datadog_ struct {
+	Datadog *TracingBackend_Datadog `protobuf:"bytes,4,opt,name=datadog,proto3,oneof"`
+}
+
+type TracingBackend_Jaeger_ struct {
+	Jaeger *TracingBackend_Jaeger `protobuf:"bytes,5,opt,name=jaeger,proto3,oneof"`
+}
+
+type TracingBackend_OpenCensus_ struct {
+	OpenCensus *TracingBackend_OpenCensus `protobuf:"bytes,6,opt,name=openCensus,proto3,oneof"`
+}
+
 func (*TracingBackend_Zipkin_) isTracingBackend_Type() {}
 
+func (*TracingBackend_Datadog_) isTracingBackend_Type() {}
+
+func (*TracingBackend_Jaeger_) isTracingBackend_Type() {}
+
+func (*TracingBackend_OpenCensus_) isTracingBackend_Type() {}
+
 func (m *TracingBackend) GetType() isTracingBackend_Type {
 	if m != nil {
 		return m.Type
@@ -423,10 +501,34 @@ func (m *TracingBackend) GetZipkin() *TracingBackend_Zipkin {
 	return nil
 }
 
+func (m *TracingBackend) GetDatadog() *TracingBackend_Datadog {
+	if x, ok := m.GetType().(*TracingBackend_Datadog_); ok {
+		return x.Datadog
+	}
+	return nil
+}
+
+func (m *TracingBackend) GetJaeger() *TracingBackend_Jaeger {
+	if x, ok := m.GetType().(*TracingBackend_Jaeger_); ok {
+		return x.Jaeger
+	}
+	return nil
+}
+
+func (m *TracingBackend) GetOpenCensus() *TracingBackend_OpenCensus {
+	if x, ok := m.GetType().(*TracingBackend_OpenCensus_); ok {
+		return x.OpenCensus
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*TracingBackend) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
 		(*TracingBackend_Zipkin_)(nil),
+		(*TracingBackend_Datadog_)(nil),
+		(*TracingBackend_Jaeger_)(nil),
+		(*TracingBackend_OpenCensus_)(nil),
 	}
 }
 
@@ -449,7 +551,7 @@ func (m *TracingBackend_Zipkin) Reset()         { *m = TracingBackend_Zipkin{} }
 func (m *TracingBackend_Zipkin) String() string { return proto.CompactTextString(m) }
 func (*TracingBackend_Zipkin) ProtoMessage()    {}
 func (*TracingBackend_Zipkin) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ae9b3cd8c92bbf6a, []int{3, 0}
+	return fileDescriptor_ae9b3cd8c92bbf6a, []int{4, 0}
 }
 
 func (m *TracingBackend_Zipkin) XXX_Unmarshal(b []byte) error {
@@ -491,6 +593,186 @@ func (m *TracingBackend_Zipkin) GetApiVersion() string {
 	return ""
 }
 
+// Datadog defines configuration of Datadog tracer.
+type TracingBackend_Datadog struct {
+	// Address of Datadog agent.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// Port of Datadog agent.
+	Port uint32 `protobuf:"varint,2,opt,name=port,proto3" json:"port,omitempty"`
+	// Name of the service reported to Datadog. Default: kuma
+	ServiceName          string   `protobuf:"bytes,3,opt,name=serviceName,proto3" json:"serviceName,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TracingBackend_Datadog) Reset()         { *m = TracingBackend_Datadog{} }
+func (m *TracingBackend_Datadog) String() string { return proto.CompactTextString(m) }
+func (*TracingBackend_Datadog) ProtoMessage()    {}
+func (*TracingBackend_Datadog) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ae9b3cd8c92bbf6a, []int{4, 1}
+}
+
+func (m *TracingBackend_Datadog) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TracingBackend_Datadog.Unmarshal(m, b)
+}
+func (m *TracingBackend_Datadog) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TracingBackend_Datadog.Marshal(b, m, deterministic)
+}
+func (m *TracingBackend_Datadog) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TracingBackend_Datadog.Merge(m, src)
+}
+func (m *TracingBackend_Datadog) XXX_Size() int {
+	return xxx_messageInfo_TracingBackend_Datadog.Size(m)
+}
+func (m *TracingBackend_Datadog) XXX_DiscardUnknown() {
+	xxx_messageInfo_TracingBackend_Datadog.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TracingBackend_Datadog proto.InternalMessageInfo
+
+func (m *TracingBackend_Datadog) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *TracingBackend_Datadog) GetPort() uint32 {
+	if m != nil {
+		return m.Port
+	}
+	return 0
+}
+
+func (m *TracingBackend_Datadog) GetServiceName() string {
+	if m != nil {
+		return m.ServiceName
+	}
+	return ""
+}
+
+// Jaeger defines configuration of Jaeger tracer. Spans are sent to the
+// Zipkin-compatible endpoint of Jaeger collector.
+type TracingBackend_Jaeger struct {
+	// Address of Zipkin-compatible endpoint of Jaeger collector. If path is
+	// not specified, /api/v2/spans is used.
+	Url string `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	// Generate 128bit traces. Default: false
+	TraceId128Bit        bool     `protobuf:"varint,2,opt,name=traceId128bit,proto3" json:"traceId128bit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TracingBackend_Jaeger) Reset()         { *m = TracingBackend_Jaeger{} }
+func (m *TracingBackend_Jaeger) String() string { return proto.CompactTextString(m) }
+func (*TracingBackend_Jaeger) ProtoMessage()    {}
+func (*TracingBackend_Jaeger) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ae9b3cd8c92bbf6a, []int{4, 2}
+}
+
+func (m *TracingBackend_Jaeger) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TracingBackend_Jaeger.Unmarshal(m, b)
+}
+func (m *TracingBackend_Jaeger) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TracingBackend_Jaeger.Marshal(b, m, deterministic)
+}
+func (m *TracingBackend_Jaeger) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TracingBackend_Jaeger.Merge(m, src)
+}
+func (m *TracingBackend_Jaeger) XXX_Size() int {
+	return xxx_messageInfo_TracingBackend_Jaeger.Size(m)
+}
+func (m *TracingBackend_Jaeger) XXX_DiscardUnknown() {
+	xxx_messageInfo_TracingBackend_Jaeger.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TracingBackend_Jaeger proto.InternalMessageInfo
+
+func (m *TracingBackend_Jaeger) GetUrl() string {
+	if m != nil {
+		return m.Url
+	}
+	return ""
+}
+
+func (m *TracingBackend_Jaeger) GetTraceId128Bit() bool {
+	if m != nil {
+		return m.TraceId128Bit
+	}
+	return false
+}
+
+// OpenCensus defines configuration of OpenCensus tracer. At least one
+// exporter has to be enabled.
+type TracingBackend_OpenCensus struct {
+	// Address of OpenCensus agent (host:port) that spans are exported to.
+	OcagentAddress string `protobuf:"bytes,1,opt,name=ocagentAddress,proto3" json:"ocagentAddress,omitempty"`
+	// Address of Zipkin collector that spans are exported to.
+	ZipkinUrl string `protobuf:"bytes,2,opt,name=zipkinUrl,proto3" json:"zipkinUrl,omitempty"`
+	// ID of Google Cloud project that spans are exported to via Stackdriver.
+	StackdriverProjectId string `protobuf:"bytes,3,opt,name=stackdriverProjectId,proto3" json:"stackdriverProjectId,omitempty"`
+	// Export spans to the standard output of Envoy. Default: false
+	Stdout               bool     `protobuf:"varint,4,opt,name=stdout,proto3" json:"stdout,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TracingBackend_OpenCensus) Reset()         { *m = TracingBackend_OpenCensus{} }
+func (m *TracingBackend_OpenCensus) String() string { return proto.CompactTextString(m) }
+func (*TracingBackend_OpenCensus) ProtoMessage()    {}
+func (*TracingBackend_OpenCensus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ae9b3cd8c92bbf6a, []int{4, 3}
+}
+
+func (m *TracingBackend_OpenCensus) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TracingBackend_OpenCensus.Unmarshal(m, b)
+}
+func (m *TracingBackend_OpenCensus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TracingBackend_OpenCensus.Marshal(b, m, deterministic)
+}
+func (m *TracingBackend_OpenCensus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TracingBackend_OpenCensus.Merge(m, src)
+}
+func (m *TracingBackend_OpenCensus) XXX_Size() int {
+	return xxx_messageInfo_TracingBackend_OpenCensus.Size(m)
+}
+func (m *TracingBackend_OpenCensus) XXX_DiscardUnknown() {
+	xxx_messageInfo_TracingBackend_OpenCensus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TracingBackend_OpenCensus proto.InternalMessageInfo
+
+func (m *TracingBackend_OpenCensus) GetOcagentAddress() string {
+	if m != nil {
+		return m.OcagentAddress
+	}
+	return ""
+}
+
+func (m *TracingBackend_OpenCensus) GetZipkinUrl() string {
+	if m != nil {
+		return m.ZipkinUrl
+	}
+	return ""
+}
+
+func (m *TracingBackend_OpenCensus) GetStackdriverProjectId() string {
+	if m != nil {
+		return m.StackdriverProjectId
+	}
+	return ""
+}
+
+func (m *TracingBackend_OpenCensus) GetStdout() bool {
+	if m != nil {
+		return m.Stdout
+	}
+	return false
+}
+
 type Logging struct {
 	// Name of the default backend
 	DefaultBackend string `protobuf:"bytes,1,opt,name=defaultBackend,proto3" json:"defaultBackend,omitempty"`
@@ -505,7 +787,7 @@ func (m *Logging) Reset()         { *m = Logging{} }
 func (m *Logging) String() string { return proto.CompactTextString(m) }
 func (*Logging) ProtoMessage()    {}
 func (*Logging) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ae9b3cd8c92bbf6a, []int{4}
+	return fileDescriptor_ae9b3cd8c92bbf6a, []int{5}
 }
 
 func (m *Logging) XXX_Unmarshal(b []byte) error {
@@ -549,9 +831,15 @@ type LoggingBackend struct {
 	// Format of access logs. Placehodlers available on
 	// https://www.envoyproxy.io/docs/envoy/latest/configuration/observability/access_log
 	Format string `protobuf:"bytes,2,opt,name=format,proto3" json:"format,omitempty"`
+	// JSON format of access logs. Every log entry is rendered as a JSON object,
+	// where keys are field names and values are format strings with the same
+	// placeholders as in format. Cannot be used together with format.
+	JsonFormat map[string]string `protobuf:"bytes,7,rep,name=jsonFormat,proto3" json:"jsonFormat,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 	// Types that are valid to be assigned to Type:
 	//	*LoggingBackend_File_
 	//	*LoggingBackend_Tcp_
+	//	*LoggingBackend_Syslog_
+	//	*LoggingBackend_Http_
 	Type                 isLoggingBackend_Type `protobuf_oneof:"type"`
 	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
 	XXX_unrecognized     []byte                `json:"-"`
@@ -562,7 +850,7 @@ func (m *LoggingBackend) Reset()         { *m = LoggingBackend{} }
 func (m *LoggingBackend) String() string { return proto.CompactTextString(m) }
 func (*LoggingBackend) ProtoMessage()    {}
 func (*LoggingBackend) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ae9b3cd8c92bbf6a, []int{5}
+	return fileDescriptor_ae9b3cd8c92bbf6a, []int{6}
 }
 
 func (m *LoggingBackend) XXX_Unmarshal(b []byte) error {
@@ -597,6 +885,13 @@ func (m *LoggingBackend) GetFormat() string {
 	return ""
 }
 
+func (m *LoggingBackend) GetJsonFormat() map[string]string {
+	if m != nil {
+		return m.JsonFormat
+	}
+	return nil
+}
+
 type isLoggingBackend_Type interface {
 	isLoggingBackend_Type()
 }
@@ -609,10 +904,22 @@ type LoggingBackend_Tcp_ struct {
 	Tcp *LoggingBackend_Tcp `protobuf:"bytes,4,opt,name=tcp,proto3,oneof"`
 }
 
+type LoggingBackend_Syslog_ struct {
+	Syslog *LoggingBackend_Syslog `protobuf:"bytes,5,opt,name=syslog,proto3,oneof"`
+}
+
+type LoggingBackend_Http_ struct {
+	Http *LoggingBackend_Http `protobuf:"bytes,6,opt,name=http,proto3,oneof"`
+}
+
 func (*LoggingBackend_File_) isLoggingBackend_Type() {}
 
 func (*LoggingBackend_Tcp_) isLoggingBackend_Type() {}
 
+func (*LoggingBackend_Syslog_) isLoggingBackend_Type() {}
+
+func (*LoggingBackend_Http_) isLoggingBackend_Type() {}
+
 func (m *LoggingBackend) GetType() isLoggingBackend_Type {
 	if m != nil {
 		return m.Type
@@ -634,11 +941,27 @@ func (m *LoggingBackend) GetTcp() *LoggingBackend_Tcp {
 	return nil
 }
 
+func (m *LoggingBackend) GetSyslog() *LoggingBackend_Syslog {
+	if x, ok := m.GetType().(*LoggingBackend_Syslog_); ok {
+		return x.Syslog
+	}
+	return nil
+}
+
+func (m *LoggingBackend) GetHttp() *LoggingBackend_Http {
+	if x, ok := m.GetType().(*LoggingBackend_Http_); ok {
+		return x.Http
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*LoggingBackend) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
 		(*LoggingBackend_File_)(nil),
 		(*LoggingBackend_Tcp_)(nil),
+		(*LoggingBackend_Syslog_)(nil),
+		(*LoggingBackend_Http_)(nil),
 	}
 }
 
@@ -654,7 +977,7 @@ func (m *LoggingBackend_File) Reset()         { *m = LoggingBackend_File{} }
 func (m *LoggingBackend_File) String() string { return proto.CompactTextString(m) }
 func (*LoggingBackend_File) ProtoMessage()    {}
 func (*LoggingBackend_File) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ae9b3cd8c92bbf6a, []int{5, 0}
+	return fileDescriptor_ae9b3cd8c92bbf6a, []int{6, 1}
 }
 
 func (m *LoggingBackend_File) XXX_Unmarshal(b []byte) error {
@@ -693,7 +1016,7 @@ func (m *LoggingBackend_Tcp) Reset()         { *m = LoggingBackend_Tcp{} }
 func (m *LoggingBackend_Tcp) String() string { return proto.CompactTextString(m) }
 func (*LoggingBackend_Tcp) ProtoMessage()    {}
 func (*LoggingBackend_Tcp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ae9b3cd8c92bbf6a, []int{5, 1}
+	return fileDescriptor_ae9b3cd8c92bbf6a, []int{6, 2}
 }
 
 func (m *LoggingBackend_Tcp) XXX_Unmarshal(b []byte) error {
@@ -721,60 +1044,222 @@ func (m *LoggingBackend_Tcp) GetAddress() string {
 	return ""
 }
 
+// Syslog defines logging to a syslog server. Entries are sent in RFC5424
+// format.
+type LoggingBackend_Syslog struct {
+	// Address of a syslog server in HOST:PORT format.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// Transport protocol, values: udp, tcp. Default: udp
+	Transport string `protobuf:"bytes,2,opt,name=transport,proto3" json:"transport,omitempty"`
+	// Syslog facility, e.g. local0, user, daemon. Default: local0
+	Facility string `protobuf:"bytes,3,opt,name=facility,proto3" json:"facility,omitempty"`
+	// APP-NAME field of syslog messages. Default: kuma
+	AppName              string   `protobuf:"bytes,4,opt,name=appName,proto3" json:"appName,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *LoggingBackend_Syslog) Reset()         { *m = LoggingBackend_Syslog{} }
+func (m *LoggingBackend_Syslog) String() string { return proto.CompactTextString(m) }
+func (*LoggingBackend_Syslog) ProtoMessage()    {}
+func (*LoggingBackend_Syslog) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ae9b3cd8c92bbf6a, []int{6, 3}
+}
+
+func (m *LoggingBackend_Syslog) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LoggingBackend_Syslog.Unmarshal(m, b)
+}
+func (m *LoggingBackend_Syslog) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LoggingBackend_Syslog.Marshal(b, m, deterministic)
+}
+func (m *LoggingBackend_Syslog) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LoggingBackend_Syslog.Merge(m, src)
+}
+func (m *LoggingBackend_Syslog) XXX_Size() int {
+	return xxx_messageInfo_LoggingBackend_Syslog.Size(m)
+}
+func (m *LoggingBackend_Syslog) XXX_DiscardUnknown() {
+	xxx_messageInfo_LoggingBackend_Syslog.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LoggingBackend_Syslog proto.InternalMessageInfo
+
+func (m *LoggingBackend_Syslog) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *LoggingBackend_Syslog) GetTransport() string {
+	if m != nil {
+		return m.Transport
+	}
+	return ""
+}
+
+func (m *LoggingBackend_Syslog) GetFacility() string {
+	if m != nil {
+		return m.Facility
+	}
+	return ""
+}
+
+func (m *LoggingBackend_Syslog) GetAppName() string {
+	if m != nil {
+		return m.AppName
+	}
+	return ""
+}
+
+// Http defines logging to an HTTP endpoint. Entries are sent in batches as
+// newline-delimited bodies of POST requests.
+type LoggingBackend_Http struct {
+	// URL of the endpoint.
+	Url string `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	// Maximum number of entries in a single batch. Default: 100
+	MaxBatchSize uint32 `protobuf:"varint,2,opt,name=maxBatchSize,proto3" json:"maxBatchSize,omitempty"`
+	// Maximum time an entry is buffered before a batch is sent. Default: 5s
+	FlushInterval        *duration.Duration `protobuf:"bytes,3,opt,name=flushInterval,proto3" json:"flushInterval,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *LoggingBackend_Http) Reset()         { *m = LoggingBackend_Http{} }
+func (m *LoggingBackend_Http) String() string { return proto.CompactTextString(m) }
+func (*LoggingBackend_Http) ProtoMessage()    {}
+func (*LoggingBackend_Http) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ae9b3cd8c92bbf6a, []int{6, 4}
+}
+
+func (m *LoggingBackend_Http) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LoggingBackend_Http.Unmarshal(m, b)
+}
+func (m *LoggingBackend_Http) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LoggingBackend_Http.Marshal(b, m, deterministic)
+}
+func (m *LoggingBackend_Http) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LoggingBackend_Http.Merge(m, src)
+}
+func (m *LoggingBackend_Http) XXX_Size() int {
+	return xxx_messageInfo_LoggingBackend_Http.Size(m)
+}
+func (m *LoggingBackend_Http) XXX_DiscardUnknown() {
+	xxx_messageInfo_LoggingBackend_Http.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LoggingBackend_Http proto.InternalMessageInfo
+
+func (m *LoggingBackend_Http) GetUrl() string {
+	if m != nil {
+		return m.Url
+	}
+	return ""
+}
+
+func (m *LoggingBackend_Http) GetMaxBatchSize() uint32 {
+	if m != nil {
+		return m.MaxBatchSize
+	}
+	return 0
+}
+
+func (m *LoggingBackend_Http) GetFlushInterval() *duration.Duration {
+	if m != nil {
+		return m.FlushInterval
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Mesh)(nil), "kuma.mesh.v1alpha1.Mesh")
 	proto.RegisterType((*Mesh_Mtls)(nil), "kuma.mesh.v1alpha1.Mesh.Mtls")
+	proto.RegisterType((*Routing)(nil), "kuma.mesh.v1alpha1.Routing")
 	proto.RegisterType((*CertificateAuthority)(nil), "kuma.mesh.v1alpha1.CertificateAuthority")
 	proto.RegisterType((*CertificateAuthority_Builtin)(nil), "kuma.mesh.v1alpha1.CertificateAuthority.Builtin")
 	proto.RegisterType((*CertificateAuthority_Provided)(nil), "kuma.mesh.v1alpha1.CertificateAuthority.Provided")
 	proto.RegisterType((*Tracing)(nil), "kuma.mesh.v1alpha1.Tracing")
 	proto.RegisterType((*TracingBackend)(nil), "kuma.mesh.v1alpha1.TracingBackend")
 	proto.RegisterType((*TracingBackend_Zipkin)(nil), "kuma.mesh.v1alpha1.TracingBackend.Zipkin")
+	proto.RegisterType((*TracingBackend_Datadog)(nil), "kuma.mesh.v1alpha1.TracingBackend.Datadog")
+	proto.RegisterType((*TracingBackend_Jaeger)(nil), "kuma.mesh.v1alpha1.TracingBackend.Jaeger")
+	proto.RegisterType((*TracingBackend_OpenCensus)(nil), "kuma.mesh.v1alpha1.TracingBackend.OpenCensus")
 	proto.RegisterType((*Logging)(nil), "kuma.mesh.v1alpha1.Logging")
 	proto.RegisterType((*LoggingBackend)(nil), "kuma.mesh.v1alpha1.LoggingBackend")
+	proto.RegisterMapType((map[string]string)(nil), "kuma.mesh.v1alpha1.LoggingBackend.JsonFormatEntry")
 	proto.RegisterType((*LoggingBackend_File)(nil), "kuma.mesh.v1alpha1.LoggingBackend.File")
 	proto.RegisterType((*LoggingBackend_Tcp)(nil), "kuma.mesh.v1alpha1.LoggingBackend.Tcp")
+	proto.RegisterType((*LoggingBackend_Syslog)(nil), "kuma.mesh.v1alpha1.LoggingBackend.Syslog")
+	proto.RegisterType((*LoggingBackend_Http)(nil), "kuma.mesh.v1alpha1.LoggingBackend.Http")
 }
 
 func init() { proto.RegisterFile("mesh/v1alpha1/mesh.proto", fileDescriptor_ae9b3cd8c92bbf6a) }
 
 var fileDescriptor_ae9b3cd8c92bbf6a = []byte{
-	// 589 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x93, 0xdd, 0x6e, 0xd3, 0x30,
-	0x14, 0xc7, 0xdb, 0x34, 0x6a, 0xd3, 0x33, 0x31, 0x21, 0x0b, 0xa1, 0x28, 0x83, 0x31, 0x55, 0x68,
-	0x8c, 0x9b, 0x94, 0x16, 0x21, 0x4d, 0x48, 0x20, 0xd1, 0x21, 0x54, 0xa4, 0x4d, 0x20, 0xab, 0xda,
-	0x45, 0xaf, 0x70, 0x12, 0xb7, 0xb5, 0xea, 0x24, 0xc6, 0x71, 0x86, 0xc6, 0x3b, 0xf0, 0x42, 0x3c,
-	0x07, 0x8f, 0xc3, 0x05, 0x72, 0x62, 0x97, 0x75, 0xeb, 0x58, 0x2f, 0xb8, 0xf3, 0x39, 0xe7, 0xff,
-	0x3b, 0x1f, 0x39, 0x27, 0xe0, 0xa7, 0xb4, 0x58, 0xf4, 0x2f, 0x06, 0x84, 0x8b, 0x05, 0x19, 0xf4,
-	0xb5, 0x15, 0x0a, 0x99, 0xab, 0x1c, 0xa1, 0x65, 0x99, 0x92, 0xb0, 0x72, 0xd8, 0x70, 0xb0, 0x77,
-	0x5d, 0xad, 0x24, 0x8b, 0x8b, 0x1a, 0x08, 0xf6, 0xe7, 0x79, 0x3e, 0xe7, 0xb4, 0x5f, 0x59, 0x51,
-	0x39, 0xeb, 0x7f, 0x93, 0x44, 0x08, 0x2a, 0x4d, 0xbc, 0xf7, 0xd3, 0x01, 0xf7, 0x8c, 0x16, 0x0b,
-	0x34, 0x00, 0x37, 0x55, 0xbc, 0xf0, 0x9b, 0x07, 0xcd, 0xa3, 0x9d, 0xe1, 0xe3, 0xf0, 0x66, 0xa1,
-	0x50, 0xeb, 0xc2, 0x33, 0xc5, 0x0b, 0x5c, 0x49, 0xd1, 0x2b, 0xe8, 0x28, 0x49, 0x62, 0x96, 0xcd,
-	0x7d, 0xa7, 0xa2, 0xf6, 0x36, 0x51, 0x93, 0x5a, 0x82, 0xad, 0x56, 0x63, 0x3c, 0x9f, 0xcf, 0x35,
-	0xd6, 0xba, 0x1d, 0x3b, 0xad, 0x25, 0xd8, 0x6a, 0x35, 0x66, 0x46, 0xf3, 0xdd, 0xdb, 0xb1, 0xb3,
-	0x5a, 0x82, 0xad, 0x36, 0x98, 0x82, 0xab, 0x5b, 0x46, 0xc7, 0xe0, 0xc4, 0xc4, 0x4c, 0x77, 0xb4,
-	0x89, 0x3c, 0xa1, 0x52, 0xb1, 0x19, 0x8b, 0x89, 0xa2, 0xef, 0x4a, 0xb5, 0xc8, 0x25, 0x53, 0x97,
-	0xd8, 0x89, 0x09, 0xf2, 0xa1, 0x43, 0x33, 0x12, 0x71, 0x9a, 0x54, 0x63, 0x7a, 0xd8, 0x9a, 0xbd,
-	0x5f, 0x4d, 0x78, 0xb0, 0x09, 0x43, 0xa7, 0xd0, 0x89, 0x4a, 0xc6, 0x15, 0xcb, 0x4c, 0xc5, 0x17,
-	0xdb, 0x56, 0x0c, 0x47, 0x35, 0x37, 0x6e, 0x60, 0x9b, 0x02, 0x7d, 0x02, 0x4f, 0xc8, 0xfc, 0x82,
-	0x25, 0xa6, 0x83, 0x9d, 0xe1, 0x60, 0xeb, 0x74, 0x9f, 0x0d, 0x38, 0x6e, 0xe0, 0x55, 0x92, 0xa0,
-	0x0b, 0x1d, 0x53, 0x26, 0x00, 0xf0, 0xac, 0x64, 0xd4, 0x06, 0x57, 0x5d, 0x0a, 0xda, 0xfb, 0x0a,
-	0x1d, 0xb3, 0x34, 0x74, 0x08, 0xbb, 0x09, 0x9d, 0x91, 0x92, 0xab, 0x11, 0x89, 0x97, 0x34, 0x4b,
-	0xaa, 0x79, 0xba, 0xf8, 0x9a, 0x17, 0xbd, 0x05, 0x2f, 0xaa, 0x9f, 0x85, 0xef, 0x1c, 0xb4, 0x8e,
-	0x76, 0x86, 0xbd, 0x7f, 0xdc, 0x82, 0xa1, 0xf0, 0x8a, 0xe9, 0xfd, 0x70, 0x60, 0x77, 0x3d, 0x88,
-	0x10, 0xb8, 0x19, 0x49, 0xa9, 0x29, 0x58, 0xbd, 0xd1, 0x31, 0x78, 0x05, 0x49, 0x05, 0xff, 0x7b,
-	0x72, 0x8f, 0xc2, 0xfa, 0xc0, 0x43, 0x7b, 0xe0, 0xe1, 0xfb, 0xbc, 0x8c, 0x38, 0x3d, 0x27, 0xbc,
-	0xa4, 0x78, 0xa5, 0x46, 0x27, 0xd0, 0xfe, 0xce, 0xc4, 0x92, 0x65, 0xe6, 0xe6, 0x9e, 0xdf, 0xdd,
-	0x5e, 0x38, 0xad, 0x80, 0x71, 0x03, 0x1b, 0x34, 0xf8, 0x02, 0xed, 0xda, 0x87, 0xee, 0x43, 0xab,
-	0x94, 0xdc, 0xf4, 0xa6, 0x9f, 0xe8, 0x29, 0xdc, 0xd3, 0x07, 0x4e, 0x3f, 0x26, 0x83, 0xe1, 0x71,
-	0xc4, 0x94, 0xb9, 0x95, 0x75, 0x27, 0xda, 0x07, 0x20, 0x82, 0x9d, 0x53, 0x59, 0xb0, 0xbc, 0x6e,
-	0xa5, 0x8b, 0xaf, 0x78, 0xae, 0xae, 0xc0, 0xfc, 0x00, 0xff, 0x7b, 0x05, 0x26, 0xed, 0xcd, 0x15,
-	0xfc, 0x6e, 0xc2, 0xee, 0x7a, 0x70, 0xe3, 0x0a, 0x1e, 0x42, 0x7b, 0x96, 0xcb, 0x94, 0xd4, 0x03,
-	0x76, 0xb1, 0xb1, 0xd0, 0x1b, 0x70, 0x67, 0x8c, 0x53, 0xf3, 0x79, 0x9f, 0xdd, 0x5d, 0x3a, 0xfc,
-	0xc0, 0x38, 0x1d, 0x37, 0x70, 0x85, 0xa1, 0xd7, 0xd0, 0x52, 0xb1, 0x30, 0x7f, 0xf6, 0xe1, 0x16,
-	0xf4, 0x24, 0x16, 0xe3, 0x06, 0xd6, 0x50, 0x10, 0x80, 0xab, 0x73, 0xe9, 0x76, 0x05, 0x51, 0x0b,
-	0xdb, 0xae, 0x7e, 0x07, 0x4f, 0xa0, 0x35, 0x89, 0x85, 0xfe, 0x87, 0x49, 0x92, 0x48, 0x5a, 0x14,
-	0x26, 0x6a, 0x4d, 0xfb, 0xc5, 0x47, 0x30, 0xf5, 0x6c, 0xa9, 0xa8, 0x5d, 0x1d, 0xd3, 0xcb, 0x3f,
-	0x01, 0x00, 0x00, 0xff, 0xff, 0xce, 0xec, 0x63, 0x75, 0x88, 0x05, 0x00, 0x00,
+	// 1005 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x96, 0xff, 0x6e, 0x1b, 0x45,
+	0x10, 0xc7, 0x6d, 0xc7, 0x3d, 0xdb, 0x13, 0x12, 0xd0, 0x2a, 0xaa, 0xcc, 0x35, 0x2d, 0x91, 0x85,
+	0x4a, 0x40, 0xe2, 0x42, 0x8c, 0x90, 0xa2, 0x4a, 0x05, 0xe2, 0x94, 0xc8, 0xa9, 0x12, 0x52, 0x6d,
+	0x43, 0x25, 0xf2, 0x4f, 0x58, 0xdf, 0xad, 0xed, 0x8d, 0xd7, 0xb7, 0xc7, 0xee, 0x9e, 0x8b, 0x2b,
+	0xf1, 0x02, 0x3c, 0x04, 0xff, 0xf3, 0x3e, 0xbc, 0x08, 0x6f, 0x80, 0xf6, 0xc7, 0x39, 0x71, 0xe2,
+	0xa4, 0x16, 0xe2, 0xbf, 0x9d, 0xd9, 0xf9, 0xcc, 0xce, 0xce, 0xce, 0xf7, 0x6c, 0x68, 0x8e, 0xa9,
+	0x1a, 0xee, 0x4c, 0x76, 0x09, 0xcf, 0x86, 0x64, 0x77, 0xc7, 0x58, 0x51, 0x26, 0x85, 0x16, 0x08,
+	0x8d, 0xf2, 0x31, 0x89, 0xac, 0xa3, 0xd8, 0x0e, 0x1f, 0xdd, 0x8c, 0xd6, 0x92, 0xc5, 0xca, 0x01,
+	0xe1, 0x93, 0x81, 0x10, 0x03, 0x4e, 0x77, 0xac, 0xd5, 0xcb, 0xfb, 0x3b, 0x49, 0x2e, 0x89, 0x66,
+	0x22, 0xbd, 0x6b, 0xff, 0xad, 0x24, 0x59, 0x46, 0xa5, 0xe7, 0x5b, 0xff, 0x54, 0xa0, 0x7a, 0x42,
+	0xd5, 0x10, 0xed, 0x42, 0x75, 0xac, 0xb9, 0x6a, 0x96, 0xb7, 0xca, 0xdb, 0xab, 0xed, 0xc7, 0xd1,
+	0xed, 0x42, 0x22, 0x13, 0x17, 0x9d, 0x68, 0xae, 0xb0, 0x0d, 0x45, 0xdf, 0x40, 0x4d, 0x4b, 0x12,
+	0xb3, 0x74, 0xd0, 0xac, 0x58, 0xea, 0xd1, 0x22, 0xea, 0xcc, 0x85, 0xe0, 0x22, 0xd6, 0x60, 0x5c,
+	0x0c, 0x06, 0x06, 0x5b, 0xb9, 0x1b, 0x3b, 0x76, 0x21, 0xb8, 0x88, 0x35, 0x98, 0xbf, 0x7a, 0xb3,
+	0x7a, 0x37, 0x76, 0xe2, 0x42, 0x70, 0x11, 0x6b, 0x30, 0x29, 0x72, 0x6d, 0x4e, 0x7b, 0x70, 0x37,
+	0x86, 0x5d, 0x08, 0x2e, 0x62, 0xc3, 0x73, 0xa8, 0x9a, 0x9b, 0xa2, 0x3d, 0xa8, 0xc4, 0xc4, 0x37,
+	0x65, 0x7b, 0x11, 0x79, 0x40, 0xa5, 0x66, 0x7d, 0x16, 0x13, 0x4d, 0xf7, 0x73, 0x3d, 0x14, 0x92,
+	0xe9, 0x29, 0xae, 0xc4, 0x04, 0x35, 0xa1, 0x46, 0x53, 0xd2, 0xe3, 0x34, 0xb1, 0xdd, 0xa9, 0xe3,
+	0xc2, 0x6c, 0x1d, 0x43, 0xcd, 0x9f, 0x87, 0xf6, 0xe1, 0x31, 0x17, 0x31, 0xe1, 0x4c, 0x4f, 0x2f,
+	0xc8, 0x5b, 0x22, 0xe9, 0x05, 0x17, 0x24, 0xb9, 0xe8, 0x11, 0x4e, 0x52, 0xdb, 0xd8, 0xb2, 0x45,
+	0xc3, 0x22, 0x68, 0xdf, 0xc4, 0x1c, 0x0b, 0x92, 0x74, 0x8a, 0x88, 0xd6, 0xdf, 0x65, 0xd8, 0x58,
+	0x54, 0x04, 0x3a, 0x86, 0x5a, 0x2f, 0x67, 0x5c, 0xb3, 0xd4, 0xd7, 0xff, 0xd5, 0xb2, 0xf5, 0x47,
+	0x1d, 0xc7, 0x75, 0x4b, 0xb8, 0x48, 0x81, 0x4e, 0xa1, 0x9e, 0x49, 0x31, 0x61, 0x89, 0xbf, 0xcf,
+	0x6a, 0x7b, 0x77, 0xe9, 0x74, 0xaf, 0x3c, 0xd8, 0x2d, 0xe1, 0x59, 0x92, 0xb0, 0x01, 0x35, 0x7f,
+	0x4c, 0x08, 0x50, 0x2f, 0x42, 0x3a, 0x01, 0x54, 0xf5, 0x34, 0xa3, 0xad, 0x5f, 0xa1, 0xe6, 0x27,
+	0x07, 0x3d, 0x85, 0xf5, 0x84, 0xf6, 0x49, 0xce, 0x75, 0x87, 0xc4, 0x23, 0x9a, 0x26, 0xf6, 0x3e,
+	0x0d, 0x7c, 0xc3, 0x8b, 0xbe, 0x85, 0x7a, 0xcf, 0x2d, 0x55, 0xb3, 0xb2, 0xb5, 0xb2, 0xbd, 0xda,
+	0x6e, 0xdd, 0x33, 0x90, 0x9e, 0xc2, 0x33, 0xa6, 0xf5, 0x57, 0x00, 0xeb, 0xf3, 0x9b, 0x08, 0x41,
+	0x35, 0x25, 0x63, 0xea, 0x0f, 0xb4, 0x6b, 0xb4, 0x07, 0x75, 0x45, 0xc6, 0x19, 0xbf, 0x9a, 0xfb,
+	0xcd, 0xc8, 0xa9, 0x2c, 0x2a, 0x54, 0x16, 0xbd, 0x10, 0x79, 0x8f, 0xd3, 0x37, 0x84, 0xe7, 0x14,
+	0xcf, 0xa2, 0xd1, 0x01, 0x04, 0xef, 0x58, 0x36, 0x62, 0xa9, 0x1f, 0xfc, 0xcf, 0xdf, 0x5f, 0x5e,
+	0x74, 0x6e, 0x81, 0x6e, 0x09, 0x7b, 0x14, 0x1d, 0x42, 0x2d, 0x21, 0x9a, 0x24, 0x62, 0xe0, 0x75,
+	0xf0, 0xc5, 0x12, 0x59, 0x5e, 0x38, 0xc2, 0x3c, 0xa8, 0x87, 0x4d, 0x31, 0x97, 0x84, 0x0e, 0xa8,
+	0x6c, 0x3e, 0x58, 0xba, 0x98, 0x97, 0x16, 0x30, 0xc5, 0x38, 0x14, 0x9d, 0x02, 0x88, 0x8c, 0xa6,
+	0x07, 0x34, 0x55, 0xb9, 0x6a, 0x06, 0x36, 0xd1, 0x97, 0x4b, 0x24, 0x3a, 0x9d, 0x41, 0xdd, 0x12,
+	0xbe, 0x96, 0x22, 0xfc, 0x05, 0x02, 0x77, 0x63, 0xf4, 0x11, 0xac, 0xe4, 0x92, 0xfb, 0xce, 0x9b,
+	0x25, 0xfa, 0x14, 0xd6, 0xcc, 0x37, 0x84, 0x1e, 0x25, 0xbb, 0xed, 0xbd, 0x1e, 0xd3, 0x5e, 0x57,
+	0xf3, 0x4e, 0xf4, 0x04, 0x80, 0x64, 0xec, 0x0d, 0x95, 0x8a, 0x09, 0xd7, 0xe8, 0x06, 0xbe, 0xe6,
+	0x09, 0x7f, 0x86, 0x9a, 0xef, 0x86, 0x91, 0x28, 0x49, 0x12, 0x49, 0x95, 0xf2, 0xc7, 0x14, 0xa6,
+	0x79, 0xf7, 0x4c, 0x48, 0x77, 0xc2, 0x1a, 0xb6, 0x6b, 0xb4, 0x05, 0xab, 0x8a, 0xca, 0x09, 0x8b,
+	0xe9, 0x8f, 0x66, 0x24, 0x5c, 0xe6, 0xeb, 0xae, 0xf0, 0x7b, 0x08, 0x5c, 0x87, 0xfe, 0x6b, 0xf1,
+	0xe1, 0x9f, 0x65, 0x80, 0xab, 0xde, 0x98, 0xc9, 0x17, 0x31, 0x19, 0xd0, 0x54, 0xef, 0xcf, 0xd5,
+	0x79, 0xc3, 0x8b, 0x36, 0xa1, 0xe1, 0xa6, 0xe3, 0x27, 0xc9, 0x6d, 0xe2, 0x06, 0xbe, 0x72, 0xa0,
+	0x36, 0x6c, 0x28, 0x4d, 0xe2, 0x51, 0x22, 0xd9, 0x84, 0xca, 0x57, 0x52, 0x5c, 0xd2, 0x58, 0x1f,
+	0x25, 0xfe, 0x06, 0x0b, 0xf7, 0xd0, 0x43, 0x08, 0x94, 0x4e, 0x44, 0xae, 0xed, 0x90, 0xd5, 0xb1,
+	0xb7, 0xae, 0xcb, 0xd3, 0x7f, 0xa1, 0xff, 0x6f, 0x79, 0xfa, 0xb4, 0xb7, 0xe5, 0xf9, 0x47, 0x00,
+	0xeb, 0xf3, 0x9b, 0x0b, 0xe5, 0xf9, 0x10, 0x82, 0xbe, 0x90, 0x63, 0xa2, 0x7d, 0x23, 0xbc, 0x85,
+	0x30, 0xc0, 0xa5, 0x12, 0xe9, 0xa1, 0xdb, 0xab, 0xd9, 0x02, 0xda, 0xef, 0x2f, 0x20, 0x7a, 0x39,
+	0x83, 0x7e, 0x48, 0xb5, 0x9c, 0xe2, 0x6b, 0x59, 0xd0, 0x73, 0xa8, 0xf6, 0x19, 0xa7, 0x5e, 0xce,
+	0x9f, 0x2d, 0x91, 0xed, 0x90, 0x71, 0xda, 0x2d, 0x61, 0x8b, 0xa1, 0x67, 0xb0, 0xa2, 0xe3, 0xcc,
+	0xcb, 0xf8, 0xe9, 0x12, 0xf4, 0x59, 0x9c, 0x75, 0x4b, 0xd8, 0x40, 0x46, 0xbe, 0x6a, 0xaa, 0xb8,
+	0x18, 0xdc, 0x27, 0xdf, 0x1b, 0xf8, 0x6b, 0x0b, 0x18, 0xf9, 0x3a, 0xd4, 0xd4, 0x3f, 0xd4, 0x3a,
+	0x6b, 0x06, 0x4b, 0xd7, 0xdf, 0xd5, 0xda, 0x94, 0x60, 0xb1, 0xf0, 0x39, 0x7c, 0x78, 0xa3, 0x3b,
+	0x66, 0xf0, 0x47, 0x74, 0x5a, 0x0c, 0xfe, 0x88, 0x4e, 0xd1, 0x06, 0x3c, 0x98, 0x98, 0xef, 0xa0,
+	0x7f, 0x0e, 0x67, 0x3c, 0xab, 0xec, 0x95, 0xc3, 0x10, 0xaa, 0xa6, 0x1d, 0x56, 0x6c, 0x44, 0x0f,
+	0x8b, 0x57, 0x34, 0xeb, 0xf0, 0x13, 0x58, 0x39, 0x8b, 0xb3, 0xbb, 0x15, 0x1a, 0x4e, 0x20, 0x70,
+	0xd7, 0xb9, 0x47, 0xc5, 0x9b, 0xd0, 0xd0, 0x92, 0xa4, 0x6a, 0x26, 0xe5, 0x06, 0xbe, 0x72, 0xa0,
+	0x10, 0xea, 0x7d, 0x12, 0x33, 0xf3, 0xb3, 0xea, 0xa5, 0x30, 0xb3, 0x6d, 0xce, 0x2c, 0xb3, 0x3a,
+	0xaf, 0xfa, 0x9c, 0xce, 0x0c, 0x7f, 0x87, 0xaa, 0xe9, 0xc1, 0x02, 0x85, 0xb7, 0xe0, 0x83, 0x31,
+	0xf9, 0xad, 0x43, 0x74, 0x3c, 0x7c, 0xcd, 0xde, 0x51, 0xff, 0xed, 0x98, 0xf3, 0xa1, 0xef, 0x60,
+	0xad, 0xcf, 0x73, 0x35, 0x3c, 0x4a, 0x35, 0x95, 0x13, 0xc2, 0xfd, 0xe4, 0x7c, 0x7c, 0xfb, 0x07,
+	0xc4, 0xff, 0x8d, 0xc3, 0xf3, 0xf1, 0x85, 0xfe, 0x3a, 0x70, 0x5e, 0x2f, 0x9e, 0xa8, 0x17, 0x58,
+	0xea, 0xeb, 0x7f, 0x07, 0x00, 0x00, 0xb7, 0xae, 0xe5, 0x57, 0x0a, 0x00, 0x00,
 }
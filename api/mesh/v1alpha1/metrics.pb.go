@@ -25,10 +25,20 @@ const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
 type Metrics struct {
 	// Prometheus-specific configuration for metrics that should be collected and
 	// exposed by dataplanes.
-	Prometheus           *Metrics_Prometheus `protobuf:"bytes,1,opt,name=prometheus,proto3" json:"prometheus,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
-	XXX_unrecognized     []byte              `json:"-"`
-	XXX_sizecache        int32               `json:"-"`
+	Prometheus *Metrics_Prometheus `protobuf:"bytes,1,opt,name=prometheus,proto3" json:"prometheus,omitempty"`
+	// StatsD-specific configuration for metrics that should be pushed by
+	// dataplanes. Can only be defined on a Mesh.
+	Statsd *Metrics_StatsD `protobuf:"bytes,2,opt,name=statsd,proto3" json:"statsd,omitempty"`
+	// DogStatsD-specific configuration for metrics that should be pushed by
+	// dataplanes. Can only be defined on a Mesh.
+	Dogstatsd *Metrics_DogStatsD `protobuf:"bytes,3,opt,name=dogstatsd,proto3" json:"dogstatsd,omitempty"`
+	// Tags to extract from names of metrics pushed by dataplanes in addition to
+	// the default ones. Tags `service`, `version` and `zone` of a dataplane are
+	// always attached to its metrics. Can only be defined on a Mesh.
+	TagExtractors        []*Metrics_TagExtractor `protobuf:"bytes,4,rep,name=tagExtractors,proto3" json:"tagExtractors,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
+	XXX_unrecognized     []byte                  `json:"-"`
+	XXX_sizecache        int32                   `json:"-"`
 }
 
 func (m *Metrics) Reset()         { *m = Metrics{} }
@@ -63,6 +73,27 @@ func (m *Metrics) GetPrometheus() *Metrics_Prometheus {
 	return nil
 }
 
+func (m *Metrics) GetStatsd() *Metrics_StatsD {
+	if m != nil {
+		return m.Statsd
+	}
+	return nil
+}
+
+func (m *Metrics) GetDogstatsd() *Metrics_DogStatsD {
+	if m != nil {
+		return m.Dogstatsd
+	}
+	return nil
+}
+
+func (m *Metrics) GetTagExtractors() []*Metrics_TagExtractor {
+	if m != nil {
+		return m.TagExtractors
+	}
+	return nil
+}
+
 // Prometheus defines Prometheus-specific configuration for metrics that
 // should be collected and exposed by dataplanes.
 type Metrics_Prometheus struct {
@@ -71,10 +102,14 @@ type Metrics_Prometheus struct {
 	Port uint32 `protobuf:"varint,1,opt,name=port,proto3" json:"port,omitempty"`
 	// Path on which a dataplane should expose HTTP endpoint with Prometheus
 	// metrics.
-	Path                 string   `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	Path string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	// Applications whose metrics should be merged with metrics of
+	// a dataplane and exposed on the same HTTP endpoint.
+	// Can only be defined on a Dataplane.
+	Applications         []*Metrics_Prometheus_Application `protobuf:"bytes,3,rep,name=applications,proto3" json:"applications,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                          `json:"-"`
+	XXX_unrecognized     []byte                            `json:"-"`
+	XXX_sizecache        int32                             `json:"-"`
 }
 
 func (m *Metrics_Prometheus) Reset()         { *m = Metrics_Prometheus{} }
@@ -116,23 +151,264 @@ func (m *Metrics_Prometheus) GetPath() string {
 	return ""
 }
 
+func (m *Metrics_Prometheus) GetApplications() []*Metrics_Prometheus_Application {
+	if m != nil {
+		return m.Applications
+	}
+	return nil
+}
+
+// Application defines an HTTP endpoint with Prometheus metrics of
+// an application that should be merged with metrics of a dataplane.
+type Metrics_Prometheus_Application struct {
+	// Name of the application. It is used to label metrics of that
+	// application.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Port on which the application exposes Prometheus metrics on
+	// the loopback interface.
+	Port uint32 `protobuf:"varint,2,opt,name=port,proto3" json:"port,omitempty"`
+	// Path on which the application exposes Prometheus metrics.
+	// Defaults to `/metrics`.
+	Path                 string   `protobuf:"bytes,3,opt,name=path,proto3" json:"path,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Metrics_Prometheus_Application) Reset()         { *m = Metrics_Prometheus_Application{} }
+func (m *Metrics_Prometheus_Application) String() string { return proto.CompactTextString(m) }
+func (*Metrics_Prometheus_Application) ProtoMessage()    {}
+func (*Metrics_Prometheus_Application) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7dd8c7f420ce268c, []int{0, 0, 0}
+}
+
+func (m *Metrics_Prometheus_Application) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Metrics_Prometheus_Application.Unmarshal(m, b)
+}
+func (m *Metrics_Prometheus_Application) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Metrics_Prometheus_Application.Marshal(b, m, deterministic)
+}
+func (m *Metrics_Prometheus_Application) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Metrics_Prometheus_Application.Merge(m, src)
+}
+func (m *Metrics_Prometheus_Application) XXX_Size() int {
+	return xxx_messageInfo_Metrics_Prometheus_Application.Size(m)
+}
+func (m *Metrics_Prometheus_Application) XXX_DiscardUnknown() {
+	xxx_messageInfo_Metrics_Prometheus_Application.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Metrics_Prometheus_Application proto.InternalMessageInfo
+
+func (m *Metrics_Prometheus_Application) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *Metrics_Prometheus_Application) GetPort() uint32 {
+	if m != nil {
+		return m.Port
+	}
+	return 0
+}
+
+func (m *Metrics_Prometheus_Application) GetPath() string {
+	if m != nil {
+		return m.Path
+	}
+	return ""
+}
+
+// StatsD defines configuration of a sink that pushes metrics of dataplanes
+// to a StatsD server over UDP.
+type Metrics_StatsD struct {
+	// Address of a StatsD server in the `IP:PORT` format.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// Prefix of names of all metrics. Default: envoy
+	Prefix               string   `protobuf:"bytes,2,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Metrics_StatsD) Reset()         { *m = Metrics_StatsD{} }
+func (m *Metrics_StatsD) String() string { return proto.CompactTextString(m) }
+func (*Metrics_StatsD) ProtoMessage()    {}
+func (*Metrics_StatsD) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7dd8c7f420ce268c, []int{0, 1}
+}
+
+func (m *Metrics_StatsD) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Metrics_StatsD.Unmarshal(m, b)
+}
+func (m *Metrics_StatsD) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Metrics_StatsD.Marshal(b, m, deterministic)
+}
+func (m *Metrics_StatsD) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Metrics_StatsD.Merge(m, src)
+}
+func (m *Metrics_StatsD) XXX_Size() int {
+	return xxx_messageInfo_Metrics_StatsD.Size(m)
+}
+func (m *Metrics_StatsD) XXX_DiscardUnknown() {
+	xxx_messageInfo_Metrics_StatsD.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Metrics_StatsD proto.InternalMessageInfo
+
+func (m *Metrics_StatsD) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *Metrics_StatsD) GetPrefix() string {
+	if m != nil {
+		return m.Prefix
+	}
+	return ""
+}
+
+// DogStatsD defines configuration of a sink that pushes metrics of
+// dataplanes to a DogStatsD server (e.g., Datadog agent) over UDP.
+// In contrast to StatsD, metrics are pushed together with their tags.
+type Metrics_DogStatsD struct {
+	// Address of a DogStatsD server in the `IP:PORT` format.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// Prefix of names of all metrics. Default: envoy
+	Prefix               string   `protobuf:"bytes,2,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Metrics_DogStatsD) Reset()         { *m = Metrics_DogStatsD{} }
+func (m *Metrics_DogStatsD) String() string { return proto.CompactTextString(m) }
+func (*Metrics_DogStatsD) ProtoMessage()    {}
+func (*Metrics_DogStatsD) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7dd8c7f420ce268c, []int{0, 2}
+}
+
+func (m *Metrics_DogStatsD) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Metrics_DogStatsD.Unmarshal(m, b)
+}
+func (m *Metrics_DogStatsD) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Metrics_DogStatsD.Marshal(b, m, deterministic)
+}
+func (m *Metrics_DogStatsD) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Metrics_DogStatsD.Merge(m, src)
+}
+func (m *Metrics_DogStatsD) XXX_Size() int {
+	return xxx_messageInfo_Metrics_DogStatsD.Size(m)
+}
+func (m *Metrics_DogStatsD) XXX_DiscardUnknown() {
+	xxx_messageInfo_Metrics_DogStatsD.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Metrics_DogStatsD proto.InternalMessageInfo
+
+func (m *Metrics_DogStatsD) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *Metrics_DogStatsD) GetPrefix() string {
+	if m != nil {
+		return m.Prefix
+	}
+	return ""
+}
+
+// TagExtractor defines how to extract a tag out of names of metrics.
+type Metrics_TagExtractor struct {
+	// Name of the tag.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Regular expression applied to names of metrics. The first capture group
+	// is removed from the name, the second one becomes the value of the tag,
+	// e.g. `^cluster\.((.+?)\.)` extracts the name of a cluster.
+	Regex                string   `protobuf:"bytes,2,opt,name=regex,proto3" json:"regex,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Metrics_TagExtractor) Reset()         { *m = Metrics_TagExtractor{} }
+func (m *Metrics_TagExtractor) String() string { return proto.CompactTextString(m) }
+func (*Metrics_TagExtractor) ProtoMessage()    {}
+func (*Metrics_TagExtractor) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7dd8c7f420ce268c, []int{0, 3}
+}
+
+func (m *Metrics_TagExtractor) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Metrics_TagExtractor.Unmarshal(m, b)
+}
+func (m *Metrics_TagExtractor) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Metrics_TagExtractor.Marshal(b, m, deterministic)
+}
+func (m *Metrics_TagExtractor) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Metrics_TagExtractor.Merge(m, src)
+}
+func (m *Metrics_TagExtractor) XXX_Size() int {
+	return xxx_messageInfo_Metrics_TagExtractor.Size(m)
+}
+func (m *Metrics_TagExtractor) XXX_DiscardUnknown() {
+	xxx_messageInfo_Metrics_TagExtractor.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Metrics_TagExtractor proto.InternalMessageInfo
+
+func (m *Metrics_TagExtractor) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *Metrics_TagExtractor) GetRegex() string {
+	if m != nil {
+		return m.Regex
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*Metrics)(nil), "kuma.mesh.v1alpha1.Metrics")
 	proto.RegisterType((*Metrics_Prometheus)(nil), "kuma.mesh.v1alpha1.Metrics.Prometheus")
+	proto.RegisterType((*Metrics_Prometheus_Application)(nil), "kuma.mesh.v1alpha1.Metrics.Prometheus.Application")
+	proto.RegisterType((*Metrics_StatsD)(nil), "kuma.mesh.v1alpha1.Metrics.StatsD")
+	proto.RegisterType((*Metrics_DogStatsD)(nil), "kuma.mesh.v1alpha1.Metrics.DogStatsD")
+	proto.RegisterType((*Metrics_TagExtractor)(nil), "kuma.mesh.v1alpha1.Metrics.TagExtractor")
 }
 
 func init() { proto.RegisterFile("mesh/v1alpha1/metrics.proto", fileDescriptor_7dd8c7f420ce268c) }
 
 var fileDescriptor_7dd8c7f420ce268c = []byte{
-	// 153 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0xce, 0x4d, 0x2d, 0xce,
-	0xd0, 0x2f, 0x33, 0x4c, 0xcc, 0x29, 0xc8, 0x48, 0x34, 0xd4, 0xcf, 0x4d, 0x2d, 0x29, 0xca, 0x4c,
-	0x2e, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0xca, 0x2e, 0xcd, 0x4d, 0xd4, 0x03, 0xa9,
-	0xd0, 0x83, 0xa9, 0x50, 0x6a, 0x67, 0xe4, 0x62, 0xf7, 0x85, 0xa8, 0x12, 0x72, 0xe3, 0xe2, 0x2a,
-	0x28, 0xca, 0xcf, 0x4d, 0x2d, 0xc9, 0x48, 0x2d, 0x2d, 0x96, 0x60, 0x54, 0x60, 0xd4, 0xe0, 0x36,
-	0x52, 0xd3, 0xc3, 0xd4, 0xa4, 0x07, 0xd5, 0xa0, 0x17, 0x00, 0x57, 0x1d, 0x84, 0xa4, 0x53, 0xca,
-	0x84, 0x8b, 0x0b, 0x21, 0x23, 0x24, 0xc4, 0xc5, 0x52, 0x90, 0x5f, 0x54, 0x02, 0x36, 0x8f, 0x37,
-	0x08, 0xcc, 0x06, 0x8b, 0x25, 0x96, 0x64, 0x48, 0x30, 0x29, 0x30, 0x6a, 0x70, 0x06, 0x81, 0xd9,
-	0x4e, 0x5c, 0x51, 0x1c, 0x30, 0x0b, 0x92, 0xd8, 0xc0, 0x0e, 0x36, 0x06, 0x04, 0x00, 0x00, 0xff,
-	0xff, 0xbf, 0xe9, 0xed, 0xe9, 0xcf, 0x00, 0x00, 0x00,
+	// 337 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x92, 0xcd, 0x4a, 0xc3, 0x40,
+	0x14, 0x85, 0x69, 0xd3, 0xa6, 0xe6, 0xb6, 0xdd, 0x0c, 0x22, 0x21, 0x6e, 0x4a, 0x41, 0xe9, 0x6a,
+	0x4a, 0xeb, 0x46, 0x0a, 0x2e, 0xd4, 0x2a, 0xb8, 0x50, 0x64, 0x14, 0x17, 0xee, 0xc6, 0x66, 0x4c,
+	0x82, 0x4d, 0x67, 0x98, 0x99, 0x4a, 0x5f, 0xcb, 0xa7, 0xf1, 0x75, 0xa4, 0x93, 0xc9, 0x4f, 0xb1,
+	0x04, 0x71, 0x77, 0x6f, 0x72, 0xbe, 0x93, 0x73, 0x0f, 0x81, 0xe3, 0x94, 0xa9, 0x78, 0xfc, 0x39,
+	0xa1, 0x4b, 0x11, 0xd3, 0xc9, 0x38, 0x65, 0x5a, 0x26, 0x0b, 0x85, 0x85, 0xe4, 0x9a, 0x23, 0xf4,
+	0xb1, 0x4e, 0x29, 0xde, 0x2a, 0x70, 0xae, 0x18, 0x7e, 0xb5, 0xa1, 0x73, 0x9f, 0xa9, 0xd0, 0x2d,
+	0x80, 0x90, 0x3c, 0x65, 0x3a, 0x66, 0x6b, 0xe5, 0x37, 0x06, 0x8d, 0x51, 0x77, 0x7a, 0x8a, 0x7f,
+	0x43, 0xd8, 0x02, 0xf8, 0xb1, 0x50, 0x93, 0x0a, 0x89, 0x66, 0xe0, 0x2a, 0x4d, 0xb5, 0x0a, 0xfd,
+	0xa6, 0xf1, 0x18, 0xd6, 0x79, 0x3c, 0x6d, 0x95, 0x73, 0x62, 0x09, 0x74, 0x0d, 0x5e, 0xc8, 0x23,
+	0x8b, 0x3b, 0x06, 0x3f, 0xa9, 0xc3, 0xe7, 0x3c, 0xb2, 0x0e, 0x25, 0x87, 0x1e, 0xa0, 0xaf, 0x69,
+	0x74, 0xb3, 0xd1, 0x92, 0x2e, 0x34, 0x97, 0xca, 0x6f, 0x0d, 0x9c, 0x51, 0x77, 0x3a, 0xaa, 0x33,
+	0x7a, 0xae, 0x00, 0x64, 0x17, 0x0f, 0xbe, 0x1b, 0x00, 0xe5, 0xad, 0x08, 0x41, 0x4b, 0x70, 0xa9,
+	0x4d, 0x43, 0x7d, 0x62, 0x66, 0xf3, 0x8c, 0xea, 0xd8, 0x5c, 0xec, 0x11, 0x33, 0xa3, 0x17, 0xe8,
+	0x51, 0x21, 0x96, 0xc9, 0x82, 0xea, 0x84, 0xaf, 0x94, 0xef, 0x98, 0x14, 0xd3, 0xbf, 0x35, 0x8a,
+	0x2f, 0x4b, 0x94, 0xec, 0xf8, 0x04, 0x77, 0xd0, 0xad, 0xbc, 0xdc, 0x7e, 0x7a, 0x45, 0x53, 0x66,
+	0xe2, 0x78, 0xc4, 0xcc, 0x45, 0xc4, 0xe6, 0x9e, 0x88, 0x4e, 0x19, 0x31, 0x98, 0x81, 0x9b, 0xd5,
+	0x87, 0x7c, 0xe8, 0xd0, 0x30, 0x94, 0x4c, 0x29, 0x6b, 0x94, 0xaf, 0xe8, 0x08, 0x5c, 0x21, 0xd9,
+	0x7b, 0xb2, 0xb1, 0xc7, 0xd9, 0x2d, 0xb8, 0x00, 0xaf, 0x68, 0xff, 0x1f, 0xf8, 0x39, 0xf4, 0xaa,
+	0x9d, 0xef, 0x3d, 0xe3, 0x10, 0xda, 0x92, 0x45, 0x2c, 0x47, 0xb3, 0xe5, 0x0a, 0x5e, 0x0f, 0xf2,
+	0xe2, 0xde, 0x5c, 0xf3, 0x6b, 0x9f, 0xfd, 0x0c, 0x00, 0x2b, 0x45, 0x19, 0x2f, 0xf9, 0x02, 0x00,
+	0x00,
 }
@@ -77,10 +77,14 @@ type ProxyTemplate_Conf struct {
 	Imports []string `protobuf:"bytes,1,rep,name=imports,proto3" json:"imports,omitempty"`
 	// List of raw xDS resources.
 	// +optional
-	Resources            []*ProxyTemplateRawResource `protobuf:"bytes,2,rep,name=resources,proto3" json:"resources,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                    `json:"-"`
-	XXX_unrecognized     []byte                      `json:"-"`
-	XXX_sizecache        int32                       `json:"-"`
+	Resources []*ProxyTemplateRawResource `protobuf:"bytes,2,rep,name=resources,proto3" json:"resources,omitempty"`
+	// List of modifications applied in order on top of xDS resources
+	// generated by imported profiles and raw resources.
+	// +optional
+	Modifications        []*ProxyTemplate_Modifications `protobuf:"bytes,3,rep,name=modifications,proto3" json:"modifications,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                       `json:"-"`
+	XXX_unrecognized     []byte                         `json:"-"`
+	XXX_sizecache        int32                          `json:"-"`
 }
 
 func (m *ProxyTemplate_Conf) Reset()         { *m = ProxyTemplate_Conf{} }
@@ -122,6 +126,774 @@ func (m *ProxyTemplate_Conf) GetResources() []*ProxyTemplateRawResource {
 	return nil
 }
 
+func (m *ProxyTemplate_Conf) GetModifications() []*ProxyTemplate_Modifications {
+	if m != nil {
+		return m.Modifications
+	}
+	return nil
+}
+
+// Modifications defines a modification of generated xDS resources.
+//
+// Resources are selected by name, by origin (`inbound`, `outbound`,
+// `transparent` or `prometheus`) of a resource, or by both. Patches are
+// applied as JSON merge patches (RFC 7386) written in YAML.
+type ProxyTemplate_Modifications struct {
+	// Types that are valid to be assigned to Type:
+	//	*ProxyTemplate_Modifications_Cluster_
+	//	*ProxyTemplate_Modifications_Listener_
+	//	*ProxyTemplate_Modifications_NetworkFilter_
+	//	*ProxyTemplate_Modifications_HttpFilter_
+	//	*ProxyTemplate_Modifications_VirtualHost_
+	Type                 isProxyTemplate_Modifications_Type `protobuf_oneof:"type"`
+	XXX_NoUnkeyedLiteral struct{}                           `json:"-"`
+	XXX_unrecognized     []byte                             `json:"-"`
+	XXX_sizecache        int32                              `json:"-"`
+}
+
+func (m *ProxyTemplate_Modifications) Reset()         { *m = ProxyTemplate_Modifications{} }
+func (m *ProxyTemplate_Modifications) String() string { return proto.CompactTextString(m) }
+func (*ProxyTemplate_Modifications) ProtoMessage()    {}
+func (*ProxyTemplate_Modifications) Descriptor() ([]byte, []int) {
+	return fileDescriptor_129e53d675ac14f4, []int{0, 1}
+}
+
+func (m *ProxyTemplate_Modifications) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ProxyTemplate_Modifications.Unmarshal(m, b)
+}
+func (m *ProxyTemplate_Modifications) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ProxyTemplate_Modifications.Marshal(b, m, deterministic)
+}
+func (m *ProxyTemplate_Modifications) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProxyTemplate_Modifications.Merge(m, src)
+}
+func (m *ProxyTemplate_Modifications) XXX_Size() int {
+	return xxx_messageInfo_ProxyTemplate_Modifications.Size(m)
+}
+func (m *ProxyTemplate_Modifications) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProxyTemplate_Modifications.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProxyTemplate_Modifications proto.InternalMessageInfo
+
+type isProxyTemplate_Modifications_Type interface {
+	isProxyTemplate_Modifications_Type()
+}
+
+type ProxyTemplate_Modifications_Cluster_ struct {
+	Cluster *ProxyTemplate_Modifications_Cluster `protobuf:"bytes,1,opt,name=cluster,proto3,oneof"`
+}
+
+type ProxyTemplate_Modifications_Listener_ struct {
+	Listener *ProxyTemplate_Modifications_Listener `protobuf:"bytes,2,opt,name=listener,proto3,oneof"`
+}
+
+type ProxyTemplate_Modifications_NetworkFilter_ struct {
+	NetworkFilter *ProxyTemplate_Modifications_NetworkFilter `protobuf:"bytes,3,opt,name=networkFilter,proto3,oneof"`
+}
+
+type ProxyTemplate_Modifications_HttpFilter_ struct {
+	HttpFilter *ProxyTemplate_Modifications_HttpFilter `protobuf:"bytes,4,opt,name=httpFilter,proto3,oneof"`
+}
+
+type ProxyTemplate_Modifications_VirtualHost_ struct {
+	VirtualHost *ProxyTemplate_Modifications_VirtualHost `protobuf:"bytes,5,opt,name=virtualHost,proto3,oneof"`
+}
+
+func (*ProxyTemplate_Modifications_Cluster_) isProxyTemplate_Modifications_Type() {}
+
+func (*ProxyTemplate_Modifications_Listener_) isProxyTemplate_Modifications_Type() {}
+
+func (*ProxyTemplate_Modifications_NetworkFilter_) isProxyTemplate_Modifications_Type() {}
+
+func (*ProxyTemplate_Modifications_HttpFilter_) isProxyTemplate_Modifications_Type() {}
+
+func (*ProxyTemplate_Modifications_VirtualHost_) isProxyTemplate_Modifications_Type() {}
+
+func (m *ProxyTemplate_Modifications) GetType() isProxyTemplate_Modifications_Type {
+	if m != nil {
+		return m.Type
+	}
+	return nil
+}
+
+func (m *ProxyTemplate_Modifications) GetCluster() *ProxyTemplate_Modifications_Cluster {
+	if x, ok := m.GetType().(*ProxyTemplate_Modifications_Cluster_); ok {
+		return x.Cluster
+	}
+	return nil
+}
+
+func (m *ProxyTemplate_Modifications) GetListener() *ProxyTemplate_Modifications_Listener {
+	if x, ok := m.GetType().(*ProxyTemplate_Modifications_Listener_); ok {
+		return x.Listener
+	}
+	return nil
+}
+
+func (m *ProxyTemplate_Modifications) GetNetworkFilter() *ProxyTemplate_Modifications_NetworkFilter {
+	if x, ok := m.GetType().(*ProxyTemplate_Modifications_NetworkFilter_); ok {
+		return x.NetworkFilter
+	}
+	return nil
+}
+
+func (m *ProxyTemplate_Modifications) GetHttpFilter() *ProxyTemplate_Modifications_HttpFilter {
+	if x, ok := m.GetType().(*ProxyTemplate_Modifications_HttpFilter_); ok {
+		return x.HttpFilter
+	}
+	return nil
+}
+
+func (m *ProxyTemplate_Modifications) GetVirtualHost() *ProxyTemplate_Modifications_VirtualHost {
+	if x, ok := m.GetType().(*ProxyTemplate_Modifications_VirtualHost_); ok {
+		return x.VirtualHost
+	}
+	return nil
+}
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*ProxyTemplate_Modifications) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*ProxyTemplate_Modifications_Cluster_)(nil),
+		(*ProxyTemplate_Modifications_Listener_)(nil),
+		(*ProxyTemplate_Modifications_NetworkFilter_)(nil),
+		(*ProxyTemplate_Modifications_HttpFilter_)(nil),
+		(*ProxyTemplate_Modifications_VirtualHost_)(nil),
+	}
+}
+
+// Cluster modification.
+type ProxyTemplate_Modifications_Cluster struct {
+	// Clusters to patch or remove. All clusters are selected if omitted.
+	// +optional
+	Match *ProxyTemplate_Modifications_Cluster_Match `protobuf:"bytes,1,opt,name=match,proto3" json:"match,omitempty"`
+	// Operation: `add`, `patch` or `remove`.
+	Operation string `protobuf:"bytes,2,opt,name=operation,proto3" json:"operation,omitempty"`
+	// Cluster to add or a patch in YAML.
+	// +optional
+	Value                string   `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ProxyTemplate_Modifications_Cluster) Reset()         { *m = ProxyTemplate_Modifications_Cluster{} }
+func (m *ProxyTemplate_Modifications_Cluster) String() string { return proto.CompactTextString(m) }
+func (*ProxyTemplate_Modifications_Cluster) ProtoMessage()    {}
+func (*ProxyTemplate_Modifications_Cluster) Descriptor() ([]byte, []int) {
+	return fileDescriptor_129e53d675ac14f4, []int{0, 1, 0}
+}
+
+func (m *ProxyTemplate_Modifications_Cluster) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ProxyTemplate_Modifications_Cluster.Unmarshal(m, b)
+}
+func (m *ProxyTemplate_Modifications_Cluster) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ProxyTemplate_Modifications_Cluster.Marshal(b, m, deterministic)
+}
+func (m *ProxyTemplate_Modifications_Cluster) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProxyTemplate_Modifications_Cluster.Merge(m, src)
+}
+func (m *ProxyTemplate_Modifications_Cluster) XXX_Size() int {
+	return xxx_messageInfo_ProxyTemplate_Modifications_Cluster.Size(m)
+}
+func (m *ProxyTemplate_Modifications_Cluster) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProxyTemplate_Modifications_Cluster.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProxyTemplate_Modifications_Cluster proto.InternalMessageInfo
+
+func (m *ProxyTemplate_Modifications_Cluster) GetMatch() *ProxyTemplate_Modifications_Cluster_Match {
+	if m != nil {
+		return m.Match
+	}
+	return nil
+}
+
+func (m *ProxyTemplate_Modifications_Cluster) GetOperation() string {
+	if m != nil {
+		return m.Operation
+	}
+	return ""
+}
+
+func (m *ProxyTemplate_Modifications_Cluster) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
+}
+
+// Match criteria of clusters to patch or remove.
+type ProxyTemplate_Modifications_Cluster_Match struct {
+	// Name of a cluster.
+	// +optional
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Origin of a cluster.
+	// +optional
+	Origin               string   `protobuf:"bytes,2,opt,name=origin,proto3" json:"origin,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ProxyTemplate_Modifications_Cluster_Match) Reset() {
+	*m = ProxyTemplate_Modifications_Cluster_Match{}
+}
+func (m *ProxyTemplate_Modifications_Cluster_Match) String() string {
+	return proto.CompactTextString(m)
+}
+func (*ProxyTemplate_Modifications_Cluster_Match) ProtoMessage() {}
+func (*ProxyTemplate_Modifications_Cluster_Match) Descriptor() ([]byte, []int) {
+	return fileDescriptor_129e53d675ac14f4, []int{0, 1, 0, 0}
+}
+
+func (m *ProxyTemplate_Modifications_Cluster_Match) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ProxyTemplate_Modifications_Cluster_Match.Unmarshal(m, b)
+}
+func (m *ProxyTemplate_Modifications_Cluster_Match) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ProxyTemplate_Modifications_Cluster_Match.Marshal(b, m, deterministic)
+}
+func (m *ProxyTemplate_Modifications_Cluster_Match) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProxyTemplate_Modifications_Cluster_Match.Merge(m, src)
+}
+func (m *ProxyTemplate_Modifications_Cluster_Match) XXX_Size() int {
+	return xxx_messageInfo_ProxyTemplate_Modifications_Cluster_Match.Size(m)
+}
+func (m *ProxyTemplate_Modifications_Cluster_Match) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProxyTemplate_Modifications_Cluster_Match.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProxyTemplate_Modifications_Cluster_Match proto.InternalMessageInfo
+
+func (m *ProxyTemplate_Modifications_Cluster_Match) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *ProxyTemplate_Modifications_Cluster_Match) GetOrigin() string {
+	if m != nil {
+		return m.Origin
+	}
+	return ""
+}
+
+// Listener modification.
+type ProxyTemplate_Modifications_Listener struct {
+	// Listeners to patch or remove. All listeners are selected if omitted.
+	// +optional
+	Match *ProxyTemplate_Modifications_Listener_Match `protobuf:"bytes,1,opt,name=match,proto3" json:"match,omitempty"`
+	// Operation: `add`, `patch` or `remove`.
+	Operation string `protobuf:"bytes,2,opt,name=operation,proto3" json:"operation,omitempty"`
+	// Listener to add or a patch in YAML.
+	// +optional
+	Value                string   `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ProxyTemplate_Modifications_Listener) Reset()         { *m = ProxyTemplate_Modifications_Listener{} }
+func (m *ProxyTemplate_Modifications_Listener) String() string { return proto.CompactTextString(m) }
+func (*ProxyTemplate_Modifications_Listener) ProtoMessage()    {}
+func (*ProxyTemplate_Modifications_Listener) Descriptor() ([]byte, []int) {
+	return fileDescriptor_129e53d675ac14f4, []int{0, 1, 1}
+}
+
+func (m *ProxyTemplate_Modifications_Listener) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ProxyTemplate_Modifications_Listener.Unmarshal(m, b)
+}
+func (m *ProxyTemplate_Modifications_Listener) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ProxyTemplate_Modifications_Listener.Marshal(b, m, deterministic)
+}
+func (m *ProxyTemplate_Modifications_Listener) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProxyTemplate_Modifications_Listener.Merge(m, src)
+}
+func (m *ProxyTemplate_Modifications_Listener) XXX_Size() int {
+	return xxx_messageInfo_ProxyTemplate_Modifications_Listener.Size(m)
+}
+func (m *ProxyTemplate_Modifications_Listener) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProxyTemplate_Modifications_Listener.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProxyTemplate_Modifications_Listener proto.InternalMessageInfo
+
+func (m *ProxyTemplate_Modifications_Listener) GetMatch() *ProxyTemplate_Modifications_Listener_Match {
+	if m != nil {
+		return m.Match
+	}
+	return nil
+}
+
+func (m *ProxyTemplate_Modifications_Listener) GetOperation() string {
+	if m != nil {
+		return m.Operation
+	}
+	return ""
+}
+
+func (m *ProxyTemplate_Modifications_Listener) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
+}
+
+// Match criteria of listeners to patch or remove.
+type ProxyTemplate_Modifications_Listener_Match struct {
+	// Name of a listener.
+	// +optional
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Origin of a listener.
+	// +optional
+	Origin               string   `protobuf:"bytes,2,opt,name=origin,proto3" json:"origin,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ProxyTemplate_Modifications_Listener_Match) Reset() {
+	*m = ProxyTemplate_Modifications_Listener_Match{}
+}
+func (m *ProxyTemplate_Modifications_Listener_Match) String() string {
+	return proto.CompactTextString(m)
+}
+func (*ProxyTemplate_Modifications_Listener_Match) ProtoMessage() {}
+func (*ProxyTemplate_Modifications_Listener_Match) Descriptor() ([]byte, []int) {
+	return fileDescriptor_129e53d675ac14f4, []int{0, 1, 1, 0}
+}
+
+func (m *ProxyTemplate_Modifications_Listener_Match) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ProxyTemplate_Modifications_Listener_Match.Unmarshal(m, b)
+}
+func (m *ProxyTemplate_Modifications_Listener_Match) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ProxyTemplate_Modifications_Listener_Match.Marshal(b, m, deterministic)
+}
+func (m *ProxyTemplate_Modifications_Listener_Match) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProxyTemplate_Modifications_Listener_Match.Merge(m, src)
+}
+func (m *ProxyTemplate_Modifications_Listener_Match) XXX_Size() int {
+	return xxx_messageInfo_ProxyTemplate_Modifications_Listener_Match.Size(m)
+}
+func (m *ProxyTemplate_Modifications_Listener_Match) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProxyTemplate_Modifications_Listener_Match.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProxyTemplate_Modifications_Listener_Match proto.InternalMessageInfo
+
+func (m *ProxyTemplate_Modifications_Listener_Match) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *ProxyTemplate_Modifications_Listener_Match) GetOrigin() string {
+	if m != nil {
+		return m.Origin
+	}
+	return ""
+}
+
+// Network filter modification.
+type ProxyTemplate_Modifications_NetworkFilter struct {
+	// Network filters to modify. Filters of all listeners are selected if
+	// omitted.
+	// +optional
+	Match *ProxyTemplate_Modifications_NetworkFilter_Match `protobuf:"bytes,1,opt,name=match,proto3" json:"match,omitempty"`
+	// Operation: `addFirst`, `addLast`, `addBefore`, `addAfter`, `patch`
+	// or `remove`. `addBefore` and `addAfter` require the name of a filter
+	// in match.
+	Operation string `protobuf:"bytes,2,opt,name=operation,proto3" json:"operation,omitempty"`
+	// Network filter to add or a patch in YAML.
+	// +optional
+	Value                string   `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ProxyTemplate_Modifications_NetworkFilter) Reset() {
+	*m = ProxyTemplate_Modifications_NetworkFilter{}
+}
+func (m *ProxyTemplate_Modifications_NetworkFilter) String() string {
+	return proto.CompactTextString(m)
+}
+func (*ProxyTemplate_Modifications_NetworkFilter) ProtoMessage() {}
+func (*ProxyTemplate_Modifications_NetworkFilter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_129e53d675ac14f4, []int{0, 1, 2}
+}
+
+func (m *ProxyTemplate_Modifications_NetworkFilter) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ProxyTemplate_Modifications_NetworkFilter.Unmarshal(m, b)
+}
+func (m *ProxyTemplate_Modifications_NetworkFilter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ProxyTemplate_Modifications_NetworkFilter.Marshal(b, m, deterministic)
+}
+func (m *ProxyTemplate_Modifications_NetworkFilter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProxyTemplate_Modifications_NetworkFilter.Merge(m, src)
+}
+func (m *ProxyTemplate_Modifications_NetworkFilter) XXX_Size() int {
+	return xxx_messageInfo_ProxyTemplate_Modifications_NetworkFilter.Size(m)
+}
+func (m *ProxyTemplate_Modifications_NetworkFilter) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProxyTemplate_Modifications_NetworkFilter.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProxyTemplate_Modifications_NetworkFilter proto.InternalMessageInfo
+
+func (m *ProxyTemplate_Modifications_NetworkFilter) GetMatch() *ProxyTemplate_Modifications_NetworkFilter_Match {
+	if m != nil {
+		return m.Match
+	}
+	return nil
+}
+
+func (m *ProxyTemplate_Modifications_NetworkFilter) GetOperation() string {
+	if m != nil {
+		return m.Operation
+	}
+	return ""
+}
+
+func (m *ProxyTemplate_Modifications_NetworkFilter) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
+}
+
+// Match criteria of network filters.
+type ProxyTemplate_Modifications_NetworkFilter_Match struct {
+	// Name of a network filter, e.g. `envoy.tcp_proxy`.
+	// +optional
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Name of a listener.
+	// +optional
+	ListenerName string `protobuf:"bytes,2,opt,name=listenerName,proto3" json:"listenerName,omitempty"`
+	// Origin of a listener.
+	// +optional
+	Origin               string   `protobuf:"bytes,3,opt,name=origin,proto3" json:"origin,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ProxyTemplate_Modifications_NetworkFilter_Match) Reset() {
+	*m = ProxyTemplate_Modifications_NetworkFilter_Match{}
+}
+func (m *ProxyTemplate_Modifications_NetworkFilter_Match) String() string {
+	return proto.CompactTextString(m)
+}
+func (*ProxyTemplate_Modifications_NetworkFilter_Match) ProtoMessage() {}
+func (*ProxyTemplate_Modifications_NetworkFilter_Match) Descriptor() ([]byte, []int) {
+	return fileDescriptor_129e53d675ac14f4, []int{0, 1, 2, 0}
+}
+
+func (m *ProxyTemplate_Modifications_NetworkFilter_Match) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ProxyTemplate_Modifications_NetworkFilter_Match.Unmarshal(m, b)
+}
+func (m *ProxyTemplate_Modifications_NetworkFilter_Match) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ProxyTemplate_Modifications_NetworkFilter_Match.Marshal(b, m, deterministic)
+}
+func (m *ProxyTemplate_Modifications_NetworkFilter_Match) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProxyTemplate_Modifications_NetworkFilter_Match.Merge(m, src)
+}
+func (m *ProxyTemplate_Modifications_NetworkFilter_Match) XXX_Size() int {
+	return xxx_messageInfo_ProxyTemplate_Modifications_NetworkFilter_Match.Size(m)
+}
+func (m *ProxyTemplate_Modifications_NetworkFilter_Match) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProxyTemplate_Modifications_NetworkFilter_Match.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProxyTemplate_Modifications_NetworkFilter_Match proto.InternalMessageInfo
+
+func (m *ProxyTemplate_Modifications_NetworkFilter_Match) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *ProxyTemplate_Modifications_NetworkFilter_Match) GetListenerName() string {
+	if m != nil {
+		return m.ListenerName
+	}
+	return ""
+}
+
+func (m *ProxyTemplate_Modifications_NetworkFilter_Match) GetOrigin() string {
+	if m != nil {
+		return m.Origin
+	}
+	return ""
+}
+
+// HTTP filter modification.
+type ProxyTemplate_Modifications_HttpFilter struct {
+	// HTTP filters to modify. Filters of all HTTP connection managers are
+	// selected if omitted.
+	// +optional
+	Match *ProxyTemplate_Modifications_HttpFilter_Match `protobuf:"bytes,1,opt,name=match,proto3" json:"match,omitempty"`
+	// Operation: `addFirst`, `addLast`, `addBefore`, `addAfter`, `patch`
+	// or `remove`. `addBefore` and `addAfter` require the name of a filter
+	// in match.
+	Operation string `protobuf:"bytes,2,opt,name=operation,proto3" json:"operation,omitempty"`
+	// HTTP filter to add or a patch in YAML.
+	// +optional
+	Value                string   `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ProxyTemplate_Modifications_HttpFilter) Reset() {
+	*m = ProxyTemplate_Modifications_HttpFilter{}
+}
+func (m *ProxyTemplate_Modifications_HttpFilter) String() string { return proto.CompactTextString(m) }
+func (*ProxyTemplate_Modifications_HttpFilter) ProtoMessage()    {}
+func (*ProxyTemplate_Modifications_HttpFilter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_129e53d675ac14f4, []int{0, 1, 3}
+}
+
+func (m *ProxyTemplate_Modifications_HttpFilter) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ProxyTemplate_Modifications_HttpFilter.Unmarshal(m, b)
+}
+func (m *ProxyTemplate_Modifications_HttpFilter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ProxyTemplate_Modifications_HttpFilter.Marshal(b, m, deterministic)
+}
+func (m *ProxyTemplate_Modifications_HttpFilter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProxyTemplate_Modifications_HttpFilter.Merge(m, src)
+}
+func (m *ProxyTemplate_Modifications_HttpFilter) XXX_Size() int {
+	return xxx_messageInfo_ProxyTemplate_Modifications_HttpFilter.Size(m)
+}
+func (m *ProxyTemplate_Modifications_HttpFilter) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProxyTemplate_Modifications_HttpFilter.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProxyTemplate_Modifications_HttpFilter proto.InternalMessageInfo
+
+func (m *ProxyTemplate_Modifications_HttpFilter) GetMatch() *ProxyTemplate_Modifications_HttpFilter_Match {
+	if m != nil {
+		return m.Match
+	}
+	return nil
+}
+
+func (m *ProxyTemplate_Modifications_HttpFilter) GetOperation() string {
+	if m != nil {
+		return m.Operation
+	}
+	return ""
+}
+
+func (m *ProxyTemplate_Modifications_HttpFilter) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
+}
+
+// Match criteria of HTTP filters.
+type ProxyTemplate_Modifications_HttpFilter_Match struct {
+	// Name of an HTTP filter, e.g. `envoy.router`.
+	// +optional
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Name of a listener.
+	// +optional
+	ListenerName string `protobuf:"bytes,2,opt,name=listenerName,proto3" json:"listenerName,omitempty"`
+	// Origin of a listener.
+	// +optional
+	Origin               string   `protobuf:"bytes,3,opt,name=origin,proto3" json:"origin,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ProxyTemplate_Modifications_HttpFilter_Match) Reset() {
+	*m = ProxyTemplate_Modifications_HttpFilter_Match{}
+}
+func (m *ProxyTemplate_Modifications_HttpFilter_Match) String() string {
+	return proto.CompactTextString(m)
+}
+func (*ProxyTemplate_Modifications_HttpFilter_Match) ProtoMessage() {}
+func (*ProxyTemplate_Modifications_HttpFilter_Match) Descriptor() ([]byte, []int) {
+	return fileDescriptor_129e53d675ac14f4, []int{0, 1, 3, 0}
+}
+
+func (m *ProxyTemplate_Modifications_HttpFilter_Match) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ProxyTemplate_Modifications_HttpFilter_Match.Unmarshal(m, b)
+}
+func (m *ProxyTemplate_Modifications_HttpFilter_Match) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ProxyTemplate_Modifications_HttpFilter_Match.Marshal(b, m, deterministic)
+}
+func (m *ProxyTemplate_Modifications_HttpFilter_Match) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProxyTemplate_Modifications_HttpFilter_Match.Merge(m, src)
+}
+func (m *ProxyTemplate_Modifications_HttpFilter_Match) XXX_Size() int {
+	return xxx_messageInfo_ProxyTemplate_Modifications_HttpFilter_Match.Size(m)
+}
+func (m *ProxyTemplate_Modifications_HttpFilter_Match) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProxyTemplate_Modifications_HttpFilter_Match.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProxyTemplate_Modifications_HttpFilter_Match proto.InternalMessageInfo
+
+func (m *ProxyTemplate_Modifications_HttpFilter_Match) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *ProxyTemplate_Modifications_HttpFilter_Match) GetListenerName() string {
+	if m != nil {
+		return m.ListenerName
+	}
+	return ""
+}
+
+func (m *ProxyTemplate_Modifications_HttpFilter_Match) GetOrigin() string {
+	if m != nil {
+		return m.Origin
+	}
+	return ""
+}
+
+// Virtual host modification.
+type ProxyTemplate_Modifications_VirtualHost struct {
+	// Virtual hosts to patch or remove, or route configurations to add a
+	// virtual host to. Virtual hosts of all route configurations, including
+	// ones embedded into listeners, are selected if omitted.
+	// +optional
+	Match *ProxyTemplate_Modifications_VirtualHost_Match `protobuf:"bytes,1,opt,name=match,proto3" json:"match,omitempty"`
+	// Operation: `add`, `patch` or `remove`.
+	Operation string `protobuf:"bytes,2,opt,name=operation,proto3" json:"operation,omitempty"`
+	// Virtual host to add or a patch in YAML.
+	// +optional
+	Value                string   `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ProxyTemplate_Modifications_VirtualHost) Reset() {
+	*m = ProxyTemplate_Modifications_VirtualHost{}
+}
+func (m *ProxyTemplate_Modifications_VirtualHost) String() string { return proto.CompactTextString(m) }
+func (*ProxyTemplate_Modifications_VirtualHost) ProtoMessage()    {}
+func (*ProxyTemplate_Modifications_VirtualHost) Descriptor() ([]byte, []int) {
+	return fileDescriptor_129e53d675ac14f4, []int{0, 1, 4}
+}
+
+func (m *ProxyTemplate_Modifications_VirtualHost) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ProxyTemplate_Modifications_VirtualHost.Unmarshal(m, b)
+}
+func (m *ProxyTemplate_Modifications_VirtualHost) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ProxyTemplate_Modifications_VirtualHost.Marshal(b, m, deterministic)
+}
+func (m *ProxyTemplate_Modifications_VirtualHost) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProxyTemplate_Modifications_VirtualHost.Merge(m, src)
+}
+func (m *ProxyTemplate_Modifications_VirtualHost) XXX_Size() int {
+	return xxx_messageInfo_ProxyTemplate_Modifications_VirtualHost.Size(m)
+}
+func (m *ProxyTemplate_Modifications_VirtualHost) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProxyTemplate_Modifications_VirtualHost.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProxyTemplate_Modifications_VirtualHost proto.InternalMessageInfo
+
+func (m *ProxyTemplate_Modifications_VirtualHost) GetMatch() *ProxyTemplate_Modifications_VirtualHost_Match {
+	if m != nil {
+		return m.Match
+	}
+	return nil
+}
+
+func (m *ProxyTemplate_Modifications_VirtualHost) GetOperation() string {
+	if m != nil {
+		return m.Operation
+	}
+	return ""
+}
+
+func (m *ProxyTemplate_Modifications_VirtualHost) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
+}
+
+// Match criteria of virtual hosts.
+type ProxyTemplate_Modifications_VirtualHost_Match struct {
+	// Name of a virtual host.
+	// +optional
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Name of a route configuration.
+	// +optional
+	RouteConfigurationName string `protobuf:"bytes,2,opt,name=routeConfigurationName,proto3" json:"routeConfigurationName,omitempty"`
+	// Origin of a route configuration.
+	// +optional
+	Origin               string   `protobuf:"bytes,3,opt,name=origin,proto3" json:"origin,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ProxyTemplate_Modifications_VirtualHost_Match) Reset() {
+	*m = ProxyTemplate_Modifications_VirtualHost_Match{}
+}
+func (m *ProxyTemplate_Modifications_VirtualHost_Match) String() string {
+	return proto.CompactTextString(m)
+}
+func (*ProxyTemplate_Modifications_VirtualHost_Match) ProtoMessage() {}
+func (*ProxyTemplate_Modifications_VirtualHost_Match) Descriptor() ([]byte, []int) {
+	return fileDescriptor_129e53d675ac14f4, []int{0, 1, 4, 0}
+}
+
+func (m *ProxyTemplate_Modifications_VirtualHost_Match) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ProxyTemplate_Modifications_VirtualHost_Match.Unmarshal(m, b)
+}
+func (m *ProxyTemplate_Modifications_VirtualHost_Match) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ProxyTemplate_Modifications_VirtualHost_Match.Marshal(b, m, deterministic)
+}
+func (m *ProxyTemplate_Modifications_VirtualHost_Match) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProxyTemplate_Modifications_VirtualHost_Match.Merge(m, src)
+}
+func (m *ProxyTemplate_Modifications_VirtualHost_Match) XXX_Size() int {
+	return xxx_messageInfo_ProxyTemplate_Modifications_VirtualHost_Match.Size(m)
+}
+func (m *ProxyTemplate_Modifications_VirtualHost_Match) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProxyTemplate_Modifications_VirtualHost_Match.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProxyTemplate_Modifications_VirtualHost_Match proto.InternalMessageInfo
+
+func (m *ProxyTemplate_Modifications_VirtualHost_Match) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *ProxyTemplate_Modifications_VirtualHost_Match) GetRouteConfigurationName() string {
+	if m != nil {
+		return m.RouteConfigurationName
+	}
+	return ""
+}
+
+func (m *ProxyTemplate_Modifications_VirtualHost_Match) GetOrigin() string {
+	if m != nil {
+		return m.Origin
+	}
+	return ""
+}
+
 type ProxyTemplateSource struct {
 	// Name of a configuration source.
 	// +optional
@@ -366,6 +1138,17 @@ func (m *ProxyTemplateRawResource) GetResource() string {
 func init() {
 	proto.RegisterType((*ProxyTemplate)(nil), "kuma.mesh.v1alpha1.ProxyTemplate")
 	proto.RegisterType((*ProxyTemplate_Conf)(nil), "kuma.mesh.v1alpha1.ProxyTemplate.Conf")
+	proto.RegisterType((*ProxyTemplate_Modifications)(nil), "kuma.mesh.v1alpha1.ProxyTemplate.Modifications")
+	proto.RegisterType((*ProxyTemplate_Modifications_Cluster)(nil), "kuma.mesh.v1alpha1.ProxyTemplate.Modifications.Cluster")
+	proto.RegisterType((*ProxyTemplate_Modifications_Cluster_Match)(nil), "kuma.mesh.v1alpha1.ProxyTemplate.Modifications.Cluster.Match")
+	proto.RegisterType((*ProxyTemplate_Modifications_Listener)(nil), "kuma.mesh.v1alpha1.ProxyTemplate.Modifications.Listener")
+	proto.RegisterType((*ProxyTemplate_Modifications_Listener_Match)(nil), "kuma.mesh.v1alpha1.ProxyTemplate.Modifications.Listener.Match")
+	proto.RegisterType((*ProxyTemplate_Modifications_NetworkFilter)(nil), "kuma.mesh.v1alpha1.ProxyTemplate.Modifications.NetworkFilter")
+	proto.RegisterType((*ProxyTemplate_Modifications_NetworkFilter_Match)(nil), "kuma.mesh.v1alpha1.ProxyTemplate.Modifications.NetworkFilter.Match")
+	proto.RegisterType((*ProxyTemplate_Modifications_HttpFilter)(nil), "kuma.mesh.v1alpha1.ProxyTemplate.Modifications.HttpFilter")
+	proto.RegisterType((*ProxyTemplate_Modifications_HttpFilter_Match)(nil), "kuma.mesh.v1alpha1.ProxyTemplate.Modifications.HttpFilter.Match")
+	proto.RegisterType((*ProxyTemplate_Modifications_VirtualHost)(nil), "kuma.mesh.v1alpha1.ProxyTemplate.Modifications.VirtualHost")
+	proto.RegisterType((*ProxyTemplate_Modifications_VirtualHost_Match)(nil), "kuma.mesh.v1alpha1.ProxyTemplate.Modifications.VirtualHost.Match")
 	proto.RegisterType((*ProxyTemplateSource)(nil), "kuma.mesh.v1alpha1.ProxyTemplateSource")
 	proto.RegisterType((*ProxyTemplateProfileSource)(nil), "kuma.mesh.v1alpha1.ProxyTemplateProfileSource")
 	proto.RegisterMapType((map[string]string)(nil), "kuma.mesh.v1alpha1.ProxyTemplateProfileSource.ParamsEntry")
@@ -373,33 +1156,55 @@ func init() {
 	proto.RegisterType((*ProxyTemplateRawResource)(nil), "kuma.mesh.v1alpha1.ProxyTemplateRawResource")
 }
 
-func init() { proto.RegisterFile("mesh/v1alpha1/proxy_template.proto", fileDescriptor_129e53d675ac14f4) }
+func init() {
+	proto.RegisterFile("mesh/v1alpha1/proxy_template.proto", fileDescriptor_129e53d675ac14f4)
+}
 
 var fileDescriptor_129e53d675ac14f4 = []byte{
-	// 392 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x53, 0x4d, 0x8b, 0xda, 0x40,
-	0x18, 0xee, 0x98, 0x54, 0xcd, 0x2b, 0x85, 0x32, 0x2d, 0x65, 0x08, 0x1e, 0x24, 0x87, 0x22, 0xa5,
-	0x8c, 0x68, 0x2f, 0x6d, 0x0e, 0x3d, 0x58, 0x0a, 0xc5, 0x93, 0x8c, 0x3d, 0xf5, 0x52, 0xa6, 0x3a,
-	0xa2, 0x98, 0x64, 0x86, 0x49, 0xd4, 0xe6, 0xc7, 0x2d, 0xfb, 0xab, 0xf6, 0xbe, 0x64, 0x92, 0xd1,
-	0x0d, 0x1b, 0x71, 0x97, 0xbd, 0xbd, 0x4f, 0xf2, 0x7c, 0xbc, 0x1f, 0x0c, 0x04, 0xb1, 0x48, 0x37,
-	0xa3, 0xc3, 0x98, 0x47, 0x6a, 0xc3, 0xc7, 0x23, 0xa5, 0xe5, 0xff, 0xfc, 0x6f, 0x26, 0x62, 0x15,
-	0xf1, 0x4c, 0x50, 0xa5, 0x65, 0x26, 0x31, 0xde, 0xed, 0x63, 0x4e, 0x0b, 0x22, 0xb5, 0x44, 0xbf,
-	0x5f, 0xd7, 0xa5, 0x22, 0x12, 0xcb, 0x4c, 0xea, 0x52, 0x11, 0xdc, 0x21, 0x78, 0x33, 0x2f, 0xac,
-	0x7e, 0x57, 0x4e, 0x38, 0x04, 0xcf, 0x72, 0x52, 0x82, 0x06, 0xce, 0xb0, 0x37, 0xe9, 0xd3, 0xc7,
-	0xbe, 0x74, 0x51, 0x91, 0xd8, 0x99, 0x8e, 0x43, 0x70, 0x97, 0x32, 0x59, 0x93, 0xd6, 0x00, 0x0d,
-	0x7b, 0x93, 0x8f, 0x4d, 0xb2, 0x5a, 0x18, 0xfd, 0x21, 0x93, 0x35, 0x33, 0x1a, 0x3f, 0x02, 0xb7,
-	0x40, 0x98, 0x40, 0x67, 0x1b, 0x2b, 0xa9, 0xb3, 0x32, 0xdd, 0x63, 0x16, 0xe2, 0x19, 0x78, 0x5a,
-	0xa4, 0x72, 0xaf, 0x97, 0x22, 0x25, 0x2d, 0xd3, 0xd9, 0xe7, 0xab, 0x11, 0x8c, 0x1f, 0x59, 0x25,
-	0x62, 0x67, 0x79, 0x70, 0x83, 0xe0, 0x5d, 0x8d, 0xb7, 0x30, 0x3f, 0x30, 0x06, 0x37, 0xe1, 0xb1,
-	0x20, 0x68, 0x80, 0x86, 0x1e, 0x33, 0x35, 0x9e, 0x41, 0x47, 0x69, 0xb9, 0xde, 0x46, 0xa2, 0x1a,
-	0x8c, 0x5e, 0x4d, 0x9d, 0x97, 0xfc, 0xd2, 0xf4, 0xd7, 0x2b, 0x66, 0x0d, 0xf0, 0x77, 0x70, 0x34,
-	0x3f, 0x12, 0xc7, 0xf8, 0x7c, 0x7a, 0x4a, 0xf7, 0x27, 0x8f, 0x42, 0x38, 0x6d, 0x83, 0x9b, 0xe5,
-	0x4a, 0x04, 0xb7, 0x08, 0xfc, 0xcb, 0x89, 0x8d, 0x63, 0x30, 0x68, 0x2b, 0xae, 0x79, 0x6c, 0x77,
-	0x17, 0x3e, 0x6f, 0x0a, 0x3a, 0x37, 0xe2, 0x9f, 0x49, 0xa6, 0x73, 0x56, 0x39, 0xf9, 0xdf, 0xa0,
-	0xf7, 0xe0, 0x33, 0x7e, 0x0b, 0xce, 0x4e, 0xe4, 0x55, 0x6a, 0x51, 0xe2, 0xf7, 0xf0, 0xfa, 0xc0,
-	0xa3, 0x7d, 0xb9, 0x39, 0x8f, 0x95, 0x20, 0x6c, 0x7d, 0x45, 0xc1, 0x0a, 0x3e, 0x34, 0x8f, 0x5a,
-	0xbf, 0x33, 0x7a, 0xd9, 0x9d, 0x57, 0x40, 0x2e, 0xd1, 0x1a, 0x97, 0x44, 0xa0, 0x73, 0x10, 0x3a,
-	0xdd, 0xca, 0xa4, 0xea, 0xd8, 0x42, 0xec, 0x43, 0xd7, 0xda, 0x9a, 0xf3, 0x79, 0xec, 0x84, 0xa7,
-	0xf0, 0xa7, 0x6b, 0xbb, 0xfa, 0xd7, 0x36, 0x0f, 0xeb, 0xcb, 0x7d, 0x00, 0x00, 0x00, 0xff, 0xff,
-	0xfb, 0x1c, 0xe2, 0x60, 0xb0, 0x03, 0x00, 0x00,
+	// 720 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x96, 0xcd, 0x6e, 0xd3, 0x40,
+	0x10, 0xc7, 0xe3, 0x7c, 0x67, 0xd2, 0x08, 0xb4, 0xa0, 0xca, 0xb2, 0x7a, 0xa8, 0x72, 0x40, 0x15,
+	0x42, 0xae, 0xda, 0x4a, 0x50, 0x82, 0xa8, 0xa0, 0x15, 0xc8, 0xaa, 0x68, 0x55, 0x6d, 0x4a, 0x2b,
+	0x10, 0x52, 0x65, 0xd2, 0x4d, 0x63, 0xc5, 0xf6, 0x5a, 0xeb, 0x75, 0x4a, 0x9e, 0x80, 0xa7, 0x42,
+	0x1c, 0x38, 0x00, 0x8f, 0xc3, 0x09, 0x8e, 0xc8, 0xeb, 0x75, 0xec, 0x15, 0x49, 0x4b, 0x9a, 0x03,
+	0xdc, 0xbc, 0xeb, 0x99, 0xdf, 0xcc, 0x7f, 0x76, 0x56, 0xb3, 0xd0, 0xf6, 0x48, 0x38, 0x58, 0x1f,
+	0x6d, 0xd8, 0x6e, 0x30, 0xb0, 0x37, 0xd6, 0x03, 0x46, 0x3f, 0x8c, 0xcf, 0x38, 0xf1, 0x02, 0xd7,
+	0xe6, 0xc4, 0x0c, 0x18, 0xe5, 0x14, 0xa1, 0x61, 0xe4, 0xd9, 0x66, 0x6c, 0x68, 0xa6, 0x86, 0xc6,
+	0x8a, 0xea, 0x17, 0x12, 0x97, 0xf4, 0x38, 0x65, 0x89, 0x47, 0xfb, 0xdb, 0x2d, 0x68, 0x1d, 0xc5,
+	0xa8, 0x63, 0x49, 0x42, 0x1d, 0x68, 0xa4, 0x36, 0xa1, 0xae, 0xad, 0x96, 0xd6, 0x9a, 0x9b, 0x2b,
+	0xe6, 0x9f, 0x5c, 0xb3, 0x2b, 0x8d, 0x70, 0x66, 0x8e, 0x3a, 0x50, 0xee, 0x51, 0xbf, 0xaf, 0x17,
+	0x57, 0xb5, 0xb5, 0xe6, 0xe6, 0xbd, 0x69, 0x6e, 0x4a, 0x30, 0x73, 0x8f, 0xfa, 0x7d, 0x2c, 0x7c,
+	0x8c, 0x2f, 0x1a, 0x94, 0xe3, 0x25, 0xd2, 0xa1, 0xe6, 0x78, 0x01, 0x65, 0x3c, 0x09, 0xdf, 0xc0,
+	0xe9, 0x12, 0xed, 0x43, 0x83, 0x91, 0x90, 0x46, 0xac, 0x47, 0x42, 0xbd, 0x28, 0x52, 0x7b, 0x70,
+	0x6d, 0x0c, 0x6c, 0x5f, 0x62, 0xe9, 0x84, 0x33, 0x77, 0xf4, 0x1a, 0x5a, 0x1e, 0x3d, 0x77, 0xfa,
+	0x4e, 0xcf, 0xe6, 0x0e, 0xf5, 0x43, 0xbd, 0x24, 0x78, 0xeb, 0xd7, 0xe7, 0x7c, 0x90, 0x77, 0xc3,
+	0x2a, 0xc5, 0xf8, 0xb5, 0x04, 0x2d, 0xc5, 0x00, 0x75, 0xa1, 0xd6, 0x73, 0xa3, 0x90, 0x13, 0xa6,
+	0x6b, 0xa2, 0x2c, 0x8f, 0xe6, 0x0c, 0x61, 0xee, 0x25, 0xee, 0x56, 0x01, 0xa7, 0x24, 0x74, 0x02,
+	0x75, 0xd7, 0x09, 0x39, 0xf1, 0x09, 0x93, 0xc5, 0xde, 0x9e, 0x97, 0xfa, 0x4a, 0xfa, 0x5b, 0x05,
+	0x3c, 0x61, 0x21, 0x02, 0x2d, 0x9f, 0xf0, 0x4b, 0xca, 0x86, 0x2f, 0x1d, 0x37, 0x4e, 0xb9, 0x24,
+	0xe0, 0x4f, 0xe7, 0x85, 0x1f, 0xe6, 0x21, 0x56, 0x01, 0xab, 0x54, 0xf4, 0x0e, 0x60, 0xc0, 0x79,
+	0x20, 0x63, 0x94, 0x45, 0x8c, 0xce, 0xbc, 0x31, 0xac, 0x09, 0xc1, 0x2a, 0xe0, 0x1c, 0x0f, 0x9d,
+	0x41, 0x73, 0xe4, 0x30, 0x1e, 0xd9, 0xae, 0x45, 0x43, 0xae, 0x57, 0x04, 0xfe, 0xc9, 0xbc, 0xf8,
+	0x93, 0x0c, 0x61, 0x15, 0x70, 0x9e, 0x68, 0x7c, 0xd5, 0xa0, 0x26, 0x0f, 0x05, 0x75, 0xa1, 0xe2,
+	0xd9, 0xbc, 0x37, 0xd0, 0xb5, 0x9b, 0x55, 0x4a, 0x72, 0xcc, 0x83, 0x18, 0x82, 0x13, 0x16, 0x5a,
+	0x81, 0x06, 0x0d, 0x08, 0x13, 0x26, 0xe2, 0x7c, 0x1b, 0x38, 0xdb, 0x40, 0x77, 0xa1, 0x32, 0xb2,
+	0xdd, 0x88, 0x88, 0xc3, 0x69, 0xe0, 0x64, 0x61, 0x6c, 0x41, 0x45, 0x30, 0x10, 0x82, 0xb2, 0x6f,
+	0x7b, 0x44, 0x24, 0xd4, 0xc0, 0xe2, 0x1b, 0x2d, 0x43, 0x95, 0x32, 0xe7, 0xc2, 0x49, 0x69, 0x72,
+	0x65, 0x7c, 0xd7, 0xa0, 0x9e, 0x36, 0x02, 0x3a, 0x56, 0xa5, 0xec, 0xdc, 0xb4, 0xa3, 0xfe, 0x91,
+	0x96, 0x9f, 0x1a, 0xb4, 0x94, 0xbe, 0x43, 0x6f, 0x54, 0x41, 0x7b, 0x0b, 0x75, 0xf1, 0xe2, 0xaa,
+	0x4e, 0xaf, 0x52, 0xd5, 0x86, 0xa5, 0xf4, 0x16, 0x1e, 0xc6, 0xff, 0x12, 0xa6, 0xb2, 0x97, 0x53,
+	0x5e, 0x52, 0x94, 0xff, 0xd0, 0x00, 0xb2, 0xdb, 0x80, 0x4e, 0x54, 0xd9, 0xcf, 0x6e, 0x7e, 0xb1,
+	0xfe, 0x63, 0xcd, 0x1f, 0x8b, 0xd0, 0xcc, 0x5d, 0x51, 0x74, 0xaa, 0x8a, 0x7e, 0xbe, 0xc0, 0x75,
+	0x5f, 0x5c, 0xf5, 0xf0, 0x2a, 0xd5, 0x0f, 0x61, 0x99, 0xd1, 0x88, 0x93, 0x78, 0xd8, 0x39, 0x17,
+	0x51, 0x02, 0xca, 0xe9, 0x9f, 0xf1, 0x77, 0x56, 0x25, 0x76, 0xab, 0x50, 0xe6, 0xe3, 0x80, 0xb4,
+	0x3f, 0x69, 0x70, 0x47, 0x51, 0xd8, 0x15, 0xa3, 0x6e, 0x6a, 0x0e, 0xfb, 0x50, 0x0b, 0x18, 0xed,
+	0x3b, 0x2e, 0x91, 0xe3, 0xc3, 0xbc, 0xb6, 0x5e, 0x47, 0x89, 0x7d, 0x02, 0x8d, 0x67, 0x91, 0x04,
+	0xa0, 0x1d, 0x28, 0x31, 0xfb, 0x52, 0x4e, 0x8a, 0xfb, 0x7f, 0x33, 0x8f, 0x27, 0x8c, 0xd8, 0x71,
+	0x92, 0xff, 0x67, 0x0d, 0x8c, 0xd9, 0x11, 0xa7, 0xca, 0xc0, 0x50, 0x0d, 0x6c, 0x66, 0x7b, 0xe9,
+	0x6b, 0xa0, 0x33, 0x9f, 0x0a, 0xf3, 0x48, 0x38, 0xbf, 0xf0, 0x39, 0x1b, 0x63, 0x49, 0x32, 0x1e,
+	0x43, 0x33, 0xb7, 0x8d, 0x6e, 0x43, 0x69, 0x48, 0xc6, 0x32, 0x6a, 0xfc, 0x99, 0x1d, 0x79, 0x31,
+	0x77, 0xe4, 0x9d, 0xe2, 0xb6, 0xd6, 0x3e, 0x87, 0xe5, 0xe9, 0x52, 0xd5, 0x97, 0x8b, 0xb6, 0xd0,
+	0xcb, 0xa5, 0x7d, 0x0e, 0xfa, 0x2c, 0xb3, 0xa9, 0x45, 0xd2, 0xa1, 0x36, 0x22, 0x2c, 0xcc, 0xda,
+	0x37, 0x5d, 0x22, 0x03, 0xea, 0x29, 0x56, 0xf6, 0xd4, 0x64, 0xbd, 0x0b, 0x6f, 0xeb, 0x69, 0x56,
+	0xef, 0xab, 0xe2, 0xad, 0xb8, 0xf5, 0x7b, 0x00, 0x16, 0xbb, 0x7b, 0xbd, 0x83, 0x0a, 0x00, 0x00,
 }
@@ -0,0 +1,350 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: mesh/v1alpha1/retry.proto
+
+package v1alpha1
+
+import (
+	fmt "fmt"
+	proto "github.com/golang/protobuf/proto"
+	duration "github.com/golang/protobuf/ptypes/duration"
+	wrappers "github.com/golang/protobuf/ptypes/wrappers"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
+
+// Retry defines configuration for retrying failed requests and connections
+// between dataplanes.
+type Retry struct {
+	// List of selectors to match dataplanes that are sources of traffic.
+	Sources []*Selector `protobuf:"bytes,1,rep,name=sources,proto3" json:"sources,omitempty"`
+	// List of selectors to match services that are destinations of traffic.
+	Destinations []*Selector `protobuf:"bytes,2,rep,name=destinations,proto3" json:"destinations,omitempty"`
+	// Configuration of Retry.
+	Conf                 *Retry_Conf `protobuf:"bytes,3,opt,name=conf,proto3" json:"conf,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *Retry) Reset()         { *m = Retry{} }
+func (m *Retry) String() string { return proto.CompactTextString(m) }
+func (*Retry) ProtoMessage()    {}
+func (*Retry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bfe09d3bc5c5967b, []int{0}
+}
+
+func (m *Retry) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Retry.Unmarshal(m, b)
+}
+func (m *Retry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Retry.Marshal(b, m, deterministic)
+}
+func (m *Retry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Retry.Merge(m, src)
+}
+func (m *Retry) XXX_Size() int {
+	return xxx_messageInfo_Retry.Size(m)
+}
+func (m *Retry) XXX_DiscardUnknown() {
+	xxx_messageInfo_Retry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Retry proto.InternalMessageInfo
+
+func (m *Retry) GetSources() []*Selector {
+	if m != nil {
+		return m.Sources
+	}
+	return nil
+}
+
+func (m *Retry) GetDestinations() []*Selector {
+	if m != nil {
+		return m.Destinations
+	}
+	return nil
+}
+
+func (m *Retry) GetConf() *Retry_Conf {
+	if m != nil {
+		return m.Conf
+	}
+	return nil
+}
+
+// Conf defines configuration of retries for various protocols.
+type Retry_Conf struct {
+	// Configuration of retries for HTTP traffic.
+	Http *Retry_Conf_Http `protobuf:"bytes,1,opt,name=http,proto3" json:"http,omitempty"`
+	// Configuration of retries for TCP traffic.
+	Tcp                  *Retry_Conf_Tcp `protobuf:"bytes,2,opt,name=tcp,proto3" json:"tcp,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *Retry_Conf) Reset()         { *m = Retry_Conf{} }
+func (m *Retry_Conf) String() string { return proto.CompactTextString(m) }
+func (*Retry_Conf) ProtoMessage()    {}
+func (*Retry_Conf) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bfe09d3bc5c5967b, []int{0, 0}
+}
+
+func (m *Retry_Conf) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Retry_Conf.Unmarshal(m, b)
+}
+func (m *Retry_Conf) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Retry_Conf.Marshal(b, m, deterministic)
+}
+func (m *Retry_Conf) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Retry_Conf.Merge(m, src)
+}
+func (m *Retry_Conf) XXX_Size() int {
+	return xxx_messageInfo_Retry_Conf.Size(m)
+}
+func (m *Retry_Conf) XXX_DiscardUnknown() {
+	xxx_messageInfo_Retry_Conf.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Retry_Conf proto.InternalMessageInfo
+
+func (m *Retry_Conf) GetHttp() *Retry_Conf_Http {
+	if m != nil {
+		return m.Http
+	}
+	return nil
+}
+
+func (m *Retry_Conf) GetTcp() *Retry_Conf_Tcp {
+	if m != nil {
+		return m.Tcp
+	}
+	return nil
+}
+
+// Http defines configuration of retries for HTTP traffic.
+type Retry_Conf_Http struct {
+	// Number of retries to attempt for a single request.
+	NumRetries *wrappers.UInt32Value `protobuf:"bytes,1,opt,name=num_retries,json=numRetries,proto3" json:"num_retries,omitempty"`
+	// Timeout of each retry attempt.
+	PerTryTimeout *duration.Duration `protobuf:"bytes,2,opt,name=per_try_timeout,json=perTryTimeout,proto3" json:"per_try_timeout,omitempty"`
+	// Back-off between consecutive retries.
+	BackOff *Retry_Conf_Http_BackOff `protobuf:"bytes,3,opt,name=back_off,json=backOff,proto3" json:"back_off,omitempty"`
+	// List of conditions under which a request should be retried, e.g.
+	// 5xx, gateway-error, connect-failure, retriable-4xx, refused-stream,
+	// reset or retriable-status-codes.
+	RetryOn []string `protobuf:"bytes,4,rep,name=retry_on,json=retryOn,proto3" json:"retry_on,omitempty"`
+	// List of HTTP status codes that should be retried.
+	RetriableStatusCodes []uint32 `protobuf:"varint,5,rep,packed,name=retriable_status_codes,json=retriableStatusCodes,proto3" json:"retriable_status_codes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Retry_Conf_Http) Reset()         { *m = Retry_Conf_Http{} }
+func (m *Retry_Conf_Http) String() string { return proto.CompactTextString(m) }
+func (*Retry_Conf_Http) ProtoMessage()    {}
+func (*Retry_Conf_Http) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bfe09d3bc5c5967b, []int{0, 0, 0}
+}
+
+func (m *Retry_Conf_Http) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Retry_Conf_Http.Unmarshal(m, b)
+}
+func (m *Retry_Conf_Http) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Retry_Conf_Http.Marshal(b, m, deterministic)
+}
+func (m *Retry_Conf_Http) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Retry_Conf_Http.Merge(m, src)
+}
+func (m *Retry_Conf_Http) XXX_Size() int {
+	return xxx_messageInfo_Retry_Conf_Http.Size(m)
+}
+func (m *Retry_Conf_Http) XXX_DiscardUnknown() {
+	xxx_messageInfo_Retry_Conf_Http.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Retry_Conf_Http proto.InternalMessageInfo
+
+func (m *Retry_Conf_Http) GetNumRetries() *wrappers.UInt32Value {
+	if m != nil {
+		return m.NumRetries
+	}
+	return nil
+}
+
+func (m *Retry_Conf_Http) GetPerTryTimeout() *duration.Duration {
+	if m != nil {
+		return m.PerTryTimeout
+	}
+	return nil
+}
+
+func (m *Retry_Conf_Http) GetBackOff() *Retry_Conf_Http_BackOff {
+	if m != nil {
+		return m.BackOff
+	}
+	return nil
+}
+
+func (m *Retry_Conf_Http) GetRetryOn() []string {
+	if m != nil {
+		return m.RetryOn
+	}
+	return nil
+}
+
+func (m *Retry_Conf_Http) GetRetriableStatusCodes() []uint32 {
+	if m != nil {
+		return m.RetriableStatusCodes
+	}
+	return nil
+}
+
+// BackOff defines configuration of an exponential back-off between
+// consecutive retries.
+type Retry_Conf_Http_BackOff struct {
+	// Base interval between retries.
+	BaseInterval *duration.Duration `protobuf:"bytes,1,opt,name=base_interval,json=baseInterval,proto3" json:"base_interval,omitempty"`
+	// Maximum interval between retries, by default 10 times the base
+	// interval.
+	MaxInterval          *duration.Duration `protobuf:"bytes,2,opt,name=max_interval,json=maxInterval,proto3" json:"max_interval,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *Retry_Conf_Http_BackOff) Reset()         { *m = Retry_Conf_Http_BackOff{} }
+func (m *Retry_Conf_Http_BackOff) String() string { return proto.CompactTextString(m) }
+func (*Retry_Conf_Http_BackOff) ProtoMessage()    {}
+func (*Retry_Conf_Http_BackOff) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bfe09d3bc5c5967b, []int{0, 0, 0, 0}
+}
+
+func (m *Retry_Conf_Http_BackOff) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Retry_Conf_Http_BackOff.Unmarshal(m, b)
+}
+func (m *Retry_Conf_Http_BackOff) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Retry_Conf_Http_BackOff.Marshal(b, m, deterministic)
+}
+func (m *Retry_Conf_Http_BackOff) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Retry_Conf_Http_BackOff.Merge(m, src)
+}
+func (m *Retry_Conf_Http_BackOff) XXX_Size() int {
+	return xxx_messageInfo_Retry_Conf_Http_BackOff.Size(m)
+}
+func (m *Retry_Conf_Http_BackOff) XXX_DiscardUnknown() {
+	xxx_messageInfo_Retry_Conf_Http_BackOff.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Retry_Conf_Http_BackOff proto.InternalMessageInfo
+
+func (m *Retry_Conf_Http_BackOff) GetBaseInterval() *duration.Duration {
+	if m != nil {
+		return m.BaseInterval
+	}
+	return nil
+}
+
+func (m *Retry_Conf_Http_BackOff) GetMaxInterval() *duration.Duration {
+	if m != nil {
+		return m.MaxInterval
+	}
+	return nil
+}
+
+// Tcp defines configuration of retries for TCP traffic.
+type Retry_Conf_Tcp struct {
+	// Maximum number of attempts to connect to an upstream host.
+	MaxConnectAttempts   uint32   `protobuf:"varint,1,opt,name=max_connect_attempts,json=maxConnectAttempts,proto3" json:"max_connect_attempts,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Retry_Conf_Tcp) Reset()         { *m = Retry_Conf_Tcp{} }
+func (m *Retry_Conf_Tcp) String() string { return proto.CompactTextString(m) }
+func (*Retry_Conf_Tcp) ProtoMessage()    {}
+func (*Retry_Conf_Tcp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bfe09d3bc5c5967b, []int{0, 0, 1}
+}
+
+func (m *Retry_Conf_Tcp) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Retry_Conf_Tcp.Unmarshal(m, b)
+}
+func (m *Retry_Conf_Tcp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Retry_Conf_Tcp.Marshal(b, m, deterministic)
+}
+func (m *Retry_Conf_Tcp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Retry_Conf_Tcp.Merge(m, src)
+}
+func (m *Retry_Conf_Tcp) XXX_Size() int {
+	return xxx_messageInfo_Retry_Conf_Tcp.Size(m)
+}
+func (m *Retry_Conf_Tcp) XXX_DiscardUnknown() {
+	xxx_messageInfo_Retry_Conf_Tcp.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Retry_Conf_Tcp proto.InternalMessageInfo
+
+func (m *Retry_Conf_Tcp) GetMaxConnectAttempts() uint32 {
+	if m != nil {
+		return m.MaxConnectAttempts
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*Retry)(nil), "kuma.mesh.v1alpha1.Retry")
+	proto.RegisterType((*Retry_Conf)(nil), "kuma.mesh.v1alpha1.Retry.Conf")
+	proto.RegisterType((*Retry_Conf_Http)(nil), "kuma.mesh.v1alpha1.Retry.Conf.Http")
+	proto.RegisterType((*Retry_Conf_Http_BackOff)(nil), "kuma.mesh.v1alpha1.Retry.Conf.Http.BackOff")
+	proto.RegisterType((*Retry_Conf_Tcp)(nil), "kuma.mesh.v1alpha1.Retry.Conf.Tcp")
+}
+
+func init() { proto.RegisterFile("mesh/v1alpha1/retry.proto", fileDescriptor_bfe09d3bc5c5967b) }
+
+var fileDescriptor_bfe09d3bc5c5967b = []byte{
+	// 486 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0xdf, 0x6e, 0xd3, 0x30,
+	0x14, 0xc6, 0x95, 0x25, 0x5b, 0xc7, 0x69, 0x2b, 0x24, 0x6b, 0x42, 0x69, 0x34, 0x4d, 0xd5, 0xb8,
+	0xa9, 0x84, 0x94, 0xb2, 0x6e, 0x62, 0x37, 0x80, 0xd8, 0x8a, 0x10, 0xbb, 0x9a, 0xe4, 0x15, 0x2e,
+	0xb8, 0x89, 0x1c, 0xd7, 0x59, 0xab, 0x26, 0xb6, 0x65, 0x9f, 0x8c, 0xf6, 0x09, 0x78, 0x01, 0xde,
+	0x82, 0x57, 0xe2, 0x61, 0x50, 0x9c, 0x04, 0x34, 0x0d, 0xe8, 0xee, 0x62, 0x7d, 0xdf, 0xef, 0xfc,
+	0xf9, 0x4e, 0x60, 0x50, 0x08, 0xbb, 0x18, 0xdf, 0x9d, 0xb0, 0x5c, 0x2f, 0xd8, 0xc9, 0xd8, 0x08,
+	0x34, 0x9b, 0x58, 0x1b, 0x85, 0x8a, 0x90, 0x55, 0x59, 0xb0, 0xb8, 0xd2, 0xe3, 0x56, 0x8f, 0x0e,
+	0xef, 0xdb, 0xad, 0xc8, 0x05, 0x47, 0x65, 0x6a, 0x22, 0x3a, 0xba, 0x55, 0xea, 0x36, 0x17, 0x63,
+	0xf7, 0x4a, 0xcb, 0x6c, 0x3c, 0x2f, 0x0d, 0xc3, 0xa5, 0x92, 0xff, 0xd2, 0xbf, 0x1a, 0xa6, 0xb5,
+	0x30, 0xb6, 0xd6, 0x8f, 0xbf, 0xef, 0xc1, 0x2e, 0xad, 0x26, 0x20, 0xaf, 0xa0, 0x63, 0x55, 0x69,
+	0xb8, 0xb0, 0xa1, 0x37, 0xf4, 0x47, 0xdd, 0xc9, 0x61, 0xfc, 0x70, 0x9a, 0xf8, 0xa6, 0x69, 0x4f,
+	0x5b, 0x33, 0x79, 0x07, 0xbd, 0xb9, 0xb0, 0xb8, 0x94, 0xae, 0xad, 0x0d, 0x77, 0x1e, 0x01, 0xdf,
+	0x23, 0xc8, 0x04, 0x02, 0xae, 0x64, 0x16, 0xfa, 0x43, 0x6f, 0xd4, 0x9d, 0x1c, 0xfd, 0x8d, 0x74,
+	0x23, 0xc6, 0x53, 0x25, 0x33, 0xea, 0xbc, 0xd1, 0xcf, 0x00, 0x82, 0xea, 0x49, 0xce, 0x21, 0x58,
+	0x20, 0xea, 0xd0, 0x73, 0xf0, 0xf3, 0xff, 0xc3, 0xf1, 0x47, 0x44, 0x4d, 0x1d, 0x40, 0xce, 0xc0,
+	0x47, 0xae, 0xc3, 0x1d, 0xc7, 0x1d, 0x6f, 0xe1, 0x66, 0x5c, 0xd3, 0xca, 0x1e, 0xfd, 0xf0, 0x21,
+	0xa8, 0x8a, 0x90, 0x37, 0xd0, 0x95, 0x65, 0x91, 0x54, 0xd7, 0x5b, 0xba, 0xc8, 0x3c, 0xb7, 0x75,
+	0x1d, 0x77, 0xdc, 0xc6, 0x1d, 0x7f, 0xba, 0x92, 0x78, 0x3a, 0xf9, 0xcc, 0xf2, 0x52, 0x50, 0x90,
+	0x65, 0x41, 0x6b, 0x3f, 0xb9, 0x80, 0xa7, 0x5a, 0x98, 0x04, 0xcd, 0x26, 0xc1, 0x65, 0x21, 0x54,
+	0x89, 0xcd, 0x24, 0x83, 0x07, 0x25, 0xde, 0x37, 0x17, 0xa5, 0x7d, 0x2d, 0xcc, 0xcc, 0x6c, 0x66,
+	0xb5, 0x9f, 0x7c, 0x80, 0xfd, 0x94, 0xf1, 0x55, 0xa2, 0xb2, 0x36, 0xba, 0x17, 0x8f, 0xd8, 0x3e,
+	0xbe, 0x64, 0x7c, 0x75, 0x9d, 0x65, 0xb4, 0x93, 0xd6, 0x1f, 0x64, 0x00, 0xfb, 0xee, 0x1f, 0x4c,
+	0x94, 0x0c, 0x83, 0xa1, 0x3f, 0x7a, 0x42, 0x3b, 0xee, 0x7d, 0x2d, 0xc9, 0x19, 0x3c, 0x73, 0x0b,
+	0xb2, 0x34, 0x17, 0x89, 0x45, 0x86, 0xa5, 0x4d, 0xb8, 0x9a, 0x0b, 0x1b, 0xee, 0x0e, 0xfd, 0x51,
+	0x9f, 0x1e, 0xfc, 0x56, 0x6f, 0x9c, 0x38, 0xad, 0xb4, 0xe8, 0x9b, 0x07, 0x9d, 0xa6, 0x0b, 0x79,
+	0x0b, 0xfd, 0x94, 0x59, 0x91, 0x2c, 0x25, 0x0a, 0x73, 0xc7, 0xf2, 0xd0, 0xdb, 0xb6, 0x65, 0xaf,
+	0xf2, 0x5f, 0x35, 0x76, 0xf2, 0x1a, 0x7a, 0x05, 0x5b, 0xff, 0xc1, 0xb7, 0x86, 0xd4, 0x2d, 0xd8,
+	0xba, 0xa5, 0xa3, 0x73, 0xf0, 0x67, 0x5c, 0x93, 0x97, 0x70, 0x50, 0x15, 0xe1, 0x4a, 0x4a, 0xc1,
+	0x31, 0x61, 0x88, 0xa2, 0xd0, 0x58, 0x1f, 0xad, 0x4f, 0x49, 0xc1, 0xd6, 0xd3, 0x5a, 0xba, 0x68,
+	0x94, 0x4b, 0xf8, 0xb2, 0xdf, 0x06, 0x98, 0xee, 0xb9, 0x26, 0xa7, 0xbf, 0x06, 0x00, 0xe4, 0xcb,
+	0x88, 0x66, 0xb8, 0x03, 0x00, 0x00,
+}
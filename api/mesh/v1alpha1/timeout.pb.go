@@ -0,0 +1,269 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: mesh/v1alpha1/timeout.proto
+
+package v1alpha1
+
+import (
+	fmt "fmt"
+	proto "github.com/golang/protobuf/proto"
+	duration "github.com/golang/protobuf/ptypes/duration"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
+
+// Timeout defines configuration of timeouts for connections and requests
+// between dataplanes.
+type Timeout struct {
+	// List of selectors to match dataplanes that are sources of traffic.
+	Sources []*Selector `protobuf:"bytes,1,rep,name=sources,proto3" json:"sources,omitempty"`
+	// List of selectors to match services that are destinations of traffic.
+	Destinations []*Selector `protobuf:"bytes,2,rep,name=destinations,proto3" json:"destinations,omitempty"`
+	// Configuration of Timeout.
+	Conf                 *Timeout_Conf `protobuf:"bytes,3,opt,name=conf,proto3" json:"conf,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *Timeout) Reset()         { *m = Timeout{} }
+func (m *Timeout) String() string { return proto.CompactTextString(m) }
+func (*Timeout) ProtoMessage()    {}
+func (*Timeout) Descriptor() ([]byte, []int) {
+	return fileDescriptor_69e560a8cfecd909, []int{0}
+}
+
+func (m *Timeout) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Timeout.Unmarshal(m, b)
+}
+func (m *Timeout) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Timeout.Marshal(b, m, deterministic)
+}
+func (m *Timeout) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Timeout.Merge(m, src)
+}
+func (m *Timeout) XXX_Size() int {
+	return xxx_messageInfo_Timeout.Size(m)
+}
+func (m *Timeout) XXX_DiscardUnknown() {
+	xxx_messageInfo_Timeout.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Timeout proto.InternalMessageInfo
+
+func (m *Timeout) GetSources() []*Selector {
+	if m != nil {
+		return m.Sources
+	}
+	return nil
+}
+
+func (m *Timeout) GetDestinations() []*Selector {
+	if m != nil {
+		return m.Destinations
+	}
+	return nil
+}
+
+func (m *Timeout) GetConf() *Timeout_Conf {
+	if m != nil {
+		return m.Conf
+	}
+	return nil
+}
+
+// Conf defines configuration of timeouts for various protocols.
+type Timeout_Conf struct {
+	// Amount of time to wait for a connection to an upstream host to be
+	// established.
+	ConnectTimeout *duration.Duration `protobuf:"bytes,1,opt,name=connect_timeout,json=connectTimeout,proto3" json:"connect_timeout,omitempty"`
+	// Configuration of timeouts for TCP traffic.
+	Tcp *Timeout_Conf_Tcp `protobuf:"bytes,2,opt,name=tcp,proto3" json:"tcp,omitempty"`
+	// Configuration of timeouts for HTTP traffic.
+	Http                 *Timeout_Conf_Http `protobuf:"bytes,3,opt,name=http,proto3" json:"http,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *Timeout_Conf) Reset()         { *m = Timeout_Conf{} }
+func (m *Timeout_Conf) String() string { return proto.CompactTextString(m) }
+func (*Timeout_Conf) ProtoMessage()    {}
+func (*Timeout_Conf) Descriptor() ([]byte, []int) {
+	return fileDescriptor_69e560a8cfecd909, []int{0, 0}
+}
+
+func (m *Timeout_Conf) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Timeout_Conf.Unmarshal(m, b)
+}
+func (m *Timeout_Conf) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Timeout_Conf.Marshal(b, m, deterministic)
+}
+func (m *Timeout_Conf) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Timeout_Conf.Merge(m, src)
+}
+func (m *Timeout_Conf) XXX_Size() int {
+	return xxx_messageInfo_Timeout_Conf.Size(m)
+}
+func (m *Timeout_Conf) XXX_DiscardUnknown() {
+	xxx_messageInfo_Timeout_Conf.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Timeout_Conf proto.InternalMessageInfo
+
+func (m *Timeout_Conf) GetConnectTimeout() *duration.Duration {
+	if m != nil {
+		return m.ConnectTimeout
+	}
+	return nil
+}
+
+func (m *Timeout_Conf) GetTcp() *Timeout_Conf_Tcp {
+	if m != nil {
+		return m.Tcp
+	}
+	return nil
+}
+
+func (m *Timeout_Conf) GetHttp() *Timeout_Conf_Http {
+	if m != nil {
+		return m.Http
+	}
+	return nil
+}
+
+// Tcp defines configuration of timeouts for TCP traffic.
+type Timeout_Conf_Tcp struct {
+	// Amount of time a TCP connection can stay idle before it gets closed.
+	// Zero value disables the timeout.
+	IdleTimeout          *duration.Duration `protobuf:"bytes,1,opt,name=idle_timeout,json=idleTimeout,proto3" json:"idle_timeout,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *Timeout_Conf_Tcp) Reset()         { *m = Timeout_Conf_Tcp{} }
+func (m *Timeout_Conf_Tcp) String() string { return proto.CompactTextString(m) }
+func (*Timeout_Conf_Tcp) ProtoMessage()    {}
+func (*Timeout_Conf_Tcp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_69e560a8cfecd909, []int{0, 0, 0}
+}
+
+func (m *Timeout_Conf_Tcp) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Timeout_Conf_Tcp.Unmarshal(m, b)
+}
+func (m *Timeout_Conf_Tcp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Timeout_Conf_Tcp.Marshal(b, m, deterministic)
+}
+func (m *Timeout_Conf_Tcp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Timeout_Conf_Tcp.Merge(m, src)
+}
+func (m *Timeout_Conf_Tcp) XXX_Size() int {
+	return xxx_messageInfo_Timeout_Conf_Tcp.Size(m)
+}
+func (m *Timeout_Conf_Tcp) XXX_DiscardUnknown() {
+	xxx_messageInfo_Timeout_Conf_Tcp.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Timeout_Conf_Tcp proto.InternalMessageInfo
+
+func (m *Timeout_Conf_Tcp) GetIdleTimeout() *duration.Duration {
+	if m != nil {
+		return m.IdleTimeout
+	}
+	return nil
+}
+
+// Http defines configuration of timeouts for HTTP traffic.
+type Timeout_Conf_Http struct {
+	// Amount of time to wait for a complete upstream response.
+	// Zero value disables the timeout.
+	RequestTimeout *duration.Duration `protobuf:"bytes,1,opt,name=request_timeout,json=requestTimeout,proto3" json:"request_timeout,omitempty"`
+	// Amount of time an HTTP stream can stay idle before it gets reset.
+	// Zero value disables the timeout.
+	IdleTimeout          *duration.Duration `protobuf:"bytes,2,opt,name=idle_timeout,json=idleTimeout,proto3" json:"idle_timeout,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *Timeout_Conf_Http) Reset()         { *m = Timeout_Conf_Http{} }
+func (m *Timeout_Conf_Http) String() string { return proto.CompactTextString(m) }
+func (*Timeout_Conf_Http) ProtoMessage()    {}
+func (*Timeout_Conf_Http) Descriptor() ([]byte, []int) {
+	return fileDescriptor_69e560a8cfecd909, []int{0, 0, 1}
+}
+
+func (m *Timeout_Conf_Http) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Timeout_Conf_Http.Unmarshal(m, b)
+}
+func (m *Timeout_Conf_Http) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Timeout_Conf_Http.Marshal(b, m, deterministic)
+}
+func (m *Timeout_Conf_Http) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Timeout_Conf_Http.Merge(m, src)
+}
+func (m *Timeout_Conf_Http) XXX_Size() int {
+	return xxx_messageInfo_Timeout_Conf_Http.Size(m)
+}
+func (m *Timeout_Conf_Http) XXX_DiscardUnknown() {
+	xxx_messageInfo_Timeout_Conf_Http.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Timeout_Conf_Http proto.InternalMessageInfo
+
+func (m *Timeout_Conf_Http) GetRequestTimeout() *duration.Duration {
+	if m != nil {
+		return m.RequestTimeout
+	}
+	return nil
+}
+
+func (m *Timeout_Conf_Http) GetIdleTimeout() *duration.Duration {
+	if m != nil {
+		return m.IdleTimeout
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*Timeout)(nil), "kuma.mesh.v1alpha1.Timeout")
+	proto.RegisterType((*Timeout_Conf)(nil), "kuma.mesh.v1alpha1.Timeout.Conf")
+	proto.RegisterType((*Timeout_Conf_Tcp)(nil), "kuma.mesh.v1alpha1.Timeout.Conf.Tcp")
+	proto.RegisterType((*Timeout_Conf_Http)(nil), "kuma.mesh.v1alpha1.Timeout.Conf.Http")
+}
+
+func init() { proto.RegisterFile("mesh/v1alpha1/timeout.proto", fileDescriptor_69e560a8cfecd909) }
+
+var fileDescriptor_69e560a8cfecd909 = []byte{
+	// 317 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x90, 0x41, 0x4b, 0xc3, 0x30,
+	0x1c, 0xc5, 0xe9, 0x5a, 0x9c, 0xfc, 0x37, 0x14, 0x72, 0xaa, 0x75, 0xc8, 0x10, 0x85, 0x9d, 0x52,
+	0x36, 0x45, 0x10, 0x3c, 0xc8, 0xe6, 0xc1, 0x73, 0xdd, 0xc9, 0x8b, 0x74, 0x59, 0xb6, 0x15, 0xbb,
+	0x24, 0x36, 0xff, 0xf8, 0x19, 0xbc, 0xf8, 0xc1, 0xfc, 0x56, 0x92, 0x34, 0x45, 0xa6, 0xc2, 0xb6,
+	0x63, 0xc8, 0xfb, 0xbd, 0xff, 0x7b, 0x0f, 0x4e, 0xd7, 0x5c, 0xaf, 0xd2, 0xf7, 0x61, 0x5e, 0xaa,
+	0x55, 0x3e, 0x4c, 0xb1, 0x58, 0x73, 0x69, 0x90, 0xaa, 0x4a, 0xa2, 0x24, 0xe4, 0xd5, 0xac, 0x73,
+	0x6a, 0x15, 0xb4, 0x51, 0x24, 0xbd, 0x4d, 0x40, 0xf3, 0x92, 0x33, 0x94, 0x55, 0x4d, 0x24, 0x67,
+	0x4b, 0x29, 0x97, 0x25, 0x4f, 0xdd, 0x6b, 0x66, 0x16, 0xe9, 0xdc, 0x54, 0x39, 0x16, 0x52, 0xd4,
+	0xff, 0xe7, 0x5f, 0x11, 0xb4, 0xa7, 0xf5, 0x0d, 0x72, 0x03, 0x6d, 0x2d, 0x4d, 0xc5, 0xb8, 0x8e,
+	0x83, 0x7e, 0x38, 0xe8, 0x8c, 0x7a, 0xf4, 0xef, 0x3d, 0xfa, 0xe4, 0x0f, 0x64, 0x8d, 0x98, 0xdc,
+	0x43, 0x77, 0xce, 0x35, 0x16, 0xc2, 0x19, 0xeb, 0xb8, 0xb5, 0x03, 0xbc, 0x41, 0x90, 0x6b, 0x88,
+	0x98, 0x14, 0x8b, 0x38, 0xec, 0x07, 0x83, 0xce, 0xa8, 0xff, 0x1f, 0xe9, 0x43, 0xd2, 0x89, 0x14,
+	0x8b, 0xcc, 0xa9, 0x93, 0xcf, 0x10, 0x22, 0xfb, 0x24, 0x63, 0x38, 0x66, 0x52, 0x08, 0xce, 0xf0,
+	0xc5, 0xef, 0x15, 0x07, 0xce, 0xe9, 0x84, 0xd6, 0xf5, 0x69, 0x53, 0x9f, 0x3e, 0xf8, 0xfa, 0xd9,
+	0x91, 0x27, 0x7e, 0xca, 0x87, 0xc8, 0x54, 0xdc, 0x72, 0xdc, 0xc5, 0xb6, 0x04, 0x74, 0xca, 0x54,
+	0x66, 0x01, 0x72, 0x0b, 0xd1, 0x0a, 0x51, 0xf9, 0xe8, 0x97, 0x5b, 0xc1, 0x47, 0x44, 0x95, 0x39,
+	0x24, 0x99, 0x40, 0x38, 0x65, 0x8a, 0xdc, 0x41, 0xb7, 0x98, 0x97, 0x7c, 0xf7, 0xe8, 0x1d, 0x2b,
+	0xf7, 0xa6, 0xc9, 0x47, 0x00, 0x91, 0xf5, 0xb4, 0x23, 0x54, 0xfc, 0xcd, 0x70, 0xbd, 0xcf, 0x08,
+	0x9e, 0x68, 0x46, 0xf8, 0x1d, 0xa5, 0xb5, 0x4f, 0x94, 0x31, 0x3c, 0x1f, 0x36, 0x9d, 0x67, 0x07,
+	0x4e, 0x7b, 0xf5, 0x3d, 0x00, 0x31, 0x34, 0x99, 0x9a, 0xcf, 0x02, 0x00, 0x00,
+}
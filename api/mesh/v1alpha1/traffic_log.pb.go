@@ -6,6 +6,8 @@ package v1alpha1
 import (
 	fmt "fmt"
 	proto "github.com/golang/protobuf/proto"
+	duration "github.com/golang/protobuf/ptypes/duration"
+	wrappers "github.com/golang/protobuf/ptypes/wrappers"
 	math "math"
 )
 
@@ -82,7 +84,15 @@ func (m *TrafficLog) GetConf() *TrafficLog_Conf {
 // Configuration defines settings of the logging.
 type TrafficLog_Conf struct {
 	// Backend defined in the Mesh entity.
-	Backend              string   `protobuf:"bytes,1,opt,name=backend,proto3" json:"backend,omitempty"`
+	Backend string `protobuf:"bytes,1,opt,name=backend,proto3" json:"backend,omitempty"`
+	// List of filters. Log entry is sent to a backend if it meets any of
+	// the filters. If the list is empty, all log entries are sent.
+	Filters []*TrafficLog_Conf_Filter `protobuf:"bytes,2,rep,name=filters,proto3" json:"filters,omitempty"`
+	// Inbound enables logging of traffic received by destinations in
+	// addition to traffic sent by sources. A source of inbound traffic is
+	// known only at runtime, that is why all traffic received by destinations
+	// is logged and sources have to match all services (`service: '*'`).
+	Inbound              bool     `protobuf:"varint,3,opt,name=inbound,proto3" json:"inbound,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -120,27 +130,187 @@ func (m *TrafficLog_Conf) GetBackend() string {
 	return ""
 }
 
+func (m *TrafficLog_Conf) GetFilters() []*TrafficLog_Conf_Filter {
+	if m != nil {
+		return m.Filters
+	}
+	return nil
+}
+
+func (m *TrafficLog_Conf) GetInbound() bool {
+	if m != nil {
+		return m.Inbound
+	}
+	return false
+}
+
+// Filter defines conditions that a log entry has to meet to be sent to
+// a backend. All conditions of a filter have to be met.
+type TrafficLog_Conf_Filter struct {
+	// Log entry is sent only if HTTP response code is within the range.
+	// Since TCP traffic has no response codes, it never matches.
+	StatusCodes *TrafficLog_Conf_Filter_StatusCodes `protobuf:"bytes,1,opt,name=statusCodes,proto3" json:"statusCodes,omitempty"`
+	// Log entry is sent only if a request took at least that long.
+	MinDuration *duration.Duration `protobuf:"bytes,2,opt,name=minDuration,proto3" json:"minDuration,omitempty"`
+	// Log entry is sent only if a request ended with an error, i.e. Envoy
+	// set at least one of the response flags.
+	OnlyErrors bool `protobuf:"varint,3,opt,name=onlyErrors,proto3" json:"onlyErrors,omitempty"`
+	// Log entry is sent only if a request has all of these headers.
+	// Since TCP traffic has no headers, it never matches.
+	Headers []string `protobuf:"bytes,4,rep,name=headers,proto3" json:"headers,omitempty"`
+	// Percentage of log entries meeting other conditions that are sent,
+	// has to be in [0.0 - 100.0] range.
+	Sampling             *wrappers.DoubleValue `protobuf:"bytes,5,opt,name=sampling,proto3" json:"sampling,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *TrafficLog_Conf_Filter) Reset()         { *m = TrafficLog_Conf_Filter{} }
+func (m *TrafficLog_Conf_Filter) String() string { return proto.CompactTextString(m) }
+func (*TrafficLog_Conf_Filter) ProtoMessage()    {}
+func (*TrafficLog_Conf_Filter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_47c4f4c9c894eeed, []int{0, 0, 0}
+}
+
+func (m *TrafficLog_Conf_Filter) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TrafficLog_Conf_Filter.Unmarshal(m, b)
+}
+func (m *TrafficLog_Conf_Filter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TrafficLog_Conf_Filter.Marshal(b, m, deterministic)
+}
+func (m *TrafficLog_Conf_Filter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TrafficLog_Conf_Filter.Merge(m, src)
+}
+func (m *TrafficLog_Conf_Filter) XXX_Size() int {
+	return xxx_messageInfo_TrafficLog_Conf_Filter.Size(m)
+}
+func (m *TrafficLog_Conf_Filter) XXX_DiscardUnknown() {
+	xxx_messageInfo_TrafficLog_Conf_Filter.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TrafficLog_Conf_Filter proto.InternalMessageInfo
+
+func (m *TrafficLog_Conf_Filter) GetStatusCodes() *TrafficLog_Conf_Filter_StatusCodes {
+	if m != nil {
+		return m.StatusCodes
+	}
+	return nil
+}
+
+func (m *TrafficLog_Conf_Filter) GetMinDuration() *duration.Duration {
+	if m != nil {
+		return m.MinDuration
+	}
+	return nil
+}
+
+func (m *TrafficLog_Conf_Filter) GetOnlyErrors() bool {
+	if m != nil {
+		return m.OnlyErrors
+	}
+	return false
+}
+
+func (m *TrafficLog_Conf_Filter) GetHeaders() []string {
+	if m != nil {
+		return m.Headers
+	}
+	return nil
+}
+
+func (m *TrafficLog_Conf_Filter) GetSampling() *wrappers.DoubleValue {
+	if m != nil {
+		return m.Sampling
+	}
+	return nil
+}
+
+// StatusCodes defines a range of HTTP response codes.
+type TrafficLog_Conf_Filter_StatusCodes struct {
+	// The lowest status code of the range, inclusive.
+	Min uint32 `protobuf:"varint,1,opt,name=min,proto3" json:"min,omitempty"`
+	// The highest status code of the range, inclusive.
+	Max                  uint32   `protobuf:"varint,2,opt,name=max,proto3" json:"max,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TrafficLog_Conf_Filter_StatusCodes) Reset()         { *m = TrafficLog_Conf_Filter_StatusCodes{} }
+func (m *TrafficLog_Conf_Filter_StatusCodes) String() string { return proto.CompactTextString(m) }
+func (*TrafficLog_Conf_Filter_StatusCodes) ProtoMessage()    {}
+func (*TrafficLog_Conf_Filter_StatusCodes) Descriptor() ([]byte, []int) {
+	return fileDescriptor_47c4f4c9c894eeed, []int{0, 0, 0, 0}
+}
+
+func (m *TrafficLog_Conf_Filter_StatusCodes) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TrafficLog_Conf_Filter_StatusCodes.Unmarshal(m, b)
+}
+func (m *TrafficLog_Conf_Filter_StatusCodes) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TrafficLog_Conf_Filter_StatusCodes.Marshal(b, m, deterministic)
+}
+func (m *TrafficLog_Conf_Filter_StatusCodes) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TrafficLog_Conf_Filter_StatusCodes.Merge(m, src)
+}
+func (m *TrafficLog_Conf_Filter_StatusCodes) XXX_Size() int {
+	return xxx_messageInfo_TrafficLog_Conf_Filter_StatusCodes.Size(m)
+}
+func (m *TrafficLog_Conf_Filter_StatusCodes) XXX_DiscardUnknown() {
+	xxx_messageInfo_TrafficLog_Conf_Filter_StatusCodes.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TrafficLog_Conf_Filter_StatusCodes proto.InternalMessageInfo
+
+func (m *TrafficLog_Conf_Filter_StatusCodes) GetMin() uint32 {
+	if m != nil {
+		return m.Min
+	}
+	return 0
+}
+
+func (m *TrafficLog_Conf_Filter_StatusCodes) GetMax() uint32 {
+	if m != nil {
+		return m.Max
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*TrafficLog)(nil), "kuma.mesh.v1alpha1.TrafficLog")
 	proto.RegisterType((*TrafficLog_Conf)(nil), "kuma.mesh.v1alpha1.TrafficLog.Conf")
+	proto.RegisterType((*TrafficLog_Conf_Filter)(nil), "kuma.mesh.v1alpha1.TrafficLog.Conf.Filter")
+	proto.RegisterType((*TrafficLog_Conf_Filter_StatusCodes)(nil), "kuma.mesh.v1alpha1.TrafficLog.Conf.Filter.StatusCodes")
 }
 
 func init() { proto.RegisterFile("mesh/v1alpha1/traffic_log.proto", fileDescriptor_47c4f4c9c894eeed) }
 
 var fileDescriptor_47c4f4c9c894eeed = []byte{
-	// 212 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0xcf, 0x4d, 0x2d, 0xce,
-	0xd0, 0x2f, 0x33, 0x4c, 0xcc, 0x29, 0xc8, 0x48, 0x34, 0xd4, 0x2f, 0x29, 0x4a, 0x4c, 0x4b, 0xcb,
-	0x4c, 0x8e, 0xcf, 0xc9, 0x4f, 0xd7, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0xca, 0x2e, 0xcd,
-	0x4d, 0xd4, 0x03, 0xa9, 0xd2, 0x83, 0xa9, 0x92, 0x92, 0x41, 0xd5, 0x54, 0x9c, 0x9a, 0x93, 0x9a,
-	0x5c, 0x92, 0x5f, 0x04, 0xd1, 0xa1, 0xf4, 0x90, 0x91, 0x8b, 0x2b, 0x04, 0x62, 0x8e, 0x4f, 0x7e,
-	0xba, 0x90, 0x19, 0x17, 0x7b, 0x71, 0x7e, 0x69, 0x51, 0x72, 0x6a, 0xb1, 0x04, 0xa3, 0x02, 0xb3,
-	0x06, 0xb7, 0x91, 0x8c, 0x1e, 0xa6, 0x91, 0x7a, 0xc1, 0x50, 0x33, 0x82, 0x60, 0x8a, 0x85, 0x1c,
-	0xb8, 0x78, 0x52, 0x52, 0x8b, 0x4b, 0x32, 0xf3, 0x12, 0x4b, 0x32, 0xf3, 0xf3, 0x8a, 0x25, 0x98,
-	0x88, 0xd0, 0x8c, 0xa2, 0x43, 0xc8, 0x9c, 0x8b, 0x25, 0x39, 0x3f, 0x2f, 0x4d, 0x82, 0x59, 0x81,
-	0x51, 0x83, 0xdb, 0x48, 0x19, 0x9b, 0x4e, 0x84, 0x3b, 0xf5, 0x9c, 0xf3, 0xf3, 0xd2, 0x82, 0xc0,
-	0x1a, 0xa4, 0x14, 0xb8, 0x58, 0x40, 0x3c, 0x21, 0x09, 0x2e, 0xf6, 0xa4, 0xc4, 0xe4, 0xec, 0xd4,
-	0xbc, 0x14, 0x09, 0x46, 0x05, 0x46, 0x0d, 0xce, 0x20, 0x18, 0xd7, 0x89, 0x2b, 0x8a, 0x03, 0x66,
-	0x46, 0x12, 0x1b, 0xd8, 0xdb, 0xc6, 0x80, 0x00, 0x00, 0x00, 0xff, 0xff, 0xa6, 0x25, 0xf4, 0xe3,
-	0x4b, 0x01, 0x00, 0x00,
+	// 410 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x51, 0x4d, 0x6f, 0xd3, 0x40,
+	0x10, 0x55, 0xe2, 0xb4, 0x09, 0x63, 0x90, 0xd0, 0x9e, 0x8c, 0x15, 0x95, 0x08, 0x2e, 0x11, 0x87,
+	0x8d, 0x52, 0xa4, 0x82, 0xc4, 0x05, 0xd1, 0xc2, 0x89, 0xd3, 0x16, 0x21, 0xc4, 0x05, 0xad, 0xed,
+	0xb5, 0x63, 0x75, 0xbd, 0x63, 0xed, 0x07, 0x94, 0xdf, 0xc0, 0xff, 0x42, 0xe2, 0x5f, 0xa1, 0x5d,
+	0x7b, 0x69, 0xaa, 0x52, 0x29, 0xb7, 0x9d, 0xd9, 0xf7, 0xde, 0xbc, 0x79, 0x03, 0x4f, 0x3b, 0x61,
+	0x76, 0x9b, 0xef, 0x5b, 0x2e, 0xfb, 0x1d, 0xdf, 0x6e, 0xac, 0xe6, 0x75, 0xdd, 0x96, 0xdf, 0x24,
+	0x36, 0xb4, 0xd7, 0x68, 0x91, 0x90, 0x2b, 0xd7, 0x71, 0xea, 0x51, 0x34, 0xa2, 0xf2, 0xe5, 0x6d,
+	0x92, 0x11, 0x52, 0x94, 0x16, 0xf5, 0xc0, 0xc8, 0x4f, 0x1a, 0xc4, 0x46, 0x8a, 0x4d, 0xa8, 0x0a,
+	0x57, 0x6f, 0x2a, 0xa7, 0xb9, 0x6d, 0x51, 0xdd, 0xf7, 0xff, 0x43, 0xf3, 0xbe, 0x17, 0xda, 0x0c,
+	0xff, 0xcf, 0x7e, 0x1d, 0x01, 0x7c, 0x1a, 0x7c, 0x7c, 0xc4, 0x86, 0x9c, 0xc1, 0xdc, 0xa0, 0xd3,
+	0xa5, 0x30, 0xd9, 0x64, 0x95, 0xac, 0xd3, 0xd3, 0x25, 0xbd, 0x6b, 0x89, 0x5e, 0x8e, 0x1e, 0x58,
+	0x04, 0x93, 0xb7, 0xf0, 0xb0, 0x12, 0xc6, 0xb6, 0x2a, 0xcc, 0x36, 0xd9, 0xf4, 0x00, 0xf2, 0x2d,
+	0x06, 0x79, 0x05, 0xb3, 0x12, 0x55, 0x9d, 0x25, 0xab, 0xc9, 0x3a, 0x3d, 0x7d, 0xfe, 0x3f, 0xe6,
+	0x8d, 0x4f, 0x7a, 0x8e, 0xaa, 0x66, 0x81, 0x90, 0xff, 0x49, 0x60, 0xe6, 0x4b, 0x92, 0xc1, 0xbc,
+	0xe0, 0xe5, 0x95, 0x50, 0x55, 0x36, 0x59, 0x4d, 0xd6, 0x0f, 0x58, 0x2c, 0xc9, 0x05, 0xcc, 0xeb,
+	0x56, 0x5a, 0xa1, 0xa3, 0xb1, 0x17, 0x07, 0xc8, 0xd3, 0x0f, 0x81, 0xc2, 0x22, 0xd5, 0xeb, 0xb7,
+	0xaa, 0x40, 0xa7, 0xaa, 0x60, 0x72, 0xc1, 0x62, 0x99, 0xff, 0x9e, 0xc2, 0xf1, 0x80, 0x26, 0x5f,
+	0x20, 0x35, 0x96, 0x5b, 0x67, 0xce, 0xb1, 0x0a, 0x21, 0xfa, 0x6d, 0xce, 0x0e, 0x1f, 0x47, 0x2f,
+	0x6f, 0xd8, 0x6c, 0x5f, 0x8a, 0xbc, 0x81, 0xb4, 0x6b, 0xd5, 0xc5, 0x78, 0xde, 0x6c, 0x1a, 0x94,
+	0x9f, 0xd0, 0xe1, 0xbe, 0x34, 0xde, 0x97, 0x46, 0x00, 0xdb, 0x47, 0x93, 0x13, 0x00, 0x54, 0xf2,
+	0xe7, 0x7b, 0xad, 0x51, 0x9b, 0xd1, 0xfe, 0x5e, 0xc7, 0xef, 0xb6, 0x13, 0xbc, 0xf2, 0x09, 0xcd,
+	0x56, 0x89, 0xcf, 0x6e, 0x2c, 0xc9, 0x6b, 0x58, 0x18, 0xde, 0xf5, 0xb2, 0x55, 0x4d, 0x76, 0x14,
+	0x66, 0x2e, 0xef, 0xce, 0x44, 0x57, 0x48, 0xf1, 0x99, 0x4b, 0x27, 0xd8, 0x3f, 0x74, 0xbe, 0x85,
+	0x74, 0x6f, 0x19, 0xf2, 0x18, 0x92, 0xae, 0x55, 0x21, 0x91, 0x47, 0xcc, 0x3f, 0x43, 0x87, 0x5f,
+	0x67, 0xd3, 0xb1, 0xc3, 0xaf, 0xdf, 0xc1, 0xd7, 0x45, 0xcc, 0xa7, 0x38, 0x0e, 0xf2, 0x2f, 0xff,
+	0x0e, 0x00, 0x55, 0x90, 0xa5, 0x6c, 0x35, 0x03, 0x00, 0x00,
 }
@@ -25,10 +25,16 @@ type TrafficPermission struct {
 	// List of selectors to match dataplanes that are sources of traffic.
 	Sources []*Selector `protobuf:"bytes,1,rep,name=sources,proto3" json:"sources,omitempty"`
 	// List of selectors to match services that are destinations of traffic.
-	Destinations         []*Selector `protobuf:"bytes,2,rep,name=destinations,proto3" json:"destinations,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
-	XXX_unrecognized     []byte      `json:"-"`
-	XXX_sizecache        int32       `json:"-"`
+	Destinations []*Selector `protobuf:"bytes,2,rep,name=destinations,proto3" json:"destinations,omitempty"`
+	// List of HTTP rules that restrict which requests are permitted.
+	//
+	// A request is permitted if it satisfies at least one of the rules.
+	// If empty, all requests are permitted. The rules are ignored if the
+	// protocol of the destination service is not HTTP.
+	Http                 []*TrafficPermission_Http `protobuf:"bytes,3,rep,name=http,proto3" json:"http,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                  `json:"-"`
+	XXX_unrecognized     []byte                    `json:"-"`
+	XXX_sizecache        int32                     `json:"-"`
 }
 
 func (m *TrafficPermission) Reset()         { *m = TrafficPermission{} }
@@ -70,8 +76,81 @@ func (m *TrafficPermission) GetDestinations() []*Selector {
 	return nil
 }
 
+func (m *TrafficPermission) GetHttp() []*TrafficPermission_Http {
+	if m != nil {
+		return m.Http
+	}
+	return nil
+}
+
+// Http defines criteria an HTTP request must satisfy to be permitted.
+//
+// A request satisfies the rule only if it matches all criteria.
+type TrafficPermission_Http struct {
+	// Path prefix of permitted requests, e.g. /invoices.
+	// Requests to any path are permitted if empty.
+	PathPrefix string `protobuf:"bytes,1,opt,name=path_prefix,json=pathPrefix,proto3" json:"path_prefix,omitempty"`
+	// HTTP methods of permitted requests, e.g. GET or POST.
+	// Requests with any method are permitted if empty.
+	Methods []string `protobuf:"bytes,2,rep,name=methods,proto3" json:"methods,omitempty"`
+	// Headers that permitted requests must have, keyed by header name
+	// with an exact value.
+	Headers              map[string]string `protobuf:"bytes,3,rep,name=headers,proto3" json:"headers,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *TrafficPermission_Http) Reset()         { *m = TrafficPermission_Http{} }
+func (m *TrafficPermission_Http) String() string { return proto.CompactTextString(m) }
+func (*TrafficPermission_Http) ProtoMessage()    {}
+func (*TrafficPermission_Http) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7871a84a653f4288, []int{0, 0}
+}
+
+func (m *TrafficPermission_Http) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TrafficPermission_Http.Unmarshal(m, b)
+}
+func (m *TrafficPermission_Http) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TrafficPermission_Http.Marshal(b, m, deterministic)
+}
+func (m *TrafficPermission_Http) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TrafficPermission_Http.Merge(m, src)
+}
+func (m *TrafficPermission_Http) XXX_Size() int {
+	return xxx_messageInfo_TrafficPermission_Http.Size(m)
+}
+func (m *TrafficPermission_Http) XXX_DiscardUnknown() {
+	xxx_messageInfo_TrafficPermission_Http.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TrafficPermission_Http proto.InternalMessageInfo
+
+func (m *TrafficPermission_Http) GetPathPrefix() string {
+	if m != nil {
+		return m.PathPrefix
+	}
+	return ""
+}
+
+func (m *TrafficPermission_Http) GetMethods() []string {
+	if m != nil {
+		return m.Methods
+	}
+	return nil
+}
+
+func (m *TrafficPermission_Http) GetHeaders() map[string]string {
+	if m != nil {
+		return m.Headers
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*TrafficPermission)(nil), "kuma.mesh.v1alpha1.TrafficPermission")
+	proto.RegisterType((*TrafficPermission_Http)(nil), "kuma.mesh.v1alpha1.TrafficPermission.Http")
+	proto.RegisterMapType((map[string]string)(nil), "kuma.mesh.v1alpha1.TrafficPermission.Http.HeadersEntry")
 }
 
 func init() {
@@ -79,16 +158,24 @@ func init() {
 }
 
 var fileDescriptor_7871a84a653f4288 = []byte{
-	// 165 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0xcb, 0x4d, 0x2d, 0xce,
-	0xd0, 0x2f, 0x33, 0x4c, 0xcc, 0x29, 0xc8, 0x48, 0x34, 0xd4, 0x2f, 0x29, 0x4a, 0x4c, 0x4b, 0xcb,
-	0x4c, 0x8e, 0x2f, 0x48, 0x2d, 0xca, 0xcd, 0x2c, 0x2e, 0xce, 0xcc, 0xcf, 0xd3, 0x2b, 0x28, 0xca,
-	0x2f, 0xc9, 0x17, 0x12, 0xca, 0x2e, 0xcd, 0x4d, 0xd4, 0x03, 0x29, 0xd6, 0x83, 0x29, 0x96, 0x92,
-	0x41, 0xd5, 0x5b, 0x9c, 0x9a, 0x93, 0x9a, 0x5c, 0x92, 0x5f, 0x04, 0xd1, 0xa1, 0xd4, 0xcb, 0xc8,
-	0x25, 0x18, 0x02, 0x31, 0x2e, 0x00, 0x6e, 0x9a, 0x90, 0x19, 0x17, 0x7b, 0x71, 0x7e, 0x69, 0x51,
-	0x72, 0x6a, 0xb1, 0x04, 0xa3, 0x02, 0xb3, 0x06, 0xb7, 0x91, 0x8c, 0x1e, 0xa6, 0xc9, 0x7a, 0xc1,
-	0x50, 0xa3, 0x82, 0x60, 0x8a, 0x85, 0x1c, 0xb8, 0x78, 0x52, 0x52, 0x8b, 0x4b, 0x32, 0xf3, 0x12,
-	0x4b, 0x32, 0xf3, 0xf3, 0x8a, 0x25, 0x98, 0x88, 0xd0, 0x8c, 0xa2, 0xc3, 0x89, 0x2b, 0x8a, 0x03,
-	0xa6, 0x24, 0x89, 0x0d, 0xec, 0x44, 0x63, 0x40, 0x00, 0x00, 0x00, 0xff, 0xff, 0xdb, 0x75, 0x98,
-	0x93, 0xfe, 0x00, 0x00, 0x00,
+	// 295 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x91, 0xcf, 0x4a, 0x33, 0x31,
+	0x14, 0xc5, 0x99, 0x4e, 0xbf, 0xaf, 0xf6, 0xb6, 0x0b, 0x0d, 0x2e, 0x42, 0x29, 0x58, 0x5c, 0x48,
+	0x71, 0x91, 0x52, 0x05, 0x95, 0x2e, 0x44, 0x04, 0xa1, 0xcb, 0x3a, 0xba, 0x72, 0x53, 0x62, 0x7b,
+	0x4b, 0x42, 0x67, 0x26, 0x21, 0xb9, 0x53, 0xec, 0xdb, 0xf8, 0x48, 0x3e, 0x92, 0xcc, 0x3f, 0xb1,
+	0xd4, 0x45, 0x77, 0x39, 0x27, 0xe7, 0x77, 0x72, 0x20, 0x70, 0x91, 0xa0, 0x57, 0xa3, 0xcd, 0x58,
+	0xc6, 0x56, 0xc9, 0xf1, 0x88, 0x9c, 0x5c, 0xad, 0xf4, 0x62, 0x6e, 0xd1, 0x25, 0xda, 0x7b, 0x6d,
+	0x52, 0x61, 0x9d, 0x21, 0xc3, 0xd8, 0x3a, 0x4b, 0xa4, 0xc8, 0xc3, 0xa2, 0x0e, 0xf7, 0xfa, 0xbb,
+	0xac, 0xc7, 0x18, 0x17, 0x64, 0x5c, 0x49, 0x9c, 0x7f, 0x86, 0x70, 0xf2, 0x5a, 0xd6, 0xcd, 0x7e,
+	0xda, 0xd8, 0x0d, 0xb4, 0xbc, 0xc9, 0xdc, 0x02, 0x3d, 0x0f, 0x06, 0xe1, 0xb0, 0x73, 0xd5, 0x17,
+	0xfb, 0xcd, 0xe2, 0xa5, 0xaa, 0x8a, 0xea, 0x30, 0x7b, 0x80, 0xee, 0x12, 0x3d, 0xe9, 0x54, 0x92,
+	0x36, 0xa9, 0xe7, 0x8d, 0x03, 0xe0, 0x1d, 0x82, 0xdd, 0x43, 0x53, 0x11, 0x59, 0x1e, 0x16, 0xe4,
+	0xe5, 0x5f, 0xe4, 0xde, 0x5c, 0x31, 0x25, 0xb2, 0x51, 0xc1, 0xf5, 0xbe, 0x02, 0x68, 0xe6, 0x92,
+	0x9d, 0x41, 0xc7, 0x4a, 0x52, 0x73, 0xeb, 0x70, 0xa5, 0x3f, 0x78, 0x30, 0x08, 0x86, 0xed, 0x08,
+	0x72, 0x6b, 0x56, 0x38, 0x8c, 0x43, 0x2b, 0x41, 0x52, 0x66, 0x59, 0xce, 0x6c, 0x47, 0xb5, 0x64,
+	0xcf, 0xd0, 0x52, 0x28, 0x97, 0xe8, 0x7c, 0x35, 0xe3, 0xf6, 0xf0, 0x19, 0x62, 0x5a, 0x92, 0x4f,
+	0x29, 0xb9, 0x6d, 0x54, 0xf7, 0xf4, 0x26, 0xd0, 0xfd, 0x7d, 0xc1, 0x8e, 0x21, 0x5c, 0xe3, 0xb6,
+	0x5a, 0x95, 0x1f, 0xd9, 0x29, 0xfc, 0xdb, 0xc8, 0x38, 0x43, 0xde, 0x28, 0xbc, 0x52, 0x4c, 0x1a,
+	0x77, 0xc1, 0x23, 0xbc, 0x1d, 0xd5, 0x8f, 0xbe, 0xff, 0x2f, 0x7e, 0xed, 0xfa, 0x7b, 0x00, 0xa6,
+	0x41, 0xab, 0x91, 0x11, 0x02, 0x00, 0x00,
 }
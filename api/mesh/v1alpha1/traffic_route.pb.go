@@ -7,6 +7,8 @@ import (
 	fmt "fmt"
 	_ "github.com/envoyproxy/protoc-gen-validate/validate"
 	proto "github.com/golang/protobuf/proto"
+	duration "github.com/golang/protobuf/ptypes/duration"
+	wrappers "github.com/golang/protobuf/ptypes/wrappers"
 	math "math"
 )
 
@@ -21,7 +23,7 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
 
-// TrafficRoute defines routing rules for L4 traffic.
+// TrafficRoute defines routing rules for L4 and L7 traffic.
 type TrafficRoute struct {
 	// List of selectors to match dataplanes that are sources of traffic.
 	Sources []*Selector `protobuf:"bytes,1,rep,name=sources,proto3" json:"sources,omitempty"`
@@ -33,10 +35,28 @@ type TrafficRoute struct {
 	// of a mesh.
 	Destinations []*Selector `protobuf:"bytes,2,rep,name=destinations,proto3" json:"destinations,omitempty"`
 	// List of destinations with weights assigned to them.
-	Conf                 []*TrafficRoute_WeightedDestination `protobuf:"bytes,3,rep,name=conf,proto3" json:"conf,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                            `json:"-"`
-	XXX_unrecognized     []byte                              `json:"-"`
-	XXX_sizecache        int32                               `json:"-"`
+	//
+	// In case of HTTP traffic, these destinations are used for requests
+	// that don't match any of the HTTP rules.
+	Conf []*TrafficRoute_WeightedDestination `protobuf:"bytes,3,rep,name=conf,proto3" json:"conf,omitempty"`
+	// Ordered list of HTTP routing rules.
+	//
+	// A request is routed according to the first rule it matches.
+	// The rules are ignored if the protocol of the destination service,
+	// inferred from endpoints of default destinations, is not HTTP.
+	Http []*TrafficRoute_Http `protobuf:"bytes,4,rep,name=http,proto3" json:"http,omitempty"`
+	// Load balancing algorithm for endpoints of destination services.
+	//
+	// Round robin is used by default.
+	LoadBalancer *TrafficRoute_LoadBalancer `protobuf:"bytes,5,opt,name=load_balancer,json=loadBalancer,proto3" json:"load_balancer,omitempty"`
+	// Mirroring of HTTP requests.
+	//
+	// Mirroring is ignored if the protocol of the destination service is not
+	// HTTP.
+	Mirror               *TrafficRoute_Mirror `protobuf:"bytes,6,opt,name=mirror,proto3" json:"mirror,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
 }
 
 func (m *TrafficRoute) Reset()         { *m = TrafficRoute{} }
@@ -85,6 +105,27 @@ func (m *TrafficRoute) GetConf() []*TrafficRoute_WeightedDestination {
 	return nil
 }
 
+func (m *TrafficRoute) GetHttp() []*TrafficRoute_Http {
+	if m != nil {
+		return m.Http
+	}
+	return nil
+}
+
+func (m *TrafficRoute) GetLoadBalancer() *TrafficRoute_LoadBalancer {
+	if m != nil {
+		return m.LoadBalancer
+	}
+	return nil
+}
+
+func (m *TrafficRoute) GetMirror() *TrafficRoute_Mirror {
+	if m != nil {
+		return m.Mirror
+	}
+	return nil
+}
+
 // WeightedDestination defines a destination with a weight assigned to it.
 type TrafficRoute_WeightedDestination struct {
 	// Weight assigned to that destination.
@@ -139,35 +180,957 @@ func (m *TrafficRoute_WeightedDestination) GetDestination() map[string]string {
 	return nil
 }
 
+// Http defines a routing rule for HTTP traffic.
+type TrafficRoute_Http struct {
+	// Criteria to match the request against.
+	Match *TrafficRoute_Http_Match `protobuf:"bytes,1,opt,name=match,proto3" json:"match,omitempty"`
+	// List of destinations with weights assigned to them
+	// for requests that match this rule.
+	Destination          []*TrafficRoute_WeightedDestination `protobuf:"bytes,2,rep,name=destination,proto3" json:"destination,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                            `json:"-"`
+	XXX_unrecognized     []byte                              `json:"-"`
+	XXX_sizecache        int32                               `json:"-"`
+}
+
+func (m *TrafficRoute_Http) Reset()         { *m = TrafficRoute_Http{} }
+func (m *TrafficRoute_Http) String() string { return proto.CompactTextString(m) }
+func (*TrafficRoute_Http) ProtoMessage()    {}
+func (*TrafficRoute_Http) Descriptor() ([]byte, []int) {
+	return fileDescriptor_059271a05615c95f, []int{0, 1}
+}
+
+func (m *TrafficRoute_Http) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TrafficRoute_Http.Unmarshal(m, b)
+}
+func (m *TrafficRoute_Http) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TrafficRoute_Http.Marshal(b, m, deterministic)
+}
+func (m *TrafficRoute_Http) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TrafficRoute_Http.Merge(m, src)
+}
+func (m *TrafficRoute_Http) XXX_Size() int {
+	return xxx_messageInfo_TrafficRoute_Http.Size(m)
+}
+func (m *TrafficRoute_Http) XXX_DiscardUnknown() {
+	xxx_messageInfo_TrafficRoute_Http.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TrafficRoute_Http proto.InternalMessageInfo
+
+func (m *TrafficRoute_Http) GetMatch() *TrafficRoute_Http_Match {
+	if m != nil {
+		return m.Match
+	}
+	return nil
+}
+
+func (m *TrafficRoute_Http) GetDestination() []*TrafficRoute_WeightedDestination {
+	if m != nil {
+		return m.Destination
+	}
+	return nil
+}
+
+// Match defines a series of matching criteria for an HTTP request.
+//
+// A request matches only if it satisfies all criteria.
+type TrafficRoute_Http_Match struct {
+	// Path to match the request against.
+	Path *TrafficRoute_Http_Match_StringMatcher `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	// HTTP method to match the request against.
+	Method *TrafficRoute_Http_Match_StringMatcher `protobuf:"bytes,2,opt,name=method,proto3" json:"method,omitempty"`
+	// Headers to match the request against, keyed by header name.
+	Headers              map[string]*TrafficRoute_Http_Match_StringMatcher `protobuf:"bytes,3,rep,name=headers,proto3" json:"headers,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}                                          `json:"-"`
+	XXX_unrecognized     []byte                                            `json:"-"`
+	XXX_sizecache        int32                                             `json:"-"`
+}
+
+func (m *TrafficRoute_Http_Match) Reset()         { *m = TrafficRoute_Http_Match{} }
+func (m *TrafficRoute_Http_Match) String() string { return proto.CompactTextString(m) }
+func (*TrafficRoute_Http_Match) ProtoMessage()    {}
+func (*TrafficRoute_Http_Match) Descriptor() ([]byte, []int) {
+	return fileDescriptor_059271a05615c95f, []int{0, 1, 0}
+}
+
+func (m *TrafficRoute_Http_Match) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TrafficRoute_Http_Match.Unmarshal(m, b)
+}
+func (m *TrafficRoute_Http_Match) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TrafficRoute_Http_Match.Marshal(b, m, deterministic)
+}
+func (m *TrafficRoute_Http_Match) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TrafficRoute_Http_Match.Merge(m, src)
+}
+func (m *TrafficRoute_Http_Match) XXX_Size() int {
+	return xxx_messageInfo_TrafficRoute_Http_Match.Size(m)
+}
+func (m *TrafficRoute_Http_Match) XXX_DiscardUnknown() {
+	xxx_messageInfo_TrafficRoute_Http_Match.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TrafficRoute_Http_Match proto.InternalMessageInfo
+
+func (m *TrafficRoute_Http_Match) GetPath() *TrafficRoute_Http_Match_StringMatcher {
+	if m != nil {
+		return m.Path
+	}
+	return nil
+}
+
+func (m *TrafficRoute_Http_Match) GetMethod() *TrafficRoute_Http_Match_StringMatcher {
+	if m != nil {
+		return m.Method
+	}
+	return nil
+}
+
+func (m *TrafficRoute_Http_Match) GetHeaders() map[string]*TrafficRoute_Http_Match_StringMatcher {
+	if m != nil {
+		return m.Headers
+	}
+	return nil
+}
+
+// StringMatcher matches a string value either by a prefix, exactly
+// or by a regular expression.
+type TrafficRoute_Http_Match_StringMatcher struct {
+	// Types that are valid to be assigned to MatcherType:
+	//	*TrafficRoute_Http_Match_StringMatcher_Prefix
+	//	*TrafficRoute_Http_Match_StringMatcher_Exact
+	//	*TrafficRoute_Http_Match_StringMatcher_Regex
+	MatcherType          isTrafficRoute_Http_Match_StringMatcher_MatcherType `protobuf_oneof:"matcherType"`
+	XXX_NoUnkeyedLiteral struct{}                                            `json:"-"`
+	XXX_unrecognized     []byte                                              `json:"-"`
+	XXX_sizecache        int32                                               `json:"-"`
+}
+
+func (m *TrafficRoute_Http_Match_StringMatcher) Reset()         { *m = TrafficRoute_Http_Match_StringMatcher{} }
+func (m *TrafficRoute_Http_Match_StringMatcher) String() string { return proto.CompactTextString(m) }
+func (*TrafficRoute_Http_Match_StringMatcher) ProtoMessage()    {}
+func (*TrafficRoute_Http_Match_StringMatcher) Descriptor() ([]byte, []int) {
+	return fileDescriptor_059271a05615c95f, []int{0, 1, 0, 0}
+}
+
+func (m *TrafficRoute_Http_Match_StringMatcher) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TrafficRoute_Http_Match_StringMatcher.Unmarshal(m, b)
+}
+func (m *TrafficRoute_Http_Match_StringMatcher) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TrafficRoute_Http_Match_StringMatcher.Marshal(b, m, deterministic)
+}
+func (m *TrafficRoute_Http_Match_StringMatcher) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TrafficRoute_Http_Match_StringMatcher.Merge(m, src)
+}
+func (m *TrafficRoute_Http_Match_StringMatcher) XXX_Size() int {
+	return xxx_messageInfo_TrafficRoute_Http_Match_StringMatcher.Size(m)
+}
+func (m *TrafficRoute_Http_Match_StringMatcher) XXX_DiscardUnknown() {
+	xxx_messageInfo_TrafficRoute_Http_Match_StringMatcher.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TrafficRoute_Http_Match_StringMatcher proto.InternalMessageInfo
+
+type isTrafficRoute_Http_Match_StringMatcher_MatcherType interface {
+	isTrafficRoute_Http_Match_StringMatcher_MatcherType()
+}
+
+type TrafficRoute_Http_Match_StringMatcher_Prefix struct {
+	Prefix string `protobuf:"bytes,1,opt,name=prefix,proto3,oneof"`
+}
+
+type TrafficRoute_Http_Match_StringMatcher_Exact struct {
+	Exact string `protobuf:"bytes,2,opt,name=exact,proto3,oneof"`
+}
+
+type TrafficRoute_Http_Match_StringMatcher_Regex struct {
+	Regex string `protobuf:"bytes,3,opt,name=regex,proto3,oneof"`
+}
+
+func (*TrafficRoute_Http_Match_StringMatcher_Prefix) isTrafficRoute_Http_Match_StringMatcher_MatcherType() {
+}
+
+func (*TrafficRoute_Http_Match_StringMatcher_Exact) isTrafficRoute_Http_Match_StringMatcher_MatcherType() {
+}
+
+func (*TrafficRoute_Http_Match_StringMatcher_Regex) isTrafficRoute_Http_Match_StringMatcher_MatcherType() {
+}
+
+func (m *TrafficRoute_Http_Match_StringMatcher) GetMatcherType() isTrafficRoute_Http_Match_StringMatcher_MatcherType {
+	if m != nil {
+		return m.MatcherType
+	}
+	return nil
+}
+
+func (m *TrafficRoute_Http_Match_StringMatcher) GetPrefix() string {
+	if x, ok := m.GetMatcherType().(*TrafficRoute_Http_Match_StringMatcher_Prefix); ok {
+		return x.Prefix
+	}
+	return ""
+}
+
+func (m *TrafficRoute_Http_Match_StringMatcher) GetExact() string {
+	if x, ok := m.GetMatcherType().(*TrafficRoute_Http_Match_StringMatcher_Exact); ok {
+		return x.Exact
+	}
+	return ""
+}
+
+func (m *TrafficRoute_Http_Match_StringMatcher) GetRegex() string {
+	if x, ok := m.GetMatcherType().(*TrafficRoute_Http_Match_StringMatcher_Regex); ok {
+		return x.Regex
+	}
+	return ""
+}
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*TrafficRoute_Http_Match_StringMatcher) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*TrafficRoute_Http_Match_StringMatcher_Prefix)(nil),
+		(*TrafficRoute_Http_Match_StringMatcher_Exact)(nil),
+		(*TrafficRoute_Http_Match_StringMatcher_Regex)(nil),
+	}
+}
+
+// LoadBalancer defines a load balancing algorithm used to pick an endpoint
+// of a destination service.
+type TrafficRoute_LoadBalancer struct {
+	// Types that are valid to be assigned to Type:
+	//	*TrafficRoute_LoadBalancer_RoundRobin_
+	//	*TrafficRoute_LoadBalancer_LeastRequest_
+	//	*TrafficRoute_LoadBalancer_Random_
+	//	*TrafficRoute_LoadBalancer_RingHash_
+	//	*TrafficRoute_LoadBalancer_Maglev_
+	Type isTrafficRoute_LoadBalancer_Type `protobuf_oneof:"type"`
+	// Ordered list of hash policies used by RingHash and Maglev load
+	// balancers.
+	//
+	// Header and Cookie policies apply only to HTTP traffic.
+	HashPolicies         []*TrafficRoute_LoadBalancer_HashPolicy `protobuf:"bytes,6,rep,name=hash_policies,json=hashPolicies,proto3" json:"hash_policies,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                                `json:"-"`
+	XXX_unrecognized     []byte                                  `json:"-"`
+	XXX_sizecache        int32                                   `json:"-"`
+}
+
+func (m *TrafficRoute_LoadBalancer) Reset()         { *m = TrafficRoute_LoadBalancer{} }
+func (m *TrafficRoute_LoadBalancer) String() string { return proto.CompactTextString(m) }
+func (*TrafficRoute_LoadBalancer) ProtoMessage()    {}
+func (*TrafficRoute_LoadBalancer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_059271a05615c95f, []int{0, 2}
+}
+
+func (m *TrafficRoute_LoadBalancer) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TrafficRoute_LoadBalancer.Unmarshal(m, b)
+}
+func (m *TrafficRoute_LoadBalancer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TrafficRoute_LoadBalancer.Marshal(b, m, deterministic)
+}
+func (m *TrafficRoute_LoadBalancer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TrafficRoute_LoadBalancer.Merge(m, src)
+}
+func (m *TrafficRoute_LoadBalancer) XXX_Size() int {
+	return xxx_messageInfo_TrafficRoute_LoadBalancer.Size(m)
+}
+func (m *TrafficRoute_LoadBalancer) XXX_DiscardUnknown() {
+	xxx_messageInfo_TrafficRoute_LoadBalancer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TrafficRoute_LoadBalancer proto.InternalMessageInfo
+
+type isTrafficRoute_LoadBalancer_Type interface {
+	isTrafficRoute_LoadBalancer_Type()
+}
+
+type TrafficRoute_LoadBalancer_RoundRobin_ struct {
+	RoundRobin *TrafficRoute_LoadBalancer_RoundRobin `protobuf:"bytes,1,opt,name=round_robin,json=roundRobin,proto3,oneof"`
+}
+
+type TrafficRoute_LoadBalancer_LeastRequest_ struct {
+	LeastRequest *TrafficRoute_LoadBalancer_LeastRequest `protobuf:"bytes,2,opt,name=least_request,json=leastRequest,proto3,oneof"`
+}
+
+type TrafficRoute_LoadBalancer_Random_ struct {
+	Random *TrafficRoute_LoadBalancer_Random `protobuf:"bytes,3,opt,name=random,proto3,oneof"`
+}
+
+type TrafficRoute_LoadBalancer_RingHash_ struct {
+	RingHash *TrafficRoute_LoadBalancer_RingHash `protobuf:"bytes,4,opt,name=ring_hash,json=ringHash,proto3,oneof"`
+}
+
+type TrafficRoute_LoadBalancer_Maglev_ struct {
+	Maglev *TrafficRoute_LoadBalancer_Maglev `protobuf:"bytes,5,opt,name=maglev,proto3,oneof"`
+}
+
+func (*TrafficRoute_LoadBalancer_RoundRobin_) isTrafficRoute_LoadBalancer_Type() {}
+
+func (*TrafficRoute_LoadBalancer_LeastRequest_) isTrafficRoute_LoadBalancer_Type() {}
+
+func (*TrafficRoute_LoadBalancer_Random_) isTrafficRoute_LoadBalancer_Type() {}
+
+func (*TrafficRoute_LoadBalancer_RingHash_) isTrafficRoute_LoadBalancer_Type() {}
+
+func (*TrafficRoute_LoadBalancer_Maglev_) isTrafficRoute_LoadBalancer_Type() {}
+
+func (m *TrafficRoute_LoadBalancer) GetType() isTrafficRoute_LoadBalancer_Type {
+	if m != nil {
+		return m.Type
+	}
+	return nil
+}
+
+func (m *TrafficRoute_LoadBalancer) GetRoundRobin() *TrafficRoute_LoadBalancer_RoundRobin {
+	if x, ok := m.GetType().(*TrafficRoute_LoadBalancer_RoundRobin_); ok {
+		return x.RoundRobin
+	}
+	return nil
+}
+
+func (m *TrafficRoute_LoadBalancer) GetLeastRequest() *TrafficRoute_LoadBalancer_LeastRequest {
+	if x, ok := m.GetType().(*TrafficRoute_LoadBalancer_LeastRequest_); ok {
+		return x.LeastRequest
+	}
+	return nil
+}
+
+func (m *TrafficRoute_LoadBalancer) GetRandom() *TrafficRoute_LoadBalancer_Random {
+	if x, ok := m.GetType().(*TrafficRoute_LoadBalancer_Random_); ok {
+		return x.Random
+	}
+	return nil
+}
+
+func (m *TrafficRoute_LoadBalancer) GetRingHash() *TrafficRoute_LoadBalancer_RingHash {
+	if x, ok := m.GetType().(*TrafficRoute_LoadBalancer_RingHash_); ok {
+		return x.RingHash
+	}
+	return nil
+}
+
+func (m *TrafficRoute_LoadBalancer) GetMaglev() *TrafficRoute_LoadBalancer_Maglev {
+	if x, ok := m.GetType().(*TrafficRoute_LoadBalancer_Maglev_); ok {
+		return x.Maglev
+	}
+	return nil
+}
+
+func (m *TrafficRoute_LoadBalancer) GetHashPolicies() []*TrafficRoute_LoadBalancer_HashPolicy {
+	if m != nil {
+		return m.HashPolicies
+	}
+	return nil
+}
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*TrafficRoute_LoadBalancer) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*TrafficRoute_LoadBalancer_RoundRobin_)(nil),
+		(*TrafficRoute_LoadBalancer_LeastRequest_)(nil),
+		(*TrafficRoute_LoadBalancer_Random_)(nil),
+		(*TrafficRoute_LoadBalancer_RingHash_)(nil),
+		(*TrafficRoute_LoadBalancer_Maglev_)(nil),
+	}
+}
+
+// RoundRobin selects endpoints in a round robin order.
+type TrafficRoute_LoadBalancer_RoundRobin struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TrafficRoute_LoadBalancer_RoundRobin) Reset()         { *m = TrafficRoute_LoadBalancer_RoundRobin{} }
+func (m *TrafficRoute_LoadBalancer_RoundRobin) String() string { return proto.CompactTextString(m) }
+func (*TrafficRoute_LoadBalancer_RoundRobin) ProtoMessage()    {}
+func (*TrafficRoute_LoadBalancer_RoundRobin) Descriptor() ([]byte, []int) {
+	return fileDescriptor_059271a05615c95f, []int{0, 2, 0}
+}
+
+func (m *TrafficRoute_LoadBalancer_RoundRobin) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TrafficRoute_LoadBalancer_RoundRobin.Unmarshal(m, b)
+}
+func (m *TrafficRoute_LoadBalancer_RoundRobin) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TrafficRoute_LoadBalancer_RoundRobin.Marshal(b, m, deterministic)
+}
+func (m *TrafficRoute_LoadBalancer_RoundRobin) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TrafficRoute_LoadBalancer_RoundRobin.Merge(m, src)
+}
+func (m *TrafficRoute_LoadBalancer_RoundRobin) XXX_Size() int {
+	return xxx_messageInfo_TrafficRoute_LoadBalancer_RoundRobin.Size(m)
+}
+func (m *TrafficRoute_LoadBalancer_RoundRobin) XXX_DiscardUnknown() {
+	xxx_messageInfo_TrafficRoute_LoadBalancer_RoundRobin.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TrafficRoute_LoadBalancer_RoundRobin proto.InternalMessageInfo
+
+// LeastRequest selects the endpoint with the fewest active requests
+// out of a number of random endpoints.
+type TrafficRoute_LoadBalancer_LeastRequest struct {
+	// Number of random endpoints to pick from, 2 by default.
+	ChoiceCount          uint32   `protobuf:"varint,1,opt,name=choice_count,json=choiceCount,proto3" json:"choice_count,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TrafficRoute_LoadBalancer_LeastRequest) Reset() {
+	*m = TrafficRoute_LoadBalancer_LeastRequest{}
+}
+func (m *TrafficRoute_LoadBalancer_LeastRequest) String() string { return proto.CompactTextString(m) }
+func (*TrafficRoute_LoadBalancer_LeastRequest) ProtoMessage()    {}
+func (*TrafficRoute_LoadBalancer_LeastRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_059271a05615c95f, []int{0, 2, 1}
+}
+
+func (m *TrafficRoute_LoadBalancer_LeastRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TrafficRoute_LoadBalancer_LeastRequest.Unmarshal(m, b)
+}
+func (m *TrafficRoute_LoadBalancer_LeastRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TrafficRoute_LoadBalancer_LeastRequest.Marshal(b, m, deterministic)
+}
+func (m *TrafficRoute_LoadBalancer_LeastRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TrafficRoute_LoadBalancer_LeastRequest.Merge(m, src)
+}
+func (m *TrafficRoute_LoadBalancer_LeastRequest) XXX_Size() int {
+	return xxx_messageInfo_TrafficRoute_LoadBalancer_LeastRequest.Size(m)
+}
+func (m *TrafficRoute_LoadBalancer_LeastRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_TrafficRoute_LoadBalancer_LeastRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TrafficRoute_LoadBalancer_LeastRequest proto.InternalMessageInfo
+
+func (m *TrafficRoute_LoadBalancer_LeastRequest) GetChoiceCount() uint32 {
+	if m != nil {
+		return m.ChoiceCount
+	}
+	return 0
+}
+
+// Random selects a random endpoint.
+type TrafficRoute_LoadBalancer_Random struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TrafficRoute_LoadBalancer_Random) Reset()         { *m = TrafficRoute_LoadBalancer_Random{} }
+func (m *TrafficRoute_LoadBalancer_Random) String() string { return proto.CompactTextString(m) }
+func (*TrafficRoute_LoadBalancer_Random) ProtoMessage()    {}
+func (*TrafficRoute_LoadBalancer_Random) Descriptor() ([]byte, []int) {
+	return fileDescriptor_059271a05615c95f, []int{0, 2, 2}
+}
+
+func (m *TrafficRoute_LoadBalancer_Random) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TrafficRoute_LoadBalancer_Random.Unmarshal(m, b)
+}
+func (m *TrafficRoute_LoadBalancer_Random) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TrafficRoute_LoadBalancer_Random.Marshal(b, m, deterministic)
+}
+func (m *TrafficRoute_LoadBalancer_Random) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TrafficRoute_LoadBalancer_Random.Merge(m, src)
+}
+func (m *TrafficRoute_LoadBalancer_Random) XXX_Size() int {
+	return xxx_messageInfo_TrafficRoute_LoadBalancer_Random.Size(m)
+}
+func (m *TrafficRoute_LoadBalancer_Random) XXX_DiscardUnknown() {
+	xxx_messageInfo_TrafficRoute_LoadBalancer_Random.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TrafficRoute_LoadBalancer_Random proto.InternalMessageInfo
+
+// RingHash selects an endpoint by consistent hashing on a ring.
+type TrafficRoute_LoadBalancer_RingHash struct {
+	// Hash function used to build the ring, either XX_HASH (default) or
+	// MURMUR_HASH_2.
+	HashFunction string `protobuf:"bytes,1,opt,name=hash_function,json=hashFunction,proto3" json:"hash_function,omitempty"`
+	// Minimum size of the ring.
+	MinRingSize uint64 `protobuf:"varint,2,opt,name=min_ring_size,json=minRingSize,proto3" json:"min_ring_size,omitempty"`
+	// Maximum size of the ring.
+	MaxRingSize          uint64   `protobuf:"varint,3,opt,name=max_ring_size,json=maxRingSize,proto3" json:"max_ring_size,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TrafficRoute_LoadBalancer_RingHash) Reset()         { *m = TrafficRoute_LoadBalancer_RingHash{} }
+func (m *TrafficRoute_LoadBalancer_RingHash) String() string { return proto.CompactTextString(m) }
+func (*TrafficRoute_LoadBalancer_RingHash) ProtoMessage()    {}
+func (*TrafficRoute_LoadBalancer_RingHash) Descriptor() ([]byte, []int) {
+	return fileDescriptor_059271a05615c95f, []int{0, 2, 3}
+}
+
+func (m *TrafficRoute_LoadBalancer_RingHash) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TrafficRoute_LoadBalancer_RingHash.Unmarshal(m, b)
+}
+func (m *TrafficRoute_LoadBalancer_RingHash) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TrafficRoute_LoadBalancer_RingHash.Marshal(b, m, deterministic)
+}
+func (m *TrafficRoute_LoadBalancer_RingHash) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TrafficRoute_LoadBalancer_RingHash.Merge(m, src)
+}
+func (m *TrafficRoute_LoadBalancer_RingHash) XXX_Size() int {
+	return xxx_messageInfo_TrafficRoute_LoadBalancer_RingHash.Size(m)
+}
+func (m *TrafficRoute_LoadBalancer_RingHash) XXX_DiscardUnknown() {
+	xxx_messageInfo_TrafficRoute_LoadBalancer_RingHash.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TrafficRoute_LoadBalancer_RingHash proto.InternalMessageInfo
+
+func (m *TrafficRoute_LoadBalancer_RingHash) GetHashFunction() string {
+	if m != nil {
+		return m.HashFunction
+	}
+	return ""
+}
+
+func (m *TrafficRoute_LoadBalancer_RingHash) GetMinRingSize() uint64 {
+	if m != nil {
+		return m.MinRingSize
+	}
+	return 0
+}
+
+func (m *TrafficRoute_LoadBalancer_RingHash) GetMaxRingSize() uint64 {
+	if m != nil {
+		return m.MaxRingSize
+	}
+	return 0
+}
+
+// Maglev selects an endpoint by consistent hashing using Maglev
+// lookup table.
+type TrafficRoute_LoadBalancer_Maglev struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TrafficRoute_LoadBalancer_Maglev) Reset()         { *m = TrafficRoute_LoadBalancer_Maglev{} }
+func (m *TrafficRoute_LoadBalancer_Maglev) String() string { return proto.CompactTextString(m) }
+func (*TrafficRoute_LoadBalancer_Maglev) ProtoMessage()    {}
+func (*TrafficRoute_LoadBalancer_Maglev) Descriptor() ([]byte, []int) {
+	return fileDescriptor_059271a05615c95f, []int{0, 2, 4}
+}
+
+func (m *TrafficRoute_LoadBalancer_Maglev) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TrafficRoute_LoadBalancer_Maglev.Unmarshal(m, b)
+}
+func (m *TrafficRoute_LoadBalancer_Maglev) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TrafficRoute_LoadBalancer_Maglev.Marshal(b, m, deterministic)
+}
+func (m *TrafficRoute_LoadBalancer_Maglev) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TrafficRoute_LoadBalancer_Maglev.Merge(m, src)
+}
+func (m *TrafficRoute_LoadBalancer_Maglev) XXX_Size() int {
+	return xxx_messageInfo_TrafficRoute_LoadBalancer_Maglev.Size(m)
+}
+func (m *TrafficRoute_LoadBalancer_Maglev) XXX_DiscardUnknown() {
+	xxx_messageInfo_TrafficRoute_LoadBalancer_Maglev.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TrafficRoute_LoadBalancer_Maglev proto.InternalMessageInfo
+
+// HashPolicy defines a source of a hash key used by RingHash and Maglev
+// load balancers.
+type TrafficRoute_LoadBalancer_HashPolicy struct {
+	// Types that are valid to be assigned to Type:
+	//	*TrafficRoute_LoadBalancer_HashPolicy_Header_
+	//	*TrafficRoute_LoadBalancer_HashPolicy_Cookie_
+	//	*TrafficRoute_LoadBalancer_HashPolicy_SourceIp_
+	Type isTrafficRoute_LoadBalancer_HashPolicy_Type `protobuf_oneof:"type"`
+	// If true and the hash key is computed successfully, remaining hash
+	// policies are ignored.
+	Terminal             bool     `protobuf:"varint,4,opt,name=terminal,proto3" json:"terminal,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TrafficRoute_LoadBalancer_HashPolicy) Reset()         { *m = TrafficRoute_LoadBalancer_HashPolicy{} }
+func (m *TrafficRoute_LoadBalancer_HashPolicy) String() string { return proto.CompactTextString(m) }
+func (*TrafficRoute_LoadBalancer_HashPolicy) ProtoMessage()    {}
+func (*TrafficRoute_LoadBalancer_HashPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_059271a05615c95f, []int{0, 2, 5}
+}
+
+func (m *TrafficRoute_LoadBalancer_HashPolicy) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TrafficRoute_LoadBalancer_HashPolicy.Unmarshal(m, b)
+}
+func (m *TrafficRoute_LoadBalancer_HashPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TrafficRoute_LoadBalancer_HashPolicy.Marshal(b, m, deterministic)
+}
+func (m *TrafficRoute_LoadBalancer_HashPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TrafficRoute_LoadBalancer_HashPolicy.Merge(m, src)
+}
+func (m *TrafficRoute_LoadBalancer_HashPolicy) XXX_Size() int {
+	return xxx_messageInfo_TrafficRoute_LoadBalancer_HashPolicy.Size(m)
+}
+func (m *TrafficRoute_LoadBalancer_HashPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_TrafficRoute_LoadBalancer_HashPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TrafficRoute_LoadBalancer_HashPolicy proto.InternalMessageInfo
+
+type isTrafficRoute_LoadBalancer_HashPolicy_Type interface {
+	isTrafficRoute_LoadBalancer_HashPolicy_Type()
+}
+
+type TrafficRoute_LoadBalancer_HashPolicy_Header_ struct {
+	Header *TrafficRoute_LoadBalancer_HashPolicy_Header `protobuf:"bytes,1,opt,name=header,proto3,oneof"`
+}
+
+type TrafficRoute_LoadBalancer_HashPolicy_Cookie_ struct {
+	Cookie *TrafficRoute_LoadBalancer_HashPolicy_Cookie `protobuf:"bytes,2,opt,name=cookie,proto3,oneof"`
+}
+
+type TrafficRoute_LoadBalancer_HashPolicy_SourceIp_ struct {
+	SourceIp *TrafficRoute_LoadBalancer_HashPolicy_SourceIp `protobuf:"bytes,3,opt,name=source_ip,json=sourceIp,proto3,oneof"`
+}
+
+func (*TrafficRoute_LoadBalancer_HashPolicy_Header_) isTrafficRoute_LoadBalancer_HashPolicy_Type() {}
+
+func (*TrafficRoute_LoadBalancer_HashPolicy_Cookie_) isTrafficRoute_LoadBalancer_HashPolicy_Type() {}
+
+func (*TrafficRoute_LoadBalancer_HashPolicy_SourceIp_) isTrafficRoute_LoadBalancer_HashPolicy_Type() {
+}
+
+func (m *TrafficRoute_LoadBalancer_HashPolicy) GetType() isTrafficRoute_LoadBalancer_HashPolicy_Type {
+	if m != nil {
+		return m.Type
+	}
+	return nil
+}
+
+func (m *TrafficRoute_LoadBalancer_HashPolicy) GetHeader() *TrafficRoute_LoadBalancer_HashPolicy_Header {
+	if x, ok := m.GetType().(*TrafficRoute_LoadBalancer_HashPolicy_Header_); ok {
+		return x.Header
+	}
+	return nil
+}
+
+func (m *TrafficRoute_LoadBalancer_HashPolicy) GetCookie() *TrafficRoute_LoadBalancer_HashPolicy_Cookie {
+	if x, ok := m.GetType().(*TrafficRoute_LoadBalancer_HashPolicy_Cookie_); ok {
+		return x.Cookie
+	}
+	return nil
+}
+
+func (m *TrafficRoute_LoadBalancer_HashPolicy) GetSourceIp() *TrafficRoute_LoadBalancer_HashPolicy_SourceIp {
+	if x, ok := m.GetType().(*TrafficRoute_LoadBalancer_HashPolicy_SourceIp_); ok {
+		return x.SourceIp
+	}
+	return nil
+}
+
+func (m *TrafficRoute_LoadBalancer_HashPolicy) GetTerminal() bool {
+	if m != nil {
+		return m.Terminal
+	}
+	return false
+}
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*TrafficRoute_LoadBalancer_HashPolicy) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*TrafficRoute_LoadBalancer_HashPolicy_Header_)(nil),
+		(*TrafficRoute_LoadBalancer_HashPolicy_Cookie_)(nil),
+		(*TrafficRoute_LoadBalancer_HashPolicy_SourceIp_)(nil),
+	}
+}
+
+// Header computes a hash from the value of a request header.
+type TrafficRoute_LoadBalancer_HashPolicy_Header struct {
+	// Name of the header.
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TrafficRoute_LoadBalancer_HashPolicy_Header) Reset() {
+	*m = TrafficRoute_LoadBalancer_HashPolicy_Header{}
+}
+func (m *TrafficRoute_LoadBalancer_HashPolicy_Header) String() string {
+	return proto.CompactTextString(m)
+}
+func (*TrafficRoute_LoadBalancer_HashPolicy_Header) ProtoMessage() {}
+func (*TrafficRoute_LoadBalancer_HashPolicy_Header) Descriptor() ([]byte, []int) {
+	return fileDescriptor_059271a05615c95f, []int{0, 2, 5, 0}
+}
+
+func (m *TrafficRoute_LoadBalancer_HashPolicy_Header) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TrafficRoute_LoadBalancer_HashPolicy_Header.Unmarshal(m, b)
+}
+func (m *TrafficRoute_LoadBalancer_HashPolicy_Header) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TrafficRoute_LoadBalancer_HashPolicy_Header.Marshal(b, m, deterministic)
+}
+func (m *TrafficRoute_LoadBalancer_HashPolicy_Header) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TrafficRoute_LoadBalancer_HashPolicy_Header.Merge(m, src)
+}
+func (m *TrafficRoute_LoadBalancer_HashPolicy_Header) XXX_Size() int {
+	return xxx_messageInfo_TrafficRoute_LoadBalancer_HashPolicy_Header.Size(m)
+}
+func (m *TrafficRoute_LoadBalancer_HashPolicy_Header) XXX_DiscardUnknown() {
+	xxx_messageInfo_TrafficRoute_LoadBalancer_HashPolicy_Header.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TrafficRoute_LoadBalancer_HashPolicy_Header proto.InternalMessageInfo
+
+func (m *TrafficRoute_LoadBalancer_HashPolicy_Header) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+// Cookie computes a hash from the value of a cookie.
+//
+// If the cookie is absent and ttl is set, Envoy generates the cookie,
+// so subsequent requests of the same client reach the same endpoint.
+type TrafficRoute_LoadBalancer_HashPolicy_Cookie struct {
+	// Name of the cookie.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Lifetime of a generated cookie.
+	Ttl *duration.Duration `protobuf:"bytes,2,opt,name=ttl,proto3" json:"ttl,omitempty"`
+	// Path of a generated cookie.
+	Path                 string   `protobuf:"bytes,3,opt,name=path,proto3" json:"path,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TrafficRoute_LoadBalancer_HashPolicy_Cookie) Reset() {
+	*m = TrafficRoute_LoadBalancer_HashPolicy_Cookie{}
+}
+func (m *TrafficRoute_LoadBalancer_HashPolicy_Cookie) String() string {
+	return proto.CompactTextString(m)
+}
+func (*TrafficRoute_LoadBalancer_HashPolicy_Cookie) ProtoMessage() {}
+func (*TrafficRoute_LoadBalancer_HashPolicy_Cookie) Descriptor() ([]byte, []int) {
+	return fileDescriptor_059271a05615c95f, []int{0, 2, 5, 1}
+}
+
+func (m *TrafficRoute_LoadBalancer_HashPolicy_Cookie) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TrafficRoute_LoadBalancer_HashPolicy_Cookie.Unmarshal(m, b)
+}
+func (m *TrafficRoute_LoadBalancer_HashPolicy_Cookie) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TrafficRoute_LoadBalancer_HashPolicy_Cookie.Marshal(b, m, deterministic)
+}
+func (m *TrafficRoute_LoadBalancer_HashPolicy_Cookie) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TrafficRoute_LoadBalancer_HashPolicy_Cookie.Merge(m, src)
+}
+func (m *TrafficRoute_LoadBalancer_HashPolicy_Cookie) XXX_Size() int {
+	return xxx_messageInfo_TrafficRoute_LoadBalancer_HashPolicy_Cookie.Size(m)
+}
+func (m *TrafficRoute_LoadBalancer_HashPolicy_Cookie) XXX_DiscardUnknown() {
+	xxx_messageInfo_TrafficRoute_LoadBalancer_HashPolicy_Cookie.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TrafficRoute_LoadBalancer_HashPolicy_Cookie proto.InternalMessageInfo
+
+func (m *TrafficRoute_LoadBalancer_HashPolicy_Cookie) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *TrafficRoute_LoadBalancer_HashPolicy_Cookie) GetTtl() *duration.Duration {
+	if m != nil {
+		return m.Ttl
+	}
+	return nil
+}
+
+func (m *TrafficRoute_LoadBalancer_HashPolicy_Cookie) GetPath() string {
+	if m != nil {
+		return m.Path
+	}
+	return ""
+}
+
+// SourceIp computes a hash from the IP address of a client.
+type TrafficRoute_LoadBalancer_HashPolicy_SourceIp struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TrafficRoute_LoadBalancer_HashPolicy_SourceIp) Reset() {
+	*m = TrafficRoute_LoadBalancer_HashPolicy_SourceIp{}
+}
+func (m *TrafficRoute_LoadBalancer_HashPolicy_SourceIp) String() string {
+	return proto.CompactTextString(m)
+}
+func (*TrafficRoute_LoadBalancer_HashPolicy_SourceIp) ProtoMessage() {}
+func (*TrafficRoute_LoadBalancer_HashPolicy_SourceIp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_059271a05615c95f, []int{0, 2, 5, 2}
+}
+
+func (m *TrafficRoute_LoadBalancer_HashPolicy_SourceIp) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TrafficRoute_LoadBalancer_HashPolicy_SourceIp.Unmarshal(m, b)
+}
+func (m *TrafficRoute_LoadBalancer_HashPolicy_SourceIp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TrafficRoute_LoadBalancer_HashPolicy_SourceIp.Marshal(b, m, deterministic)
+}
+func (m *TrafficRoute_LoadBalancer_HashPolicy_SourceIp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TrafficRoute_LoadBalancer_HashPolicy_SourceIp.Merge(m, src)
+}
+func (m *TrafficRoute_LoadBalancer_HashPolicy_SourceIp) XXX_Size() int {
+	return xxx_messageInfo_TrafficRoute_LoadBalancer_HashPolicy_SourceIp.Size(m)
+}
+func (m *TrafficRoute_LoadBalancer_HashPolicy_SourceIp) XXX_DiscardUnknown() {
+	xxx_messageInfo_TrafficRoute_LoadBalancer_HashPolicy_SourceIp.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TrafficRoute_LoadBalancer_HashPolicy_SourceIp proto.InternalMessageInfo
+
+// Mirror defines a destination that receives a copy of HTTP requests.
+//
+// Responses of the mirror destination are ignored.
+type TrafficRoute_Mirror struct {
+	// Selector to match individual endpoints that receive mirrored requests.
+	Destination map[string]string `protobuf:"bytes,1,rep,name=destination,proto3" json:"destination,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Percentage of requests to mirror (range 0.0 - 100.0).
+	// Empty value defaults to 100.0%.
+	Percentage           *wrappers.DoubleValue `protobuf:"bytes,2,opt,name=percentage,proto3" json:"percentage,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *TrafficRoute_Mirror) Reset()         { *m = TrafficRoute_Mirror{} }
+func (m *TrafficRoute_Mirror) String() string { return proto.CompactTextString(m) }
+func (*TrafficRoute_Mirror) ProtoMessage()    {}
+func (*TrafficRoute_Mirror) Descriptor() ([]byte, []int) {
+	return fileDescriptor_059271a05615c95f, []int{0, 3}
+}
+
+func (m *TrafficRoute_Mirror) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TrafficRoute_Mirror.Unmarshal(m, b)
+}
+func (m *TrafficRoute_Mirror) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TrafficRoute_Mirror.Marshal(b, m, deterministic)
+}
+func (m *TrafficRoute_Mirror) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TrafficRoute_Mirror.Merge(m, src)
+}
+func (m *TrafficRoute_Mirror) XXX_Size() int {
+	return xxx_messageInfo_TrafficRoute_Mirror.Size(m)
+}
+func (m *TrafficRoute_Mirror) XXX_DiscardUnknown() {
+	xxx_messageInfo_TrafficRoute_Mirror.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TrafficRoute_Mirror proto.InternalMessageInfo
+
+func (m *TrafficRoute_Mirror) GetDestination() map[string]string {
+	if m != nil {
+		return m.Destination
+	}
+	return nil
+}
+
+func (m *TrafficRoute_Mirror) GetPercentage() *wrappers.DoubleValue {
+	if m != nil {
+		return m.Percentage
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*TrafficRoute)(nil), "kuma.mesh.v1alpha1.TrafficRoute")
 	proto.RegisterType((*TrafficRoute_WeightedDestination)(nil), "kuma.mesh.v1alpha1.TrafficRoute.WeightedDestination")
 	proto.RegisterMapType((map[string]string)(nil), "kuma.mesh.v1alpha1.TrafficRoute.WeightedDestination.DestinationEntry")
+	proto.RegisterType((*TrafficRoute_Http)(nil), "kuma.mesh.v1alpha1.TrafficRoute.Http")
+	proto.RegisterType((*TrafficRoute_Http_Match)(nil), "kuma.mesh.v1alpha1.TrafficRoute.Http.Match")
+	proto.RegisterMapType((map[string]*TrafficRoute_Http_Match_StringMatcher)(nil), "kuma.mesh.v1alpha1.TrafficRoute.Http.Match.HeadersEntry")
+	proto.RegisterType((*TrafficRoute_Http_Match_StringMatcher)(nil), "kuma.mesh.v1alpha1.TrafficRoute.Http.Match.StringMatcher")
+	proto.RegisterType((*TrafficRoute_LoadBalancer)(nil), "kuma.mesh.v1alpha1.TrafficRoute.LoadBalancer")
+	proto.RegisterType((*TrafficRoute_LoadBalancer_RoundRobin)(nil), "kuma.mesh.v1alpha1.TrafficRoute.LoadBalancer.RoundRobin")
+	proto.RegisterType((*TrafficRoute_LoadBalancer_LeastRequest)(nil), "kuma.mesh.v1alpha1.TrafficRoute.LoadBalancer.LeastRequest")
+	proto.RegisterType((*TrafficRoute_LoadBalancer_Random)(nil), "kuma.mesh.v1alpha1.TrafficRoute.LoadBalancer.Random")
+	proto.RegisterType((*TrafficRoute_LoadBalancer_RingHash)(nil), "kuma.mesh.v1alpha1.TrafficRoute.LoadBalancer.RingHash")
+	proto.RegisterType((*TrafficRoute_LoadBalancer_Maglev)(nil), "kuma.mesh.v1alpha1.TrafficRoute.LoadBalancer.Maglev")
+	proto.RegisterType((*TrafficRoute_LoadBalancer_HashPolicy)(nil), "kuma.mesh.v1alpha1.TrafficRoute.LoadBalancer.HashPolicy")
+	proto.RegisterType((*TrafficRoute_LoadBalancer_HashPolicy_Header)(nil), "kuma.mesh.v1alpha1.TrafficRoute.LoadBalancer.HashPolicy.Header")
+	proto.RegisterType((*TrafficRoute_LoadBalancer_HashPolicy_Cookie)(nil), "kuma.mesh.v1alpha1.TrafficRoute.LoadBalancer.HashPolicy.Cookie")
+	proto.RegisterType((*TrafficRoute_LoadBalancer_HashPolicy_SourceIp)(nil), "kuma.mesh.v1alpha1.TrafficRoute.LoadBalancer.HashPolicy.SourceIp")
+	proto.RegisterType((*TrafficRoute_Mirror)(nil), "kuma.mesh.v1alpha1.TrafficRoute.Mirror")
+	proto.RegisterMapType((map[string]string)(nil), "kuma.mesh.v1alpha1.TrafficRoute.Mirror.DestinationEntry")
 }
 
 func init() { proto.RegisterFile("mesh/v1alpha1/traffic_route.proto", fileDescriptor_059271a05615c95f) }
 
 var fileDescriptor_059271a05615c95f = []byte{
-	// 332 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0xcc, 0x4d, 0x2d, 0xce,
-	0xd0, 0x2f, 0x33, 0x4c, 0xcc, 0x29, 0xc8, 0x48, 0x34, 0xd4, 0x2f, 0x29, 0x4a, 0x4c, 0x4b, 0xcb,
-	0x4c, 0x8e, 0x2f, 0xca, 0x2f, 0x2d, 0x49, 0xd5, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0xca,
-	0x2e, 0xcd, 0x4d, 0xd4, 0x03, 0xa9, 0xd3, 0x83, 0xa9, 0x93, 0x92, 0x41, 0xd5, 0x56, 0x9c, 0x9a,
-	0x93, 0x9a, 0x5c, 0x92, 0x5f, 0x04, 0xd1, 0x21, 0x25, 0x5e, 0x96, 0x98, 0x93, 0x99, 0x92, 0x58,
-	0x92, 0xaa, 0x0f, 0x63, 0x40, 0x24, 0x94, 0x5e, 0x30, 0x73, 0xf1, 0x84, 0x40, 0xac, 0x08, 0x02,
-	0xd9, 0x20, 0xe4, 0xc0, 0xc5, 0x5e, 0x9c, 0x5f, 0x5a, 0x94, 0x9c, 0x5a, 0x2c, 0xc1, 0xa8, 0xc0,
-	0xac, 0xc1, 0x6d, 0x24, 0xa3, 0x87, 0x69, 0x9b, 0x5e, 0x30, 0xd4, 0x78, 0x27, 0x8e, 0x5f, 0x4e,
-	0xac, 0x93, 0x18, 0x99, 0x38, 0x18, 0x83, 0x60, 0xda, 0x84, 0xbc, 0xb8, 0x78, 0x52, 0x52, 0x8b,
-	0x4b, 0x32, 0xf3, 0x12, 0x4b, 0x32, 0xf3, 0xf3, 0x8a, 0x25, 0x98, 0x48, 0x32, 0x06, 0x45, 0xaf,
-	0x50, 0x10, 0x17, 0x4b, 0x72, 0x7e, 0x5e, 0x9a, 0x04, 0x33, 0xd8, 0x0c, 0x13, 0x6c, 0x66, 0x20,
-	0xbb, 0x5e, 0x2f, 0x3c, 0x35, 0x33, 0x3d, 0xa3, 0x24, 0x35, 0xc5, 0x05, 0x61, 0x08, 0x92, 0xd9,
-	0x60, 0xb3, 0xa4, 0xbe, 0x32, 0x72, 0x09, 0x63, 0x51, 0x27, 0x24, 0xcf, 0xc5, 0x56, 0x0e, 0x16,
-	0x96, 0x60, 0x54, 0x60, 0xd4, 0xe0, 0x75, 0x62, 0xff, 0xe5, 0xc4, 0xa2, 0xc5, 0xa4, 0xc1, 0x10,
-	0x04, 0x15, 0x16, 0xaa, 0xe5, 0xe2, 0x46, 0x72, 0x1c, 0xd4, 0x5f, 0xae, 0xe4, 0xb8, 0x49, 0x0f,
-	0x89, 0xed, 0x9a, 0x57, 0x52, 0x54, 0xe9, 0x24, 0xf2, 0xcb, 0x49, 0x70, 0x16, 0x23, 0x1f, 0x07,
-	0xa3, 0x12, 0x4b, 0x11, 0x93, 0x00, 0xa3, 0x16, 0x98, 0x0c, 0x42, 0xb6, 0x4f, 0xca, 0x8e, 0x4b,
-	0x00, 0x5d, 0x9b, 0x90, 0x00, 0x17, 0x73, 0x76, 0x6a, 0x25, 0xd8, 0xc1, 0x9c, 0x41, 0x20, 0xa6,
-	0x90, 0x08, 0x17, 0x6b, 0x59, 0x62, 0x4e, 0x69, 0xaa, 0x04, 0x13, 0x58, 0x0c, 0xc2, 0xb1, 0x62,
-	0xb2, 0x60, 0x74, 0xe2, 0x8a, 0xe2, 0x80, 0x39, 0x30, 0x89, 0x0d, 0x1c, 0xfb, 0xc6, 0x80, 0x00,
-	0x00, 0x00, 0xff, 0xff, 0x7b, 0xf5, 0xf0, 0xbd, 0x6d, 0x02, 0x00, 0x00,
+	// 1064 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x96, 0x5d, 0x6f, 0xdc, 0x44,
+	0x17, 0xc7, 0xe3, 0x5d, 0xaf, 0xb3, 0x39, 0xbb, 0xfb, 0x28, 0xcf, 0x50, 0x81, 0xb1, 0x22, 0x9a,
+	0x04, 0x21, 0xa2, 0x56, 0x38, 0x4a, 0x40, 0x28, 0xad, 0x10, 0x85, 0x6d, 0x8b, 0x16, 0x68, 0x78,
+	0x99, 0x14, 0x10, 0x45, 0xd5, 0x76, 0xe2, 0x9d, 0x5d, 0x8f, 0x62, 0x7b, 0xcc, 0x78, 0x9c, 0x97,
+	0x4a, 0x5c, 0x71, 0xc7, 0x25, 0x97, 0xdc, 0xf2, 0x0d, 0xf8, 0x20, 0x48, 0x7c, 0x05, 0xbe, 0x03,
+	0x37, 0xb9, 0x42, 0xf3, 0xe2, 0xc6, 0xdb, 0x04, 0x25, 0xbb, 0xb9, 0xf2, 0xbc, 0x9c, 0xf3, 0x9b,
+	0xff, 0x9c, 0x73, 0x66, 0x3c, 0xb0, 0x96, 0xd2, 0x22, 0xde, 0x3c, 0xdc, 0x22, 0x49, 0x1e, 0x93,
+	0xad, 0x4d, 0x29, 0xc8, 0x78, 0xcc, 0xa2, 0xa1, 0xe0, 0xa5, 0xa4, 0x61, 0x2e, 0xb8, 0xe4, 0x08,
+	0x1d, 0x94, 0x29, 0x09, 0x95, 0x5d, 0x58, 0xd9, 0x05, 0x2b, 0xd3, 0x6e, 0x05, 0x4d, 0x68, 0x24,
+	0xb9, 0x30, 0x1e, 0xc1, 0x6b, 0x87, 0x24, 0x61, 0x23, 0x22, 0xe9, 0x66, 0xd5, 0xb0, 0x13, 0x6f,
+	0x4c, 0x38, 0x9f, 0x24, 0x74, 0x53, 0xf7, 0xf6, 0xcb, 0xf1, 0xe6, 0xa8, 0x14, 0x44, 0x32, 0x9e,
+	0xfd, 0xd7, 0xfc, 0x91, 0x20, 0x79, 0x4e, 0x45, 0x61, 0xe6, 0xd7, 0x7f, 0x7f, 0x15, 0xba, 0x8f,
+	0x8d, 0x44, 0xac, 0x14, 0xa2, 0x8f, 0x60, 0xb1, 0xe0, 0xa5, 0x88, 0x68, 0xe1, 0x3b, 0xab, 0xcd,
+	0x8d, 0xce, 0xf6, 0x4a, 0x78, 0x5e, 0x6d, 0xb8, 0x67, 0xe5, 0xf5, 0xdb, 0xa7, 0xfd, 0xd6, 0xaf,
+	0x4e, 0xa3, 0xed, 0xe0, 0xca, 0x0d, 0x7d, 0x06, 0xdd, 0x11, 0x2d, 0x24, 0xcb, 0xb4, 0x8e, 0xc2,
+	0x6f, 0xcc, 0x84, 0x99, 0xf2, 0x45, 0x18, 0xdc, 0x88, 0x67, 0x63, 0xbf, 0xa9, 0x19, 0xef, 0x5d,
+	0xc4, 0xa8, 0xab, 0x0f, 0xbf, 0xa3, 0x6c, 0x12, 0x4b, 0x3a, 0x7a, 0x70, 0x06, 0xa9, 0xb1, 0x35,
+	0x0b, 0xdd, 0x01, 0x37, 0x96, 0x32, 0xf7, 0x5d, 0xcd, 0x7c, 0xeb, 0x52, 0xe6, 0x40, 0xca, 0x1c,
+	0x6b, 0x17, 0x84, 0xa1, 0x97, 0x70, 0x32, 0x1a, 0xee, 0x93, 0x84, 0x64, 0x11, 0x15, 0x7e, 0x6b,
+	0xd5, 0xd9, 0xe8, 0x6c, 0xbf, 0x73, 0x29, 0xe3, 0x11, 0x27, 0xa3, 0xbe, 0x75, 0xc2, 0xdd, 0xa4,
+	0xd6, 0x43, 0xf7, 0xc0, 0x4b, 0x99, 0x10, 0x5c, 0xf8, 0x9e, 0x86, 0xbd, 0x7d, 0x29, 0x6c, 0x57,
+	0x9b, 0x63, 0xeb, 0x16, 0xfc, 0xe3, 0xc0, 0x2b, 0x17, 0xec, 0x1b, 0xdd, 0x04, 0xef, 0x48, 0x0f,
+	0xfb, 0xce, 0xaa, 0xb3, 0xd1, 0xeb, 0x2f, 0x9e, 0xf6, 0xdd, 0x5b, 0x8d, 0x8d, 0x05, 0x6c, 0x87,
+	0xd1, 0x4f, 0xd0, 0xa9, 0x05, 0xdb, 0xe6, 0xe9, 0xe1, 0x3c, 0x31, 0x0e, 0x6b, 0xed, 0x87, 0x99,
+	0x14, 0x27, 0xfd, 0x1b, 0xa7, 0xfd, 0xff, 0xff, 0xe6, 0xfc, 0xef, 0x96, 0x2b, 0x1a, 0xcb, 0x4e,
+	0xdb, 0x59, 0xd7, 0x5f, 0x5c, 0x5f, 0x2f, 0xf8, 0x10, 0x96, 0x5f, 0x76, 0x43, 0xcb, 0xd0, 0x3c,
+	0xa0, 0x27, 0x5a, 0xf0, 0x12, 0x56, 0x4d, 0x74, 0x03, 0x5a, 0x87, 0x24, 0x29, 0xa9, 0xdf, 0xd0,
+	0x63, 0xa6, 0x73, 0xb7, 0xb1, 0xe3, 0x04, 0x7f, 0xb4, 0xc0, 0x55, 0xb9, 0x41, 0x9f, 0x43, 0x2b,
+	0x25, 0x32, 0x8a, 0xb5, 0x5b, 0x67, 0xfb, 0xf6, 0x95, 0x32, 0x1a, 0xee, 0x2a, 0x17, 0x5d, 0x1c,
+	0xbf, 0x38, 0x4a, 0x9b, 0x61, 0xa0, 0x67, 0x17, 0x05, 0xe5, 0xba, 0x85, 0x37, 0xb5, 0xef, 0xd3,
+	0x26, 0xb4, 0xf4, 0xe2, 0x68, 0x17, 0xdc, 0x9c, 0xc8, 0x4a, 0xf7, 0x9d, 0x19, 0x74, 0x87, 0x7b,
+	0x52, 0xb0, 0x6c, 0xa2, 0xdb, 0x54, 0x60, 0x8d, 0x41, 0x5f, 0x83, 0x97, 0x52, 0x19, 0xf3, 0x91,
+	0xdf, 0xb8, 0x2e, 0xd0, 0x82, 0x10, 0x86, 0xc5, 0x98, 0x92, 0x11, 0x15, 0x85, 0x3d, 0x82, 0x3b,
+	0xb3, 0x30, 0x07, 0xc6, 0x55, 0xa7, 0x16, 0x57, 0xa0, 0xe0, 0x67, 0x07, 0x7a, 0x53, 0xab, 0xa1,
+	0x35, 0xf0, 0x72, 0x41, 0xc7, 0xec, 0xd8, 0x24, 0x5e, 0x57, 0xaa, 0xaa, 0x97, 0xc1, 0x02, 0xb6,
+	0x13, 0xe8, 0x26, 0xb4, 0xe8, 0x31, 0x89, 0xa4, 0xdf, 0x78, 0xd9, 0xc2, 0x8c, 0x2b, 0x03, 0x41,
+	0x27, 0xf4, 0xd8, 0x6f, 0x9e, 0x33, 0xd0, 0xe3, 0xfd, 0x1e, 0x74, 0x52, 0xb3, 0xde, 0xe3, 0x93,
+	0x9c, 0x06, 0x25, 0x74, 0xeb, 0xf2, 0x2e, 0xa8, 0xbc, 0x2f, 0xeb, 0x95, 0x77, 0xad, 0x68, 0xd6,
+	0x8a, 0xf6, 0xcf, 0x25, 0xe8, 0xd6, 0x2f, 0x03, 0xf4, 0x03, 0x74, 0x04, 0x2f, 0xb3, 0xd1, 0x50,
+	0xf0, 0x7d, 0x96, 0xd9, 0x52, 0xd8, 0x99, 0xe9, 0x42, 0x09, 0xb1, 0x02, 0x60, 0xe5, 0x3f, 0x58,
+	0xc0, 0x20, 0x5e, 0xf4, 0x10, 0x81, 0x5e, 0x42, 0x49, 0x21, 0x87, 0x82, 0xfe, 0x58, 0xd2, 0x42,
+	0xda, 0xad, 0xdc, 0x9d, 0x0d, 0xff, 0x48, 0x21, 0xb0, 0x21, 0x0c, 0x16, 0x70, 0x37, 0xa9, 0xf5,
+	0xd1, 0x17, 0xe0, 0x09, 0x92, 0x8d, 0x78, 0xaa, 0x03, 0x7f, 0x95, 0xa3, 0x32, 0x2d, 0x5d, 0xfb,
+	0xaa, 0x44, 0x1b, 0x0a, 0xfa, 0x06, 0x96, 0x54, 0xe8, 0x86, 0x31, 0x29, 0x62, 0xdf, 0xd5, 0xc8,
+	0xf7, 0x67, 0x44, 0xb2, 0x6c, 0x32, 0x20, 0x45, 0x3c, 0x58, 0xc0, 0x6d, 0x61, 0xdb, 0x4a, 0x66,
+	0x4a, 0x26, 0x09, 0x3d, 0xf4, 0x5b, 0xf3, 0xc8, 0xdc, 0xd5, 0xbe, 0x4a, 0xa6, 0xa1, 0xa0, 0xa7,
+	0xd0, 0x53, 0x0a, 0x87, 0x39, 0x4f, 0x58, 0xc4, 0x68, 0xe1, 0x7b, 0x57, 0x3c, 0x1e, 0x53, 0x58,
+	0x25, 0xed, 0x2b, 0x45, 0x38, 0xc1, 0xdd, 0xb8, 0x6a, 0x33, 0x5a, 0x04, 0x5d, 0x80, 0xb3, 0xa4,
+	0x06, 0x5b, 0xd0, 0xad, 0xe7, 0x00, 0xad, 0x41, 0x37, 0x8a, 0x39, 0x8b, 0xe8, 0x30, 0xe2, 0x65,
+	0x66, 0xef, 0x77, 0xdc, 0x31, 0x63, 0xf7, 0xd5, 0x50, 0xd0, 0x06, 0xcf, 0x84, 0x36, 0x38, 0x82,
+	0x76, 0x15, 0x11, 0xf4, 0xa6, 0x55, 0x3d, 0x2e, 0xb3, 0x48, 0x5f, 0x6f, 0xa6, 0xdc, 0xf5, 0xda,
+	0x9f, 0xd8, 0x31, 0xb4, 0x0e, 0xbd, 0x94, 0x65, 0x43, 0x9d, 0x85, 0x82, 0x3d, 0x37, 0xf5, 0xef,
+	0xe2, 0x4e, 0xca, 0x32, 0x05, 0xda, 0x63, 0xcf, 0xa9, 0xb6, 0x21, 0xc7, 0x35, 0x9b, 0xa6, 0xb5,
+	0x21, 0xc7, 0x95, 0x8d, 0x92, 0x60, 0xc2, 0x16, 0xfc, 0xd5, 0x04, 0x38, 0xdb, 0x2a, 0xfa, 0x1e,
+	0x3c, 0x73, 0x17, 0xd8, 0x6a, 0xbf, 0x37, 0x6f, 0xd0, 0xec, 0x05, 0xa3, 0xd2, 0x62, 0x80, 0x0a,
+	0x1d, 0x71, 0x7e, 0xc0, 0xaa, 0x43, 0x3b, 0x3f, 0xfa, 0xbe, 0xc6, 0x28, 0xb4, 0x01, 0xa2, 0x67,
+	0xb0, 0x64, 0x5e, 0x38, 0x43, 0x96, 0xdb, 0x5a, 0xff, 0x78, 0x6e, 0xfa, 0x9e, 0x26, 0x7d, 0x9a,
+	0xab, 0x1a, 0x2d, 0x6c, 0x1b, 0x05, 0xd0, 0x96, 0x54, 0xa4, 0x2c, 0x23, 0x89, 0xae, 0xfc, 0x36,
+	0x7e, 0xd1, 0x0f, 0x56, 0xc0, 0x33, 0x9b, 0x45, 0x08, 0xdc, 0x8c, 0xa4, 0xd4, 0xa6, 0x4e, 0xb7,
+	0x83, 0xa7, 0xe0, 0x19, 0xbd, 0x17, 0xcd, 0xa2, 0xdb, 0xd0, 0x94, 0x32, 0xb1, 0x11, 0x79, 0x3d,
+	0x34, 0x2f, 0xc2, 0xb0, 0x7a, 0x11, 0x86, 0x0f, 0xec, 0x8b, 0x11, 0x2b, 0x2b, 0x05, 0xd0, 0xff,
+	0xa4, 0xa6, 0x01, 0xa8, 0x76, 0x00, 0xd0, 0xae, 0x04, 0xf7, 0x3d, 0x70, 0xe5, 0x49, 0x4e, 0xab,
+	0x6f, 0xf0, 0xb7, 0x03, 0x9e, 0x79, 0x90, 0xa0, 0x27, 0xd3, 0xbf, 0x4e, 0xe7, 0x8a, 0x27, 0xc2,
+	0x78, 0x9f, 0x7b, 0x42, 0x4c, 0xfd, 0x34, 0xd1, 0x07, 0x00, 0x39, 0x15, 0x11, 0xcd, 0x24, 0x99,
+	0x54, 0xc9, 0x5d, 0x39, 0xbf, 0x15, 0x5e, 0xee, 0x27, 0xf4, 0x5b, 0x75, 0xdb, 0xe2, 0x9a, 0xfd,
+	0x75, 0x9f, 0x1a, 0x7d, 0x78, 0xd2, 0xae, 0xb4, 0xef, 0x7b, 0x7a, 0xb5, 0x77, 0xff, 0x1d, 0x00,
+	0xa7, 0xc6, 0x53, 0x80, 0xe8, 0x0b, 0x00, 0x00,
 }
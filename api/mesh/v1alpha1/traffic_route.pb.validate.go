@@ -110,6 +110,41 @@ func (m *TrafficRoute) Validate() error {
 
 	}
 
+	for idx, item := range m.GetHttp() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return TrafficRouteValidationError{
+					field:  fmt.Sprintf("Http[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if v, ok := interface{}(m.GetLoadBalancer()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TrafficRouteValidationError{
+				field:  "LoadBalancer",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if v, ok := interface{}(m.GetMirror()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TrafficRouteValidationError{
+				field:  "Mirror",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	return nil
 }
 
@@ -267,3 +302,1218 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = TrafficRoute_WeightedDestinationValidationError{}
+
+// Validate checks the field values on TrafficRoute_Http with the rules defined
+// in the proto definition for this message. If any rules are violated, an
+// error is returned.
+func (m *TrafficRoute_Http) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if m.GetMatch() == nil {
+		return TrafficRoute_HttpValidationError{
+			field:  "Match",
+			reason: "value is required",
+		}
+	}
+
+	if v, ok := interface{}(m.GetMatch()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TrafficRoute_HttpValidationError{
+				field:  "Match",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(m.GetDestination()) < 1 {
+		return TrafficRoute_HttpValidationError{
+			field:  "Destination",
+			reason: "value must contain at least 1 item(s)",
+		}
+	}
+
+	for idx, item := range m.GetDestination() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return TrafficRoute_HttpValidationError{
+					field:  fmt.Sprintf("Destination[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// TrafficRoute_HttpValidationError is the validation error returned by
+// TrafficRoute_Http.Validate if the designated constraints aren't met.
+type TrafficRoute_HttpValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TrafficRoute_HttpValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TrafficRoute_HttpValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TrafficRoute_HttpValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TrafficRoute_HttpValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TrafficRoute_HttpValidationError) ErrorName() string {
+	return "TrafficRoute_HttpValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e TrafficRoute_HttpValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTrafficRoute_Http.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TrafficRoute_HttpValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TrafficRoute_HttpValidationError{}
+
+// Validate checks the field values on TrafficRoute_LoadBalancer with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *TrafficRoute_LoadBalancer) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	for idx, item := range m.GetHashPolicies() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return TrafficRoute_LoadBalancerValidationError{
+					field:  fmt.Sprintf("HashPolicies[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	switch m.Type.(type) {
+
+	case *TrafficRoute_LoadBalancer_RoundRobin_:
+
+		if v, ok := interface{}(m.GetRoundRobin()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return TrafficRoute_LoadBalancerValidationError{
+					field:  "RoundRobin",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *TrafficRoute_LoadBalancer_LeastRequest_:
+
+		if v, ok := interface{}(m.GetLeastRequest()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return TrafficRoute_LoadBalancerValidationError{
+					field:  "LeastRequest",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *TrafficRoute_LoadBalancer_Random_:
+
+		if v, ok := interface{}(m.GetRandom()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return TrafficRoute_LoadBalancerValidationError{
+					field:  "Random",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *TrafficRoute_LoadBalancer_RingHash_:
+
+		if v, ok := interface{}(m.GetRingHash()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return TrafficRoute_LoadBalancerValidationError{
+					field:  "RingHash",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *TrafficRoute_LoadBalancer_Maglev_:
+
+		if v, ok := interface{}(m.GetMaglev()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return TrafficRoute_LoadBalancerValidationError{
+					field:  "Maglev",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// TrafficRoute_LoadBalancerValidationError is the validation error returned by
+// TrafficRoute_LoadBalancer.Validate if the designated constraints aren't met.
+type TrafficRoute_LoadBalancerValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TrafficRoute_LoadBalancerValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TrafficRoute_LoadBalancerValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TrafficRoute_LoadBalancerValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TrafficRoute_LoadBalancerValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TrafficRoute_LoadBalancerValidationError) ErrorName() string {
+	return "TrafficRoute_LoadBalancerValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e TrafficRoute_LoadBalancerValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTrafficRoute_LoadBalancer.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TrafficRoute_LoadBalancerValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TrafficRoute_LoadBalancerValidationError{}
+
+// Validate checks the field values on TrafficRoute_Mirror with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *TrafficRoute_Mirror) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for Destination
+
+	if v, ok := interface{}(m.GetPercentage()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TrafficRoute_MirrorValidationError{
+				field:  "Percentage",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	return nil
+}
+
+// TrafficRoute_MirrorValidationError is the validation error returned by
+// TrafficRoute_Mirror.Validate if the designated constraints aren't met.
+type TrafficRoute_MirrorValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TrafficRoute_MirrorValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TrafficRoute_MirrorValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TrafficRoute_MirrorValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TrafficRoute_MirrorValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TrafficRoute_MirrorValidationError) ErrorName() string {
+	return "TrafficRoute_MirrorValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e TrafficRoute_MirrorValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTrafficRoute_Mirror.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TrafficRoute_MirrorValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TrafficRoute_MirrorValidationError{}
+
+// Validate checks the field values on TrafficRoute_Http_Match with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *TrafficRoute_Http_Match) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if v, ok := interface{}(m.GetPath()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TrafficRoute_Http_MatchValidationError{
+				field:  "Path",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if v, ok := interface{}(m.GetMethod()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TrafficRoute_Http_MatchValidationError{
+				field:  "Method",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for key, val := range m.GetHeaders() {
+		_ = val
+
+		// no validation rules for Headers[key]
+
+		if v, ok := interface{}(val).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return TrafficRoute_Http_MatchValidationError{
+					field:  fmt.Sprintf("Headers[%v]", key),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// TrafficRoute_Http_MatchValidationError is the validation error returned by
+// TrafficRoute_Http_Match.Validate if the designated constraints aren't met.
+type TrafficRoute_Http_MatchValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TrafficRoute_Http_MatchValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TrafficRoute_Http_MatchValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TrafficRoute_Http_MatchValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TrafficRoute_Http_MatchValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TrafficRoute_Http_MatchValidationError) ErrorName() string {
+	return "TrafficRoute_Http_MatchValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e TrafficRoute_Http_MatchValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTrafficRoute_Http_Match.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TrafficRoute_Http_MatchValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TrafficRoute_Http_MatchValidationError{}
+
+// Validate checks the field values on TrafficRoute_Http_Match_StringMatcher
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, an error is returned.
+func (m *TrafficRoute_Http_Match_StringMatcher) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	switch m.MatcherType.(type) {
+
+	case *TrafficRoute_Http_Match_StringMatcher_Prefix:
+
+		if utf8.RuneCountInString(m.GetPrefix()) < 1 {
+			return TrafficRoute_Http_Match_StringMatcherValidationError{
+				field:  "Prefix",
+				reason: "value length must be at least 1 runes",
+			}
+		}
+
+	case *TrafficRoute_Http_Match_StringMatcher_Exact:
+
+		if utf8.RuneCountInString(m.GetExact()) < 1 {
+			return TrafficRoute_Http_Match_StringMatcherValidationError{
+				field:  "Exact",
+				reason: "value length must be at least 1 runes",
+			}
+		}
+
+	case *TrafficRoute_Http_Match_StringMatcher_Regex:
+
+		if utf8.RuneCountInString(m.GetRegex()) < 1 {
+			return TrafficRoute_Http_Match_StringMatcherValidationError{
+				field:  "Regex",
+				reason: "value length must be at least 1 runes",
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// TrafficRoute_Http_Match_StringMatcherValidationError is the validation error
+// returned by TrafficRoute_Http_Match_StringMatcher.Validate if the
+// designated constraints aren't met.
+type TrafficRoute_Http_Match_StringMatcherValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TrafficRoute_Http_Match_StringMatcherValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TrafficRoute_Http_Match_StringMatcherValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TrafficRoute_Http_Match_StringMatcherValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TrafficRoute_Http_Match_StringMatcherValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TrafficRoute_Http_Match_StringMatcherValidationError) ErrorName() string {
+	return "TrafficRoute_Http_Match_StringMatcherValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e TrafficRoute_Http_Match_StringMatcherValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTrafficRoute_Http_Match_StringMatcher.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TrafficRoute_Http_Match_StringMatcherValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TrafficRoute_Http_Match_StringMatcherValidationError{}
+
+// Validate checks the field values on TrafficRoute_LoadBalancer_RoundRobin
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, an error is returned.
+func (m *TrafficRoute_LoadBalancer_RoundRobin) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	return nil
+}
+
+// TrafficRoute_LoadBalancer_RoundRobinValidationError is the validation error
+// returned by TrafficRoute_LoadBalancer_RoundRobin.Validate if the designated
+// constraints aren't met.
+type TrafficRoute_LoadBalancer_RoundRobinValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TrafficRoute_LoadBalancer_RoundRobinValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TrafficRoute_LoadBalancer_RoundRobinValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TrafficRoute_LoadBalancer_RoundRobinValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TrafficRoute_LoadBalancer_RoundRobinValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TrafficRoute_LoadBalancer_RoundRobinValidationError) ErrorName() string {
+	return "TrafficRoute_LoadBalancer_RoundRobinValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e TrafficRoute_LoadBalancer_RoundRobinValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTrafficRoute_LoadBalancer_RoundRobin.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TrafficRoute_LoadBalancer_RoundRobinValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TrafficRoute_LoadBalancer_RoundRobinValidationError{}
+
+// Validate checks the field values on TrafficRoute_LoadBalancer_LeastRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, an error is returned.
+func (m *TrafficRoute_LoadBalancer_LeastRequest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for ChoiceCount
+
+	return nil
+}
+
+// TrafficRoute_LoadBalancer_LeastRequestValidationError is the validation
+// error returned by TrafficRoute_LoadBalancer_LeastRequest.Validate if the
+// designated constraints aren't met.
+type TrafficRoute_LoadBalancer_LeastRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TrafficRoute_LoadBalancer_LeastRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TrafficRoute_LoadBalancer_LeastRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TrafficRoute_LoadBalancer_LeastRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TrafficRoute_LoadBalancer_LeastRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TrafficRoute_LoadBalancer_LeastRequestValidationError) ErrorName() string {
+	return "TrafficRoute_LoadBalancer_LeastRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e TrafficRoute_LoadBalancer_LeastRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTrafficRoute_LoadBalancer_LeastRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TrafficRoute_LoadBalancer_LeastRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TrafficRoute_LoadBalancer_LeastRequestValidationError{}
+
+// Validate checks the field values on TrafficRoute_LoadBalancer_Random with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, an error is returned.
+func (m *TrafficRoute_LoadBalancer_Random) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	return nil
+}
+
+// TrafficRoute_LoadBalancer_RandomValidationError is the validation error
+// returned by TrafficRoute_LoadBalancer_Random.Validate if the designated
+// constraints aren't met.
+type TrafficRoute_LoadBalancer_RandomValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TrafficRoute_LoadBalancer_RandomValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TrafficRoute_LoadBalancer_RandomValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TrafficRoute_LoadBalancer_RandomValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TrafficRoute_LoadBalancer_RandomValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TrafficRoute_LoadBalancer_RandomValidationError) ErrorName() string {
+	return "TrafficRoute_LoadBalancer_RandomValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e TrafficRoute_LoadBalancer_RandomValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTrafficRoute_LoadBalancer_Random.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TrafficRoute_LoadBalancer_RandomValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TrafficRoute_LoadBalancer_RandomValidationError{}
+
+// Validate checks the field values on TrafficRoute_LoadBalancer_RingHash with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, an error is returned.
+func (m *TrafficRoute_LoadBalancer_RingHash) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for HashFunction
+
+	// no validation rules for MinRingSize
+
+	// no validation rules for MaxRingSize
+
+	return nil
+}
+
+// TrafficRoute_LoadBalancer_RingHashValidationError is the validation error
+// returned by TrafficRoute_LoadBalancer_RingHash.Validate if the designated
+// constraints aren't met.
+type TrafficRoute_LoadBalancer_RingHashValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TrafficRoute_LoadBalancer_RingHashValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TrafficRoute_LoadBalancer_RingHashValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TrafficRoute_LoadBalancer_RingHashValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TrafficRoute_LoadBalancer_RingHashValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TrafficRoute_LoadBalancer_RingHashValidationError) ErrorName() string {
+	return "TrafficRoute_LoadBalancer_RingHashValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e TrafficRoute_LoadBalancer_RingHashValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTrafficRoute_LoadBalancer_RingHash.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TrafficRoute_LoadBalancer_RingHashValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TrafficRoute_LoadBalancer_RingHashValidationError{}
+
+// Validate checks the field values on TrafficRoute_LoadBalancer_Maglev with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, an error is returned.
+func (m *TrafficRoute_LoadBalancer_Maglev) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	return nil
+}
+
+// TrafficRoute_LoadBalancer_MaglevValidationError is the validation error
+// returned by TrafficRoute_LoadBalancer_Maglev.Validate if the designated
+// constraints aren't met.
+type TrafficRoute_LoadBalancer_MaglevValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TrafficRoute_LoadBalancer_MaglevValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TrafficRoute_LoadBalancer_MaglevValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TrafficRoute_LoadBalancer_MaglevValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TrafficRoute_LoadBalancer_MaglevValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TrafficRoute_LoadBalancer_MaglevValidationError) ErrorName() string {
+	return "TrafficRoute_LoadBalancer_MaglevValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e TrafficRoute_LoadBalancer_MaglevValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTrafficRoute_LoadBalancer_Maglev.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TrafficRoute_LoadBalancer_MaglevValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TrafficRoute_LoadBalancer_MaglevValidationError{}
+
+// Validate checks the field values on TrafficRoute_LoadBalancer_HashPolicy
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, an error is returned.
+func (m *TrafficRoute_LoadBalancer_HashPolicy) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for Terminal
+
+	switch m.Type.(type) {
+
+	case *TrafficRoute_LoadBalancer_HashPolicy_Header_:
+
+		if v, ok := interface{}(m.GetHeader()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return TrafficRoute_LoadBalancer_HashPolicyValidationError{
+					field:  "Header",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *TrafficRoute_LoadBalancer_HashPolicy_Cookie_:
+
+		if v, ok := interface{}(m.GetCookie()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return TrafficRoute_LoadBalancer_HashPolicyValidationError{
+					field:  "Cookie",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *TrafficRoute_LoadBalancer_HashPolicy_SourceIp_:
+
+		if v, ok := interface{}(m.GetSourceIp()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return TrafficRoute_LoadBalancer_HashPolicyValidationError{
+					field:  "SourceIp",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// TrafficRoute_LoadBalancer_HashPolicyValidationError is the validation error
+// returned by TrafficRoute_LoadBalancer_HashPolicy.Validate if the designated
+// constraints aren't met.
+type TrafficRoute_LoadBalancer_HashPolicyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TrafficRoute_LoadBalancer_HashPolicyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TrafficRoute_LoadBalancer_HashPolicyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TrafficRoute_LoadBalancer_HashPolicyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TrafficRoute_LoadBalancer_HashPolicyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TrafficRoute_LoadBalancer_HashPolicyValidationError) ErrorName() string {
+	return "TrafficRoute_LoadBalancer_HashPolicyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e TrafficRoute_LoadBalancer_HashPolicyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTrafficRoute_LoadBalancer_HashPolicy.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TrafficRoute_LoadBalancer_HashPolicyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TrafficRoute_LoadBalancer_HashPolicyValidationError{}
+
+// Validate checks the field values on
+// TrafficRoute_LoadBalancer_HashPolicy_Header with the rules defined in the
+// proto definition for this message. If any rules are violated, an error is returned.
+func (m *TrafficRoute_LoadBalancer_HashPolicy_Header) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for Name
+
+	return nil
+}
+
+// TrafficRoute_LoadBalancer_HashPolicy_HeaderValidationError is the validation
+// error returned by TrafficRoute_LoadBalancer_HashPolicy_Header.Validate if
+// the designated constraints aren't met.
+type TrafficRoute_LoadBalancer_HashPolicy_HeaderValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TrafficRoute_LoadBalancer_HashPolicy_HeaderValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TrafficRoute_LoadBalancer_HashPolicy_HeaderValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TrafficRoute_LoadBalancer_HashPolicy_HeaderValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TrafficRoute_LoadBalancer_HashPolicy_HeaderValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TrafficRoute_LoadBalancer_HashPolicy_HeaderValidationError) ErrorName() string {
+	return "TrafficRoute_LoadBalancer_HashPolicy_HeaderValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e TrafficRoute_LoadBalancer_HashPolicy_HeaderValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTrafficRoute_LoadBalancer_HashPolicy_Header.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TrafficRoute_LoadBalancer_HashPolicy_HeaderValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TrafficRoute_LoadBalancer_HashPolicy_HeaderValidationError{}
+
+// Validate checks the field values on
+// TrafficRoute_LoadBalancer_HashPolicy_Cookie with the rules defined in the
+// proto definition for this message. If any rules are violated, an error is returned.
+func (m *TrafficRoute_LoadBalancer_HashPolicy_Cookie) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for Name
+
+	if v, ok := interface{}(m.GetTtl()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TrafficRoute_LoadBalancer_HashPolicy_CookieValidationError{
+				field:  "Ttl",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Path
+
+	return nil
+}
+
+// TrafficRoute_LoadBalancer_HashPolicy_CookieValidationError is the validation
+// error returned by TrafficRoute_LoadBalancer_HashPolicy_Cookie.Validate if
+// the designated constraints aren't met.
+type TrafficRoute_LoadBalancer_HashPolicy_CookieValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TrafficRoute_LoadBalancer_HashPolicy_CookieValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TrafficRoute_LoadBalancer_HashPolicy_CookieValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TrafficRoute_LoadBalancer_HashPolicy_CookieValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TrafficRoute_LoadBalancer_HashPolicy_CookieValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TrafficRoute_LoadBalancer_HashPolicy_CookieValidationError) ErrorName() string {
+	return "TrafficRoute_LoadBalancer_HashPolicy_CookieValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e TrafficRoute_LoadBalancer_HashPolicy_CookieValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTrafficRoute_LoadBalancer_HashPolicy_Cookie.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TrafficRoute_LoadBalancer_HashPolicy_CookieValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TrafficRoute_LoadBalancer_HashPolicy_CookieValidationError{}
+
+// Validate checks the field values on
+// TrafficRoute_LoadBalancer_HashPolicy_SourceIp with the rules defined in the
+// proto definition for this message. If any rules are violated, an error is returned.
+func (m *TrafficRoute_LoadBalancer_HashPolicy_SourceIp) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	return nil
+}
+
+// TrafficRoute_LoadBalancer_HashPolicy_SourceIpValidationError is the
+// validation error returned by
+// TrafficRoute_LoadBalancer_HashPolicy_SourceIp.Validate if the designated
+// constraints aren't met.
+type TrafficRoute_LoadBalancer_HashPolicy_SourceIpValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TrafficRoute_LoadBalancer_HashPolicy_SourceIpValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TrafficRoute_LoadBalancer_HashPolicy_SourceIpValidationError) Reason() string {
+	return e.reason
+}
+
+// Cause function returns cause value.
+func (e TrafficRoute_LoadBalancer_HashPolicy_SourceIpValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TrafficRoute_LoadBalancer_HashPolicy_SourceIpValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TrafficRoute_LoadBalancer_HashPolicy_SourceIpValidationError) ErrorName() string {
+	return "TrafficRoute_LoadBalancer_HashPolicy_SourceIpValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e TrafficRoute_LoadBalancer_HashPolicy_SourceIpValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTrafficRoute_LoadBalancer_HashPolicy_SourceIp.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TrafficRoute_LoadBalancer_HashPolicy_SourceIpValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TrafficRoute_LoadBalancer_HashPolicy_SourceIpValidationError{}
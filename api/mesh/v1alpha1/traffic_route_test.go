@@ -59,6 +59,34 @@ var _ = Describe("TrafficRoute", func() {
                   destination:
                     service: backend
                     version: v2
+`,
+			}),
+			Entry("conf with http rules", testCase{
+				input: `
+                sources:
+                - match:
+                    service: web
+                destinations:
+                - match:
+                    service: backend
+                conf:
+                - destination:
+                    service: backend
+                    version: v1
+                http:
+                - match:
+                    path:
+                      prefix: /api/v2
+                    method:
+                      exact: GET
+                    headers:
+                      x-canary:
+                        exact: "true"
+                  destination:
+                  - weight: 100
+                    destination:
+                      service: backend
+                      version: v2
 `,
 			}),
 		)
@@ -121,6 +149,24 @@ var _ = Describe("TrafficRoute", func() {
 `,
 				expectedErr: `invalid TrafficRoute.Conf[0]: embedded message failed validation | caused by: invalid TrafficRoute_WeightedDestination.Destination: value must contain at least 1 pair(s)`,
 			}),
+			Entry("http rule without match", testCase{
+				input: `
+                sources:
+                - match:
+                    service: web
+                destinations:
+                - match:
+                    service: backend
+                conf:
+                - destination:
+                    service: backend
+                http:
+                - destination:
+                  - destination:
+                      service: backend
+`,
+				expectedErr: `invalid TrafficRoute.Http[0]: embedded message failed validation | caused by: invalid TrafficRoute_Http.Match: value is required`,
+			}),
 		)
 	})
 })
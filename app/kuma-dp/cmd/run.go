@@ -16,7 +16,10 @@ import (
 
 	kumadp_config "github.com/Kong/kuma/app/kuma-dp/pkg/config"
 	"github.com/Kong/kuma/app/kuma-dp/pkg/dataplane/accesslogs"
+	"github.com/Kong/kuma/app/kuma-dp/pkg/dataplane/admin"
 	"github.com/Kong/kuma/app/kuma-dp/pkg/dataplane/envoy"
+	"github.com/Kong/kuma/app/kuma-dp/pkg/dataplane/faults"
+	"github.com/Kong/kuma/app/kuma-dp/pkg/dataplane/metrics"
 	"github.com/Kong/kuma/pkg/config"
 	kuma_dp "github.com/Kong/kuma/pkg/config/app/kuma-dp"
 	config_types "github.com/Kong/kuma/pkg/config/types"
@@ -100,17 +103,27 @@ func newRunCmd() *cobra.Command {
 				runLog.Info("generated Envoy configuration will be stored in a temporary directory", "dir", tmpDir)
 			}
 
+			controlPlaneCa := admin.NewControlPlaneCa()
 			dataplane := envoy.New(envoy.Opts{
 				Catalog:   catalog,
 				Config:    cfg,
-				Generator: bootstrapGenerator,
+				Generator: controlPlaneCa.LearnFrom(bootstrapGenerator),
 				Stdout:    cmd.OutOrStdout(),
 				Stderr:    cmd.OutOrStderr(),
 			})
-			server := accesslogs.NewAccessLogServer(cfg.Dataplane)
+			server := accesslogs.NewAccessLogServer(cfg.Dataplane, cfg.AccessLogs)
+			metricsMerger := metrics.NewMetricsMerger(cfg.Dataplane, server)
+			faultInjectionServer := faults.NewFaultInjectionServer(cfg.Dataplane)
+
+			components := []component.Component{server, metricsMerger, faultInjectionServer, dataplane}
+			if catalog.Apis.EnvoyAdmin.Enabled() && !cfg.Dataplane.AdminPort.Empty() {
+				components = append(components, admin.NewEnvoyAdminStream(catalog.Apis.EnvoyAdmin.Url, controlPlaneCa, cfg))
+			} else {
+				runLog.Info("Envoy Admin API will not be accessible through the Control Plane")
+			}
 
 			componentMgr := component.NewManager()
-			if err := componentMgr.Add(server, dataplane); err != nil {
+			if err := componentMgr.Add(components...); err != nil {
 				return err
 			}
 
@@ -0,0 +1,182 @@
+package accesslogs
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"regexp"
+	"sync"
+
+	"github.com/pkg/errors"
+)
+
+// recordBuffer is a bounded FIFO queue of log entries.
+//
+// Once the in-memory part of the buffer is full, log entries are spilled to disk (if enabled).
+// To preserve the order of log entries, new entries keep going to disk until the spill file is drained.
+// Once there is no more room, either the newest or the oldest in-memory entry is dropped.
+type recordBuffer struct {
+	mutex sync.Mutex
+	cond  *sync.Cond
+
+	records    []string
+	size       int
+	dropOldest bool
+	spill      *spillFile
+	closed     bool
+}
+
+func newRecordBuffer(size int, dropOldest bool, spill *spillFile) *recordBuffer {
+	b := &recordBuffer{
+		size:       size,
+		dropOldest: dropOldest,
+		spill:      spill,
+	}
+	b.cond = sync.NewCond(&b.mutex)
+	return b
+}
+
+// push adds a log entry to the buffer and returns whether any entry had to be dropped.
+func (b *recordBuffer) push(record string) (dropped bool, err error) {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+	defer b.cond.Signal()
+
+	if b.closed {
+		return true, nil
+	}
+	if len(b.records) < b.size && (b.spill == nil || b.spill.empty()) {
+		b.records = append(b.records, record)
+		return false, nil
+	}
+	if b.spill != nil {
+		spilled, err := b.spill.push(record)
+		if err != nil || spilled {
+			return !spilled, err
+		}
+	}
+	if b.dropOldest && len(b.records) > 0 && (b.spill == nil || b.spill.empty()) {
+		b.records = append(b.records[1:], record)
+	}
+	return true, nil
+}
+
+// pop blocks until there is a log entry in the buffer or the buffer is closed and empty.
+// If the spill file cannot be read, all entries in it are lost and their number is returned.
+func (b *recordBuffer) pop() (record string, ok bool, lost int, err error) {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	for len(b.records) == 0 && (b.spill == nil || b.spill.empty()) && !b.closed {
+		b.cond.Wait()
+	}
+	if len(b.records) > 0 {
+		record := b.records[0]
+		b.records = b.records[1:]
+		return record, true, 0, nil
+	}
+	if b.spill != nil && !b.spill.empty() {
+		record, err := b.spill.pop()
+		if err != nil {
+			return "", true, b.spill.reset(), err
+		}
+		return record, true, 0, nil
+	}
+	return "", false, 0, nil
+}
+
+// len returns the number of buffered log entries.
+func (b *recordBuffer) len() int {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+	n := len(b.records)
+	if b.spill != nil {
+		n += b.spill.count
+	}
+	return n
+}
+
+// close makes buffer reject new log entries. Buffered log entries can still be popped.
+func (b *recordBuffer) close() {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+	b.closed = true
+	b.cond.Broadcast()
+}
+
+// spillFile is a FIFO queue of log entries stored in a file.
+// Every entry is prefixed with its length. The file is truncated once all entries are read.
+type spillFile struct {
+	file        *os.File
+	maxSize     int64
+	readOffset  int64
+	writeOffset int64
+	count       int
+}
+
+// spillFileNameRegexp matches characters that cannot be a part of a name of a spill file.
+var spillFileNameRegexp = regexp.MustCompile(`[^a-zA-Z0-9_.-]`)
+
+func newSpillFile(dir string, backend string, maxSize int64) (*spillFile, error) {
+	file, err := ioutil.TempFile(dir, fmt.Sprintf("kuma-access-logs-%s-*.spill", spillFileNameRegexp.ReplaceAllString(backend, "_")))
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to create a spill file in %q", dir)
+	}
+	return &spillFile{
+		file:    file,
+		maxSize: maxSize,
+	}, nil
+}
+
+func (s *spillFile) empty() bool {
+	return s.count == 0
+}
+
+// push writes a log entry to the file and returns false if the file is full.
+func (s *spillFile) push(record string) (bool, error) {
+	entry := make([]byte, 4+len(record))
+	binary.BigEndian.PutUint32(entry, uint32(len(record)))
+	copy(entry[4:], record)
+	if s.writeOffset+int64(len(entry)) > s.maxSize {
+		return false, nil
+	}
+	if _, err := s.file.WriteAt(entry, s.writeOffset); err != nil {
+		return false, errors.Wrapf(err, "failed to write a log entry to a spill file %q", s.file.Name())
+	}
+	s.writeOffset += int64(len(entry))
+	s.count++
+	return true, nil
+}
+
+func (s *spillFile) pop() (string, error) {
+	header := make([]byte, 4)
+	if _, err := s.file.ReadAt(header, s.readOffset); err != nil {
+		return "", errors.Wrapf(err, "failed to read a log entry from a spill file %q", s.file.Name())
+	}
+	record := make([]byte, binary.BigEndian.Uint32(header))
+	if _, err := s.file.ReadAt(record, s.readOffset+4); err != nil {
+		return "", errors.Wrapf(err, "failed to read a log entry from a spill file %q", s.file.Name())
+	}
+	s.readOffset += int64(4 + len(record))
+	s.count--
+	if s.count == 0 {
+		s.reset()
+	}
+	return string(record), nil
+}
+
+// reset discards all entries and returns their number.
+func (s *spillFile) reset() int {
+	count := s.count
+	s.readOffset, s.writeOffset, s.count = 0, 0, 0
+	_ = s.file.Truncate(0) // if truncation fails, the file is simply overwritten
+	return count
+}
+
+func (s *spillFile) Close() error {
+	if err := s.file.Close(); err != nil {
+		return err
+	}
+	return os.Remove(s.file.Name())
+}
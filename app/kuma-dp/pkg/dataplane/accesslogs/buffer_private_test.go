@@ -0,0 +1,123 @@
+package accesslogs
+
+import (
+	"io/ioutil"
+	"os"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("recordBuffer", func() {
+
+	popAll := func(buffer *recordBuffer) []string {
+		buffer.close()
+		var records []string
+		for {
+			record, ok, _, err := buffer.pop()
+			Expect(err).ToNot(HaveOccurred())
+			if !ok {
+				return records
+			}
+			records = append(records, record)
+		}
+	}
+
+	It("should drop newest entries when full", func() {
+		// given
+		buffer := newRecordBuffer(2, false, nil)
+
+		// when
+		for _, record := range []string{"1", "2", "3"} {
+			_, err := buffer.push(record)
+			Expect(err).ToNot(HaveOccurred())
+		}
+
+		// then
+		Expect(popAll(buffer)).To(Equal([]string{"1", "2"}))
+	})
+
+	It("should drop oldest entries when full", func() {
+		// given
+		buffer := newRecordBuffer(2, true, nil)
+
+		// when
+		var dropped []bool
+		for _, record := range []string{"1", "2", "3"} {
+			d, err := buffer.push(record)
+			Expect(err).ToNot(HaveOccurred())
+			dropped = append(dropped, d)
+		}
+
+		// then
+		Expect(dropped).To(Equal([]bool{false, false, true}))
+		// and
+		Expect(popAll(buffer)).To(Equal([]string{"2", "3"}))
+	})
+
+	Context("with spill file", func() {
+
+		var dir string
+
+		BeforeEach(func() {
+			var err error
+			dir, err = ioutil.TempDir("", "kuma-dp-spill-")
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		AfterEach(func() {
+			Expect(os.RemoveAll(dir)).To(Succeed())
+		})
+
+		It("should spill entries to disk preserving their order", func() {
+			// given
+			spill, err := newSpillFile(dir, "logstash", 1024)
+			Expect(err).ToNot(HaveOccurred())
+			defer spill.Close()
+			// and
+			buffer := newRecordBuffer(2, false, spill)
+
+			// when
+			for _, record := range []string{"1", "2", "3\nmultiline", "4"} {
+				dropped, err := buffer.push(record)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(dropped).To(BeFalse())
+			}
+			// then
+			Expect(buffer.len()).To(Equal(4))
+
+			// when
+			record, _, _, err := buffer.pop()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(record).To(Equal("1"))
+			// and
+			_, err = buffer.push("5")
+			Expect(err).ToNot(HaveOccurred())
+
+			// then
+			Expect(popAll(buffer)).To(Equal([]string{"2", "3\nmultiline", "4", "5"}))
+		})
+
+		It("should drop new entries when spill file is full", func() {
+			// given
+			spill, err := newSpillFile(dir, "logstash", 10)
+			Expect(err).ToNot(HaveOccurred())
+			defer spill.Close()
+			// and
+			buffer := newRecordBuffer(1, true, spill)
+
+			// when
+			var dropped []bool
+			for _, record := range []string{"1", "2", "3", "4"} {
+				d, err := buffer.push(record)
+				Expect(err).ToNot(HaveOccurred())
+				dropped = append(dropped, d)
+			}
+
+			// then
+			Expect(dropped).To(Equal([]bool{false, false, false, true}))
+			// and
+			Expect(popAll(buffer)).To(Equal([]string{"1", "2", "3"}))
+		})
+	})
+})
@@ -0,0 +1,140 @@
+package accesslogs
+
+import (
+	"sync/atomic"
+	"time"
+
+	"github.com/go-logr/logr"
+)
+
+const (
+	defaultDrainTimeout = 5 * time.Second
+)
+
+// bufferedSender decouples Access Logs streams from logging backends.
+//
+// Log entries are put into a bounded buffer and delivered by a background goroutine,
+// so that a failure of a logging backend never terminates an Access Logs stream.
+// Once a log entry cannot be sent, the underlying sender is reconnected with
+// an exponential backoff and the log entry is retried.
+type bufferedSender struct {
+	log            logr.Logger
+	sender         logSender
+	buffer         *recordBuffer
+	counters       *deliveryCounters
+	initialBackoff time.Duration
+	maxBackoff     time.Duration
+	drainTimeout   time.Duration
+
+	dropping int32 // Send() is called concurrently by all Access Logs streams of a backend
+	stop     chan struct{}
+	done     chan struct{}
+}
+
+func (s *bufferedSender) Connect() error {
+	if async, ok := s.sender.(asyncSender); ok {
+		async.reportTo(s.counters)
+	}
+	s.stop = make(chan struct{})
+	s.done = make(chan struct{})
+	go s.deliver()
+	return nil
+}
+
+func (s *bufferedSender) Send(record string) error {
+	dropped, err := s.buffer.push(record)
+	if err != nil {
+		s.log.Error(err, "failed to buffer a log entry")
+	}
+	var dropping int32
+	if dropped {
+		dropping = 1
+		s.counters.addDropped(1)
+	}
+	if atomic.SwapInt32(&s.dropping, dropping) == 0 && dropped {
+		s.log.Info("buffer of a logging backend is full, log entries are being dropped")
+	}
+	return nil
+}
+
+func (s *bufferedSender) deliver() {
+	defer close(s.done)
+	connected := false
+	defer func() {
+		if connected {
+			if err := s.sender.Close(); err != nil {
+				s.log.Error(err, "failed to close connection to a logging backend")
+			}
+		}
+		// log entries accepted by an asynchronous sender are lost once it is closed
+		if async, ok := s.sender.(asyncSender); ok {
+			s.counters.addDropped(uint64(async.pending()))
+		}
+	}()
+	_, async := s.sender.(asyncSender)
+	backoff := s.initialBackoff
+	for {
+		record, ok, lost, err := s.buffer.pop()
+		if err != nil {
+			s.log.Error(err, "failed to read buffered log entries", "lost", lost)
+			s.counters.addDropped(uint64(lost))
+			continue
+		}
+		if !ok {
+			break
+		}
+		for {
+			if !connected {
+				if err := s.sender.Connect(); err != nil {
+					s.log.Error(err, "failed to connect to a logging backend", "backoff", backoff)
+				} else {
+					connected = true
+				}
+			}
+			if connected {
+				err := s.sender.Send(record)
+				if err == nil {
+					if !async { // asynchronous senders report delivered log entries themselves
+						s.counters.addDelivered(1)
+					}
+					backoff = s.initialBackoff
+					break
+				}
+				s.log.Error(err, "failed to send a log entry, reconnecting", "backoff", backoff)
+				if err := s.sender.Close(); err != nil {
+					s.log.Error(err, "failed to close connection to a logging backend")
+				}
+				connected = false
+			}
+			s.counters.addRetried(1)
+			select {
+			case <-time.After(backoff):
+			case <-s.stop:
+				s.counters.addDropped(uint64(1 + s.buffer.len()))
+				return
+			}
+			backoff *= 2
+			if backoff > s.maxBackoff {
+				backoff = s.maxBackoff
+			}
+		}
+	}
+}
+
+// Close waits for buffered log entries to be delivered. Log entries that are
+// not delivered within a drain timeout are dropped.
+func (s *bufferedSender) Close() error {
+	s.buffer.close()
+	select {
+	case <-s.done:
+	case <-time.After(s.drainTimeout):
+		close(s.stop)
+		<-s.done
+	}
+	stats := s.counters.snapshot()
+	s.log.Info("access log delivery stats", "delivered", stats.Delivered, "dropped", stats.Dropped, "retried", stats.Retried)
+	if s.buffer.spill != nil {
+		return s.buffer.spill.Close()
+	}
+	return nil
+}
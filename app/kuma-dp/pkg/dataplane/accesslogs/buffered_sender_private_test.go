@@ -0,0 +1,146 @@
+package accesslogs
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	mesh_proto "github.com/Kong/kuma/api/mesh/v1alpha1"
+	"github.com/Kong/kuma/pkg/core"
+)
+
+// flakySender fails a given number of connection and send attempts.
+type flakySender struct {
+	sync.Mutex
+	connectFailures int
+	sendFailures    int
+	records         []string
+}
+
+func (s *flakySender) Connect() error {
+	s.Lock()
+	defer s.Unlock()
+	if s.connectFailures > 0 {
+		s.connectFailures--
+		return errors.New("connection refused")
+	}
+	return nil
+}
+
+func (s *flakySender) Send(record string) error {
+	s.Lock()
+	defer s.Unlock()
+	if s.sendFailures > 0 {
+		s.sendFailures--
+		return errors.New("broken pipe")
+	}
+	s.records = append(s.records, record)
+	return nil
+}
+
+func (s *flakySender) Close() error {
+	return nil
+}
+
+func (s *flakySender) Records() []string {
+	s.Lock()
+	defer s.Unlock()
+	return append([]string(nil), s.records...)
+}
+
+var _ = Describe("bufferedSender", func() {
+
+	newBufferedSender := func(sender logSender, size int, drainTimeout time.Duration) *bufferedSender {
+		return &bufferedSender{
+			log:            core.Log,
+			sender:         sender,
+			buffer:         newRecordBuffer(size, false, nil),
+			counters:       &deliveryCounters{},
+			initialBackoff: time.Millisecond,
+			maxBackoff:     10 * time.Millisecond,
+			drainTimeout:   drainTimeout,
+		}
+	}
+
+	It("should reconnect and retry log entries", func() {
+		// given
+		backend := &flakySender{connectFailures: 2, sendFailures: 1}
+		sender := newBufferedSender(backend, 10, time.Second)
+
+		// when
+		Expect(sender.Connect()).To(Succeed())
+		// and
+		for _, record := range []string{"1", "2", "3"} {
+			Expect(sender.Send(record)).To(Succeed())
+		}
+		// and
+		Expect(sender.Close()).To(Succeed())
+
+		// then
+		Expect(backend.Records()).To(Equal([]string{"1", "2", "3"}))
+		// and
+		Expect(sender.counters.snapshot()).To(Equal(DeliveryStats{
+			Delivered: 3,
+			Dropped:   0,
+			Retried:   3,
+		}))
+	})
+
+	It("should drop log entries that cannot be delivered", func() {
+		// given
+		backend := &flakySender{connectFailures: 1000000}
+		sender := newBufferedSender(backend, 2, 50*time.Millisecond)
+
+		// when
+		Expect(sender.Connect()).To(Succeed())
+		// and
+		for _, record := range []string{"1", "2", "3", "4"} {
+			Expect(sender.Send(record)).To(Succeed())
+		}
+		// and
+		Expect(sender.Close()).To(Succeed())
+
+		// then
+		Expect(backend.Records()).To(BeEmpty())
+		// and
+		stats := sender.counters.snapshot()
+		Expect(stats.Delivered).To(Equal(uint64(0)))
+		Expect(stats.Dropped).To(Equal(uint64(4)))
+		Expect(stats.Retried).To(BeNumerically(">", 0))
+	})
+
+	It("should count log entries accepted by an asynchronous sender as dropped unless they are delivered", func() {
+		// given
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}))
+		defer server.Close()
+		backend, err := newHttpSender(core.Log, &mesh_proto.LoggingBackend_Http{
+			Url: server.URL,
+		})
+		Expect(err).ToNot(HaveOccurred())
+		sender := newBufferedSender(backend, 10, time.Second)
+
+		// when
+		Expect(sender.Connect()).To(Succeed())
+		// and
+		for _, record := range []string{"1", "2", "3"} {
+			Expect(sender.Send(record)).To(Succeed())
+		}
+		// and
+		Expect(sender.Close()).To(Succeed())
+
+		// then
+		Expect(sender.counters.snapshot()).To(Equal(DeliveryStats{
+			Delivered: 0,
+			Dropped:   3,
+			Retried:   0,
+		}))
+	})
+})
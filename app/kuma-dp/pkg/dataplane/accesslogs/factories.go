@@ -9,26 +9,41 @@ import (
 
 	envoy_accesslog "github.com/envoyproxy/go-control-plane/envoy/service/accesslog/v2"
 
+	mesh_proto "github.com/Kong/kuma/api/mesh/v1alpha1"
+	kumadp "github.com/Kong/kuma/pkg/config/app/kuma-dp"
 	"github.com/Kong/kuma/pkg/envoy/accesslog"
+	util_proto "github.com/Kong/kuma/pkg/util/proto"
 )
 
-func defaultHandler(log logr.Logger, msg *envoy_accesslog.StreamAccessLogsMessage) (logHandler, error) {
+// newDefaultHandlerFactory returns a factory of handlers that deliver log entries
+// through a buffer configured according to a given config.
+func newDefaultHandlerFactory(config kumadp.AccessLogs, stats *deliveryStatsRegistry) logHandlerFactoryFunc {
+	senders := newSenderRegistry(config, stats)
+	return func(log logr.Logger, msg *envoy_accesslog.StreamAccessLogsMessage) (logHandler, error) {
+		return defaultHandler(log, msg, senders)
+	}
+}
+
+func defaultHandler(log logr.Logger, msg *envoy_accesslog.StreamAccessLogsMessage, senders *senderRegistry) (logHandler, error) {
 	parts := strings.SplitN(msg.GetIdentifier().GetLogName(), ";", 2)
 	if len(parts) != 2 {
 		return nil, errors.Errorf("log name %q has invalid format: expected %d components separated by ';', got %d", msg.GetIdentifier().GetLogName(), 2, len(parts))
 	}
-	address, formatString := parts[0], parts[1]
+	backendSpec, formatString := parts[0], parts[1]
 
-	format, err := accesslog.ParseFormat(formatString)
+	backend, err := parseBackendSpec(backendSpec)
 	if err != nil {
 		return nil, err
 	}
 
-	sender, err := defaultSender(log, address)
+	format, err := parseFormat(backend, formatString)
 	if err != nil {
 		return nil, err
 	}
-	if err := sender.Connect(); err != nil {
+	log.Info("sending access logs to a logging backend", "backend", backend.Name)
+
+	sender, err := senders.acquire(backend)
+	if err != nil {
 		return nil, err
 	}
 
@@ -38,9 +53,66 @@ func defaultHandler(log logr.Logger, msg *envoy_accesslog.StreamAccessLogsMessag
 	}, nil
 }
 
-func defaultSender(log logr.Logger, address string) (logSender, error) {
-	return &sender{
-		log:     log,
-		address: address,
+// parseBackendSpec parses a backend spec, which is either an address of
+// a TCP logging backend or a JSON representation of LoggingBackend.
+func parseBackendSpec(backendSpec string) (*mesh_proto.LoggingBackend, error) {
+	if !strings.HasPrefix(backendSpec, "{") {
+		return &mesh_proto.LoggingBackend{
+			Name: backendSpec,
+			Type: &mesh_proto.LoggingBackend_Tcp_{
+				Tcp: &mesh_proto.LoggingBackend_Tcp{
+					Address: backendSpec,
+				},
+			},
+		}, nil
+	}
+	backend := &mesh_proto.LoggingBackend{}
+	if err := util_proto.FromJSON([]byte(backendSpec), backend); err != nil {
+		return nil, errors.Wrapf(err, "logging backend %q has invalid format", backendSpec)
+	}
+	return backend, nil
+}
+
+// parseFormat parses either a JSON format passed as a part of a backend spec or a plain format string.
+func parseFormat(backend *mesh_proto.LoggingBackend, formatString string) (accesslog.LogEntryFormatter, error) {
+	if len(backend.JsonFormat) > 0 {
+		return accesslog.ParseJsonFormat(backend.JsonFormat)
+	}
+	return accesslog.ParseFormat(formatString)
+}
+
+func defaultSender(log logr.Logger, backend *mesh_proto.LoggingBackend) (logSender, error) {
+	switch backendType := backend.GetType().(type) {
+	case *mesh_proto.LoggingBackend_Tcp_:
+		return &sender{
+			log:          log,
+			address:      backendType.Tcp.Address,
+			writeTimeout: defaultWriteTimeout,
+		}, nil
+	case *mesh_proto.LoggingBackend_Syslog_:
+		return newSyslogSender(log, backendType.Syslog)
+	case *mesh_proto.LoggingBackend_Http_:
+		return newHttpSender(log, backendType.Http)
+	default:
+		return nil, errors.Errorf("logging backend %q is not supported by kuma-dp", backend.Name)
+	}
+}
+
+func bufferSender(log logr.Logger, backendName string, sender logSender, config kumadp.AccessLogs, counters *deliveryCounters) (logSender, error) {
+	var spill *spillFile
+	if config.SpillDir != "" {
+		var err error
+		if spill, err = newSpillFile(config.SpillDir, backendName, config.MaxSpillSize); err != nil {
+			return nil, err
+		}
+	}
+	return &bufferedSender{
+		log:            log,
+		sender:         sender,
+		buffer:         newRecordBuffer(config.BufferSize, config.DropPolicy == kumadp.DropOldest, spill),
+		counters:       counters,
+		initialBackoff: config.InitialBackoff,
+		maxBackoff:     config.MaxBackoff,
+		drainTimeout:   defaultDrainTimeout,
 	}, nil
 }
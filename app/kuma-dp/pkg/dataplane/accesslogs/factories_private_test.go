@@ -6,6 +6,10 @@ import (
 	. "github.com/onsi/gomega"
 
 	envoy_accesslog "github.com/envoyproxy/go-control-plane/envoy/service/accesslog/v2"
+
+	mesh_proto "github.com/Kong/kuma/api/mesh/v1alpha1"
+	kumadp "github.com/Kong/kuma/pkg/config/app/kuma-dp"
+	"github.com/Kong/kuma/pkg/core"
 )
 
 var _ = Describe("defaultHandler", func() {
@@ -18,7 +22,7 @@ var _ = Describe("defaultHandler", func() {
 		DescribeTable("should fail if configuration is not valid",
 			func(given testCase) {
 				// when
-				_, err := defaultHandler(nil, given.msg)
+				_, err := defaultHandler(core.Log, given.msg, newSenderRegistry(kumadp.DefaultConfig().AccessLogs, newDeliveryStatsRegistry()))
 				// then
 				Expect(err).To(HaveOccurred())
 				// and
@@ -35,6 +39,114 @@ var _ = Describe("defaultHandler", func() {
 				},
 				expectedErr: `format string is not valid: expected a command operator to start at position 1, instead got: "%bytes_sent%"`,
 			}),
+			Entry("invalid JSON access log format", testCase{
+				msg: &envoy_accesslog.StreamAccessLogsMessage{
+					Identifier: &envoy_accesslog.StreamAccessLogsMessage_Identifier{
+						LogName: `{"name":"tcp","jsonFormat":{"bytes":"%bytes_sent%"},"tcp":{"address":"127.0.0.1:1234"}};`,
+					},
+				},
+				expectedErr: `JSON format of field "bytes" is not valid: format string is not valid: expected a command operator to start at position 1, instead got: "%bytes_sent%"`,
+			}),
+			Entry("logging backend not supported by kuma-dp", testCase{
+				msg: &envoy_accesslog.StreamAccessLogsMessage{
+					Identifier: &envoy_accesslog.StreamAccessLogsMessage_Identifier{
+						LogName: `{"name":"file","file":{"path":"/tmp/log"}};%START_TIME%`,
+					},
+				},
+				expectedErr: `logging backend "file" is not supported by kuma-dp`,
+			}),
 		)
 	})
 })
+
+var _ = Describe("parseBackendSpec", func() {
+
+	It("should parse an address of a TCP logging backend", func() {
+		// when
+		backend, err := parseBackendSpec("127.0.0.1:1234")
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(backend.Name).To(Equal("127.0.0.1:1234"))
+		Expect(backend.GetTcp().GetAddress()).To(Equal("127.0.0.1:1234"))
+	})
+
+	It("should parse a JSON representation of a logging backend", func() {
+		// when
+		backend, err := parseBackendSpec(`{"name":"syslog","syslog":{"address":"127.0.0.1:514","appName":"web\u003bapi"}}`)
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(backend.Name).To(Equal("syslog"))
+		Expect(backend.GetSyslog().GetAppName()).To(Equal("web;api"))
+	})
+})
+
+var _ = Describe("parseFormat", func() {
+
+	It("should parse a plain format string", func() {
+		// when
+		format, err := parseFormat(&mesh_proto.LoggingBackend{}, "%BYTES_SENT%")
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(format.String()).To(Equal("%BYTES_SENT%"))
+	})
+
+	It("should prefer a JSON format of a logging backend", func() {
+		// when
+		format, err := parseFormat(&mesh_proto.LoggingBackend{
+			JsonFormat: map[string]string{
+				"bytes_sent": "%BYTES_SENT%",
+			},
+		}, "")
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(format.String()).To(Equal(`{"bytes_sent":"%BYTES_SENT%"}`))
+	})
+})
+
+var _ = Describe("defaultSender", func() {
+
+	It("should create a TCP sender", func() {
+		// when
+		actual, err := defaultSender(core.Log, &mesh_proto.LoggingBackend{
+			Type: &mesh_proto.LoggingBackend_Tcp_{
+				Tcp: &mesh_proto.LoggingBackend_Tcp{Address: "127.0.0.1:1234"},
+			},
+		})
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(actual).To(BeAssignableToTypeOf(&sender{}))
+	})
+
+	It("should create a syslog sender", func() {
+		// when
+		actual, err := defaultSender(core.Log, &mesh_proto.LoggingBackend{
+			Type: &mesh_proto.LoggingBackend_Syslog_{
+				Syslog: &mesh_proto.LoggingBackend_Syslog{Address: "127.0.0.1:514"},
+			},
+		})
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(actual).To(BeAssignableToTypeOf(&syslogSender{}))
+	})
+
+	It("should create an HTTP sender", func() {
+		// when
+		actual, err := defaultSender(core.Log, &mesh_proto.LoggingBackend{
+			Type: &mesh_proto.LoggingBackend_Http_{
+				Http: &mesh_proto.LoggingBackend_Http{Url: "http://logs.local/ingest", MaxBatchSize: 10},
+			},
+		})
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(actual).To(BeAssignableToTypeOf(&httpSender{}))
+		// and
+		Expect(actual.(*httpSender).maxBatchSize).To(Equal(10))
+	})
+})
@@ -9,7 +9,7 @@ import (
 )
 
 type handler struct {
-	format *accesslog.AccessLogFormat
+	format accesslog.LogEntryFormatter
 	sender logSender
 }
 
@@ -0,0 +1,156 @@
+package accesslogs
+
+import (
+	"bytes"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/pkg/errors"
+
+	mesh_proto "github.com/Kong/kuma/api/mesh/v1alpha1"
+)
+
+const (
+	defaultHttpMaxBatchSize  = 100
+	defaultHttpFlushInterval = 5 * time.Second
+	defaultHttpTimeout       = 10 * time.Second
+)
+
+// httpSender buffers log entries and sends them in batches as newline-delimited
+// bodies of POST requests. A batch is sent either when it reaches the max size
+// or when the flush interval elapses, whichever comes first.
+// Log entries are reported as delivered only once a backend has accepted a batch.
+type httpSender struct {
+	log           logr.Logger
+	url           string
+	maxBatchSize  int
+	flushInterval time.Duration
+	client        *http.Client
+
+	sync.Mutex
+	batch    []string
+	counters *deliveryCounters
+	stop     chan struct{}
+	done     chan struct{}
+}
+
+var _ asyncSender = &httpSender{}
+
+func newHttpSender(log logr.Logger, httpBackend *mesh_proto.LoggingBackend_Http) (*httpSender, error) {
+	maxBatchSize := int(httpBackend.MaxBatchSize)
+	if maxBatchSize == 0 {
+		maxBatchSize = defaultHttpMaxBatchSize
+	}
+	flushInterval := defaultHttpFlushInterval
+	if httpBackend.FlushInterval != nil {
+		interval, err := ptypes.Duration(httpBackend.FlushInterval)
+		if err != nil {
+			return nil, errors.Wrap(err, "invalid flush interval of an HTTP logging backend")
+		}
+		flushInterval = interval
+	}
+	return &httpSender{
+		log:           log,
+		url:           httpBackend.Url,
+		maxBatchSize:  maxBatchSize,
+		flushInterval: flushInterval,
+		client:        &http.Client{Timeout: defaultHttpTimeout},
+	}, nil
+}
+
+func (s *httpSender) Connect() error {
+	s.stop = make(chan struct{})
+	s.done = make(chan struct{})
+	go s.flushPeriodically(s.stop, s.done)
+	s.log.Info("sending access logs to HTTP logging backend", "url", s.url, "maxBatchSize", s.maxBatchSize, "flushInterval", s.flushInterval)
+	return nil
+}
+
+func (s *httpSender) flushPeriodically(stop <-chan struct{}, done chan<- struct{}) {
+	defer close(done)
+	ticker := time.NewTicker(s.flushInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			if err := s.flush(); err != nil {
+				s.log.Error(err, "failed to flush access logs")
+			}
+		case <-stop:
+			return
+		}
+	}
+}
+
+// Send adds a log entry to a batch. If the batch is full and cannot be sent,
+// the log entry is taken out of the batch so that it can be retried by a caller.
+func (s *httpSender) Send(record string) error {
+	s.Lock()
+	defer s.Unlock()
+	s.batch = append(s.batch, strings.TrimRight(record, "\n"))
+	if len(s.batch) < s.maxBatchSize {
+		return nil
+	}
+	if err := s.flushLocked(); err != nil {
+		s.batch = s.batch[:len(s.batch)-1]
+		return err
+	}
+	return nil
+}
+
+func (s *httpSender) flush() error {
+	s.Lock()
+	defer s.Unlock()
+	return s.flushLocked()
+}
+
+// flushLocked sends the batch. If the batch cannot be sent, it is kept to be retried later.
+func (s *httpSender) flushLocked() error {
+	if len(s.batch) == 0 {
+		return nil
+	}
+	body := strings.Join(s.batch, "\n") + "\n"
+	resp, err := s.client.Post(s.url, "text/plain", bytes.NewBufferString(body))
+	if err != nil {
+		return errors.Wrapf(err, "failed to send %d log entries to an HTTP logging backend: %s", len(s.batch), s.url)
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(ioutil.Discard, resp.Body)
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return errors.Errorf("failed to send %d log entries to an HTTP logging backend: %s: unexpected status code %d", len(s.batch), s.url, resp.StatusCode)
+	}
+	if s.counters != nil {
+		s.counters.addDelivered(uint64(len(s.batch)))
+	}
+	s.batch = nil
+	return nil
+}
+
+func (s *httpSender) reportTo(counters *deliveryCounters) {
+	s.Lock()
+	defer s.Unlock()
+	s.counters = counters
+}
+
+func (s *httpSender) pending() int {
+	s.Lock()
+	defer s.Unlock()
+	return len(s.batch)
+}
+
+// Close stops periodic flushing and makes the last attempt to send the batch.
+// A sender can be connected again after it has been closed.
+func (s *httpSender) Close() error {
+	if s.stop != nil {
+		close(s.stop)
+		<-s.done
+		s.stop, s.done = nil, nil
+	}
+	return s.flush()
+}
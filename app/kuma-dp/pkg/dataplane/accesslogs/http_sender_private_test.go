@@ -0,0 +1,159 @@
+package accesslogs
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/golang/protobuf/ptypes/duration"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	mesh_proto "github.com/Kong/kuma/api/mesh/v1alpha1"
+	"github.com/Kong/kuma/pkg/core"
+)
+
+var _ = Describe("httpSender", func() {
+
+	var server *httptest.Server
+	var bodies chan string
+	var statusCode int
+
+	BeforeEach(func() {
+		bodies = make(chan string, 10)
+		statusCode = http.StatusOK
+		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+			Expect(req.Method).To(Equal(http.MethodPost))
+			body, err := ioutil.ReadAll(req.Body)
+			Expect(err).ToNot(HaveOccurred())
+			bodies <- string(body)
+			w.WriteHeader(statusCode)
+		}))
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	It("should send a batch once it reaches max size", func() {
+		// given
+		sender, err := newHttpSender(core.Log, &mesh_proto.LoggingBackend_Http{
+			Url:           server.URL,
+			MaxBatchSize:  2,
+			FlushInterval: &duration.Duration{Seconds: 3600},
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(sender.Connect()).To(Succeed())
+		defer sender.Close()
+
+		// when
+		Expect(sender.Send("first\n")).To(Succeed())
+		// then
+		Consistently(bodies, "100ms").ShouldNot(Receive())
+
+		// when
+		Expect(sender.Send("second\n")).To(Succeed())
+		// then
+		Eventually(bodies).Should(Receive(Equal("first\nsecond\n")))
+	})
+
+	It("should send a batch once flush interval elapses", func() {
+		// given
+		sender, err := newHttpSender(core.Log, &mesh_proto.LoggingBackend_Http{
+			Url:           server.URL,
+			FlushInterval: &duration.Duration{Nanos: 50000000},
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(sender.Connect()).To(Succeed())
+		defer sender.Close()
+
+		// when
+		Expect(sender.Send("first")).To(Succeed())
+
+		// then
+		Eventually(bodies, "5s").Should(Receive(Equal("first\n")))
+	})
+
+	It("should send remaining entries on close", func() {
+		// given
+		sender, err := newHttpSender(core.Log, &mesh_proto.LoggingBackend_Http{
+			Url: server.URL,
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(sender.Connect()).To(Succeed())
+
+		// when
+		Expect(sender.Send("first")).To(Succeed())
+		// and
+		Expect(sender.Close()).To(Succeed())
+
+		// then
+		Expect(bodies).To(Receive(Equal("first\n")))
+	})
+
+	It("should return an error when backend responds with non-2xx status code", func() {
+		// given
+		statusCode = http.StatusServiceUnavailable
+		sender, err := newHttpSender(core.Log, &mesh_proto.LoggingBackend_Http{
+			Url:          server.URL,
+			MaxBatchSize: 1,
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(sender.Connect()).To(Succeed())
+		defer sender.Close()
+
+		// when
+		err = sender.Send("first")
+
+		// then
+		Expect(err).To(MatchError(ContainSubstring("unexpected status code 503")))
+	})
+
+	It("should report log entries as delivered only once a batch has been sent", func() {
+		// given
+		counters := &deliveryCounters{}
+		sender, err := newHttpSender(core.Log, &mesh_proto.LoggingBackend_Http{
+			Url:           server.URL,
+			MaxBatchSize:  2,
+			FlushInterval: &duration.Duration{Seconds: 3600},
+		})
+		Expect(err).ToNot(HaveOccurred())
+		sender.reportTo(counters)
+		Expect(sender.Connect()).To(Succeed())
+		defer sender.Close()
+
+		// when
+		Expect(sender.Send("first")).To(Succeed())
+		// then
+		Expect(counters.snapshot().Delivered).To(Equal(uint64(0)))
+		Expect(sender.pending()).To(Equal(1))
+
+		// when
+		Expect(sender.Send("second")).To(Succeed())
+		// then
+		Expect(counters.snapshot().Delivered).To(Equal(uint64(2)))
+		Expect(sender.pending()).To(Equal(0))
+	})
+
+	It("should not report log entries as delivered when backend rejects a batch", func() {
+		// given
+		statusCode = http.StatusServiceUnavailable
+		counters := &deliveryCounters{}
+		sender, err := newHttpSender(core.Log, &mesh_proto.LoggingBackend_Http{
+			Url: server.URL,
+		})
+		Expect(err).ToNot(HaveOccurred())
+		sender.reportTo(counters)
+		Expect(sender.Connect()).To(Succeed())
+
+		// when
+		Expect(sender.Send("first")).To(Succeed())
+		// and
+		Expect(sender.Close()).ToNot(Succeed())
+
+		// then
+		Expect(counters.snapshot().Delivered).To(Equal(uint64(0)))
+		Expect(sender.pending()).To(Equal(1))
+	})
+})
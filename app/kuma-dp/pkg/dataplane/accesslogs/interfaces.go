@@ -21,5 +21,15 @@ type logSender interface {
 	io.Closer
 }
 
+// asyncSender represents a log sender that accepts log entries before they are delivered,
+// e.g. to send them in batches. Such a sender reports delivered log entries itself.
+type asyncSender interface {
+	logSender
+	// reportTo makes a sender report delivered log entries to given counters.
+	reportTo(counters *deliveryCounters)
+	// pending returns the number of accepted log entries that have not been delivered yet.
+	pending() int
+}
+
 // logHandlerFactoryFunc represents a factory of log handler implementations.
 type logHandlerFactoryFunc = func(log logr.Logger, msg *envoy_accesslog.StreamAccessLogsMessage) (logHandler, error)
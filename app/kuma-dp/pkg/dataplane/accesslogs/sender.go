@@ -11,12 +11,16 @@ import (
 
 const (
 	defaultConnectTimeout = 5 * time.Second
+	// defaultWriteTimeout bounds a single write to a logging backend,
+	// so that a stalled backend cannot block delivery (and shutdown) forever.
+	defaultWriteTimeout = 5 * time.Second
 )
 
 type sender struct {
-	log     logr.Logger
-	address string
-	conn    net.Conn
+	log          logr.Logger
+	address      string
+	writeTimeout time.Duration
+	conn         net.Conn
 }
 
 func (s *sender) Connect() error {
@@ -30,6 +34,9 @@ func (s *sender) Connect() error {
 }
 
 func (s *sender) Send(record string) error {
+	if err := s.conn.SetWriteDeadline(time.Now().Add(s.writeTimeout)); err != nil {
+		return errors.Wrapf(err, "failed to set a write deadline on a connection to a TCP logging backend: %s", s.address)
+	}
 	_, err := s.conn.Write(append([]byte(record), byte('\n')))
 	return errors.Wrapf(err, "failed to send a log entry to a TCP logging backend: %s", s.address)
 }
@@ -0,0 +1,79 @@
+package accesslogs
+
+import (
+	"bufio"
+	"net"
+	"strings"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/Kong/kuma/pkg/core"
+)
+
+var _ = Describe("sender", func() {
+
+	It("should send newline-delimited log entries over TCP", func() {
+		// given
+		l, err := net.Listen("tcp", "127.0.0.1:0")
+		Expect(err).ToNot(HaveOccurred())
+		defer l.Close()
+		received := make(chan string, 1)
+		go func() {
+			defer GinkgoRecover()
+			conn, err := l.Accept()
+			Expect(err).ToNot(HaveOccurred())
+			defer conn.Close()
+			line, err := bufio.NewReader(conn).ReadString('\n')
+			Expect(err).ToNot(HaveOccurred())
+			received <- line
+		}()
+
+		// and
+		sender := &sender{log: core.Log, address: l.Addr().String(), writeTimeout: defaultWriteTimeout}
+
+		// when
+		Expect(sender.Connect()).To(Succeed())
+		defer sender.Close()
+		// and
+		Expect(sender.Send("GET /api 200")).To(Succeed())
+
+		// then
+		Eventually(received, "5s").Should(Receive(Equal("GET /api 200\n")))
+	})
+
+	It("should give up on a backend that doesn't read log entries", func(done Done) {
+		// given
+		l, err := net.Listen("tcp", "127.0.0.1:0")
+		Expect(err).ToNot(HaveOccurred())
+		defer l.Close()
+		stop := make(chan struct{})
+		defer close(stop)
+		go func() {
+			conn, err := l.Accept()
+			if err == nil {
+				defer conn.Close()
+				<-stop
+			}
+		}()
+
+		// and
+		sender := &sender{log: core.Log, address: l.Addr().String(), writeTimeout: 100 * time.Millisecond}
+		Expect(sender.Connect()).To(Succeed())
+		defer sender.Close()
+
+		// when large entries fill up socket buffers
+		record := strings.Repeat("x", 1024*1024)
+		var sendErr error
+		for i := 0; i < 1024 && sendErr == nil; i++ {
+			sendErr = sender.Send(record)
+		}
+
+		// then
+		Expect(sendErr).To(HaveOccurred())
+		Expect(sendErr.Error()).To(ContainSubstring("i/o timeout"))
+
+		close(done)
+	}, 10)
+})
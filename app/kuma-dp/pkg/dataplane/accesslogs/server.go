@@ -8,6 +8,7 @@ import (
 
 	envoy_accesslog "github.com/envoyproxy/go-control-plane/envoy/service/accesslog/v2"
 	"github.com/pkg/errors"
+	io_prometheus_client "github.com/prometheus/client_model/go"
 	"google.golang.org/grpc"
 
 	kumadp "github.com/Kong/kuma/pkg/config/app/kuma-dp"
@@ -23,19 +24,27 @@ type accessLogServer struct {
 	server     *grpc.Server
 	address    string
 	newHandler logHandlerFactoryFunc
+	stats      *deliveryStatsRegistry
 
 	// streamCount for counting streams
 	streamCount int64
 }
 
-func NewAccessLogServer(dataplane kumadp.Dataplane) *accessLogServer {
+func NewAccessLogServer(dataplane kumadp.Dataplane, accessLogs kumadp.AccessLogs) *accessLogServer {
+	stats := newDeliveryStatsRegistry()
 	return &accessLogServer{
 		server:     grpc.NewServer(),
-		newHandler: defaultHandler,
+		newHandler: newDefaultHandlerFactory(accessLogs, stats),
+		stats:      stats,
 		address:    fmt.Sprintf("/tmp/kuma-access-logs-%s-%s.sock", dataplane.Name, dataplane.Mesh),
 	}
 }
 
+// Collect returns counters of log entries per logging backend as metrics of kuma-dp.
+func (s *accessLogServer) Collect() []*io_prometheus_client.MetricFamily {
+	return s.stats.metrics()
+}
+
 func (s *accessLogServer) StreamAccessLogs(stream envoy_accesslog.AccessLogService_StreamAccessLogsServer) (err error) {
 	// increment stream count
 	streamID := atomic.AddInt64(&s.streamCount, 1)
@@ -0,0 +1,103 @@
+package accesslogs
+
+import (
+	"sync"
+
+	"github.com/golang/protobuf/proto"
+
+	mesh_proto "github.com/Kong/kuma/api/mesh/v1alpha1"
+	kumadp "github.com/Kong/kuma/pkg/config/app/kuma-dp"
+	util_proto "github.com/Kong/kuma/pkg/util/proto"
+)
+
+// senderRegistry keeps a single buffered sender per logging backend,
+// so that all Access Logs streams of a backend share its buffer, spill file and connection.
+// A sender is closed once the last stream that uses it is terminated.
+type senderRegistry struct {
+	config kumadp.AccessLogs
+	stats  *deliveryStatsRegistry
+
+	sync.Mutex
+	senders map[string]*sharedSender
+}
+
+func newSenderRegistry(config kumadp.AccessLogs, stats *deliveryStatsRegistry) *senderRegistry {
+	return &senderRegistry{
+		config:  config,
+		stats:   stats,
+		senders: map[string]*sharedSender{},
+	}
+}
+
+// acquire returns a sender of a given logging backend. Returned sender is already connected
+// and must be closed by a caller once it is no longer used.
+func (r *senderRegistry) acquire(backend *mesh_proto.LoggingBackend) (logSender, error) {
+	key, err := senderKey(backend)
+	if err != nil {
+		return nil, err
+	}
+	r.Lock()
+	defer r.Unlock()
+	if shared, ok := r.senders[key]; ok {
+		shared.refs++
+		return shared, nil
+	}
+	log := logger.WithValues("backend", backend.Name)
+	sender, err := defaultSender(log, backend)
+	if err != nil {
+		return nil, err
+	}
+	sender, err = bufferSender(log, backend.Name, sender, r.config, r.stats.forBackend(backend.Name))
+	if err != nil {
+		return nil, err
+	}
+	if err := sender.Connect(); err != nil {
+		return nil, err
+	}
+	shared := &sharedSender{logSender: sender, registry: r, key: key, refs: 1}
+	r.senders[key] = shared
+	return shared, nil
+}
+
+func (r *senderRegistry) release(shared *sharedSender) error {
+	r.Lock()
+	shared.refs--
+	last := shared.refs == 0
+	if last {
+		delete(r.senders, shared.key)
+	}
+	r.Unlock()
+	if !last {
+		return nil
+	}
+	// draining a buffer might take a while, so it must not block other streams
+	return shared.logSender.Close()
+}
+
+// senderKey identifies a logging backend regardless of a format of log entries,
+// since log entries are formatted before they are sent.
+func senderKey(backend *mesh_proto.LoggingBackend) (string, error) {
+	backend = proto.Clone(backend).(*mesh_proto.LoggingBackend)
+	backend.JsonFormat = nil
+	key, err := util_proto.ToJSON(backend)
+	if err != nil {
+		return "", err
+	}
+	return string(key), nil
+}
+
+// sharedSender is a reference to a sender of a logging backend used by a single Access Logs stream.
+type sharedSender struct {
+	logSender
+	registry *senderRegistry
+	key      string
+	refs     int // guarded by registry
+}
+
+func (s *sharedSender) Connect() error {
+	return nil // a sender is connected once it is created
+}
+
+func (s *sharedSender) Close() error {
+	return s.registry.release(s)
+}
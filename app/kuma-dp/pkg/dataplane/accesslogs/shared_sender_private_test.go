@@ -0,0 +1,83 @@
+package accesslogs
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	mesh_proto "github.com/Kong/kuma/api/mesh/v1alpha1"
+	kumadp "github.com/Kong/kuma/pkg/config/app/kuma-dp"
+)
+
+var _ = Describe("senderRegistry", func() {
+
+	var dir string
+	var senders *senderRegistry
+
+	BeforeEach(func() {
+		var err error
+		dir, err = ioutil.TempDir("", "kuma-dp-spill-")
+		Expect(err).ToNot(HaveOccurred())
+		// and
+		config := kumadp.DefaultConfig().AccessLogs
+		config.SpillDir = dir
+		senders = newSenderRegistry(config, newDeliveryStatsRegistry())
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(dir)).To(Succeed())
+	})
+
+	tcpBackend := func(name, address string, jsonFormat map[string]string) *mesh_proto.LoggingBackend {
+		return &mesh_proto.LoggingBackend{
+			Name:       name,
+			JsonFormat: jsonFormat,
+			Type: &mesh_proto.LoggingBackend_Tcp_{
+				Tcp: &mesh_proto.LoggingBackend_Tcp{Address: address},
+			},
+		}
+	}
+
+	It("should share a sender between Access Logs streams of the same logging backend", func() {
+		// when
+		first, err := senders.acquire(tcpBackend("logstash", "127.0.0.1:1234", map[string]string{"a": "%START_TIME%"}))
+		Expect(err).ToNot(HaveOccurred())
+		second, err := senders.acquire(tcpBackend("logstash", "127.0.0.1:1234", map[string]string{"b": "%BYTES_SENT%"}))
+		Expect(err).ToNot(HaveOccurred())
+		third, err := senders.acquire(tcpBackend("other", "127.0.0.1:5678", nil))
+		Expect(err).ToNot(HaveOccurred())
+
+		// then
+		Expect(first.(*sharedSender).logSender).To(BeIdenticalTo(second.(*sharedSender).logSender))
+		Expect(first.(*sharedSender).logSender).ToNot(BeIdenticalTo(third.(*sharedSender).logSender))
+		// and
+		spills, err := filepath.Glob(filepath.Join(dir, "kuma-access-logs-*.spill"))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(spills).To(HaveLen(2))
+		Expect(filepath.Glob(filepath.Join(dir, "kuma-access-logs-logstash-*.spill"))).To(HaveLen(1))
+
+		// when
+		Expect(first.Close()).To(Succeed())
+		Expect(third.Close()).To(Succeed())
+		// then
+		Expect(senders.senders).To(HaveLen(1))
+
+		// when
+		Expect(second.Close()).To(Succeed())
+		// then
+		Expect(senders.senders).To(BeEmpty())
+	})
+
+	It("should name a spill file after a logging backend", func() {
+		// when
+		sender, err := senders.acquire(tcpBackend("logs/tcp:1234", "127.0.0.1:1234", nil))
+		Expect(err).ToNot(HaveOccurred())
+		defer sender.Close()
+
+		// then
+		Expect(filepath.Glob(filepath.Join(dir, "kuma-access-logs-logs_tcp_1234-*.spill"))).To(HaveLen(1))
+	})
+})
@@ -0,0 +1,133 @@
+package accesslogs
+
+import (
+	"sort"
+	"sync"
+	"sync/atomic"
+
+	"github.com/golang/protobuf/proto"
+	io_prometheus_client "github.com/prometheus/client_model/go"
+)
+
+const (
+	deliveredMetric = "kuma_access_logs_delivered_total"
+	droppedMetric   = "kuma_access_logs_dropped_total"
+	retriedMetric   = "kuma_access_logs_retried_total"
+	backendLabel    = "backend"
+)
+
+// DeliveryStats represents counters of log entries of a single logging backend.
+type DeliveryStats struct {
+	// Delivered is a number of log entries successfully sent to a logging backend.
+	Delivered uint64
+	// Dropped is a number of log entries lost either because a buffer was full
+	// or because they could not be delivered before kuma-dp stopped.
+	Dropped uint64
+	// Retried is a number of attempts to resend a log entry after a failure.
+	Retried uint64
+}
+
+type deliveryCounters struct {
+	delivered uint64
+	dropped   uint64
+	retried   uint64
+}
+
+func (c *deliveryCounters) addDelivered(n uint64) {
+	atomic.AddUint64(&c.delivered, n)
+}
+
+func (c *deliveryCounters) addDropped(n uint64) {
+	atomic.AddUint64(&c.dropped, n)
+}
+
+func (c *deliveryCounters) addRetried(n uint64) {
+	atomic.AddUint64(&c.retried, n)
+}
+
+func (c *deliveryCounters) snapshot() DeliveryStats {
+	return DeliveryStats{
+		Delivered: atomic.LoadUint64(&c.delivered),
+		Dropped:   atomic.LoadUint64(&c.dropped),
+		Retried:   atomic.LoadUint64(&c.retried),
+	}
+}
+
+// deliveryStatsRegistry keeps counters per logging backend.
+// Counters are shared by all Access Logs streams of a given backend.
+type deliveryStatsRegistry struct {
+	sync.Mutex
+	counters map[string]*deliveryCounters
+}
+
+func newDeliveryStatsRegistry() *deliveryStatsRegistry {
+	return &deliveryStatsRegistry{
+		counters: map[string]*deliveryCounters{},
+	}
+}
+
+func (r *deliveryStatsRegistry) forBackend(backend string) *deliveryCounters {
+	r.Lock()
+	defer r.Unlock()
+	counters, ok := r.counters[backend]
+	if !ok {
+		counters = &deliveryCounters{}
+		r.counters[backend] = counters
+	}
+	return counters
+}
+
+func (r *deliveryStatsRegistry) snapshot() map[string]DeliveryStats {
+	r.Lock()
+	defer r.Unlock()
+	stats := make(map[string]DeliveryStats, len(r.counters))
+	for backend, counters := range r.counters {
+		stats[backend] = counters.snapshot()
+	}
+	return stats
+}
+
+// metrics returns counters of all logging backends as Prometheus metric families.
+// No families are returned until log entries of any backend are received,
+// since a metric family without metrics cannot be exposed.
+func (r *deliveryStatsRegistry) metrics() []*io_prometheus_client.MetricFamily {
+	stats := r.snapshot()
+	if len(stats) == 0 {
+		return nil
+	}
+	backends := make([]string, 0, len(stats))
+	for backend := range stats {
+		backends = append(backends, backend)
+	}
+	sort.Strings(backends)
+
+	delivered := newCounterFamily(deliveredMetric, "Number of log entries sent to a logging backend.")
+	dropped := newCounterFamily(droppedMetric, "Number of log entries that could not be delivered to a logging backend.")
+	retried := newCounterFamily(retriedMetric, "Number of attempts to resend a log entry to a logging backend after a failure.")
+	for _, backend := range backends {
+		addCounter(delivered, backend, stats[backend].Delivered)
+		addCounter(dropped, backend, stats[backend].Dropped)
+		addCounter(retried, backend, stats[backend].Retried)
+	}
+	return []*io_prometheus_client.MetricFamily{delivered, dropped, retried}
+}
+
+func newCounterFamily(name, help string) *io_prometheus_client.MetricFamily {
+	return &io_prometheus_client.MetricFamily{
+		Name: proto.String(name),
+		Help: proto.String(help),
+		Type: io_prometheus_client.MetricType_COUNTER.Enum(),
+	}
+}
+
+func addCounter(family *io_prometheus_client.MetricFamily, backend string, value uint64) {
+	family.Metric = append(family.Metric, &io_prometheus_client.Metric{
+		Label: []*io_prometheus_client.LabelPair{{
+			Name:  proto.String(backendLabel),
+			Value: proto.String(backend),
+		}},
+		Counter: &io_prometheus_client.Counter{
+			Value: proto.Float64(float64(value)),
+		},
+	})
+}
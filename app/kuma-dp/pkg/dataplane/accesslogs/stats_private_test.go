@@ -0,0 +1,52 @@
+package accesslogs
+
+import (
+	"bytes"
+
+	"github.com/prometheus/common/expfmt"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("deliveryStatsRegistry", func() {
+
+	It("should not return metric families until there are counters of a logging backend", func() {
+		// when
+		families := newDeliveryStatsRegistry().metrics()
+
+		// then
+		Expect(families).To(BeEmpty())
+	})
+
+	It("should return counters per logging backend as metrics", func() {
+		// given
+		stats := newDeliveryStatsRegistry()
+		logstash := stats.forBackend("logstash")
+		logstash.addDelivered(3)
+		logstash.addRetried(1)
+		stats.forBackend("graylog").addDropped(2)
+
+		// when
+		var buf bytes.Buffer
+		for _, family := range stats.metrics() {
+			_, err := expfmt.MetricFamilyToText(&buf, family)
+			Expect(err).ToNot(HaveOccurred())
+		}
+
+		// then
+		Expect(buf.String()).To(Equal(`# HELP kuma_access_logs_delivered_total Number of log entries sent to a logging backend.
+# TYPE kuma_access_logs_delivered_total counter
+kuma_access_logs_delivered_total{backend="graylog"} 0
+kuma_access_logs_delivered_total{backend="logstash"} 3
+# HELP kuma_access_logs_dropped_total Number of log entries that could not be delivered to a logging backend.
+# TYPE kuma_access_logs_dropped_total counter
+kuma_access_logs_dropped_total{backend="graylog"} 2
+kuma_access_logs_dropped_total{backend="logstash"} 0
+# HELP kuma_access_logs_retried_total Number of attempts to resend a log entry to a logging backend after a failure.
+# TYPE kuma_access_logs_retried_total counter
+kuma_access_logs_retried_total{backend="graylog"} 0
+kuma_access_logs_retried_total{backend="logstash"} 1
+`))
+	})
+})
@@ -0,0 +1,133 @@
+package accesslogs
+
+import (
+	"fmt"
+	"net"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/pkg/errors"
+
+	mesh_proto "github.com/Kong/kuma/api/mesh/v1alpha1"
+)
+
+const (
+	defaultSyslogTransport = "udp"
+	defaultSyslogFacility  = "local0"
+	defaultSyslogAppName   = "kuma"
+
+	// access log entries are informational messages
+	syslogSeverityInfo = 6
+)
+
+var syslogFacilities = map[string]int{
+	"kern":     0,
+	"user":     1,
+	"mail":     2,
+	"daemon":   3,
+	"auth":     4,
+	"syslog":   5,
+	"lpr":      6,
+	"news":     7,
+	"uucp":     8,
+	"cron":     9,
+	"authpriv": 10,
+	"ftp":      11,
+	"local0":   16,
+	"local1":   17,
+	"local2":   18,
+	"local3":   19,
+	"local4":   20,
+	"local5":   21,
+	"local6":   22,
+	"local7":   23,
+}
+
+// syslogSender sends log entries to a syslog server in RFC5424 format.
+// Over TCP, messages are framed using octet counting (RFC6587).
+type syslogSender struct {
+	log       logr.Logger
+	address   string
+	transport string
+	priority  int
+	hostname  string
+	appName   string
+	now       func() time.Time
+	conn      net.Conn
+}
+
+func newSyslogSender(log logr.Logger, syslog *mesh_proto.LoggingBackend_Syslog) (*syslogSender, error) {
+	transport := syslog.Transport
+	if transport == "" {
+		transport = defaultSyslogTransport
+	}
+	if transport != "udp" && transport != "tcp" {
+		return nil, errors.Errorf("unsupported syslog transport %q", transport)
+	}
+	facilityName := syslog.Facility
+	if facilityName == "" {
+		facilityName = defaultSyslogFacility
+	}
+	facility, ok := syslogFacilities[facilityName]
+	if !ok {
+		return nil, errors.Errorf("unknown syslog facility %q", facilityName)
+	}
+	appName := syslog.AppName
+	if appName == "" {
+		appName = defaultSyslogAppName
+	}
+	hostname, err := os.Hostname()
+	if err != nil || hostname == "" {
+		hostname = "-"
+	}
+	return &syslogSender{
+		log:       log,
+		address:   syslog.Address,
+		transport: transport,
+		priority:  facility*8 + syslogSeverityInfo,
+		hostname:  hostname,
+		appName:   appName,
+		now:       time.Now,
+	}, nil
+}
+
+func (s *syslogSender) Connect() error {
+	conn, err := net.DialTimeout(s.transport, s.address, defaultConnectTimeout)
+	if err != nil {
+		return errors.Wrapf(err, "failed to connect to a syslog logging backend: %s://%s", s.transport, s.address)
+	}
+	s.log.Info("connected to syslog logging backend", "address", s.address, "transport", s.transport)
+	s.conn = conn
+	return nil
+}
+
+func (s *syslogSender) Send(record string) error {
+	msg := s.format(record)
+	if s.transport == "tcp" {
+		msg = fmt.Sprintf("%d %s", len(msg), msg)
+	}
+	if err := s.conn.SetWriteDeadline(time.Now().Add(defaultWriteTimeout)); err != nil {
+		return errors.Wrapf(err, "failed to set a write deadline on a connection to a syslog logging backend: %s://%s", s.transport, s.address)
+	}
+	_, err := s.conn.Write([]byte(msg))
+	return errors.Wrapf(err, "failed to send a log entry to a syslog logging backend: %s://%s", s.transport, s.address)
+}
+
+// format renders a record as `<PRI>VERSION TIMESTAMP HOSTNAME APP-NAME PROCID MSGID STRUCTURED-DATA MSG`.
+func (s *syslogSender) format(record string) string {
+	return fmt.Sprintf("<%d>1 %s %s %s - - - %s",
+		s.priority,
+		s.now().UTC().Format(time.RFC3339Nano),
+		s.hostname,
+		s.appName,
+		strings.TrimRight(record, "\n"))
+}
+
+func (s *syslogSender) Close() error {
+	if s.conn != nil {
+		return s.conn.Close()
+	}
+	return nil
+}
@@ -0,0 +1,99 @@
+package accesslogs
+
+import (
+	"bufio"
+	"io"
+	"net"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	mesh_proto "github.com/Kong/kuma/api/mesh/v1alpha1"
+	"github.com/Kong/kuma/pkg/core"
+)
+
+var _ = Describe("syslogSender", func() {
+
+	now := func() time.Time {
+		return time.Date(2020, 2, 11, 12, 34, 56, 123000000, time.UTC)
+	}
+
+	It("should send RFC5424 messages over UDP", func() {
+		// given
+		conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+		Expect(err).ToNot(HaveOccurred())
+		defer conn.Close()
+
+		// and
+		sender, err := newSyslogSender(core.Log, &mesh_proto.LoggingBackend_Syslog{
+			Address:  conn.LocalAddr().String(),
+			Facility: "local3",
+			AppName:  "web",
+		})
+		Expect(err).ToNot(HaveOccurred())
+		sender.now = now
+		sender.hostname = "dataplane-1"
+
+		// when
+		Expect(sender.Connect()).To(Succeed())
+		defer sender.Close()
+		// and
+		Expect(sender.Send("GET /api 200\n")).To(Succeed())
+
+		// then
+		buf := make([]byte, 1024)
+		Expect(conn.SetReadDeadline(time.Now().Add(5 * time.Second))).To(Succeed())
+		n, _, err := conn.ReadFrom(buf)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(buf[:n])).To(Equal("<158>1 2020-02-11T12:34:56.123Z dataplane-1 web - - - GET /api 200"))
+	})
+
+	It("should send octet-counted RFC5424 messages over TCP", func() {
+		// given
+		l, err := net.Listen("tcp", "127.0.0.1:0")
+		Expect(err).ToNot(HaveOccurred())
+		defer l.Close()
+		received := make(chan string, 1)
+		go func() {
+			defer GinkgoRecover()
+			conn, err := l.Accept()
+			Expect(err).ToNot(HaveOccurred())
+			defer conn.Close()
+			reader := bufio.NewReader(conn)
+			buf := make([]byte, 70)
+			_, err = io.ReadFull(reader, buf)
+			Expect(err).ToNot(HaveOccurred())
+			received <- string(buf)
+		}()
+
+		// and
+		sender, err := newSyslogSender(core.Log, &mesh_proto.LoggingBackend_Syslog{
+			Address:   l.Addr().String(),
+			Transport: "tcp",
+		})
+		Expect(err).ToNot(HaveOccurred())
+		sender.now = now
+		sender.hostname = "dataplane-1"
+
+		// when
+		Expect(sender.Connect()).To(Succeed())
+		defer sender.Close()
+		// and
+		Expect(sender.Send("GET /api 200")).To(Succeed())
+
+		// then
+		Eventually(received, "5s").Should(Receive(Equal("67 <134>1 2020-02-11T12:34:56.123Z dataplane-1 kuma - - - GET /api 200")))
+	})
+
+	It("should reject unknown facility", func() {
+		// when
+		_, err := newSyslogSender(core.Log, &mesh_proto.LoggingBackend_Syslog{
+			Address:  "127.0.0.1:514",
+			Facility: "local8",
+		})
+
+		// then
+		Expect(err).To(MatchError(`unknown syslog facility "local8"`))
+	})
+})
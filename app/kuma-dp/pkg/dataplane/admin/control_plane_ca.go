@@ -0,0 +1,101 @@
+package admin
+
+import (
+	"context"
+	"crypto/x509"
+	"sync"
+
+	envoy_auth "github.com/envoyproxy/go-control-plane/envoy/api/v2/auth"
+	envoy_bootstrap "github.com/envoyproxy/go-control-plane/envoy/config/bootstrap/v2"
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/pkg/errors"
+
+	"github.com/Kong/kuma/app/kuma-dp/pkg/dataplane/envoy"
+	kumadp "github.com/Kong/kuma/pkg/config/app/kuma-dp"
+)
+
+// adsClusterName is a name of the cluster Envoy uses to connect to the xDS server of the Control Plane.
+const adsClusterName = "ads_cluster"
+
+// ControlPlaneCa is a certificate that kuma-dp uses to verify the Control Plane
+// before the dataplane token is sent over an Envoy Admin stream.
+//
+// Envoy Admin streams are served by the xDS server of the Control Plane, whose certificate
+// is already pinned in the Envoy bootstrap config. That is why the certificate is learnt
+// from the bootstrap config rather than configured separately.
+type ControlPlaneCa struct {
+	once  sync.Once
+	ready chan struct{}
+	pool  *x509.CertPool
+	err   error
+}
+
+func NewControlPlaneCa() *ControlPlaneCa {
+	return &ControlPlaneCa{
+		ready: make(chan struct{}),
+	}
+}
+
+// LearnFrom wraps a given BootstrapConfigFactoryFunc to learn the certificate from the generated bootstrap config.
+func (c *ControlPlaneCa) LearnFrom(generator envoy.BootstrapConfigFactoryFunc) envoy.BootstrapConfigFactoryFunc {
+	return func(url string, cfg kumadp.Config) (proto.Message, error) {
+		bootstrap, err := generator(url, cfg)
+		if err != nil {
+			return nil, err
+		}
+		c.set(xdsServerCert(bootstrap))
+		return bootstrap, nil
+	}
+}
+
+func (c *ControlPlaneCa) set(cert []byte, err error) {
+	c.once.Do(func() {
+		defer close(c.ready)
+		if err != nil {
+			c.err = err
+			return
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(cert) {
+			c.err = errors.New("certificate of the xDS server in the bootstrap config is not a valid PEM-encoded certificate")
+			return
+		}
+		c.pool = pool
+	})
+}
+
+// CertPool blocks until the certificate is learnt from the bootstrap config.
+func (c *ControlPlaneCa) CertPool(ctx context.Context) (*x509.CertPool, error) {
+	select {
+	case <-c.ready:
+		return c.pool, c.err
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+func xdsServerCert(message proto.Message) ([]byte, error) {
+	bootstrap, ok := message.(*envoy_bootstrap.Bootstrap)
+	if !ok {
+		return nil, errors.Errorf("unsupported type of the bootstrap config: %T", message)
+	}
+	for _, cluster := range bootstrap.GetStaticResources().GetClusters() {
+		if cluster.GetName() != adsClusterName {
+			continue
+		}
+		if cluster.GetTransportSocket().GetTypedConfig() == nil {
+			return nil, errors.New("bootstrap config does not define TLS settings of the xDS server")
+		}
+		tlsContext := &envoy_auth.UpstreamTlsContext{}
+		if err := ptypes.UnmarshalAny(cluster.GetTransportSocket().GetTypedConfig(), tlsContext); err != nil {
+			return nil, errors.Wrap(err, "could not parse TLS settings of the xDS server")
+		}
+		cert := tlsContext.GetCommonTlsContext().GetValidationContext().GetTrustedCa().GetInlineBytes()
+		if len(cert) == 0 {
+			return nil, errors.New("bootstrap config does not define a certificate of the xDS server")
+		}
+		return cert, nil
+	}
+	return nil, errors.Errorf("bootstrap config does not define %q cluster", adsClusterName)
+}
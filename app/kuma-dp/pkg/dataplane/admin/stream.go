@@ -0,0 +1,217 @@
+package admin
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+
+	mesh_proto "github.com/Kong/kuma/api/mesh/v1alpha1"
+	kumadp "github.com/Kong/kuma/pkg/config/app/kuma-dp"
+	"github.com/Kong/kuma/pkg/core"
+	"github.com/Kong/kuma/pkg/core/runtime/component"
+)
+
+var logger = core.Log.WithName("envoy-admin-stream")
+
+const (
+	defaultAdminTimeout   = 10 * time.Second
+	defaultInitialBackoff = 1 * time.Second
+	defaultMaxBackoff     = 30 * time.Second
+	// Envoy config dumps of large meshes might not fit into the default limit of 4MB
+	maxSendMsgSize = 32 * 1024 * 1024
+)
+
+var adminPaths = map[mesh_proto.EnvoyAdminRequest_Resource]string{
+	mesh_proto.EnvoyAdminRequest_CONFIG_DUMP: "/config_dump",
+	mesh_proto.EnvoyAdminRequest_CLUSTERS:    "/clusters",
+	mesh_proto.EnvoyAdminRequest_STATS:       "/stats",
+}
+
+var _ component.Component = &envoyAdminStream{}
+
+// envoyAdminStream keeps a stream to the Control Plane open and answers requests
+// of the Control Plane with data from the Envoy Admin API.
+//
+// The stream is opened by kuma-dp, so the Control Plane can retrieve data from Envoy
+// without a direct network access to the Dataplane.
+// Only read-only resources of the Envoy Admin API are exposed.
+type envoyAdminStream struct {
+	serverURL      string
+	controlPlaneCa *ControlPlaneCa
+	dataplane      kumadp.Dataplane
+	tokenPath      string
+	adminAddress   string
+	client         *http.Client
+	initialBackoff time.Duration
+	maxBackoff     time.Duration
+}
+
+func NewEnvoyAdminStream(serverURL string, controlPlaneCa *ControlPlaneCa, cfg kumadp.Config) *envoyAdminStream {
+	return &envoyAdminStream{
+		serverURL:      serverURL,
+		controlPlaneCa: controlPlaneCa,
+		dataplane:      cfg.Dataplane,
+		tokenPath:      cfg.DataplaneRuntime.TokenPath,
+		adminAddress:   fmt.Sprintf("http://127.0.0.1:%d", cfg.Dataplane.AdminPort.Lowest()),
+		client:         &http.Client{Timeout: defaultAdminTimeout},
+		initialBackoff: defaultInitialBackoff,
+		maxBackoff:     defaultMaxBackoff,
+	}
+}
+
+func (s *envoyAdminStream) Start(stop <-chan struct{}) error {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() {
+		select {
+		case <-stop:
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+
+	logger.Info("starting Envoy Admin stream", "url", s.serverURL)
+	backoff := s.initialBackoff
+	for {
+		served, err := s.stream(ctx)
+		if ctx.Err() != nil {
+			logger.Info("stopping Envoy Admin stream")
+			return nil
+		}
+		if served {
+			backoff = s.initialBackoff
+		}
+		logger.Error(err, "Envoy Admin stream to the Control Plane has been closed, reconnecting", "backoff", backoff)
+		select {
+		case <-time.After(backoff):
+		case <-ctx.Done():
+			logger.Info("stopping Envoy Admin stream")
+			return nil
+		}
+		backoff *= 2
+		if backoff > s.maxBackoff {
+			backoff = s.maxBackoff
+		}
+	}
+}
+
+// stream serves a single stream until it is closed. It returns true if at least one request
+// has been received, which means that the Control Plane has accepted the stream.
+func (s *envoyAdminStream) stream(ctx context.Context) (bool, error) {
+	conn, err := s.dial(ctx)
+	if err != nil {
+		return false, err
+	}
+	defer conn.Close()
+
+	md := metadata.MD{}
+	if s.tokenPath != "" {
+		token, err := ioutil.ReadFile(s.tokenPath)
+		if err != nil {
+			return false, errors.Wrap(err, "could not read the dataplane token")
+		}
+		md.Set("authorization", string(token))
+	}
+	stream, err := mesh_proto.NewEnvoyAdminServiceClient(conn).StreamEnvoyAdmin(metadata.NewOutgoingContext(ctx, md))
+	if err != nil {
+		return false, err
+	}
+	if err := stream.Send(&mesh_proto.EnvoyAdminResponse{
+		Mesh: s.dataplane.Mesh,
+		Name: s.dataplane.Name,
+	}); err != nil {
+		return false, err
+	}
+
+	var sendLock sync.Mutex
+	served := false
+	for {
+		req, err := stream.Recv()
+		if err != nil {
+			return served, err
+		}
+		served = true
+		go func() {
+			resp := s.handle(ctx, req)
+			sendLock.Lock()
+			defer sendLock.Unlock()
+			if err := stream.Send(resp); err != nil {
+				logger.Error(err, "failed to send a response to the Control Plane", "resource", req.Resource)
+			}
+		}()
+	}
+}
+
+func (s *envoyAdminStream) dial(ctx context.Context) (*grpc.ClientConn, error) {
+	serverURL, err := url.Parse(s.serverURL)
+	if err != nil {
+		return nil, err
+	}
+	dialOpts := []grpc.DialOption{
+		grpc.WithDefaultCallOptions(grpc.MaxCallSendMsgSize(maxSendMsgSize)),
+	}
+	switch serverURL.Scheme {
+	case "grpc":
+		dialOpts = append(dialOpts, grpc.WithInsecure())
+	case "grpcs":
+		// the Control Plane has to be verified, otherwise the dataplane token could be sent to anyone
+		rootCAs, err := s.controlPlaneCa.CertPool(ctx)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not verify the Control Plane")
+		}
+		dialOpts = append(dialOpts, grpc.WithTransportCredentials(credentials.NewTLS(&tls.Config{
+			RootCAs:    rootCAs,
+			MinVersion: tls.VersionTLS12,
+		})))
+	default:
+		return nil, errors.Errorf("unsupported scheme %q. Use one of %s", serverURL.Scheme, []string{"grpc", "grpcs"})
+	}
+	return grpc.Dial(serverURL.Host, dialOpts...)
+}
+
+func (s *envoyAdminStream) handle(ctx context.Context, req *mesh_proto.EnvoyAdminRequest) *mesh_proto.EnvoyAdminResponse {
+	resp := &mesh_proto.EnvoyAdminResponse{
+		RequestId: req.RequestId,
+	}
+	content, err := s.fetch(ctx, req.Resource)
+	if err != nil {
+		resp.Error = err.Error()
+		return resp
+	}
+	resp.Content = content
+	return resp
+}
+
+func (s *envoyAdminStream) fetch(ctx context.Context, resource mesh_proto.EnvoyAdminRequest_Resource) ([]byte, error) {
+	path, ok := adminPaths[resource]
+	if !ok {
+		return nil, errors.Errorf("unsupported resource of Envoy Admin API: %s", resource)
+	}
+	req, err := http.NewRequest(http.MethodGet, s.adminAddress+path, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := s.client.Do(req.WithContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	content, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, errors.Errorf("Envoy Admin API responded with status code %d: %s", resp.StatusCode, string(content))
+	}
+	return content, nil
+}
@@ -0,0 +1,225 @@
+package admin
+
+import (
+	"context"
+	go_tls "crypto/tls"
+	"encoding/base64"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	envoy_bootstrap "github.com/envoyproxy/go-control-plane/envoy/config/bootstrap/v2"
+	"github.com/golang/protobuf/proto"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+
+	mesh_proto "github.com/Kong/kuma/api/mesh/v1alpha1"
+	kumadp "github.com/Kong/kuma/pkg/config/app/kuma-dp"
+	config_types "github.com/Kong/kuma/pkg/config/types"
+	core_xds "github.com/Kong/kuma/pkg/core/xds"
+	envoy_admin "github.com/Kong/kuma/pkg/envoy/admin"
+	sds_auth "github.com/Kong/kuma/pkg/sds/auth"
+	"github.com/Kong/kuma/pkg/tls"
+	util_proto "github.com/Kong/kuma/pkg/util/proto"
+)
+
+type tokenAuthenticator struct {
+	token sds_auth.Credential
+}
+
+func (a *tokenAuthenticator) Authenticate(_ context.Context, proxyId core_xds.ProxyId, credential sds_auth.Credential) (sds_auth.Identity, error) {
+	if credential != a.token {
+		return sds_auth.Identity{}, errors.New("invalid token")
+	}
+	return sds_auth.Identity{Mesh: proxyId.Mesh}, nil
+}
+
+var _ = Describe("envoyAdminStream", func() {
+
+	proxyId := core_xds.ProxyId{Mesh: "demo", Name: "backend-01"}
+
+	var streams envoy_admin.Streams
+	var grpcServer *grpc.Server
+	var serverURL string
+	var envoyAdmin *httptest.Server
+	var tokenFile string
+	var stop chan struct{}
+	var done chan error
+
+	serve := func(scheme string, opts ...grpc.ServerOption) {
+		grpcServer = grpc.NewServer(opts...)
+		mesh_proto.RegisterEnvoyAdminServiceServer(grpcServer, envoy_admin.NewServer(streams, &tokenAuthenticator{token: "secret"}))
+		lis, err := net.Listen("tcp", "127.0.0.1:0")
+		Expect(err).ToNot(HaveOccurred())
+		go func() {
+			_ = grpcServer.Serve(lis)
+		}()
+		serverURL = fmt.Sprintf("%s://%s", scheme, lis.Addr().String())
+	}
+
+	BeforeEach(func() {
+		// setup a Control Plane
+		streams = envoy_admin.NewStreams()
+
+		// and Envoy Admin API
+		mux := http.NewServeMux()
+		mux.HandleFunc("/config_dump", func(writer http.ResponseWriter, _ *http.Request) {
+			_, _ = writer.Write([]byte(`{"configs": []}`))
+		})
+		mux.HandleFunc("/clusters", func(writer http.ResponseWriter, _ *http.Request) {
+			http.Error(writer, "clusters are not available", http.StatusServiceUnavailable)
+		})
+		envoyAdmin = httptest.NewServer(mux)
+
+		// and a dataplane token
+		file, err := ioutil.TempFile("", "token")
+		Expect(err).ToNot(HaveOccurred())
+		_, err = file.WriteString("secret")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(file.Close()).To(Succeed())
+		tokenFile = file.Name()
+	})
+
+	AfterEach(func() {
+		close(stop)
+		Eventually(done).Should(Receive(BeNil()))
+		grpcServer.Stop()
+		envoyAdmin.Close()
+		Expect(os.Remove(tokenFile)).To(Succeed())
+	})
+
+	start := func(token string, controlPlaneCa *ControlPlaneCa) {
+		Expect(ioutil.WriteFile(tokenFile, []byte(token), 0600)).To(Succeed())
+		cfg := kumadp.DefaultConfig()
+		cfg.Dataplane.Mesh = proxyId.Mesh
+		cfg.Dataplane.Name = proxyId.Name
+		cfg.Dataplane.AdminPort = config_types.MustExactPort(uint32(envoyAdmin.Listener.Addr().(*net.TCPAddr).Port))
+		cfg.DataplaneRuntime.TokenPath = tokenFile
+		stream := NewEnvoyAdminStream(serverURL, controlPlaneCa, cfg)
+		stream.initialBackoff = 10 * time.Millisecond
+
+		stop = make(chan struct{})
+		done = make(chan error, 1)
+		go func() {
+			done <- stream.Start(stop)
+		}()
+	}
+
+	It("should answer requests of the Control Plane with data from Envoy Admin API", func() {
+		// given
+		serve("grpc")
+		start("secret", NewControlPlaneCa())
+
+		// when
+		var configDump []byte
+		Eventually(func() error {
+			var err error
+			configDump, err = streams.ConfigDump(context.Background(), proxyId)
+			return err
+		}, "5s").Should(Succeed())
+
+		// then
+		Expect(string(configDump)).To(Equal(`{"configs": []}`))
+
+		// when
+		_, err := streams.Clusters(context.Background(), proxyId)
+
+		// then
+		Expect(err).To(MatchError("kuma-dp failed to retrieve data from Envoy Admin API: Envoy Admin API responded with status code 503: clusters are not available\n"))
+	})
+
+	It("should not be accepted by the Control Plane with an invalid token", func() {
+		// given
+		serve("grpc")
+		start("invalid", NewControlPlaneCa())
+
+		// when
+		Consistently(func() bool {
+			_, err := streams.ConfigDump(context.Background(), proxyId)
+			return envoy_admin.IsDataplaneNotConnected(err)
+		}, "200ms").Should(BeTrue())
+	})
+
+	Context("when the Control Plane is served over TLS", func() {
+
+		var serverCert tls.KeyPair
+
+		BeforeEach(func() {
+			var err error
+			serverCert, err = tls.NewSelfSignedCert("kuma-cp", tls.ServerCertType, "127.0.0.1")
+			Expect(err).ToNot(HaveOccurred())
+			keyPair, err := go_tls.X509KeyPair(serverCert.CertPEM, serverCert.KeyPEM)
+			Expect(err).ToNot(HaveOccurred())
+			serve("grpcs", grpc.Creds(credentials.NewServerTLSFromCert(&keyPair)))
+		})
+
+		controlPlaneCa := func(cert []byte) *ControlPlaneCa {
+			bootstrap := &envoy_bootstrap.Bootstrap{}
+			Expect(util_proto.FromYAML([]byte(fmt.Sprintf(`
+            staticResources:
+              clusters:
+              - name: ads_cluster
+                transportSocket:
+                  name: envoy.transport_sockets.tls
+                  typedConfig:
+                    '@type': type.googleapis.com/envoy.api.v2.auth.UpstreamTlsContext
+                    commonTlsContext:
+                      validationContext:
+                        trustedCa:
+                          inlineBytes: %s
+`, base64.StdEncoding.EncodeToString(cert))), bootstrap)).To(Succeed())
+			generator := func(string, kumadp.Config) (proto.Message, error) {
+				return bootstrap, nil
+			}
+			ca := NewControlPlaneCa()
+			_, err := ca.LearnFrom(generator)("", kumadp.Config{})
+			Expect(err).ToNot(HaveOccurred())
+			return ca
+		}
+
+		It("should verify the Control Plane with a certificate from the bootstrap config", func() {
+			// given
+			start("secret", controlPlaneCa(serverCert.CertPEM))
+
+			// when
+			Eventually(func() error {
+				_, err := streams.ConfigDump(context.Background(), proxyId)
+				return err
+			}, "5s").Should(Succeed())
+		})
+
+		It("should not connect to a Control Plane with an unknown certificate", func() {
+			// given
+			otherCert, err := tls.NewSelfSignedCert("kuma-cp", tls.ServerCertType, "127.0.0.1")
+			Expect(err).ToNot(HaveOccurred())
+
+			// when
+			start("secret", controlPlaneCa(otherCert.CertPEM))
+
+			// then
+			Consistently(func() bool {
+				_, err := streams.ConfigDump(context.Background(), proxyId)
+				return envoy_admin.IsDataplaneNotConnected(err)
+			}, "200ms").Should(BeTrue())
+		})
+
+		It("should not connect to the Control Plane until the certificate is known", func() {
+			// when
+			start("secret", NewControlPlaneCa())
+
+			// then
+			Consistently(func() bool {
+				_, err := streams.ConfigDump(context.Background(), proxyId)
+				return envoy_admin.IsDataplaneNotConnected(err)
+			}, "200ms").Should(BeTrue())
+		})
+	})
+})
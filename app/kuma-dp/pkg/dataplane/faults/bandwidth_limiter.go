@@ -0,0 +1,190 @@
+package faults
+
+import (
+	"io"
+	"net"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/Kong/kuma/pkg/envoy/faults"
+)
+
+const (
+	// bytesPerKbps converts a limit in KiB/s the same way as Envoy does for HTTP services.
+	bytesPerKbps = 1024
+	// throttleInterval is how often a throttled connection gets another portion of data.
+	throttleInterval = 100 * time.Millisecond
+)
+
+// bandwidthLimiters keeps a bandwidth limiter per outbound listener of Envoy.
+//
+// A limiter is started once the first connection with limited bandwidth is checked,
+// so that it is ready to accept the connection by the time Envoy forwards it.
+type bandwidthLimiters struct {
+	dataplaneName string
+	mesh          string
+	// random returns a number in [0.0 - 100.0) range
+	random func() float64
+
+	sync.Mutex
+	limiters map[string]*bandwidthLimiter
+	stopped  bool
+}
+
+func newBandwidthLimiters(dataplaneName, mesh string, random func() float64) *bandwidthLimiters {
+	return &bandwidthLimiters{
+		dataplaneName: dataplaneName,
+		mesh:          mesh,
+		random:        random,
+		limiters:      map[string]*bandwidthLimiter{},
+	}
+}
+
+// ensure starts a limiter of a given listener unless it is already running
+// and updates the limit of new connections.
+func (l *bandwidthLimiters) ensure(bandwidth faults.ConnectionResponseBandwidth) error {
+	l.Lock()
+	defer l.Unlock()
+	if l.stopped {
+		return errors.New("bandwidth limiters are stopped")
+	}
+	limiter, ok := l.limiters[bandwidth.Listener]
+	if !ok {
+		address := faults.BandwidthLimiterSocketPath(l.dataplaneName, l.mesh, bandwidth.Listener)
+		// a socket might be left over by a previous run of kuma-dp
+		if err := os.Remove(address); err != nil && !os.IsNotExist(err) {
+			return errors.Wrapf(err, "failed to remove a stale socket %q of a bandwidth limiter", address)
+		}
+		listener, err := net.Listen("unix", address)
+		if err != nil {
+			return errors.Wrapf(err, "failed to start a bandwidth limiter of listener %q", bandwidth.Listener)
+		}
+		limiter = &bandwidthLimiter{
+			listener: listener,
+			upstream: faults.ThrottledListenerSocketPath(l.dataplaneName, l.mesh, bandwidth.Listener),
+			random:   l.random,
+		}
+		l.limiters[bandwidth.Listener] = limiter
+		logger.Info("starting a bandwidth limiter", "listener", bandwidth.Listener, "address", address)
+		go limiter.serve()
+	}
+	limiter.update(bandwidth)
+	return nil
+}
+
+func (l *bandwidthLimiters) stop() {
+	l.Lock()
+	defer l.Unlock()
+	l.stopped = true
+	for name, limiter := range l.limiters {
+		if err := limiter.listener.Close(); err != nil {
+			logger.Error(err, "failed to stop a bandwidth limiter", "listener", name)
+		}
+	}
+}
+
+// bandwidthLimiter accepts connections forwarded by Envoy, forwards them back to Envoy
+// and limits the rate at which data is sent back to a client.
+type bandwidthLimiter struct {
+	listener net.Listener
+	upstream string
+	random   func() float64
+
+	sync.Mutex
+	bandwidth faults.ConnectionResponseBandwidth
+}
+
+func (l *bandwidthLimiter) update(bandwidth faults.ConnectionResponseBandwidth) {
+	l.Lock()
+	defer l.Unlock()
+	l.bandwidth = bandwidth
+}
+
+// limitOfConnection returns a limit in bytes per second of a new connection or 0 if it is not throttled.
+func (l *bandwidthLimiter) limitOfConnection() uint64 {
+	l.Lock()
+	defer l.Unlock()
+	if l.random() < l.bandwidth.Percentage {
+		return l.bandwidth.LimitKbps * bytesPerKbps
+	}
+	return 0
+}
+
+func (l *bandwidthLimiter) serve() {
+	for {
+		conn, err := l.listener.Accept()
+		if err != nil {
+			return // listener is closed
+		}
+		go l.handle(conn)
+	}
+}
+
+func (l *bandwidthLimiter) handle(downstream net.Conn) {
+	defer downstream.Close()
+	upstream, err := net.Dial("unix", l.upstream)
+	if err != nil {
+		logger.Error(err, "failed to forward a connection with limited bandwidth", "address", l.upstream)
+		return
+	}
+	defer upstream.Close()
+
+	limit := l.limitOfConnection()
+	done := make(chan struct{}, 2)
+	go func() {
+		_, _ = io.Copy(upstream, downstream)
+		closeWrite(upstream)
+		done <- struct{}{}
+	}()
+	go func() {
+		_, _ = copyWithLimit(downstream, upstream, limit)
+		closeWrite(downstream)
+		done <- struct{}{}
+	}()
+	<-done
+	<-done
+}
+
+// closeWrite propagates the end of data sent in one direction without closing the other one.
+func closeWrite(conn net.Conn) {
+	if conn, ok := conn.(interface{ CloseWrite() error }); ok {
+		_ = conn.CloseWrite()
+	}
+}
+
+// copyWithLimit copies data at a rate of at most limit bytes per second. A zero limit means no limit.
+func copyWithLimit(dst io.Writer, src io.Reader, limit uint64) (int64, error) {
+	if limit == 0 {
+		return io.Copy(dst, src)
+	}
+	chunk := limit * uint64(throttleInterval) / uint64(time.Second)
+	if chunk == 0 {
+		chunk = 1
+	}
+	buf := make([]byte, chunk)
+	start := time.Now()
+	var written int64
+	for {
+		n, err := src.Read(buf)
+		if n > 0 {
+			w, werr := dst.Write(buf[:n])
+			written += int64(w)
+			if werr != nil {
+				return written, werr
+			}
+			// wait until the average rate drops to the limit
+			if wait := time.Duration(float64(written)/float64(limit)*float64(time.Second)) - time.Since(start); wait > 0 {
+				time.Sleep(wait)
+			}
+		}
+		if err == io.EOF {
+			return written, nil
+		}
+		if err != nil {
+			return written, err
+		}
+	}
+}
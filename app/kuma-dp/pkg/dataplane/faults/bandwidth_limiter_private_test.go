@@ -0,0 +1,151 @@
+package faults
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net"
+	"os"
+	"strings"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/Kong/kuma/pkg/envoy/faults"
+)
+
+var _ = Describe("copyWithLimit", func() {
+
+	It("should copy data at a given rate", func() {
+		// given
+		src := bytes.NewBufferString(strings.Repeat("x", 2048))
+		dst := &bytes.Buffer{}
+
+		// when
+		start := time.Now()
+		written, err := copyWithLimit(dst, src, 4096)
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(written).To(Equal(int64(2048)))
+		Expect(dst.Len()).To(Equal(2048))
+		// and
+		Expect(time.Since(start)).To(BeNumerically(">=", 450*time.Millisecond))
+	})
+
+	It("should copy data without a limit", func() {
+		// given
+		src := bytes.NewBufferString(strings.Repeat("x", 2048))
+		dst := &bytes.Buffer{}
+
+		// when
+		written, err := copyWithLimit(dst, src, 0)
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(written).To(Equal(int64(2048)))
+	})
+})
+
+var _ = Describe("bandwidthLimiters", func() {
+
+	var limiters *bandwidthLimiters
+	var envoy net.Listener
+	var dataplaneName string
+
+	const listenerName = "outbound:127.0.0.1:54321"
+
+	BeforeEach(func() {
+		dataplaneName = fmt.Sprintf("side-car-%d", os.Getpid())
+		limiters = newBandwidthLimiters(dataplaneName, "default", func() float64 {
+			return 50
+		})
+		// and Envoy that responds with data sent by a client in upper case
+		var err error
+		envoy, err = net.Listen("unix", faults.ThrottledListenerSocketPath(dataplaneName, "default", listenerName))
+		Expect(err).ToNot(HaveOccurred())
+		go func() {
+			for {
+				conn, err := envoy.Accept()
+				if err != nil {
+					return
+				}
+				go func() {
+					defer conn.Close()
+					data, _ := ioutil.ReadAll(conn)
+					_, _ = conn.Write(bytes.ToUpper(data))
+				}()
+			}
+		}()
+	})
+
+	AfterEach(func() {
+		limiters.stop()
+		Expect(envoy.Close()).To(Succeed())
+	})
+
+	exchange := func(request string) (string, time.Duration) {
+		conn, err := net.Dial("unix", faults.BandwidthLimiterSocketPath(dataplaneName, "default", listenerName))
+		Expect(err).ToNot(HaveOccurred())
+		defer conn.Close()
+
+		start := time.Now()
+		_, err = io.WriteString(conn, request)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(conn.(*net.UnixConn).CloseWrite()).To(Succeed())
+		response, err := ioutil.ReadAll(conn)
+		Expect(err).ToNot(HaveOccurred())
+		return string(response), time.Since(start)
+	}
+
+	It("should forward connections back to Envoy and throttle responses", func() {
+		// given
+		Expect(limiters.ensure(faults.ConnectionResponseBandwidth{
+			Percentage: 60,
+			LimitKbps:  2,
+			Listener:   listenerName,
+		})).To(Succeed())
+
+		// when
+		response, elapsed := exchange(strings.Repeat("x", 1024))
+
+		// then
+		Expect(response).To(Equal(strings.Repeat("X", 1024)))
+		Expect(elapsed).To(BeNumerically(">=", 450*time.Millisecond))
+	})
+
+	It("should not throttle connections outside of a percentage", func() {
+		// given
+		Expect(limiters.ensure(faults.ConnectionResponseBandwidth{
+			Percentage: 40,
+			LimitKbps:  1,
+			Listener:   listenerName,
+		})).To(Succeed())
+
+		// when
+		response, elapsed := exchange(strings.Repeat("x", 1024))
+
+		// then
+		Expect(response).To(Equal(strings.Repeat("X", 1024)))
+		Expect(elapsed).To(BeNumerically("<", 450*time.Millisecond))
+	})
+
+	It("should reuse a limiter of a listener", func() {
+		// given
+		bandwidth := faults.ConnectionResponseBandwidth{
+			Percentage: 40,
+			LimitKbps:  1,
+			Listener:   listenerName,
+		}
+		Expect(limiters.ensure(bandwidth)).To(Succeed())
+
+		// when
+		bandwidth.Percentage = 100
+		// then
+		Expect(limiters.ensure(bandwidth)).To(Succeed())
+		Expect(limiters.limiters).To(HaveLen(1))
+		Expect(limiters.limiters[listenerName].bandwidth.Percentage).To(Equal(100.0))
+	})
+})
@@ -0,0 +1,118 @@
+package faults
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"net"
+	"time"
+
+	envoy_auth "github.com/envoyproxy/go-control-plane/envoy/service/auth/v2"
+	rpc_status "google.golang.org/genproto/googleapis/rpc/status"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	kumadp "github.com/Kong/kuma/pkg/config/app/kuma-dp"
+	"github.com/Kong/kuma/pkg/core"
+	"github.com/Kong/kuma/pkg/core/runtime/component"
+	"github.com/Kong/kuma/pkg/envoy/faults"
+)
+
+var logger = core.Log.WithName("fault-injection-server")
+
+var _ component.Component = &faultInjectionServer{}
+
+// faultInjectionServer injects faults into connections of TCP services.
+//
+// Envoy checks new connections with this server via the network ext_authz filter
+// and passes faults in gRPC metadata. A delay is injected by holding a check
+// and an abort by denying it, which makes Envoy close the connection.
+// Bandwidth is limited by a limiter that Envoy forwards a connection to once it is checked.
+type faultInjectionServer struct {
+	server   *grpc.Server
+	address  string
+	limiters *bandwidthLimiters
+	// random returns a number in [0.0 - 100.0) range
+	random func() float64
+}
+
+func NewFaultInjectionServer(dataplane kumadp.Dataplane) *faultInjectionServer {
+	random := func() float64 {
+		return rand.Float64() * 100
+	}
+	return &faultInjectionServer{
+		server:   grpc.NewServer(),
+		address:  faults.SocketPath(dataplane.Name, dataplane.Mesh),
+		limiters: newBandwidthLimiters(dataplane.Name, dataplane.Mesh, random),
+		random:   random,
+	}
+}
+
+func (s *faultInjectionServer) Check(ctx context.Context, _ *envoy_auth.CheckRequest) (*envoy_auth.CheckResponse, error) {
+	var value string
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if values := md.Get(faults.ConnectionFaultsMetadataKey); len(values) > 0 {
+			value = values[0]
+		}
+	}
+	connectionFaults, err := faults.DecodeConnectionFaults(value)
+	if err != nil {
+		logger.Error(err, "failed to determine faults of a connection")
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if bandwidth := connectionFaults.ResponseBandwidth; bandwidth != nil {
+		if err := s.limiters.ensure(*bandwidth); err != nil {
+			logger.Error(err, "failed to limit bandwidth of a connection")
+			return nil, status.Error(codes.Unavailable, err.Error())
+		}
+	}
+	if delay := connectionFaults.Delay; delay != nil && s.random() < delay.Percentage {
+		select {
+		case <-time.After(delay.Value):
+		case <-ctx.Done():
+			return nil, status.FromContextError(ctx.Err()).Err()
+		}
+	}
+	if abort := connectionFaults.Abort; abort != nil && s.random() < abort.Percentage {
+		return &envoy_auth.CheckResponse{
+			Status: &rpc_status.Status{
+				Code:    int32(codes.PermissionDenied),
+				Message: "connection is aborted by fault injection",
+			},
+		}, nil
+	}
+	return &envoy_auth.CheckResponse{
+		Status: &rpc_status.Status{
+			Code: int32(codes.OK),
+		},
+	}, nil
+}
+
+func (s *faultInjectionServer) Start(stop <-chan struct{}) error {
+	envoy_auth.RegisterAuthorizationServer(s.server, s)
+	lis, err := net.Listen("unix", s.address)
+	if err != nil {
+		return err
+	}
+	logger.Info("starting Fault Injection Server", "address", fmt.Sprintf("unix://%s", s.address))
+	errCh := make(chan error, 1)
+	go func() {
+		if err := s.server.Serve(lis); err != nil {
+			errCh <- err
+		}
+	}()
+	select {
+	case err := <-errCh:
+		return err
+	case <-stop:
+		logger.Info("stopping Fault Injection Server")
+		s.server.GracefulStop()
+		s.limiters.stop()
+		return nil
+	}
+}
+
+var _ envoy_auth.AuthorizationServer = &faultInjectionServer{}
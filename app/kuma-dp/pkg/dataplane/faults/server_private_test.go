@@ -0,0 +1,137 @@
+package faults
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"os"
+	"time"
+
+	envoy_auth "github.com/envoyproxy/go-control-plane/envoy/service/auth/v2"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/Kong/kuma/pkg/envoy/faults"
+)
+
+var _ = Describe("faultInjectionServer", func() {
+
+	var server *faultInjectionServer
+
+	BeforeEach(func() {
+		server = &faultInjectionServer{
+			random: func() float64 {
+				return 50
+			},
+		}
+	})
+
+	contextWith := func(connectionFaults faults.ConnectionFaults) context.Context {
+		value, err := faults.EncodeConnectionFaults(connectionFaults)
+		Expect(err).ToNot(HaveOccurred())
+		return metadata.NewIncomingContext(context.Background(), metadata.Pairs(faults.ConnectionFaultsMetadataKey, value))
+	}
+
+	It("should allow a connection without faults", func() {
+		// when
+		resp, err := server.Check(context.Background(), &envoy_auth.CheckRequest{})
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(resp.Status.Code).To(Equal(int32(codes.OK)))
+	})
+
+	It("should abort a connection", func() {
+		// given
+		ctx := contextWith(faults.ConnectionFaults{
+			Abort: &faults.ConnectionAbort{Percentage: 60},
+		})
+
+		// when
+		resp, err := server.Check(ctx, &envoy_auth.CheckRequest{})
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(resp.Status.Code).To(Equal(int32(codes.PermissionDenied)))
+	})
+
+	It("should not abort a connection outside of a percentage", func() {
+		// given
+		ctx := contextWith(faults.ConnectionFaults{
+			Abort: &faults.ConnectionAbort{Percentage: 40},
+		})
+
+		// when
+		resp, err := server.Check(ctx, &envoy_auth.CheckRequest{})
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(resp.Status.Code).To(Equal(int32(codes.OK)))
+	})
+
+	It("should delay a connection", func() {
+		// given
+		ctx := contextWith(faults.ConnectionFaults{
+			Delay: &faults.ConnectionDelay{Percentage: 100, Value: 50 * time.Millisecond},
+		})
+
+		// when
+		start := time.Now()
+		resp, err := server.Check(ctx, &envoy_auth.CheckRequest{})
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(resp.Status.Code).To(Equal(int32(codes.OK)))
+		Expect(time.Since(start)).To(BeNumerically(">=", 50*time.Millisecond))
+	})
+
+	It("should stop delaying a connection once a check is cancelled", func() {
+		// given
+		ctx, cancel := context.WithCancel(contextWith(faults.ConnectionFaults{
+			Delay: &faults.ConnectionDelay{Percentage: 100, Value: time.Hour},
+		}))
+		cancel()
+
+		// when
+		_, err := server.Check(ctx, &envoy_auth.CheckRequest{})
+
+		// then
+		Expect(status.Code(err)).To(Equal(codes.Canceled))
+	})
+
+	It("should start a bandwidth limiter before a connection is allowed", func() {
+		// given
+		dataplaneName := fmt.Sprintf("side-car-%d", os.Getpid())
+		server.limiters = newBandwidthLimiters(dataplaneName, "default", server.random)
+		defer server.limiters.stop()
+		// and
+		ctx := contextWith(faults.ConnectionFaults{
+			ResponseBandwidth: &faults.ConnectionResponseBandwidth{Percentage: 100, LimitKbps: 10, Listener: "outbound:127.0.0.1:6379"},
+		})
+
+		// when
+		resp, err := server.Check(ctx, &envoy_auth.CheckRequest{})
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(resp.Status.Code).To(Equal(int32(codes.OK)))
+		// and
+		conn, err := net.Dial("unix", faults.BandwidthLimiterSocketPath(dataplaneName, "default", "outbound:127.0.0.1:6379"))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(conn.Close()).To(Succeed())
+	})
+
+	It("should reject invalid faults", func() {
+		// given
+		ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(faults.ConnectionFaultsMetadataKey, "{"))
+
+		// when
+		_, err := server.Check(ctx, &envoy_auth.CheckRequest{})
+
+		// then
+		Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+	})
+})
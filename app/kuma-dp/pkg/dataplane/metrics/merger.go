@@ -0,0 +1,185 @@
+package metrics
+
+import (
+	"context"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/pkg/errors"
+	io_prometheus_client "github.com/prometheus/client_model/go"
+	"github.com/prometheus/common/expfmt"
+)
+
+const (
+	envoySource  = "envoy"
+	kumaDpSource = "kuma-dp"
+
+	// applicationLabel is added to every metric of an application
+	// to tell it apart from metrics of Envoy and other applications.
+	applicationLabel = "kuma_application"
+	// exportedLabelPrefix is prepended to labels of an application
+	// that conflict with labels added by the merger.
+	exportedLabelPrefix = "exported_"
+
+	mergerMetricPrefix  = "kuma_metrics_merger_"
+	sourceUpMetric      = mergerMetricPrefix + "source_up"
+	sourceDroppedMetric = mergerMetricPrefix + "source_dropped_families"
+	sourceLabel         = "source"
+)
+
+type source struct {
+	name string
+	url  string
+	// application is false for metrics of Envoy
+	application bool
+}
+
+type scrapeResult struct {
+	source   source
+	families map[string]*io_prometheus_client.MetricFamily
+	err      error
+}
+
+// scrape fetches metrics of all sources concurrently.
+// Results are returned in the same order as sources.
+func scrape(ctx context.Context, client *http.Client, sources []source) []scrapeResult {
+	results := make([]scrapeResult, len(sources))
+	var wg sync.WaitGroup
+	for i, src := range sources {
+		wg.Add(1)
+		go func(i int, src source) {
+			defer wg.Done()
+			families, err := scrapeSource(ctx, client, src)
+			results[i] = scrapeResult{source: src, families: families, err: err}
+		}(i, src)
+	}
+	wg.Wait()
+	return results
+}
+
+func scrapeSource(ctx context.Context, client *http.Client, src source) (map[string]*io_prometheus_client.MetricFamily, error) {
+	req, err := http.NewRequest(http.MethodGet, src.url, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", string(expfmt.FmtText))
+	resp, err := client.Do(req.WithContext(ctx))
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to scrape metrics of %q", src.name)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, errors.Errorf("failed to scrape metrics of %q: unexpected status code %d", src.name, resp.StatusCode)
+	}
+	var parser expfmt.TextParser
+	families, err := parser.TextToMetricFamilies(resp.Body)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to parse metrics of %q", src.name)
+	}
+	return families, nil
+}
+
+// merge combines metric families of all sources into a single list sorted by name.
+//
+// Metrics of applications are labeled with the name of an application.
+// If an application exposes a metric family with the same name as Envoy or
+// another application but of a different type, that family is dropped.
+// Failures of every source are reported by `kuma_metrics_merger_source_up`
+// and `kuma_metrics_merger_source_dropped_families` metrics.
+func merge(results []scrapeResult) []*io_prometheus_client.MetricFamily {
+	merged := map[string]*io_prometheus_client.MetricFamily{}
+	up := newGaugeFamily(sourceUpMetric, "Whether metrics of a source have been scraped successfully.")
+	dropped := newGaugeFamily(sourceDroppedMetric, "Number of metric families of a source dropped due to conflicts.")
+	for _, result := range results {
+		log := logger.WithValues("source", result.source.name)
+		if result.err != nil {
+			log.Error(result.err, "failed to scrape metrics")
+			addGauge(up, result.source.name, 0)
+			continue
+		}
+		addGauge(up, result.source.name, 1)
+		droppedFamilies := 0
+		for _, name := range sortedNames(result.families) {
+			family := result.families[name]
+			if strings.HasPrefix(name, mergerMetricPrefix) {
+				log.Info("metric family conflicts with metrics of the merger, dropping it", "family", name)
+				droppedFamilies++
+				continue
+			}
+			if result.source.application {
+				labelMetrics(family, result.source.name)
+			}
+			existing, ok := merged[name]
+			if !ok {
+				merged[name] = family
+				continue
+			}
+			if existing.GetType() != family.GetType() {
+				log.Info("metric family conflicts with another metric family of a different type, dropping it", "family", name, "type", family.GetType(), "existingType", existing.GetType())
+				droppedFamilies++
+				continue
+			}
+			existing.Metric = append(existing.Metric, family.Metric...)
+		}
+		addGauge(dropped, result.source.name, float64(droppedFamilies))
+	}
+	merged[sourceUpMetric] = up
+	merged[sourceDroppedMetric] = dropped
+
+	families := make([]*io_prometheus_client.MetricFamily, 0, len(merged))
+	for _, name := range sortedNames(merged) {
+		families = append(families, merged[name])
+	}
+	return families
+}
+
+// labelMetrics adds a label with the name of an application to every metric of a family.
+// An existing label with the same name is preserved with the `exported_` prefix.
+func labelMetrics(family *io_prometheus_client.MetricFamily, application string) {
+	for _, metric := range family.Metric {
+		for _, label := range metric.Label {
+			if label.GetName() == applicationLabel {
+				label.Name = proto.String(exportedLabelPrefix + applicationLabel)
+			}
+		}
+		metric.Label = append(metric.Label, &io_prometheus_client.LabelPair{
+			Name:  proto.String(applicationLabel),
+			Value: proto.String(application),
+		})
+		sort.Slice(metric.Label, func(i, j int) bool {
+			return metric.Label[i].GetName() < metric.Label[j].GetName()
+		})
+	}
+}
+
+func newGaugeFamily(name, help string) *io_prometheus_client.MetricFamily {
+	return &io_prometheus_client.MetricFamily{
+		Name: proto.String(name),
+		Help: proto.String(help),
+		Type: io_prometheus_client.MetricType_GAUGE.Enum(),
+	}
+}
+
+func addGauge(family *io_prometheus_client.MetricFamily, source string, value float64) {
+	family.Metric = append(family.Metric, &io_prometheus_client.Metric{
+		Label: []*io_prometheus_client.LabelPair{{
+			Name:  proto.String(sourceLabel),
+			Value: proto.String(source),
+		}},
+		Gauge: &io_prometheus_client.Gauge{
+			Value: proto.Float64(value),
+		},
+	})
+}
+
+func sortedNames(families map[string]*io_prometheus_client.MetricFamily) []string {
+	names := make([]string, 0, len(families))
+	for name := range families {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
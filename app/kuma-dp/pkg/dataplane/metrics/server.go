@@ -0,0 +1,129 @@
+package metrics
+
+import (
+	"bytes"
+	"fmt"
+	"net"
+	"net/http"
+	"time"
+
+	io_prometheus_client "github.com/prometheus/client_model/go"
+	"github.com/prometheus/common/expfmt"
+
+	kumadp "github.com/Kong/kuma/pkg/config/app/kuma-dp"
+	"github.com/Kong/kuma/pkg/core"
+	"github.com/Kong/kuma/pkg/core/runtime/component"
+	"github.com/Kong/kuma/pkg/envoy/metrics"
+)
+
+var logger = core.Log.WithName("metrics-merger")
+
+const (
+	defaultScrapeTimeout = 10 * time.Second
+)
+
+var _ component.Component = &metricsMerger{}
+
+// Collector provides metrics of kuma-dp itself.
+type Collector interface {
+	Collect() []*io_prometheus_client.MetricFamily
+}
+
+// metricsMerger serves metrics of Envoy merged with metrics of applications
+// listed in the request header set by Envoy and metrics of kuma-dp itself.
+type metricsMerger struct {
+	address        string
+	envoyAdminPort uint32
+	client         *http.Client
+	collectors     []Collector
+}
+
+func NewMetricsMerger(dataplane kumadp.Dataplane, collectors ...Collector) *metricsMerger {
+	var adminPort uint32
+	if !dataplane.AdminPort.Empty() {
+		adminPort = dataplane.AdminPort.Lowest()
+	}
+	return &metricsMerger{
+		address:        metrics.MergerSocketPath(dataplane.Name, dataplane.Mesh),
+		envoyAdminPort: adminPort,
+		client:         &http.Client{Timeout: defaultScrapeTimeout},
+		collectors:     collectors,
+	}
+}
+
+func (m *metricsMerger) ServeHTTP(writer http.ResponseWriter, req *http.Request) {
+	apps, err := metrics.DecodeApplications(req.Header.Get(metrics.ApplicationsHeader))
+	if err != nil {
+		logger.Error(err, "failed to determine applications to scrape")
+		http.Error(writer, err.Error(), http.StatusBadRequest)
+		return
+	}
+	var sources []source
+	if m.envoyAdminPort != 0 {
+		sources = append(sources, source{
+			name: envoySource,
+			url:  fmt.Sprintf("http://127.0.0.1:%d/stats/prometheus", m.envoyAdminPort),
+		})
+	}
+	for _, app := range apps {
+		sources = append(sources, source{
+			name:        app.Name,
+			url:         fmt.Sprintf("http://127.0.0.1:%d%s", app.Port, app.Path),
+			application: true,
+		})
+	}
+	results := scrape(req.Context(), m.client, sources)
+	if len(m.collectors) > 0 {
+		results = append(results, m.collect())
+	}
+	families := merge(results)
+
+	var buf bytes.Buffer
+	for _, family := range families {
+		if _, err := expfmt.MetricFamilyToText(&buf, family); err != nil {
+			logger.Error(err, "failed to encode metrics", "family", family.GetName())
+			http.Error(writer, err.Error(), http.StatusInternalServerError)
+			return
+		}
+	}
+	writer.Header().Set("Content-Type", string(expfmt.FmtText))
+	if _, err := buf.WriteTo(writer); err != nil {
+		logger.Error(err, "failed to write merged metrics")
+	}
+}
+
+// collect gathers metrics of kuma-dp as if they were scraped from another source.
+func (m *metricsMerger) collect() scrapeResult {
+	families := map[string]*io_prometheus_client.MetricFamily{}
+	for _, collector := range m.collectors {
+		for _, family := range collector.Collect() {
+			families[family.GetName()] = family
+		}
+	}
+	return scrapeResult{
+		source:   source{name: kumaDpSource},
+		families: families,
+	}
+}
+
+func (m *metricsMerger) Start(stop <-chan struct{}) error {
+	lis, err := net.Listen("unix", m.address)
+	if err != nil {
+		return err
+	}
+	server := &http.Server{Handler: m}
+	logger.Info("starting Metrics Merger", "address", fmt.Sprintf("unix://%s", m.address))
+	errCh := make(chan error, 1)
+	go func() {
+		if err := server.Serve(lis); err != nil && err != http.ErrServerClosed {
+			errCh <- err
+		}
+	}()
+	select {
+	case err := <-errCh:
+		return err
+	case <-stop:
+		logger.Info("stopping Metrics Merger")
+		return server.Close()
+	}
+}
@@ -0,0 +1,185 @@
+package metrics
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+
+	"github.com/golang/protobuf/proto"
+	io_prometheus_client "github.com/prometheus/client_model/go"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/Kong/kuma/pkg/envoy/metrics"
+)
+
+type staticCollector []*io_prometheus_client.MetricFamily
+
+func (c staticCollector) Collect() []*io_prometheus_client.MetricFamily {
+	return c
+}
+
+var _ = Describe("metricsMerger", func() {
+
+	newSource := func(statusCode int, body string) *httptest.Server {
+		return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			w.WriteHeader(statusCode)
+			_, _ = w.Write([]byte(body))
+		}))
+	}
+
+	portOf := func(server *httptest.Server) uint32 {
+		u, err := url.Parse(server.URL)
+		Expect(err).ToNot(HaveOccurred())
+		port, err := strconv.ParseUint(u.Port(), 10, 32)
+		Expect(err).ToNot(HaveOccurred())
+		return uint32(port)
+	}
+
+	var envoy, backend, sidecar *httptest.Server
+
+	BeforeEach(func() {
+		envoy = newSource(http.StatusOK, `
+# TYPE envoy_server_live gauge
+envoy_server_live{} 1
+# TYPE requests_total counter
+requests_total{envoy_cluster_name="backend"} 7
+`)
+		backend = newSource(http.StatusOK, `
+# HELP requests_total Number of requests.
+# TYPE requests_total counter
+requests_total{path="/api"} 3
+# TYPE kuma_metrics_merger_source_up gauge
+kuma_metrics_merger_source_up 1
+`)
+		sidecar = newSource(http.StatusOK, `
+# TYPE requests_total gauge
+requests_total 5
+# TYPE queue_size gauge
+queue_size{kuma_application="queue"} 2
+`)
+	})
+
+	AfterEach(func() {
+		envoy.Close()
+		backend.Close()
+		sidecar.Close()
+	})
+
+	serve := func(merger *metricsMerger, apps []metrics.Application) string {
+		header, err := metrics.EncodeApplications(apps)
+		Expect(err).ToNot(HaveOccurred())
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		req.Header.Set(metrics.ApplicationsHeader, header)
+		recorder := httptest.NewRecorder()
+
+		merger.ServeHTTP(recorder, req)
+
+		Expect(recorder.Code).To(Equal(http.StatusOK))
+		body, err := ioutil.ReadAll(recorder.Body)
+		Expect(err).ToNot(HaveOccurred())
+		return string(body)
+	}
+
+	It("should merge metrics of Envoy and applications", func() {
+		// given
+		merger := &metricsMerger{
+			envoyAdminPort: portOf(envoy),
+			client:         http.DefaultClient,
+		}
+
+		// when
+		actual := serve(merger, []metrics.Application{
+			{Name: "backend", Port: portOf(backend), Path: "/metrics"},
+			{Name: "sidecar", Port: portOf(sidecar), Path: "/stats"},
+		})
+
+		// then
+		Expect(actual).To(Equal(`# TYPE envoy_server_live gauge
+envoy_server_live 1
+# HELP kuma_metrics_merger_source_dropped_families Number of metric families of a source dropped due to conflicts.
+# TYPE kuma_metrics_merger_source_dropped_families gauge
+kuma_metrics_merger_source_dropped_families{source="envoy"} 0
+kuma_metrics_merger_source_dropped_families{source="backend"} 1
+kuma_metrics_merger_source_dropped_families{source="sidecar"} 1
+# HELP kuma_metrics_merger_source_up Whether metrics of a source have been scraped successfully.
+# TYPE kuma_metrics_merger_source_up gauge
+kuma_metrics_merger_source_up{source="envoy"} 1
+kuma_metrics_merger_source_up{source="backend"} 1
+kuma_metrics_merger_source_up{source="sidecar"} 1
+# TYPE queue_size gauge
+queue_size{exported_kuma_application="queue",kuma_application="sidecar"} 2
+# TYPE requests_total counter
+requests_total{envoy_cluster_name="backend"} 7
+requests_total{kuma_application="backend",path="/api"} 3
+`))
+	})
+
+	It("should report failures of individual sources", func() {
+		// given
+		failing := newSource(http.StatusInternalServerError, "")
+		defer failing.Close()
+		// and
+		merger := &metricsMerger{
+			envoyAdminPort: portOf(envoy),
+			client:         http.DefaultClient,
+		}
+
+		// when
+		actual := serve(merger, []metrics.Application{
+			{Name: "failing", Port: portOf(failing), Path: "/metrics"},
+			{Name: "backend", Port: portOf(backend), Path: "/metrics"},
+		})
+
+		// then
+		Expect(actual).To(ContainSubstring(`kuma_metrics_merger_source_up{source="failing"} 0`))
+		Expect(actual).To(ContainSubstring(`kuma_metrics_merger_source_up{source="backend"} 1`))
+		Expect(actual).To(ContainSubstring(`requests_total{kuma_application="backend",path="/api"} 3`))
+	})
+
+	It("should serve metrics of kuma-dp", func() {
+		// given
+		merger := &metricsMerger{
+			envoyAdminPort: portOf(envoy),
+			client:         http.DefaultClient,
+			collectors: []Collector{staticCollector{{
+				Name: proto.String("kuma_access_logs_delivered_total"),
+				Type: io_prometheus_client.MetricType_COUNTER.Enum(),
+				Metric: []*io_prometheus_client.Metric{{
+					Label: []*io_prometheus_client.LabelPair{{
+						Name:  proto.String("backend"),
+						Value: proto.String("logstash"),
+					}},
+					Counter: &io_prometheus_client.Counter{Value: proto.Float64(3)},
+				}},
+			}}},
+		}
+
+		// when
+		actual := serve(merger, nil)
+
+		// then
+		Expect(actual).To(ContainSubstring(`kuma_access_logs_delivered_total{backend="logstash"} 3`))
+		Expect(actual).To(ContainSubstring(`kuma_metrics_merger_source_up{source="kuma-dp"} 1`))
+		Expect(actual).To(ContainSubstring(`envoy_server_live 1`))
+	})
+
+	It("should reject an invalid list of applications", func() {
+		// given
+		merger := &metricsMerger{client: http.DefaultClient}
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		req.Header.Set(metrics.ApplicationsHeader, "not-a-json")
+		recorder := httptest.NewRecorder()
+
+		// when
+		merger.ServeHTTP(recorder, req)
+
+		// then
+		Expect(recorder.Code).To(Equal(http.StatusBadRequest))
+		Expect(recorder.Body.String()).To(HavePrefix(fmt.Sprintf("failed to decode applications from %q header", metrics.ApplicationsHeader)))
+	})
+})
@@ -14,6 +14,22 @@ const (
 	// KumaSidecarInjectionDisabled defines a value of KumaSidecarInjectionAnnotation
 	// that will prevent Kuma from injecting a side-car into that Pod.
 	KumaSidecarInjectionDisabled = "disabled"
+
+	// KumaMetricsPrometheusAggregatePrefix defines a prefix of Pod annotations
+	// that declare applications whose Prometheus metrics should be merged with
+	// metrics of a dataplane, e.g.
+	//
+	//   prometheus.metrics.kuma.io/aggregate-<name>-port: "8081"
+	//   prometheus.metrics.kuma.io/aggregate-<name>-path: "/metrics"
+	//
+	// where `<name>` is the name of an application.
+	KumaMetricsPrometheusAggregatePrefix = "prometheus.metrics.kuma.io/aggregate-"
+	// KumaMetricsPrometheusAggregatePortSuffix defines a suffix of a Pod annotation
+	// with a port of an application.
+	KumaMetricsPrometheusAggregatePortSuffix = "-port"
+	// KumaMetricsPrometheusAggregatePathSuffix defines a suffix of a Pod annotation
+	// with a path of an application.
+	KumaMetricsPrometheusAggregatePathSuffix = "-path"
 )
 
 // Annotations that are being automatically set by the Kuma Sidecar Injector.
@@ -48,9 +48,17 @@ func NewDeleteCmd(pctx *kumactl_cmd.RootContext) *cobra.Command {
 				resourceType = mesh.TrafficTraceType
 			case "fault-injection":
 				resourceType = mesh.FaultInjectionType
+			case "retry":
+				resourceType = mesh.RetryType
+			case "timeout":
+				resourceType = mesh.TimeoutType
+			case "circuit-breaker":
+				resourceType = mesh.CircuitBreakerType
+			case "external-service":
+				resourceType = mesh.ExternalServiceType
 
 			default:
-				return errors.Errorf("unknown TYPE: %s. Allowed values: mesh, dataplane, healthcheck, proxytemplate, traffic-log, traffic-permission, traffic-route, traffic-trace, fault-injection", resourceTypeArg)
+				return errors.Errorf("unknown TYPE: %s. Allowed values: mesh, dataplane, healthcheck, proxytemplate, traffic-log, traffic-permission, traffic-route, traffic-trace, fault-injection, retry, timeout, circuit-breaker, external-service", resourceTypeArg)
 			}
 
 			currentMesh := pctx.CurrentMesh()
@@ -78,9 +78,9 @@ var _ = Describe("kumactl delete ", func() {
 			// then
 			Expect(err).To(HaveOccurred())
 			// and
-			Expect(err.Error()).To(Equal("unknown TYPE: some-type. Allowed values: mesh, dataplane, healthcheck, proxytemplate, traffic-log, traffic-permission, traffic-route, traffic-trace, fault-injection"))
+			Expect(err.Error()).To(Equal("unknown TYPE: some-type. Allowed values: mesh, dataplane, healthcheck, proxytemplate, traffic-log, traffic-permission, traffic-route, traffic-trace, fault-injection, retry, timeout, circuit-breaker, external-service"))
 			// and
-			Expect(outbuf.String()).To(MatchRegexp(`unknown TYPE: some-type. Allowed values: mesh, dataplane, healthcheck, proxytemplate, traffic-log, traffic-permission, traffic-route, traffic-trace, fault-injection`))
+			Expect(outbuf.String()).To(MatchRegexp(`unknown TYPE: some-type. Allowed values: mesh, dataplane, healthcheck, proxytemplate, traffic-log, traffic-permission, traffic-route, traffic-trace, fault-injection, retry, timeout, circuit-breaker, external-service`))
 			// and
 			Expect(errbuf.Bytes()).To(BeEmpty())
 		})
@@ -183,6 +183,30 @@ var _ = Describe("kumactl delete ", func() {
 					resource:        func() core_model.Resource { return &mesh_core.FaultInjectionResource{} },
 					expectedMessage: "deleted FaultInjection \"web\"\n",
 				}),
+				Entry("retries", testCase{
+					typ:             "retry",
+					name:            "web-to-backend",
+					resource:        func() core_model.Resource { return &mesh_core.RetryResource{} },
+					expectedMessage: "deleted Retry \"web-to-backend\"\n",
+				}),
+				Entry("timeouts", testCase{
+					typ:             "timeout",
+					name:            "web-to-backend",
+					resource:        func() core_model.Resource { return &mesh_core.TimeoutResource{} },
+					expectedMessage: "deleted Timeout \"web-to-backend\"\n",
+				}),
+				Entry("circuit-breakers", testCase{
+					typ:             "circuit-breaker",
+					name:            "web-to-backend",
+					resource:        func() core_model.Resource { return &mesh_core.CircuitBreakerResource{} },
+					expectedMessage: "deleted CircuitBreaker \"web-to-backend\"\n",
+				}),
+				Entry("external-services", testCase{
+					typ:             "external-service",
+					name:            "httpbin",
+					resource:        func() core_model.Resource { return &mesh_core.ExternalServiceResource{} },
+					expectedMessage: "deleted ExternalService \"httpbin\"\n",
+				}),
 			)
 
 			DescribeTable("should fail if resource doesn't exist",
@@ -244,6 +268,30 @@ var _ = Describe("kumactl delete ", func() {
 					resource:        func() core_model.Resource { return &mesh_core.FaultInjectionResource{} },
 					expectedMessage: "Error: there is no FaultInjection with name \"web\"\n",
 				}),
+				Entry("retries", testCase{
+					typ:             "retry",
+					name:            "web-to-backend",
+					resource:        func() core_model.Resource { return &mesh_core.RetryResource{} },
+					expectedMessage: "Error: there is no Retry with name \"web-to-backend\"\n",
+				}),
+				Entry("timeouts", testCase{
+					typ:             "timeout",
+					name:            "web-to-backend",
+					resource:        func() core_model.Resource { return &mesh_core.TimeoutResource{} },
+					expectedMessage: "Error: there is no Timeout with name \"web-to-backend\"\n",
+				}),
+				Entry("circuit-breakers", testCase{
+					typ:             "circuit-breaker",
+					name:            "web-to-backend",
+					resource:        func() core_model.Resource { return &mesh_core.CircuitBreakerResource{} },
+					expectedMessage: "Error: there is no CircuitBreaker with name \"web-to-backend\"\n",
+				}),
+				Entry("external-services", testCase{
+					typ:             "external-service",
+					name:            "httpbin",
+					resource:        func() core_model.Resource { return &mesh_core.ExternalServiceResource{} },
+					expectedMessage: "Error: there is no ExternalService with name \"httpbin\"\n",
+				}),
 			)
 		})
 	})
@@ -44,6 +44,10 @@ func NewGetCmd(pctx *kumactl_cmd.RootContext) *cobra.Command {
 	cmd.AddCommand(withPaginationArgs(newGetTrafficLogsCmd(listCtx), listCtx))
 	cmd.AddCommand(withPaginationArgs(newGetTrafficTracesCmd(listCtx), listCtx))
 	cmd.AddCommand(withPaginationArgs(newGetFaultInjectionsCmd(listCtx), listCtx))
+	cmd.AddCommand(withPaginationArgs(newGetRetriesCmd(listCtx), listCtx))
+	cmd.AddCommand(withPaginationArgs(newGetTimeoutsCmd(listCtx), listCtx))
+	cmd.AddCommand(withPaginationArgs(newGetCircuitBreakersCmd(listCtx), listCtx))
+	cmd.AddCommand(withPaginationArgs(newGetExternalServicesCmd(listCtx), listCtx))
 
 	cmd.AddCommand(newGetFaultInjectionCmd(ctx))
 	cmd.AddCommand(newGetMeshCmd(ctx))
@@ -54,6 +58,10 @@ func NewGetCmd(pctx *kumactl_cmd.RootContext) *cobra.Command {
 	cmd.AddCommand(newGetTrafficPermissionCmd(ctx))
 	cmd.AddCommand(newGetTrafficRouteCmd(ctx))
 	cmd.AddCommand(newGetTrafficTraceCmd(ctx))
+	cmd.AddCommand(newGetRetryCmd(ctx))
+	cmd.AddCommand(newGetTimeoutCmd(ctx))
+	cmd.AddCommand(newGetCircuitBreakerCmd(ctx))
+	cmd.AddCommand(newGetExternalServiceCmd(ctx))
 	return cmd
 }
 
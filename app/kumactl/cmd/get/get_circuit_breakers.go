@@ -0,0 +1,71 @@
+package get
+
+import (
+	"context"
+	"io"
+
+	"github.com/Kong/kuma/app/kumactl/pkg/output/table"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/Kong/kuma/app/kumactl/pkg/output"
+	"github.com/Kong/kuma/app/kumactl/pkg/output/printers"
+	mesh_core "github.com/Kong/kuma/pkg/core/resources/apis/mesh"
+	rest_types "github.com/Kong/kuma/pkg/core/resources/model/rest"
+	core_store "github.com/Kong/kuma/pkg/core/resources/store"
+)
+
+func newGetCircuitBreakersCmd(pctx *listContext) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "circuit-breakers",
+		Short: "Show CircuitBreakers",
+		Long:  `Show CircuitBreakers.`,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			rs, err := pctx.CurrentResourceStore()
+			if err != nil {
+				return err
+			}
+
+			circuitBreakers := &mesh_core.CircuitBreakerResourceList{}
+			if err := rs.List(context.Background(), circuitBreakers, core_store.ListByMesh(pctx.CurrentMesh()), core_store.ListByPage(pctx.args.size, pctx.args.offset)); err != nil {
+				return errors.Wrapf(err, "failed to list CircuitBreakers")
+			}
+
+			switch format := output.Format(pctx.getContext.args.outputFormat); format {
+			case output.TableFormat:
+				return printCircuitBreakers(circuitBreakers, cmd.OutOrStdout())
+			default:
+				printer, err := printers.NewGenericPrinter(format)
+				if err != nil {
+					return err
+				}
+				return printer.Print(rest_types.From.ResourceList(circuitBreakers), cmd.OutOrStdout())
+			}
+		},
+	}
+	return cmd
+}
+
+func printCircuitBreakers(circuitBreakers *mesh_core.CircuitBreakerResourceList, out io.Writer) error {
+	data := printers.Table{
+		Headers: []string{"MESH", "NAME"},
+		NextRow: func() func() []string {
+			i := 0
+			return func() []string {
+				defer func() { i++ }()
+				if len(circuitBreakers.Items) <= i {
+					return nil
+				}
+				circuitBreaker := circuitBreakers.Items[i]
+
+				return []string{
+					circuitBreaker.Meta.GetMesh(), // MESH
+					circuitBreaker.Meta.GetName(), // NAME
+				}
+			}
+		}(),
+		Footer: table.PaginationFooter(circuitBreakers),
+	}
+	return printers.NewTablePrinter().Print(data, out)
+}
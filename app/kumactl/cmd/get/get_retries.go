@@ -0,0 +1,71 @@
+package get
+
+import (
+	"context"
+	"io"
+
+	"github.com/Kong/kuma/app/kumactl/pkg/output/table"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/Kong/kuma/app/kumactl/pkg/output"
+	"github.com/Kong/kuma/app/kumactl/pkg/output/printers"
+	mesh_core "github.com/Kong/kuma/pkg/core/resources/apis/mesh"
+	rest_types "github.com/Kong/kuma/pkg/core/resources/model/rest"
+	core_store "github.com/Kong/kuma/pkg/core/resources/store"
+)
+
+func newGetRetriesCmd(pctx *listContext) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "retries",
+		Short: "Show Retries",
+		Long:  `Show Retries.`,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			rs, err := pctx.CurrentResourceStore()
+			if err != nil {
+				return err
+			}
+
+			retries := &mesh_core.RetryResourceList{}
+			if err := rs.List(context.Background(), retries, core_store.ListByMesh(pctx.CurrentMesh()), core_store.ListByPage(pctx.args.size, pctx.args.offset)); err != nil {
+				return errors.Wrapf(err, "failed to list Retries")
+			}
+
+			switch format := output.Format(pctx.getContext.args.outputFormat); format {
+			case output.TableFormat:
+				return printRetries(retries, cmd.OutOrStdout())
+			default:
+				printer, err := printers.NewGenericPrinter(format)
+				if err != nil {
+					return err
+				}
+				return printer.Print(rest_types.From.ResourceList(retries), cmd.OutOrStdout())
+			}
+		},
+	}
+	return cmd
+}
+
+func printRetries(retries *mesh_core.RetryResourceList, out io.Writer) error {
+	data := printers.Table{
+		Headers: []string{"MESH", "NAME"},
+		NextRow: func() func() []string {
+			i := 0
+			return func() []string {
+				defer func() { i++ }()
+				if len(retries.Items) <= i {
+					return nil
+				}
+				retry := retries.Items[i]
+
+				return []string{
+					retry.Meta.GetMesh(), // MESH
+					retry.Meta.GetName(), // NAME
+				}
+			}
+		}(),
+		Footer: table.PaginationFooter(retries),
+	}
+	return printers.NewTablePrinter().Print(data, out)
+}
@@ -53,6 +53,10 @@ var _ = Describe("kumactl get [resource] NAME", func() {
 		Entry("traffic-permission", "traffic-permission"),
 		Entry("traffic-route", "traffic-route"),
 		Entry("traffic-trace", "traffic-trace"),
+		Entry("retry", "retry"),
+		Entry("timeout", "timeout"),
+		Entry("circuit-breaker", "circuit-breaker"),
+		Entry("external-service", "external-service"),
 	}
 
 	DescribeTable("should throw an error in case of no args",
@@ -0,0 +1,71 @@
+package get
+
+import (
+	"context"
+	"io"
+
+	"github.com/Kong/kuma/app/kumactl/pkg/output/table"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/Kong/kuma/app/kumactl/pkg/output"
+	"github.com/Kong/kuma/app/kumactl/pkg/output/printers"
+	mesh_core "github.com/Kong/kuma/pkg/core/resources/apis/mesh"
+	rest_types "github.com/Kong/kuma/pkg/core/resources/model/rest"
+	core_store "github.com/Kong/kuma/pkg/core/resources/store"
+)
+
+func newGetTimeoutsCmd(pctx *listContext) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "timeouts",
+		Short: "Show Timeouts",
+		Long:  `Show Timeouts.`,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			rs, err := pctx.CurrentResourceStore()
+			if err != nil {
+				return err
+			}
+
+			timeouts := &mesh_core.TimeoutResourceList{}
+			if err := rs.List(context.Background(), timeouts, core_store.ListByMesh(pctx.CurrentMesh()), core_store.ListByPage(pctx.args.size, pctx.args.offset)); err != nil {
+				return errors.Wrapf(err, "failed to list Timeouts")
+			}
+
+			switch format := output.Format(pctx.getContext.args.outputFormat); format {
+			case output.TableFormat:
+				return printTimeouts(timeouts, cmd.OutOrStdout())
+			default:
+				printer, err := printers.NewGenericPrinter(format)
+				if err != nil {
+					return err
+				}
+				return printer.Print(rest_types.From.ResourceList(timeouts), cmd.OutOrStdout())
+			}
+		},
+	}
+	return cmd
+}
+
+func printTimeouts(timeouts *mesh_core.TimeoutResourceList, out io.Writer) error {
+	data := printers.Table{
+		Headers: []string{"MESH", "NAME"},
+		NextRow: func() func() []string {
+			i := 0
+			return func() []string {
+				defer func() { i++ }()
+				if len(timeouts.Items) <= i {
+					return nil
+				}
+				timeout := timeouts.Items[i]
+
+				return []string{
+					timeout.Meta.GetMesh(), // MESH
+					timeout.Meta.GetName(), // NAME
+				}
+			}
+		}(),
+		Footer: table.PaginationFooter(timeouts),
+	}
+	return printers.NewTablePrinter().Print(data, out)
+}
@@ -27,5 +27,7 @@ func NewInspectCmd(pctx *kumactl_cmd.RootContext) *cobra.Command {
 	cmd.PersistentFlags().StringVarP(&ctx.args.outputFormat, "output", "o", string(output.TableFormat), kuma_cmd.UsageOptions("output format", output.TableFormat, output.YAMLFormat, output.JSONFormat))
 	// sub-commands
 	cmd.AddCommand(newInspectDataplanesCmd(ctx))
+	cmd.AddCommand(newInspectDataplaneCmd(ctx))
+	cmd.AddCommand(newInspectPolicyCmds(ctx)...)
 	return cmd
 }
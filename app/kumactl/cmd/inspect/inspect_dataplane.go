@@ -0,0 +1,150 @@
+package inspect
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/Kong/kuma/app/kumactl/pkg/output"
+	"github.com/Kong/kuma/app/kumactl/pkg/output/printers"
+	"github.com/Kong/kuma/pkg/api-server/types"
+)
+
+type inspectDataplaneContext struct {
+	*inspectContext
+
+	args struct {
+		policies   bool
+		configDump bool
+		clusters   bool
+		stats      bool
+	}
+}
+
+// envoyAdminResource returns a resource of the Envoy Admin API requested by flags, if any.
+func (c *inspectDataplaneContext) envoyAdminResource() (string, bool) {
+	switch {
+	case c.args.configDump:
+		return "config-dump", true
+	case c.args.clusters:
+		return "clusters", true
+	case c.args.stats:
+		return "stats", true
+	}
+	return "", false
+}
+
+func (c *inspectDataplaneContext) selectedViews() int {
+	selected := 0
+	for _, view := range []bool{c.args.policies, c.args.configDump, c.args.clusters, c.args.stats} {
+		if view {
+			selected++
+		}
+	}
+	return selected
+}
+
+func newInspectDataplaneCmd(pctx *inspectContext) *cobra.Command {
+	ctx := inspectDataplaneContext{
+		inspectContext: pctx,
+	}
+	cmd := &cobra.Command{
+		Use:   "dataplane NAME",
+		Short: "Inspect Dataplane",
+		Long: `Inspect Dataplane.
+
+Envoy config dump, clusters and stats are retrieved through the Admin Server of the Control Plane.
+If the Control Plane runs in several instances, only Dataplanes connected to the instance kumactl
+talks to can be inspected this way. Other Dataplanes are reported as not connected.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if ctx.selectedViews() != 1 {
+				return errors.New("exactly one of --policies, --config-dump, --clusters or --stats must be specified")
+			}
+			if resource, ok := ctx.envoyAdminResource(); ok {
+				// Envoy Admin API is served by the Admin Server, since config dumps and stats reveal the whole configuration of a dataplane
+				client, err := pctx.CurrentEnvoyAdminClient()
+				if err != nil {
+					return errors.Wrap(err, "failed to create an envoy admin client")
+				}
+				content, err := client.Get(context.Background(), pctx.CurrentMesh(), args[0], resource)
+				if err != nil {
+					return err
+				}
+				_, err = cmd.OutOrStdout().Write(content)
+				return err
+			}
+			client, err := pctx.CurrentInspectClient()
+			if err != nil {
+				return errors.Wrap(err, "failed to create an inspect client")
+			}
+			policies, err := client.DataplanePolicies(context.Background(), pctx.CurrentMesh(), args[0])
+			if err != nil {
+				return err
+			}
+
+			switch format := output.Format(pctx.args.outputFormat); format {
+			case output.TableFormat:
+				return printDataplanePolicies(policies, cmd.OutOrStdout())
+			default:
+				printer, err := printers.NewGenericPrinter(format)
+				if err != nil {
+					return err
+				}
+				return printer.Print(policies, cmd.OutOrStdout())
+			}
+		},
+	}
+	cmd.PersistentFlags().BoolVarP(&ctx.args.policies, "policies", "", false, "list policies selected for each interface of a Dataplane")
+	cmd.PersistentFlags().BoolVarP(&ctx.args.configDump, "config-dump", "", false, "print Envoy config dump of a Dataplane retrieved through the Control Plane")
+	cmd.PersistentFlags().BoolVarP(&ctx.args.clusters, "clusters", "", false, "print Envoy clusters of a Dataplane retrieved through the Control Plane")
+	cmd.PersistentFlags().BoolVarP(&ctx.args.stats, "stats", "", false, "print Envoy stats of a Dataplane retrieved through the Control Plane")
+	return cmd
+}
+
+func printDataplanePolicies(policies *types.DataplanePolicies, out io.Writer) error {
+	var rows [][]string
+	add := func(iface string, service string, policyType string, match *types.PolicyMatch) {
+		if match == nil {
+			return
+		}
+		rows = append(rows, []string{iface, service, policyType, match.Name, formatRank(match.Rank)})
+	}
+	for _, inbound := range policies.Inbound {
+		iface := "inbound " + inbound.Interface
+		add(iface, inbound.Service, "TrafficPermission", inbound.TrafficPermission)
+		add(iface, inbound.Service, "TrafficLog", inbound.TrafficLog)
+		add(iface, inbound.Service, "FaultInjection", inbound.FaultInjection)
+	}
+	for _, outbound := range policies.Outbound {
+		iface := "outbound " + outbound.Interface
+		add(iface, outbound.Service, "TrafficRoute", outbound.TrafficRoute)
+		add(iface, outbound.Service, "TrafficLog", outbound.TrafficLog)
+		add(iface, outbound.Service, "HealthCheck", outbound.HealthCheck)
+		add(iface, outbound.Service, "FaultInjection", outbound.FaultInjection)
+	}
+	add("-", "-", "TrafficTrace", policies.TrafficTrace)
+	add("-", "-", "ProxyTemplate", policies.ProxyTemplate)
+
+	data := printers.Table{
+		Headers: []string{"INTERFACE", "SERVICE", "POLICY", "NAME", "RANK"},
+		NextRow: func() func() []string {
+			i := 0
+			return func() []string {
+				defer func() { i++ }()
+				if len(rows) <= i {
+					return nil
+				}
+				return rows[i]
+			}
+		}(),
+	}
+	return printers.NewTablePrinter().Print(data, out)
+}
+
+func formatRank(rank types.PolicyRank) string {
+	return fmt.Sprintf("exact=%d wildcard=%d", rank.ExactMatches, rank.WildcardMatches)
+}
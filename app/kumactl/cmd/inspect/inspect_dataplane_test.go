@@ -0,0 +1,212 @@
+package inspect_test
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	gomega_types "github.com/onsi/gomega/types"
+	"github.com/spf13/cobra"
+
+	"github.com/Kong/kuma/app/kumactl/cmd"
+	kumactl_cmd "github.com/Kong/kuma/app/kumactl/pkg/cmd"
+	envoy_admin "github.com/Kong/kuma/app/kumactl/pkg/envoy-admin"
+	"github.com/Kong/kuma/app/kumactl/pkg/resources"
+	"github.com/Kong/kuma/pkg/api-server/types"
+	"github.com/Kong/kuma/pkg/catalog"
+	catalog_client "github.com/Kong/kuma/pkg/catalog/client"
+	config_proto "github.com/Kong/kuma/pkg/config/app/kumactl/v1alpha1"
+	core_model "github.com/Kong/kuma/pkg/core/resources/model"
+	test_catalog "github.com/Kong/kuma/pkg/test/catalog"
+)
+
+type testInspectClient struct {
+	receivedType core_model.ResourceType
+	receivedMesh string
+	receivedName string
+	policies     *types.DataplanePolicies
+	dataplanes   *types.PolicyDataplanes
+}
+
+func (c *testInspectClient) DataplanePolicies(_ context.Context, meshName string, name string) (*types.DataplanePolicies, error) {
+	c.receivedMesh = meshName
+	c.receivedName = name
+	return c.policies, nil
+}
+
+func (c *testInspectClient) PolicyDataplanes(_ context.Context, resourceType core_model.ResourceType, meshName string, name string) (*types.PolicyDataplanes, error) {
+	c.receivedType = resourceType
+	c.receivedMesh = meshName
+	c.receivedName = name
+	return c.dataplanes, nil
+}
+
+var _ resources.InspectClient = &testInspectClient{}
+
+type testEnvoyAdminClient struct {
+	receivedMesh     string
+	receivedName     string
+	receivedResource string
+	content          []byte
+}
+
+func (c *testEnvoyAdminClient) Get(_ context.Context, meshName string, name string, resource string) ([]byte, error) {
+	c.receivedMesh = meshName
+	c.receivedName = name
+	c.receivedResource = resource
+	return c.content, nil
+}
+
+var _ envoy_admin.EnvoyAdminClient = &testEnvoyAdminClient{}
+
+var _ = Describe("kumactl inspect dataplane", func() {
+
+	var rootCmd *cobra.Command
+	var buf *bytes.Buffer
+	var testClient *testInspectClient
+	var testEnvoyAdmin *testEnvoyAdminClient
+
+	BeforeEach(func() {
+		// setup
+		testClient = &testInspectClient{
+			policies: &types.DataplanePolicies{
+				Mesh: "default",
+				Name: "backend-01",
+				Inbound: []types.InboundPolicies{
+					{
+						Interface:         "192.168.0.1:80:8080",
+						Service:           "backend",
+						TrafficPermission: &types.PolicyMatch{Name: "allow-web", Rank: types.PolicyRank{ExactMatches: 2}},
+						TrafficLog:        &types.PolicyMatch{Name: "log-all", Rank: types.PolicyRank{WildcardMatches: 1}},
+					},
+				},
+				Outbound: []types.OutboundPolicies{
+					{
+						Interface:      "127.0.0.1:54321",
+						Service:        "db",
+						TrafficRoute:   &types.PolicyMatch{Name: "route-db", Rank: types.PolicyRank{ExactMatches: 1, WildcardMatches: 1}},
+						HealthCheck:    &types.PolicyMatch{Name: "db-health", Rank: types.PolicyRank{ExactMatches: 2}},
+						FaultInjection: &types.PolicyMatch{Name: "slow-db", Rank: types.PolicyRank{ExactMatches: 2}},
+					},
+				},
+				TrafficTrace: &types.PolicyMatch{Name: "trace-backend", Rank: types.PolicyRank{ExactMatches: 1}},
+			},
+		}
+		testEnvoyAdmin = &testEnvoyAdminClient{
+			content: []byte(`{"configs": []}`),
+		}
+
+		rootCtx := &kumactl_cmd.RootContext{
+			Runtime: kumactl_cmd.RootRuntime{
+				NewInspectClient: func(*config_proto.ControlPlaneCoordinates_ApiServer) (resources.InspectClient, error) {
+					return testClient, nil
+				},
+				NewEnvoyAdminClient: func(string, *config_proto.Context_AdminApiCredentials) (envoy_admin.EnvoyAdminClient, error) {
+					return testEnvoyAdmin, nil
+				},
+				NewCatalogClient: func(string) (catalog_client.CatalogClient, error) {
+					return &test_catalog.StaticCatalogClient{
+						Resp: catalog.Catalog{
+							Apis: catalog.Apis{
+								Admin: catalog.AdminApi{
+									LocalUrl: "http://localhost:1234",
+								},
+							},
+						},
+					}, nil
+				},
+			},
+		}
+
+		rootCmd = cmd.NewRootCmd(rootCtx)
+		buf = &bytes.Buffer{}
+		rootCmd.SetOut(buf)
+	})
+
+	type testCase struct {
+		outputFormat string
+		goldenFile   string
+		matcher      func(interface{}) gomega_types.GomegaMatcher
+	}
+
+	DescribeTable("kumactl inspect dataplane NAME --policies -o table|json|yaml",
+		func(given testCase) {
+			// given
+			rootCmd.SetArgs(append([]string{
+				"--config-file", filepath.Join("..", "testdata", "sample-kumactl.config.yaml"),
+				"inspect", "dataplane", "backend-01", "--policies", "--mesh", "demo"}, given.outputFormat))
+
+			// when
+			err := rootCmd.Execute()
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			// and
+			Expect(testClient.receivedMesh).To(Equal("demo"))
+			Expect(testClient.receivedName).To(Equal("backend-01"))
+
+			// when
+			expected, err := ioutil.ReadFile(filepath.Join("testdata", given.goldenFile))
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			// and
+			Expect(buf.String()).To(given.matcher(expected))
+		},
+		Entry("should support Table output", testCase{
+			outputFormat: "-otable",
+			goldenFile:   "inspect-dataplane-policies.golden.txt",
+			matcher: func(expected interface{}) gomega_types.GomegaMatcher {
+				return WithTransform(strings.TrimSpace, Equal(strings.TrimSpace(string(expected.([]byte)))))
+			},
+		}),
+		Entry("should support JSON output", testCase{
+			outputFormat: "-ojson",
+			goldenFile:   "inspect-dataplane-policies.golden.json",
+			matcher:      MatchJSON,
+		}),
+	)
+
+	DescribeTable("kumactl inspect dataplane NAME --config-dump|--clusters|--stats",
+		func(flag string, resource string) {
+			// given
+			rootCmd.SetArgs([]string{
+				"inspect", "dataplane", "backend-01", flag, "--mesh", "demo"})
+
+			// when
+			err := rootCmd.Execute()
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			// and
+			Expect(testEnvoyAdmin.receivedMesh).To(Equal("demo"))
+			Expect(testEnvoyAdmin.receivedName).To(Equal("backend-01"))
+			Expect(testEnvoyAdmin.receivedResource).To(Equal(resource))
+			// and
+			Expect(buf.String()).To(Equal(`{"configs": []}`))
+		},
+		Entry("should print config dump", "--config-dump", "config-dump"),
+		Entry("should print clusters", "--clusters", "clusters"),
+		Entry("should print stats", "--stats", "stats"),
+	)
+
+	DescribeTable("should require exactly one of --policies, --config-dump, --clusters or --stats",
+		func(flags []string) {
+			// given
+			rootCmd.SetArgs(append([]string{
+				"--config-file", filepath.Join("..", "testdata", "sample-kumactl.config.yaml"),
+				"inspect", "dataplane", "backend-01"}, flags...))
+			rootCmd.SetErr(&bytes.Buffer{})
+
+			// when
+			err := rootCmd.Execute()
+
+			// then
+			Expect(err).To(MatchError("exactly one of --policies, --config-dump, --clusters or --stats must be specified"))
+		},
+		Entry("no flags", []string{}),
+		Entry("several flags", []string{"--policies", "--config-dump"}),
+	)
+})
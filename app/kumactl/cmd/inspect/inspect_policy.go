@@ -0,0 +1,95 @@
+package inspect
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/Kong/kuma/app/kumactl/pkg/output"
+	"github.com/Kong/kuma/app/kumactl/pkg/output/printers"
+	"github.com/Kong/kuma/pkg/api-server/types"
+	"github.com/Kong/kuma/pkg/core/resources/apis/mesh"
+	core_model "github.com/Kong/kuma/pkg/core/resources/model"
+)
+
+func newInspectPolicyCmds(pctx *inspectContext) []*cobra.Command {
+	return []*cobra.Command{
+		newInspectPolicyCmd(pctx, "traffic-permission", mesh.TrafficPermissionType),
+		newInspectPolicyCmd(pctx, "traffic-log", mesh.TrafficLogType),
+		newInspectPolicyCmd(pctx, "traffic-route", mesh.TrafficRouteType),
+		newInspectPolicyCmd(pctx, "healthcheck", mesh.HealthCheckType),
+		newInspectPolicyCmd(pctx, "fault-injection", mesh.FaultInjectionType),
+		newInspectPolicyCmd(pctx, "traffic-trace", mesh.TrafficTraceType),
+		newInspectPolicyCmd(pctx, "proxytemplate", mesh.ProxyTemplateType),
+	}
+}
+
+func newInspectPolicyCmd(pctx *inspectContext, use string, resourceType core_model.ResourceType) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   use + " NAME",
+		Short: fmt.Sprintf("Inspect Dataplanes matched by a %s", resourceType),
+		Long:  fmt.Sprintf("Inspect Dataplanes matched by a %s.\n\nLists interfaces of Dataplanes where the %s is selected, as well as interfaces where it matches but a more specific %s is selected instead.", resourceType, resourceType, resourceType),
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := pctx.CurrentInspectClient()
+			if err != nil {
+				return errors.Wrap(err, "failed to create an inspect client")
+			}
+			dataplanes, err := client.PolicyDataplanes(context.Background(), resourceType, pctx.CurrentMesh(), args[0])
+			if err != nil {
+				return err
+			}
+
+			switch format := output.Format(pctx.args.outputFormat); format {
+			case output.TableFormat:
+				return printPolicyDataplanes(dataplanes, cmd.OutOrStdout())
+			default:
+				printer, err := printers.NewGenericPrinter(format)
+				if err != nil {
+					return err
+				}
+				return printer.Print(dataplanes, cmd.OutOrStdout())
+			}
+		},
+	}
+	return cmd
+}
+
+func printPolicyDataplanes(dataplanes *types.PolicyDataplanes, out io.Writer) error {
+	var rows [][]string
+	add := func(status string, attachment types.PolicyAttachment) {
+		iface, service, shadowedBy := "-", "-", "-"
+		if attachment.Direction != "" {
+			iface = attachment.Direction + " " + attachment.Interface
+			service = attachment.Service
+		}
+		if attachment.ShadowedBy != nil {
+			shadowedBy = fmt.Sprintf("%s (%s)", attachment.ShadowedBy.Name, formatRank(attachment.ShadowedBy.Rank))
+		}
+		rows = append(rows, []string{status, attachment.Dataplane, iface, service, formatRank(attachment.Rank), shadowedBy})
+	}
+	for _, attachment := range dataplanes.Winning {
+		add("winning", attachment)
+	}
+	for _, attachment := range dataplanes.Shadowed {
+		add("shadowed", attachment)
+	}
+
+	data := printers.Table{
+		Headers: []string{"STATUS", "DATAPLANE", "INTERFACE", "SERVICE", "RANK", "SHADOWED BY"},
+		NextRow: func() func() []string {
+			i := 0
+			return func() []string {
+				defer func() { i++ }()
+				if len(rows) <= i {
+					return nil
+				}
+				return rows[i]
+			}
+		}(),
+	}
+	return printers.NewTablePrinter().Print(data, out)
+}
@@ -0,0 +1,140 @@
+package inspect_test
+
+import (
+	"bytes"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	gomega_types "github.com/onsi/gomega/types"
+	"github.com/spf13/cobra"
+
+	"github.com/Kong/kuma/app/kumactl/cmd"
+	kumactl_cmd "github.com/Kong/kuma/app/kumactl/pkg/cmd"
+	"github.com/Kong/kuma/app/kumactl/pkg/resources"
+	"github.com/Kong/kuma/pkg/api-server/types"
+	config_proto "github.com/Kong/kuma/pkg/config/app/kumactl/v1alpha1"
+	"github.com/Kong/kuma/pkg/core/resources/apis/mesh"
+)
+
+var _ = Describe("kumactl inspect POLICY", func() {
+
+	var rootCmd *cobra.Command
+	var buf *bytes.Buffer
+	var testClient *testInspectClient
+
+	BeforeEach(func() {
+		// setup
+		testClient = &testInspectClient{
+			dataplanes: &types.PolicyDataplanes{
+				Mesh: "demo",
+				Type: "TrafficPermission",
+				Name: "allow-all",
+				Winning: []types.PolicyAttachment{
+					{
+						Dataplane: "web-01",
+						Direction: "inbound",
+						Interface: "192.168.0.2:80:8080",
+						Service:   "web",
+						Rank:      types.PolicyRank{WildcardMatches: 1},
+					},
+				},
+				Shadowed: []types.PolicyAttachment{
+					{
+						Dataplane:  "backend-01",
+						Direction:  "inbound",
+						Interface:  "192.168.0.1:80:8080",
+						Service:    "backend",
+						Rank:       types.PolicyRank{WildcardMatches: 1},
+						ShadowedBy: &types.PolicyMatch{Name: "allow-web", Rank: types.PolicyRank{ExactMatches: 2}},
+					},
+				},
+			},
+		}
+
+		rootCtx := &kumactl_cmd.RootContext{
+			Runtime: kumactl_cmd.RootRuntime{
+				NewInspectClient: func(*config_proto.ControlPlaneCoordinates_ApiServer) (resources.InspectClient, error) {
+					return testClient, nil
+				},
+			},
+		}
+
+		rootCmd = cmd.NewRootCmd(rootCtx)
+		buf = &bytes.Buffer{}
+		rootCmd.SetOut(buf)
+	})
+
+	type testCase struct {
+		outputFormat string
+		goldenFile   string
+		matcher      func(interface{}) gomega_types.GomegaMatcher
+	}
+
+	DescribeTable("kumactl inspect traffic-permission NAME -o table|json|yaml",
+		func(given testCase) {
+			// given
+			rootCmd.SetArgs(append([]string{
+				"--config-file", filepath.Join("..", "testdata", "sample-kumactl.config.yaml"),
+				"inspect", "traffic-permission", "allow-all", "--mesh", "demo"}, given.outputFormat))
+
+			// when
+			err := rootCmd.Execute()
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			// and
+			Expect(testClient.receivedType).To(Equal(mesh.TrafficPermissionType))
+			Expect(testClient.receivedMesh).To(Equal("demo"))
+			Expect(testClient.receivedName).To(Equal("allow-all"))
+
+			// when
+			expected, err := ioutil.ReadFile(filepath.Join("testdata", given.goldenFile))
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			// and
+			Expect(buf.String()).To(given.matcher(expected))
+		},
+		Entry("should support Table output", testCase{
+			outputFormat: "-otable",
+			goldenFile:   "inspect-policy-dataplanes.golden.txt",
+			matcher: func(expected interface{}) gomega_types.GomegaMatcher {
+				return WithTransform(strings.TrimSpace, Equal(strings.TrimSpace(string(expected.([]byte)))))
+			},
+		}),
+		Entry("should support JSON output", testCase{
+			outputFormat: "-ojson",
+			goldenFile:   "inspect-policy-dataplanes.golden.json",
+			matcher:      MatchJSON,
+		}),
+	)
+
+	It("should inspect a policy of a given type", func() {
+		// given
+		testClient.dataplanes = &types.PolicyDataplanes{
+			Mesh: "demo",
+			Type: "TrafficTrace",
+			Name: "trace-all",
+			Winning: []types.PolicyAttachment{
+				{Dataplane: "web-01", Rank: types.PolicyRank{WildcardMatches: 1}},
+			},
+		}
+		rootCmd.SetArgs([]string{
+			"--config-file", filepath.Join("..", "testdata", "sample-kumactl.config.yaml"),
+			"inspect", "traffic-trace", "trace-all"})
+
+		// when
+		err := rootCmd.Execute()
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(testClient.receivedType).To(Equal(mesh.TrafficTraceType))
+		Expect(testClient.receivedMesh).To(Equal("default"))
+		// and
+		Expect(strings.TrimSpace(buf.String())).To(Equal(strings.TrimSpace(`
+STATUS    DATAPLANE   INTERFACE   SERVICE   RANK                 SHADOWED BY
+winning   web-01      -           -         exact=0 wildcard=1   -`)))
+	})
+})
@@ -10,6 +10,7 @@ import (
 
 	"github.com/Kong/kuma/app/kumactl/pkg/ca"
 	"github.com/Kong/kuma/app/kumactl/pkg/config"
+	envoy_admin "github.com/Kong/kuma/app/kumactl/pkg/envoy-admin"
 	kumactl_resources "github.com/Kong/kuma/app/kumactl/pkg/resources"
 	"github.com/Kong/kuma/app/kumactl/pkg/tokens"
 	"github.com/Kong/kuma/pkg/catalog"
@@ -31,9 +32,11 @@ type RootRuntime struct {
 	Now                        func() time.Time
 	NewResourceStore           func(*config_proto.ControlPlaneCoordinates_ApiServer) (core_store.ResourceStore, error)
 	NewDataplaneOverviewClient func(*config_proto.ControlPlaneCoordinates_ApiServer) (kumactl_resources.DataplaneOverviewClient, error)
+	NewInspectClient           func(*config_proto.ControlPlaneCoordinates_ApiServer) (kumactl_resources.InspectClient, error)
 	NewDataplaneTokenClient    func(string, *kumactl_config.Context_AdminApiCredentials) (tokens.DataplaneTokenClient, error)
 	NewCatalogClient           func(string) (catalog_client.CatalogClient, error)
 	NewProvidedCaClient        func(string, *kumactl_config.Context_AdminApiCredentials) (ca.ProvidedCaClient, error)
+	NewEnvoyAdminClient        func(string, *kumactl_config.Context_AdminApiCredentials) (envoy_admin.EnvoyAdminClient, error)
 }
 
 type RootContext struct {
@@ -47,9 +50,11 @@ func DefaultRootContext() *RootContext {
 			Now:                        time.Now,
 			NewResourceStore:           kumactl_resources.NewResourceStore,
 			NewDataplaneOverviewClient: kumactl_resources.NewDataplaneOverviewClient,
+			NewInspectClient:           kumactl_resources.NewInspectClient,
 			NewDataplaneTokenClient:    tokens.NewDataplaneTokenClient,
 			NewCatalogClient:           catalog_client.NewCatalogClient,
 			NewProvidedCaClient:        ca.NewProvidedCaClient,
+			NewEnvoyAdminClient:        envoy_admin.NewEnvoyAdminClient,
 		},
 	}
 }
@@ -120,6 +125,14 @@ func (rc *RootContext) CurrentDataplaneOverviewClient() (kumactl_resources.Datap
 	return rc.Runtime.NewDataplaneOverviewClient(controlPlane.Coordinates.ApiServer)
 }
 
+func (rc *RootContext) CurrentInspectClient() (kumactl_resources.InspectClient, error) {
+	controlPlane, err := rc.CurrentControlPlane()
+	if err != nil {
+		return nil, err
+	}
+	return rc.Runtime.NewInspectClient(controlPlane.Coordinates.ApiServer)
+}
+
 func (rc *RootContext) catalog() (catalog.Catalog, error) {
 	controlPlane, err := rc.CurrentControlPlane()
 	if err != nil {
@@ -238,3 +251,16 @@ func (rc *RootContext) CurrentProvidedCaClient() (ca.ProvidedCaClient, error) {
 	}
 	return rc.Runtime.NewProvidedCaClient(adminServerUrl, ctx.GetCredentials().GetAdminApi())
 }
+
+func (rc *RootContext) CurrentEnvoyAdminClient() (envoy_admin.EnvoyAdminClient, error) {
+	ctx, err := rc.CurrentContext()
+	if err != nil {
+		return nil, err
+	}
+
+	adminServerUrl, err := rc.adminServerUrl()
+	if err != nil {
+		return nil, err
+	}
+	return rc.Runtime.NewEnvoyAdminClient(adminServerUrl, ctx.GetCredentials().GetAdminApi())
+}
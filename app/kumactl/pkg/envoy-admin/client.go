@@ -0,0 +1,81 @@
+package envoy_admin
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/pkg/errors"
+
+	kumactl_config "github.com/Kong/kuma/pkg/config/app/kumactl/v1alpha1"
+	error_types "github.com/Kong/kuma/pkg/core/rest/errors/types"
+	util_http "github.com/Kong/kuma/pkg/util/http"
+)
+
+const (
+	// the Control Plane itself waits up to 10s for a response of kuma-dp
+	timeout = 20 * time.Second
+)
+
+// EnvoyAdminClient retrieves data from the Envoy Admin API of Dataplanes through the Admin Server of the Control Plane.
+type EnvoyAdminClient interface {
+	// Get retrieves raw data from the Envoy Admin API of a Dataplane, e.g. "config-dump", "clusters" or "stats".
+	Get(ctx context.Context, meshName string, name string, resource string) ([]byte, error)
+}
+
+func NewEnvoyAdminClient(address string, config *kumactl_config.Context_AdminApiCredentials) (EnvoyAdminClient, error) {
+	baseURL, err := url.Parse(address)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to parse the server URL")
+	}
+	httpClient := &http.Client{
+		Timeout: timeout,
+	}
+	if baseURL.Scheme == "https" {
+		if !config.HasClientCert() {
+			return nil, errors.New("certificates has to be configured to use https destination")
+		}
+		// Since we're not going to pass any secrets to the server, we can skip validating its identity.
+		if err := util_http.ConfigureTlsWithoutServerVerification(httpClient, config.ClientCert, config.ClientKey); err != nil {
+			return nil, errors.Wrap(err, "could not configure tls for envoy admin client")
+		}
+	}
+	client := util_http.ClientWithBaseURL(httpClient, baseURL)
+	return &httpEnvoyAdminClient{
+		client: client,
+	}, nil
+}
+
+type httpEnvoyAdminClient struct {
+	client util_http.Client
+}
+
+var _ EnvoyAdminClient = &httpEnvoyAdminClient{}
+
+func (h *httpEnvoyAdminClient) Get(ctx context.Context, meshName string, name string, resource string) ([]byte, error) {
+	req, err := http.NewRequest("GET", fmt.Sprintf("/meshes/%s/dataplanes/%s/%s", meshName, name, resource), nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not construct the request")
+	}
+	resp, err := h.client.Do(req.WithContext(ctx))
+	if err != nil {
+		return nil, errors.Wrap(err, "could not execute the request")
+	}
+	defer resp.Body.Close()
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not read a body of the response")
+	}
+	if resp.StatusCode != 200 {
+		kumaErr := error_types.Error{}
+		if err := json.Unmarshal(body, &kumaErr); err == nil && kumaErr.Title != "" && kumaErr.Details != "" {
+			return nil, &kumaErr
+		}
+		return nil, errors.Errorf("unexpected status code %d. Expected 200", resp.StatusCode)
+	}
+	return body, nil
+}
@@ -0,0 +1,13 @@
+package envoy_admin_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestEnvoyAdminClient(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Envoy Admin Client Suite")
+}
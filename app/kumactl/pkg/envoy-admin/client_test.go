@@ -0,0 +1,74 @@
+package envoy_admin_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	envoy_admin "github.com/Kong/kuma/app/kumactl/pkg/envoy-admin"
+)
+
+var _ = Describe("Envoy Admin Client", func() {
+
+	var server *httptest.Server
+	var mux *http.ServeMux
+
+	BeforeEach(func() {
+		mux = http.NewServeMux()
+		server = httptest.NewServer(mux)
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	It("should request raw data from the Envoy Admin API of a dataplane", func() {
+		// given
+		mux.HandleFunc("/meshes/default/dataplanes/backend-01/config-dump", func(writer http.ResponseWriter, req *http.Request) {
+			_, _ = writer.Write([]byte(`{"configs": []}`))
+		})
+		client, err := envoy_admin.NewEnvoyAdminClient(server.URL, nil)
+		Expect(err).ToNot(HaveOccurred())
+
+		// when
+		content, err := client.Get(context.Background(), "default", "backend-01", "config-dump")
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(content)).To(Equal(`{"configs": []}`))
+	})
+
+	It("should return an error of the Admin Server", func() {
+		// given
+		mux.HandleFunc("/meshes/default/dataplanes/backend-01/config-dump", func(writer http.ResponseWriter, req *http.Request) {
+			writer.WriteHeader(http.StatusServiceUnavailable)
+			_, _ = writer.Write([]byte(`{"title": "Could not retrieve config dump of a dataplane", "details": "Dataplane is not connected to this instance of the Control Plane"}`))
+		})
+		client, err := envoy_admin.NewEnvoyAdminClient(server.URL, nil)
+		Expect(err).ToNot(HaveOccurred())
+
+		// when
+		_, err = client.Get(context.Background(), "default", "backend-01", "config-dump")
+
+		// then
+		Expect(err).To(MatchError("Could not retrieve config dump of a dataplane (Dataplane is not connected to this instance of the Control Plane)"))
+	})
+
+	It("should return an error when status code is different than 200", func() {
+		// given
+		mux.HandleFunc("/meshes/default/dataplanes/backend-01/stats", func(writer http.ResponseWriter, req *http.Request) {
+			writer.WriteHeader(500)
+		})
+		client, err := envoy_admin.NewEnvoyAdminClient(server.URL, nil)
+		Expect(err).ToNot(HaveOccurred())
+
+		// when
+		_, err = client.Get(context.Background(), "default", "backend-01", "stats")
+
+		// then
+		Expect(err).To(MatchError("unexpected status code 500. Expected 200"))
+	})
+})
@@ -0,0 +1,81 @@
+package resources
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+
+	"github.com/pkg/errors"
+
+	kuma_rest "github.com/Kong/kuma/pkg/api-server/definitions"
+	"github.com/Kong/kuma/pkg/api-server/types"
+	config_proto "github.com/Kong/kuma/pkg/config/app/kumactl/v1alpha1"
+	core_model "github.com/Kong/kuma/pkg/core/resources/model"
+	error_types "github.com/Kong/kuma/pkg/core/rest/errors/types"
+	kuma_http "github.com/Kong/kuma/pkg/util/http"
+)
+
+type InspectClient interface {
+	DataplanePolicies(ctx context.Context, meshName string, name string) (*types.DataplanePolicies, error)
+	PolicyDataplanes(ctx context.Context, resourceType core_model.ResourceType, meshName string, name string) (*types.PolicyDataplanes, error)
+}
+
+func NewInspectClient(coordinates *config_proto.ControlPlaneCoordinates_ApiServer) (InspectClient, error) {
+	client, err := apiServerClient(coordinates.Url)
+	if err != nil {
+		return nil, err
+	}
+	return &httpInspectClient{
+		Client: client,
+	}, nil
+}
+
+type httpInspectClient struct {
+	Client kuma_http.Client
+}
+
+func (c *httpInspectClient) DataplanePolicies(ctx context.Context, meshName string, name string) (*types.DataplanePolicies, error) {
+	policies := &types.DataplanePolicies{}
+	if err := c.get(ctx, fmt.Sprintf("/meshes/%s/dataplanes/%s/policies", meshName, name), policies); err != nil {
+		return nil, err
+	}
+	return policies, nil
+}
+
+func (c *httpInspectClient) PolicyDataplanes(ctx context.Context, resourceType core_model.ResourceType, meshName string, name string) (*types.PolicyDataplanes, error) {
+	resourceApi, err := kuma_rest.AllApis().GetResourceApi(resourceType)
+	if err != nil {
+		return nil, err
+	}
+	dataplanes := &types.PolicyDataplanes{}
+	if err := c.get(ctx, resourceApi.Item(meshName, name)+"/dataplanes", dataplanes); err != nil {
+		return nil, err
+	}
+	return dataplanes, nil
+}
+
+func (c *httpInspectClient) get(ctx context.Context, path string, out interface{}) error {
+	req, err := http.NewRequest("GET", path, nil)
+	if err != nil {
+		return err
+	}
+	resp, err := c.Client.Do(req.WithContext(ctx))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	b, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode != 200 {
+		kumaErr := error_types.Error{}
+		if err := json.Unmarshal(b, &kumaErr); err == nil && kumaErr.Title != "" && kumaErr.Details != "" {
+			return &kumaErr
+		}
+		return errors.Errorf("(%d): %s", resp.StatusCode, string(b))
+	}
+	return json.Unmarshal(b, out)
+}
@@ -0,0 +1,108 @@
+package resources
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"strings"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/Kong/kuma/pkg/api-server/types"
+	mesh_core "github.com/Kong/kuma/pkg/core/resources/apis/mesh"
+)
+
+var _ = Describe("httpInspectClient", func() {
+	Describe("DataplanePolicies()", func() {
+		It("should request policies of a dataplane and parse response", func() {
+			// given
+			client := httpInspectClient{
+				Client: &http.Client{
+					Transport: RoundTripperFunc(func(req *http.Request) (*http.Response, error) {
+						Expect(req.URL.String()).To(Equal("/meshes/default/dataplanes/backend-01/policies"))
+						return &http.Response{
+							StatusCode: http.StatusOK,
+							Body: ioutil.NopCloser(strings.NewReader(`
+							{
+								"mesh": "default",
+								"name": "backend-01",
+								"inbound": [{"interface": "192.168.0.1:80:8080", "service": "backend"}],
+								"outbound": [],
+								"trafficTrace": {"name": "trace-all", "rank": {"exactMatches": 0, "wildcardMatches": 1}}
+							}`)),
+						}, nil
+					}),
+				},
+			}
+
+			// when
+			policies, err := client.DataplanePolicies(context.Background(), "default", "backend-01")
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			// and
+			Expect(policies.Inbound).To(Equal([]types.InboundPolicies{{Interface: "192.168.0.1:80:8080", Service: "backend"}}))
+			Expect(policies.TrafficTrace).To(Equal(&types.PolicyMatch{Name: "trace-all", Rank: types.PolicyRank{WildcardMatches: 1}}))
+		})
+
+		It("should return an error of the API server", func() {
+			// given
+			client := httpInspectClient{
+				Client: &http.Client{
+					Transport: RoundTripperFunc(func(req *http.Request) (*http.Response, error) {
+						return &http.Response{
+							StatusCode: http.StatusNotFound,
+							Body:       ioutil.NopCloser(strings.NewReader(`{"title": "Could not retrieve policies of a dataplane", "details": "Not found"}`)),
+						}, nil
+					}),
+				},
+			}
+
+			// when
+			_, err := client.DataplanePolicies(context.Background(), "default", "backend-01")
+
+			// then
+			Expect(err).To(MatchError("Could not retrieve policies of a dataplane (Not found)"))
+		})
+	})
+
+	Describe("PolicyDataplanes()", func() {
+		It("should request dataplanes matched by a policy and parse response", func() {
+			// given
+			client := httpInspectClient{
+				Client: &http.Client{
+					Transport: RoundTripperFunc(func(req *http.Request) (*http.Response, error) {
+						Expect(req.URL.String()).To(Equal("/meshes/default/health-checks/db-health/dataplanes"))
+						return &http.Response{
+							StatusCode: http.StatusOK,
+							Body: ioutil.NopCloser(strings.NewReader(`
+							{
+								"mesh": "default",
+								"type": "HealthCheck",
+								"name": "db-health",
+								"winning": [{"dataplane": "backend-01", "direction": "outbound", "interface": "127.0.0.1:54321", "service": "db", "rank": {"exactMatches": 1, "wildcardMatches": 1}}],
+								"shadowed": []
+							}`)),
+						}, nil
+					}),
+				},
+			}
+
+			// when
+			dataplanes, err := client.PolicyDataplanes(context.Background(), mesh_core.HealthCheckType, "default", "db-health")
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			// and
+			Expect(dataplanes.Winning).To(Equal([]types.PolicyAttachment{{
+				Dataplane: "backend-01",
+				Direction: "outbound",
+				Interface: "127.0.0.1:54321",
+				Service:   "db",
+				Rank:      types.PolicyRank{ExactMatches: 1, WildcardMatches: 1},
+			}}))
+			Expect(dataplanes.Shadowed).To(BeEmpty())
+		})
+	})
+})
@@ -19,8 +19,10 @@ import (
 	"github.com/Kong/kuma/pkg/core"
 	ca_provided_rest "github.com/Kong/kuma/pkg/core/ca/provided/rest"
 	"github.com/Kong/kuma/pkg/core/runtime"
+	envoy_admin_rest "github.com/Kong/kuma/pkg/envoy/admin/rest"
 	"github.com/Kong/kuma/pkg/tokens/builtin"
 	tokens_server "github.com/Kong/kuma/pkg/tokens/builtin/server"
+	xds_history_rest "github.com/Kong/kuma/pkg/xds/history/rest"
 )
 
 var (
@@ -160,6 +162,12 @@ func SetupServer(rt runtime.Runtime) error {
 	ws := ca_provided_rest.NewWebservice(rt.ProvidedCaManager(), rt.ResourceManager())
 	webservices = append(webservices, ws)
 
+	ws = envoy_admin_rest.NewWebservice(rt.ReadOnlyResourceManager(), rt.EnvoyAdmin())
+	webservices = append(webservices, ws)
+
+	ws = xds_history_rest.NewWebservice(rt.SnapshotHistory())
+	webservices = append(webservices, ws)
+
 	ws, err := dataplaneTokenWs(rt)
 	if err != nil {
 		return err
@@ -24,6 +24,7 @@ var _ = Describe("Catalog WS", func() {
 		cfg.Catalog.Bootstrap.Url = "http://kuma.internal:3333"
 		cfg.Catalog.MonitoringAssignment.Url = "grpc://kuma.internal:4444"
 		cfg.Catalog.Sds.Url = "https://sds.kuma.io:5555"
+		cfg.Catalog.EnvoyAdmin.Url = "grpc://kuma.internal:6666"
 
 		// setup
 		resourceStore := memory.NewStore()
@@ -66,6 +67,9 @@ var _ = Describe("Catalog WS", func() {
 				},
 				"monitoringAssignment": {
 					"url": "grpc://kuma.internal:4444"
+				},
+				"envoyAdmin": {
+					"url": "grpc://kuma.internal:6666"
 				}
 			}
 		}
@@ -172,6 +172,8 @@ var _ = Describe("Config WS", func() {
             "dataplaneStatusFlushInterval": "1s",
             "diagnosticsPort": 5680,
             "grpcPort": 5678,
+            "snapshotHistoryRetention": "1h0m0s",
+            "snapshotHistorySize": 10,
             "tlsCertFile": "",
             "tlsKeyFile": ""
           }
@@ -11,4 +11,8 @@ var All = []ResourceWsDefinition{
 	TrafficRouteWsDefinition,
 	TrafficTraceWsDefinition,
 	FaultInjectionWsDefinition,
+	RetryWsDefinition,
+	TimeoutWsDefinition,
+	CircuitBreakerWsDefinition,
+	ExternalServiceWsDefinition,
 }
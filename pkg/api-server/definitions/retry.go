@@ -0,0 +1,17 @@
+package definitions
+
+import (
+	"github.com/Kong/kuma/pkg/core/resources/apis/mesh"
+	"github.com/Kong/kuma/pkg/core/resources/model"
+)
+
+var RetryWsDefinition = ResourceWsDefinition{
+	Name: "Retry",
+	Path: "retries",
+	ResourceFactory: func() model.Resource {
+		return &mesh.RetryResource{}
+	},
+	ResourceListFactory: func() model.ResourceList {
+		return &mesh.RetryResourceList{}
+	},
+}
@@ -0,0 +1,17 @@
+package definitions
+
+import (
+	"github.com/Kong/kuma/pkg/core/resources/apis/mesh"
+	"github.com/Kong/kuma/pkg/core/resources/model"
+)
+
+var TimeoutWsDefinition = ResourceWsDefinition{
+	Name: "Timeout",
+	Path: "timeouts",
+	ResourceFactory: func() model.Resource {
+		return &mesh.TimeoutResource{}
+	},
+	ResourceListFactory: func() model.ResourceList {
+		return &mesh.TimeoutResourceList{}
+	},
+}
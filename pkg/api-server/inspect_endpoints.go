@@ -0,0 +1,71 @@
+package api_server
+
+import (
+	"fmt"
+
+	"github.com/emicklei/go-restful"
+
+	"github.com/Kong/kuma/pkg/core/resources/apis/mesh"
+	"github.com/Kong/kuma/pkg/core/resources/manager"
+	"github.com/Kong/kuma/pkg/core/resources/model"
+	"github.com/Kong/kuma/pkg/core/resources/store"
+	rest_errors "github.com/Kong/kuma/pkg/core/rest/errors"
+	"github.com/Kong/kuma/pkg/xds/inspect"
+)
+
+type inspectEndpoints struct {
+	resManager manager.ReadOnlyResourceManager
+}
+
+func (r *inspectEndpoints) addDataplanePoliciesEndpoint(ws *restful.WebService, pathPrefix string) {
+	ws.Route(ws.GET(pathPrefix+"/dataplanes/{name}/policies").To(r.inspectDataplanePolicies).
+		Doc("Inspect policies selected for a dataplane").
+		Param(ws.PathParameter("name", "Name of a dataplane").DataType("string")).
+		Param(ws.PathParameter("mesh", "Name of a mesh").DataType("string")).
+		Returns(200, "OK", nil).
+		Returns(404, "Not found", nil))
+}
+
+func (r *inspectEndpoints) inspectDataplanePolicies(request *restful.Request, response *restful.Response) {
+	name := request.PathParameter("name")
+	meshName := request.PathParameter("mesh")
+
+	dataplane := &mesh.DataplaneResource{}
+	if err := r.resManager.Get(request.Request.Context(), dataplane, store.GetByKey(name, meshName)); err != nil {
+		rest_errors.HandleError(response, err, "Could not retrieve policies of a dataplane")
+		return
+	}
+	policies, err := inspect.DataplanePolicies(request.Request.Context(), r.resManager, dataplane)
+	if err != nil {
+		rest_errors.HandleError(response, err, "Could not retrieve policies of a dataplane")
+		return
+	}
+	if err := response.WriteAsJson(policies); err != nil {
+		rest_errors.HandleError(response, err, "Could not retrieve policies of a dataplane")
+	}
+}
+
+func (r *inspectEndpoints) addPolicyDataplanesEndpoint(ws *restful.WebService, pathPrefix string, resourceType model.ResourceType) {
+	ws.Route(ws.GET(pathPrefix+"/{name}/dataplanes").To(r.inspectPolicyDataplanes(resourceType)).
+		Doc(fmt.Sprintf("Inspect dataplanes matched by a %s", resourceType)).
+		Param(ws.PathParameter("name", fmt.Sprintf("Name of a %s", resourceType)).DataType("string")).
+		Param(ws.PathParameter("mesh", "Name of a mesh").DataType("string")).
+		Returns(200, "OK", nil).
+		Returns(404, "Not found", nil))
+}
+
+func (r *inspectEndpoints) inspectPolicyDataplanes(resourceType model.ResourceType) restful.RouteFunction {
+	return func(request *restful.Request, response *restful.Response) {
+		name := request.PathParameter("name")
+		meshName := request.PathParameter("mesh")
+
+		dataplanes, err := inspect.PolicyDataplanes(request.Request.Context(), r.resManager, resourceType, meshName, name)
+		if err != nil {
+			rest_errors.HandleError(response, err, "Could not retrieve dataplanes matched by a policy")
+			return
+		}
+		if err := response.WriteAsJson(dataplanes); err != nil {
+			rest_errors.HandleError(response, err, "Could not retrieve dataplanes matched by a policy")
+		}
+	}
+}
@@ -0,0 +1,150 @@
+package api_server_test
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/Kong/kuma/api/mesh/v1alpha1"
+	api_server "github.com/Kong/kuma/pkg/api-server"
+	config "github.com/Kong/kuma/pkg/config/api-server"
+	mesh_core "github.com/Kong/kuma/pkg/core/resources/apis/mesh"
+	"github.com/Kong/kuma/pkg/core/resources/store"
+	"github.com/Kong/kuma/pkg/plugins/resources/memory"
+)
+
+var _ = Describe("Inspect Endpoints", func() {
+	var apiServer *api_server.ApiServer
+	var resourceStore store.ResourceStore
+	var stop chan struct{}
+
+	BeforeEach(func() {
+		resourceStore = memory.NewStore()
+		apiServer = createTestApiServer(resourceStore, config.DefaultApiServerConfig())
+		client := resourceApiClient{
+			address: apiServer.Address(),
+			path:    "/meshes",
+		}
+		stop = make(chan struct{})
+		go func() {
+			defer GinkgoRecover()
+			err := apiServer.Start(stop)
+			Expect(err).ToNot(HaveOccurred())
+		}()
+		waitForServer(&client)
+	}, 5)
+
+	AfterEach(func() {
+		close(stop)
+	})
+
+	BeforeEach(func() {
+		err := resourceStore.Create(context.Background(), &mesh_core.MeshResource{}, store.CreateByKey("mesh1", "mesh1"))
+		Expect(err).ToNot(HaveOccurred())
+
+		dataplane := mesh_core.DataplaneResource{
+			Spec: v1alpha1.Dataplane{
+				Networking: &v1alpha1.Dataplane_Networking{
+					Address: "127.0.0.1",
+					Inbound: []*v1alpha1.Dataplane_Networking_Inbound{
+						{
+							Port:        9090,
+							ServicePort: 9091,
+							Tags: map[string]string{
+								"service": "sample",
+							},
+						},
+					},
+				},
+			},
+		}
+		err = resourceStore.Create(context.Background(), &dataplane, store.CreateByKey("dp1", "mesh1"))
+		Expect(err).ToNot(HaveOccurred())
+
+		permission := mesh_core.TrafficPermissionResource{
+			Spec: v1alpha1.TrafficPermission{
+				Sources:      []*v1alpha1.Selector{{Match: v1alpha1.MatchAnyService()}},
+				Destinations: []*v1alpha1.Selector{{Match: v1alpha1.MatchService("sample")}},
+			},
+		}
+		err = resourceStore.Create(context.Background(), &permission, store.CreateByKey("allow-sample", "mesh1"))
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	Describe("On GET", func() {
+		It("should return policies of a dataplane", func() {
+			// when
+			response, err := http.Get("http://" + apiServer.Address() + "/meshes/mesh1/dataplanes/dp1/policies")
+			Expect(err).ToNot(HaveOccurred())
+
+			// then
+			Expect(response.StatusCode).To(Equal(200))
+			body, err := ioutil.ReadAll(response.Body)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(body).To(MatchJSON(`
+			{
+				"mesh": "mesh1",
+				"name": "dp1",
+				"inbound": [
+					{
+						"interface": "127.0.0.1:9090:9091",
+						"service": "sample",
+						"trafficPermission": {
+							"name": "allow-sample",
+							"rank": {"exactMatches": 1, "wildcardMatches": 0}
+						}
+					}
+				],
+				"outbound": []
+			}`))
+		})
+
+		It("should return 404 for a missing dataplane", func() {
+			// when
+			response, err := http.Get("http://" + apiServer.Address() + "/meshes/mesh1/dataplanes/non-existing/policies")
+			Expect(err).ToNot(HaveOccurred())
+
+			// then
+			Expect(response.StatusCode).To(Equal(404))
+		})
+
+		It("should return dataplanes matched by a policy", func() {
+			// when
+			response, err := http.Get("http://" + apiServer.Address() + "/meshes/mesh1/traffic-permissions/allow-sample/dataplanes")
+			Expect(err).ToNot(HaveOccurred())
+
+			// then
+			Expect(response.StatusCode).To(Equal(200))
+			body, err := ioutil.ReadAll(response.Body)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(body).To(MatchJSON(`
+			{
+				"mesh": "mesh1",
+				"type": "TrafficPermission",
+				"name": "allow-sample",
+				"winning": [
+					{
+						"dataplane": "dp1",
+						"direction": "inbound",
+						"interface": "127.0.0.1:9090:9091",
+						"service": "sample",
+						"rank": {"exactMatches": 1, "wildcardMatches": 0}
+					}
+				],
+				"shadowed": []
+			}`))
+		})
+
+		It("should return 404 for a missing policy", func() {
+			// when
+			response, err := http.Get("http://" + apiServer.Address() + "/meshes/mesh1/traffic-permissions/non-existing/dataplanes")
+			Expect(err).ToNot(HaveOccurred())
+
+			// then
+			Expect(response.StatusCode).To(Equal(404))
+		})
+	})
+})
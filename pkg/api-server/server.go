@@ -18,6 +18,7 @@ import (
 	"github.com/Kong/kuma/pkg/core/resources/apis/mesh"
 	"github.com/Kong/kuma/pkg/core/resources/manager"
 	"github.com/Kong/kuma/pkg/core/runtime"
+	xds_inspect "github.com/Kong/kuma/pkg/xds/inspect"
 )
 
 var (
@@ -100,6 +101,11 @@ func addResourcesEndpoints(ws *restful.WebService, defs []definitions.ResourceWs
 	endpoints.addFindEndpoint(ws, "/meshes/{mesh}")
 	endpoints.addListEndpoint(ws, "") // listing all resources in all meshes
 
+	inspect := inspectEndpoints{
+		resManager: resManager,
+	}
+	inspect.addDataplanePoliciesEndpoint(ws, "/meshes/{mesh}")
+
 	for _, definition := range defs {
 		if definition.ResourceFactory().GetType() != mesh.MeshType {
 			endpoints := resourceEndpoints{
@@ -115,6 +121,9 @@ func addResourcesEndpoints(ws *restful.WebService, defs []definitions.ResourceWs
 			endpoints.addFindEndpoint(ws, "/meshes/{mesh}/"+definition.Path)
 			endpoints.addListEndpoint(ws, "/meshes/{mesh}/"+definition.Path)
 			endpoints.addListEndpoint(ws, "/"+definition.Path) // listing all resources in all meshes
+			if resourceType := definition.ResourceFactory().GetType(); xds_inspect.IsPolicyType(resourceType) {
+				inspect.addPolicyDataplanesEndpoint(ws, "/meshes/{mesh}/"+definition.Path, resourceType)
+			}
 		} else {
 			endpoints := resourceEndpoints{
 				publicURL:            config.Catalog.ApiServer.Url,
@@ -0,0 +1,71 @@
+package types
+
+// PolicyRank describes how specific the selectors of a policy that matched a dataplane are.
+// A policy with a higher rank wins.
+type PolicyRank struct {
+	ExactMatches    int `json:"exactMatches"`
+	WildcardMatches int `json:"wildcardMatches"`
+}
+
+// PolicyMatch is a policy selected for a dataplane.
+type PolicyMatch struct {
+	Name string     `json:"name"`
+	Rank PolicyRank `json:"rank"`
+}
+
+// InboundPolicies lists policies selected for an inbound interface of a dataplane.
+type InboundPolicies struct {
+	Interface         string       `json:"interface"`
+	Service           string       `json:"service"`
+	TrafficPermission *PolicyMatch `json:"trafficPermission,omitempty"`
+	TrafficLog        *PolicyMatch `json:"trafficLog,omitempty"`
+	FaultInjection    *PolicyMatch `json:"faultInjection,omitempty"`
+}
+
+// OutboundPolicies lists policies selected for an outbound interface of a dataplane.
+type OutboundPolicies struct {
+	Interface      string       `json:"interface"`
+	Service        string       `json:"service"`
+	TrafficRoute   *PolicyMatch `json:"trafficRoute,omitempty"`
+	TrafficLog     *PolicyMatch `json:"trafficLog,omitempty"`
+	HealthCheck    *PolicyMatch `json:"healthCheck,omitempty"`
+	FaultInjection *PolicyMatch `json:"faultInjection,omitempty"`
+}
+
+// DataplanePolicies lists policies selected for a dataplane.
+// TrafficTrace and ProxyTemplate are selected for a dataplane as a whole rather than per interface.
+type DataplanePolicies struct {
+	Mesh          string             `json:"mesh"`
+	Name          string             `json:"name"`
+	Inbound       []InboundPolicies  `json:"inbound"`
+	Outbound      []OutboundPolicies `json:"outbound"`
+	TrafficTrace  *PolicyMatch       `json:"trafficTrace,omitempty"`
+	ProxyTemplate *PolicyMatch       `json:"proxyTemplate,omitempty"`
+}
+
+const (
+	InboundDirection  = "inbound"
+	OutboundDirection = "outbound"
+)
+
+// PolicyAttachment is an interface of a dataplane matched by a policy.
+// Direction, Interface and Service are empty if a policy is selected for a dataplane as a whole.
+type PolicyAttachment struct {
+	Dataplane  string       `json:"dataplane"`
+	Direction  string       `json:"direction,omitempty"`
+	Interface  string       `json:"interface,omitempty"`
+	Service    string       `json:"service,omitempty"`
+	Rank       PolicyRank   `json:"rank"`
+	ShadowedBy *PolicyMatch `json:"shadowedBy,omitempty"`
+}
+
+// PolicyDataplanes lists dataplanes matched by a policy.
+// Winning lists interfaces where the policy is selected, while Shadowed lists interfaces
+// where the policy matches but a more specific policy of the same type is selected instead.
+type PolicyDataplanes struct {
+	Mesh     string             `json:"mesh"`
+	Type     string             `json:"type"`
+	Name     string             `json:"name"`
+	Winning  []PolicyAttachment `json:"winning"`
+	Shadowed []PolicyAttachment `json:"shadowed"`
+}
@@ -8,6 +8,7 @@ type CatalogConfig struct {
 	Admin                AdminApiConfig                `yaml:"-"`
 	MonitoringAssignment MonitoringAssignmentApiConfig `yaml:"monitoringAssignment"`
 	Sds                  SdsApiConfig                  `yaml:"sds"`
+	EnvoyAdmin           EnvoyAdminApiConfig           `yaml:"-"`
 }
 
 type ApiServerConfig struct {
@@ -35,3 +36,7 @@ type MonitoringAssignmentApiConfig struct {
 type SdsApiConfig struct {
 	Url string `yaml:"url" envconfig:"kuma_api_server_catalog_sds_url"`
 }
+
+type EnvoyAdminApiConfig struct {
+	Url string
+}
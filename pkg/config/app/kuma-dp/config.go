@@ -28,6 +28,14 @@ func DefaultConfig() Config {
 			BinaryPath: "envoy",
 			ConfigDir:  "", // if left empty, a temporary directory will be generated automatically
 		},
+		AccessLogs: AccessLogs{
+			BufferSize:     10000,
+			DropPolicy:     DropNewest,
+			SpillDir:       "", // if left empty, log entries are not spilled to disk
+			MaxSpillSize:   100 * 1024 * 1024,
+			InitialBackoff: 100 * time.Millisecond,
+			MaxBackoff:     30 * time.Second,
+		},
 	}
 }
 
@@ -39,12 +47,15 @@ type Config struct {
 	Dataplane Dataplane `yaml:"dataplane,omitempty"`
 	// DataplaneRuntime defines the context in which dataplane (Envoy) runs.
 	DataplaneRuntime DataplaneRuntime `yaml:"dataplaneRuntime,omitempty"`
+	// AccessLogs defines how access logs are delivered to logging backends.
+	AccessLogs AccessLogs `yaml:"accessLogs,omitempty"`
 }
 
 func (c *Config) Sanitize() {
 	c.ControlPlane.Sanitize()
 	c.Dataplane.Sanitize()
 	c.DataplaneRuntime.Sanitize()
+	c.AccessLogs.Sanitize()
 }
 
 // ControlPlane defines coordinates of the Control Plane.
@@ -82,6 +93,33 @@ type DataplaneRuntime struct {
 	TokenPath string `yaml:"dataplaneTokenPath,omitempty" envconfig:"kuma_dataplane_runtime_token_path"`
 }
 
+// Policies applied to new log entries when a buffer of a logging backend is full.
+const (
+	// DropNewest drops new log entries.
+	DropNewest = "dropNewest"
+	// DropOldest drops the oldest buffered log entries to make room for new ones.
+	DropOldest = "dropOldest"
+)
+
+// AccessLogs defines how access logs are delivered to logging backends.
+type AccessLogs struct {
+	// Maximum number of log entries buffered in memory per logging backend.
+	BufferSize int `yaml:"bufferSize,omitempty" envconfig:"kuma_access_logs_buffer_size"`
+	// Policy applied when a buffer is full: "dropNewest" or "dropOldest".
+	// If spilling to disk is enabled, new log entries are dropped once the spill file is full
+	// in order to preserve the order of log entries.
+	DropPolicy string `yaml:"dropPolicy,omitempty" envconfig:"kuma_access_logs_drop_policy"`
+	// Dir to spill log entries to once the in-memory buffer is full.
+	// Empty value indicates that log entries should not be spilled to disk.
+	SpillDir string `yaml:"spillDir,omitempty" envconfig:"kuma_access_logs_spill_dir"`
+	// Maximum size (in bytes) of a spill file per logging backend.
+	MaxSpillSize int64 `yaml:"maxSpillSize,omitempty" envconfig:"kuma_access_logs_max_spill_size"`
+	// Initial backoff between attempts to reconnect to a logging backend.
+	InitialBackoff time.Duration `yaml:"initialBackoff,omitempty" envconfig:"kuma_access_logs_initial_backoff"`
+	// Maximum backoff between attempts to reconnect to a logging backend.
+	MaxBackoff time.Duration `yaml:"maxBackoff,omitempty" envconfig:"kuma_access_logs_max_backoff"`
+}
+
 var _ config.Config = &Config{}
 
 func (c *Config) Validate() (errs error) {
@@ -94,6 +132,9 @@ func (c *Config) Validate() (errs error) {
 	if err := c.DataplaneRuntime.Validate(); err != nil {
 		errs = multierr.Append(errs, errors.Wrapf(err, ".DataplaneRuntime is not valid"))
 	}
+	if err := c.AccessLogs.Validate(); err != nil {
+		errs = multierr.Append(errs, errors.Wrapf(err, ".AccessLogs is not valid"))
+	}
 	return
 }
 
@@ -141,6 +182,30 @@ func (d *DataplaneRuntime) Validate() (errs error) {
 	return
 }
 
+var _ config.Config = &AccessLogs{}
+
+func (a *AccessLogs) Sanitize() {
+}
+
+func (a *AccessLogs) Validate() (errs error) {
+	if a.BufferSize <= 0 {
+		errs = multierr.Append(errs, errors.Errorf(".BufferSize must be positive"))
+	}
+	if a.DropPolicy != DropNewest && a.DropPolicy != DropOldest {
+		errs = multierr.Append(errs, errors.Errorf(".DropPolicy must be either %q or %q", DropNewest, DropOldest))
+	}
+	if a.SpillDir != "" && a.MaxSpillSize <= 0 {
+		errs = multierr.Append(errs, errors.Errorf(".MaxSpillSize must be positive"))
+	}
+	if a.InitialBackoff <= 0 {
+		errs = multierr.Append(errs, errors.Errorf(".InitialBackoff must be positive"))
+	}
+	if a.MaxBackoff < a.InitialBackoff {
+		errs = multierr.Append(errs, errors.Errorf(".MaxBackoff must not be less than .InitialBackoff"))
+	}
+	return
+}
+
 var _ config.Config = &ApiServer{}
 
 func (d *ApiServer) Sanitize() {
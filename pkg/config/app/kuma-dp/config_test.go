@@ -30,6 +30,12 @@ var _ = Describe("Config", func() {
 		Expect(cfg.ControlPlane.ApiServer.URL).To(Equal("https://kuma-control-plane.internal:5682"))
 		Expect(cfg.Dataplane.AdminPort).To(Equal(config_types.MustExactPort(2345)))
 		Expect(cfg.Dataplane.DrainTime).To(Equal(60 * time.Second))
+		Expect(cfg.AccessLogs.BufferSize).To(Equal(500))
+		Expect(cfg.AccessLogs.DropPolicy).To(Equal(kuma_dp.DropOldest))
+		Expect(cfg.AccessLogs.SpillDir).To(Equal("/var/spool/kuma-dp"))
+		Expect(cfg.AccessLogs.MaxSpillSize).To(Equal(int64(1048576)))
+		Expect(cfg.AccessLogs.InitialBackoff).To(Equal(1 * time.Second))
+		Expect(cfg.AccessLogs.MaxBackoff).To(Equal(1 * time.Minute))
 	})
 
 	Context("with modified environment variables", func() {
@@ -59,6 +65,12 @@ var _ = Describe("Config", func() {
 				"KUMA_DATAPLANE_RUNTIME_BINARY_PATH": "envoy.sh",
 				"KUMA_DATAPLANE_RUNTIME_CONFIG_DIR":  "/var/run/envoy",
 				"KUMA_DATAPLANE_RUNTIME_TOKEN_PATH":  "/tmp/token",
+				"KUMA_ACCESS_LOGS_BUFFER_SIZE":       "500",
+				"KUMA_ACCESS_LOGS_DROP_POLICY":       "dropOldest",
+				"KUMA_ACCESS_LOGS_SPILL_DIR":         "/var/spool/kuma-dp",
+				"KUMA_ACCESS_LOGS_MAX_SPILL_SIZE":    "1048576",
+				"KUMA_ACCESS_LOGS_INITIAL_BACKOFF":   "1s",
+				"KUMA_ACCESS_LOGS_MAX_BACKOFF":       "1m",
 			}
 			for key, value := range env {
 				os.Setenv(key, value)
@@ -82,6 +94,12 @@ var _ = Describe("Config", func() {
 			Expect(cfg.DataplaneRuntime.BinaryPath).To(Equal("envoy.sh"))
 			Expect(cfg.DataplaneRuntime.ConfigDir).To(Equal("/var/run/envoy"))
 			Expect(cfg.DataplaneRuntime.TokenPath).To(Equal("/tmp/token"))
+			Expect(cfg.AccessLogs.BufferSize).To(Equal(500))
+			Expect(cfg.AccessLogs.DropPolicy).To(Equal(kuma_dp.DropOldest))
+			Expect(cfg.AccessLogs.SpillDir).To(Equal("/var/spool/kuma-dp"))
+			Expect(cfg.AccessLogs.MaxSpillSize).To(Equal(int64(1048576)))
+			Expect(cfg.AccessLogs.InitialBackoff).To(Equal(1 * time.Second))
+			Expect(cfg.AccessLogs.MaxBackoff).To(Equal(1 * time.Minute))
 		})
 	})
 
@@ -110,6 +128,6 @@ var _ = Describe("Config", func() {
 		err := config.Load(filepath.Join("testdata", "invalid-config.input.yaml"), &cfg)
 
 		// then
-		Expect(err).To(MatchError(`Invalid configuration: .ControlPlane is not valid: .ApiServer is not valid: .URL must be a valid absolute URI; .Dataplane is not valid: .Mesh must be non-empty; .Name must be non-empty; .DrainTime must be positive; .DataplaneRuntime is not valid: .BinaryPath must be non-empty`))
+		Expect(err).To(MatchError(`Invalid configuration: .ControlPlane is not valid: .ApiServer is not valid: .URL must be a valid absolute URI; .Dataplane is not valid: .Mesh must be non-empty; .Name must be non-empty; .DrainTime must be positive; .DataplaneRuntime is not valid: .BinaryPath must be non-empty; .AccessLogs is not valid: .BufferSize must be positive; .DropPolicy must be either "dropNewest" or "dropOldest"; .MaxSpillSize must be positive; .InitialBackoff must be positive`))
 	})
 })
@@ -65,6 +65,8 @@ var _ = Describe("Config loader", func() {
 			// then
 			Expect(cfg.XdsServer.GrpcPort).To(Equal(5000))
 			Expect(cfg.XdsServer.DiagnosticsPort).To(Equal(5003))
+			Expect(cfg.XdsServer.SnapshotHistorySize).To(Equal(25))
+			Expect(cfg.XdsServer.SnapshotHistoryRetention).To(Equal(30 * time.Minute))
 
 			Expect(cfg.BootstrapServer.Port).To(Equal(uint32(5004)))
 			Expect(cfg.BootstrapServer.Params.AdminPort).To(Equal(uint32(1234)))
@@ -151,6 +153,8 @@ store:
 xdsServer:
   grpcPort: 5000
   diagnosticsPort: 5003
+  snapshotHistorySize: 25
+  snapshotHistoryRetention: 30m
 bootstrapServer:
   port: 5004
   params:
@@ -209,6 +213,8 @@ guiServer:
 			envVars: map[string]string{
 				"KUMA_XDS_SERVER_GRPC_PORT":                                     "5000",
 				"KUMA_XDS_SERVER_DIAGNOSTICS_PORT":                              "5003",
+				"KUMA_XDS_SERVER_SNAPSHOT_HISTORY_SIZE":                         "25",
+				"KUMA_XDS_SERVER_SNAPSHOT_HISTORY_RETENTION":                    "30m",
 				"KUMA_BOOTSTRAP_SERVER_PORT":                                    "5004",
 				"KUMA_BOOTSTRAP_SERVER_PARAMS_ADMIN_PORT":                       "1234",
 				"KUMA_BOOTSTRAP_SERVER_PARAMS_XDS_HOST":                         "kuma-control-plane",
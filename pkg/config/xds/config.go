@@ -25,6 +25,10 @@ type XdsServerConfig struct {
 	TlsCertFile string `yaml:"tlsCertFile" envconfig:"kuma_xds_server_tls_cert_file"`
 	// TlsKeyFile defines a path to a file with PEM-encoded TLS key.
 	TlsKeyFile string `yaml:"tlsKeyFile" envconfig:"kuma_xds_server_tls_key_file"`
+	// Number of recent configuration snapshots kept per Dataplane for diagnostics. History is disabled if set to 0.
+	SnapshotHistorySize int `yaml:"snapshotHistorySize" envconfig:"kuma_xds_server_snapshot_history_size"`
+	// How long configuration snapshots of a Dataplane are kept after it has disconnected from the Control Plane
+	SnapshotHistoryRetention time.Duration `yaml:"snapshotHistoryRetention" envconfig:"kuma_xds_server_snapshot_history_retention"`
 }
 
 func (x *XdsServerConfig) Sanitize() {
@@ -43,6 +47,12 @@ func (x *XdsServerConfig) Validate() error {
 	if x.DataplaneStatusFlushInterval <= 0 {
 		return errors.New("DataplaneStatusFlushInterval must be positive")
 	}
+	if x.SnapshotHistorySize < 0 {
+		return errors.New("SnapshotHistorySize cannot be negative")
+	}
+	if x.SnapshotHistoryRetention < 0 {
+		return errors.New("SnapshotHistoryRetention cannot be negative")
+	}
 	if x.TlsCertFile == "" && x.TlsKeyFile != "" {
 		return errors.New("TlsCertFile cannot be empty if TlsKeyFile has been set")
 	}
@@ -60,5 +70,7 @@ func DefaultXdsServerConfig() *XdsServerConfig {
 		DataplaneStatusFlushInterval:          1 * time.Second,
 		TlsCertFile:                           "",
 		TlsKeyFile:                            "",
+		SnapshotHistorySize:                   10,
+		SnapshotHistoryRetention:              1 * time.Hour,
 	}
 }
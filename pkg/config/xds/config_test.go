@@ -32,6 +32,8 @@ var _ = Describe("XdsServerConfig", func() {
 		Expect(cfg.DataplaneStatusFlushInterval).To(Equal(5 * time.Second))
 		Expect(cfg.TlsCertFile).To(Equal("/tmp/cert.pem"))
 		Expect(cfg.TlsKeyFile).To(Equal("/tmp/key.pem"))
+		Expect(cfg.SnapshotHistorySize).To(Equal(20))
+		Expect(cfg.SnapshotHistoryRetention).To(Equal(30 * time.Minute))
 	})
 
 	Context("with modified environment variables", func() {
@@ -59,6 +61,8 @@ var _ = Describe("XdsServerConfig", func() {
 				"KUMA_XDS_SERVER_DATAPLANE_STATUS_FLUSH_INTERVAL":          "5s",
 				"KUMA_XDS_SERVER_TLS_CERT_FILE":                            "/tmp/cert-env.pem",
 				"KUMA_XDS_SERVER_TLS_KEY_FILE":                             "/tmp/key-env.pem",
+				"KUMA_XDS_SERVER_SNAPSHOT_HISTORY_SIZE":                    "30",
+				"KUMA_XDS_SERVER_SNAPSHOT_HISTORY_RETENTION":               "45m",
 			}
 			for key, value := range env {
 				os.Setenv(key, value)
@@ -80,6 +84,8 @@ var _ = Describe("XdsServerConfig", func() {
 			Expect(cfg.DataplaneStatusFlushInterval).To(Equal(5 * time.Second))
 			Expect(cfg.TlsCertFile).To(Equal("/tmp/cert-env.pem"))
 			Expect(cfg.TlsKeyFile).To(Equal("/tmp/key-env.pem"))
+			Expect(cfg.SnapshotHistorySize).To(Equal(30))
+			Expect(cfg.SnapshotHistoryRetention).To(Equal(45 * time.Minute))
 		})
 	})
 
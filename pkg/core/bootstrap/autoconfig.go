@@ -25,9 +25,9 @@ var autoconfigureLog = core.Log.WithName("bootstrap").WithName("auto-configure")
 
 func autoconfigure(cfg *kuma_cp.Config) error {
 	autoconfigureAdminServer(cfg)
+	autoconfigBootstrapXdsParams(cfg)
 	autoconfigureCatalog(cfg)
 	autoconfigureGui(cfg)
-	autoconfigBootstrapXdsParams(cfg)
 	return autoconfigureSds(cfg)
 }
 
@@ -40,6 +40,12 @@ func autoconfigureCatalog(cfg *kuma_cp.Config) {
 	if len(madsUrl) == 0 {
 		madsUrl = fmt.Sprintf("grpc://%s:%d", cfg.General.AdvertisedHostname, cfg.MonitoringAssignmentServer.GrpcPort)
 	}
+	// kuma-dp opens Envoy Admin streams to the same gRPC server Envoy connects to for ADS
+	envoyAdminScheme := "grpc"
+	if cfg.XdsServer.TlsCertFile != "" {
+		envoyAdminScheme = "grpcs"
+	}
+	envoyAdminUrl := fmt.Sprintf("%s://%s:%d", envoyAdminScheme, cfg.BootstrapServer.Params.XdsHost, cfg.BootstrapServer.Params.XdsPort)
 	cat := &catalog.CatalogConfig{
 		ApiServer: catalog.ApiServerConfig{
 			Url: fmt.Sprintf("http://%s:%d", cfg.General.AdvertisedHostname, cfg.ApiServer.Port),
@@ -56,6 +62,9 @@ func autoconfigureCatalog(cfg *kuma_cp.Config) {
 		Sds: catalog.SdsApiConfig{
 			Url: cfg.ApiServer.Catalog.Sds.Url,
 		},
+		EnvoyAdmin: catalog.EnvoyAdminApiConfig{
+			Url: envoyAdminUrl,
+		},
 	}
 	if cfg.AdminServer.Public.Enabled {
 		cat.Admin.PublicUrl = fmt.Sprintf("https://%s:%d", cfg.General.AdvertisedHostname, cfg.AdminServer.Public.Port)
@@ -61,6 +61,9 @@ var _ = Describe("Auto configuration", func() {
 				MonitoringAssignment: catalog.MonitoringAssignmentApiConfig{
 					Url: "grpc://kuma.internal:5676",
 				},
+				EnvoyAdmin: catalog.EnvoyAdminApiConfig{
+					Url: "grpc://kuma.internal:5678",
+				},
 			},
 		}),
 		Entry("without public port explicitly defined", testCase{
@@ -91,6 +94,9 @@ var _ = Describe("Auto configuration", func() {
 				MonitoringAssignment: catalog.MonitoringAssignmentApiConfig{
 					Url: "grpc://kuma.internal:5676",
 				},
+				EnvoyAdmin: catalog.EnvoyAdminApiConfig{
+					Url: "grpc://kuma.internal:5678",
+				},
 			},
 		}),
 		Entry("without public settings for dataplane token server", testCase{
@@ -119,6 +125,9 @@ var _ = Describe("Auto configuration", func() {
 				MonitoringAssignment: catalog.MonitoringAssignmentApiConfig{
 					Url: "grpc://kuma.internal:5676",
 				},
+				EnvoyAdmin: catalog.EnvoyAdminApiConfig{
+					Url: "grpc://kuma.internal:5678",
+				},
 			},
 		}),
 		Entry("without dataplane token server", testCase{
@@ -145,6 +154,9 @@ var _ = Describe("Auto configuration", func() {
 				MonitoringAssignment: catalog.MonitoringAssignmentApiConfig{
 					Url: "grpc://localhost:5676",
 				},
+				EnvoyAdmin: catalog.EnvoyAdminApiConfig{
+					Url: "grpc://localhost:5678",
+				},
 			},
 		}),
 		Entry("with public settings for bootstrap and mads server", testCase{
@@ -178,6 +190,9 @@ var _ = Describe("Auto configuration", func() {
 				MonitoringAssignment: catalog.MonitoringAssignmentApiConfig{
 					Url: "grpcs://mads.kuma.com:1234",
 				},
+				EnvoyAdmin: catalog.EnvoyAdminApiConfig{
+					Url: "grpc://kuma.internal:5678",
+				},
 			},
 		}),
 	)
@@ -276,4 +291,21 @@ var _ = Describe("Auto configuration", func() {
 		// and
 		Expect(cfg.ApiServer.Catalog.MonitoringAssignment.Url).To(Equal("grpc://kuma.internal:8765"))
 	})
+	It("should autoconfigure Envoy Admin API with TLS of xDS server", func() {
+		// given
+		cfg := kuma_cp.DefaultConfig()
+		cfg.General.AdvertisedHostname = "kuma.internal"
+		cfg.XdsServer.GrpcPort = 8765
+		cfg.XdsServer.TlsCertFile = "/tmp/cert.pem"
+		cfg.XdsServer.TlsKeyFile = "/tmp/key.pem"
+
+		// when
+		err := autoconfigure(&cfg)
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+
+		// and
+		Expect(cfg.ApiServer.Catalog.EnvoyAdmin.Url).To(Equal("grpcs://kuma.internal:8765"))
+	})
 })
@@ -20,7 +20,10 @@ import (
 	secret_cipher "github.com/Kong/kuma/pkg/core/secrets/cipher"
 	secret_manager "github.com/Kong/kuma/pkg/core/secrets/manager"
 	core_xds "github.com/Kong/kuma/pkg/core/xds"
+	envoy_admin "github.com/Kong/kuma/pkg/envoy/admin"
 	builtin_issuer "github.com/Kong/kuma/pkg/tokens/builtin/issuer"
+	xds_generator "github.com/Kong/kuma/pkg/xds/generator"
+	xds_history "github.com/Kong/kuma/pkg/xds/history"
 )
 
 func buildRuntime(cfg kuma_cp.Config) (core_runtime.Runtime, error) {
@@ -213,6 +216,13 @@ func initializeDiscovery(cfg kuma_cp.Config, builder *core_runtime.Builder) erro
 
 func initializeXds(builder *core_runtime.Builder) {
 	builder.WithXdsContext(core_xds.NewXdsContext())
+	builder.WithEnvoyAdminStreams(envoy_admin.NewStreams())
+	builder.WithSnapshotHistory(xds_history.NewSnapshotHistory(builder.Config().XdsServer.SnapshotHistorySize, builder.Config().XdsServer.SnapshotHistoryRetention))
+
+	// plugins can register their own ProxyTemplate profiles when the Runtime is customized
+	profiles := xds_generator.NewProfileRegistry()
+	builder.WithExtensions(xds_generator.NewProfilesContext(builder.Extensions(), profiles))
+	mesh.UseProfileRegistry(profiles)
 }
 
 func initializeCaManagers(builder *core_runtime.Builder) {
@@ -7,6 +7,7 @@ import (
 
 	core_xds "github.com/Kong/kuma/pkg/core/xds"
 
+	mesh_proto "github.com/Kong/kuma/api/mesh/v1alpha1"
 	"github.com/Kong/kuma/pkg/core/policy"
 	mesh_core "github.com/Kong/kuma/pkg/core/resources/apis/mesh"
 	"github.com/Kong/kuma/pkg/core/resources/manager"
@@ -17,18 +18,14 @@ type FaultInjectionMatcher struct {
 	ResourceManager manager.ReadOnlyResourceManager
 }
 
+// Match picks the most specific FaultInjection applied on the destination side for each inbound interface of a given Dataplane.
 func (f *FaultInjectionMatcher) Match(ctx context.Context, dataplane *mesh_core.DataplaneResource) (core_xds.FaultInjectionMap, error) {
-	faultInjections := &mesh_core.FaultInjectionResourceList{}
-	if err := f.ResourceManager.List(ctx, faultInjections, store.ListByMesh(dataplane.GetMeta().GetMesh())); err != nil {
-		return nil, errors.Wrap(err, "could not retrieve fault injections")
-	}
-
-	policies := make([]policy.ConnectionPolicy, len(faultInjections.Items))
-	for i, faultInjection := range faultInjections.Items {
-		policies[i] = faultInjection
+	faultInjections, err := f.list(ctx, dataplane)
+	if err != nil {
+		return nil, err
 	}
 
-	policyMap, err := policy.SelectInboundConnectionPolicies(dataplane, policies)
+	policyMap, err := policy.SelectInboundConnectionPolicies(dataplane, DestinationSidePolicies(faultInjections.Items))
 	if err != nil {
 		return nil, err
 	}
@@ -39,3 +36,99 @@ func (f *FaultInjectionMatcher) Match(ctx context.Context, dataplane *mesh_core.
 	}
 	return result, nil
 }
+
+// MatchOutbound picks the most specific FaultInjection applied on the source side for each outbound interface of a given Dataplane.
+//
+// Since ExternalServices have no destination side, FaultInjections applied on the destination side
+// of an ExternalService are applied on the source side instead.
+func (f *FaultInjectionMatcher) MatchOutbound(ctx context.Context, dataplane *mesh_core.DataplaneResource, outbound core_xds.EndpointMap) (core_xds.OutboundFaultInjectionMap, error) {
+	faultInjections, err := f.list(ctx, dataplane)
+	if err != nil {
+		return nil, err
+	}
+
+	result := core_xds.OutboundFaultInjectionMap{}
+	for service, connectionPolicy := range policy.SelectOutboundConnectionPolicies(dataplane, SourceSidePolicies(faultInjections.Items)) {
+		result[service] = &connectionPolicy.(*outboundFaultInjection).Spec
+	}
+	externalServices := policy.ToServices(externalServicesOf(outbound))
+	for service, connectionPolicy := range policy.SelectConnectionPolicies(dataplane, externalServices, ExternalServicePolicies(faultInjections.Items)) {
+		result[service] = &connectionPolicy.(*outboundFaultInjection).Spec
+	}
+	return result, nil
+}
+
+// externalServicesOf returns services of ExternalServices among given endpoints.
+func externalServicesOf(outbound core_xds.EndpointMap) []core_xds.ServiceName {
+	var services []core_xds.ServiceName
+	for service, endpoints := range outbound {
+		if len(endpoints) > 0 && endpoints[0].IsExternalService() {
+			services = append(services, service)
+		}
+	}
+	return services
+}
+
+// DestinationSidePolicies returns FaultInjections that are matched against inbound interfaces.
+func DestinationSidePolicies(faultInjections []*mesh_core.FaultInjectionResource) []policy.ConnectionPolicy {
+	var policies []policy.ConnectionPolicy
+	for _, faultInjection := range faultInjections {
+		if faultInjection.Spec.IsSourceSide() {
+			continue
+		}
+		policies = append(policies, faultInjection)
+	}
+	return policies
+}
+
+// SourceSidePolicies returns FaultInjections that are matched against outbound interfaces.
+func SourceSidePolicies(faultInjections []*mesh_core.FaultInjectionResource) []policy.ConnectionPolicy {
+	var policies []policy.ConnectionPolicy
+	for _, faultInjection := range faultInjections {
+		if !faultInjection.Spec.IsSourceSide() {
+			continue
+		}
+		policies = append(policies, &outboundFaultInjection{faultInjection})
+	}
+	return policies
+}
+
+// ExternalServicePolicies returns FaultInjections that are matched against ExternalServices
+// no matter the side they are applied on.
+func ExternalServicePolicies(faultInjections []*mesh_core.FaultInjectionResource) []policy.ConnectionPolicy {
+	var policies []policy.ConnectionPolicy
+	for _, faultInjection := range faultInjections {
+		policies = append(policies, &outboundFaultInjection{faultInjection})
+	}
+	return policies
+}
+
+func (f *FaultInjectionMatcher) list(ctx context.Context, dataplane *mesh_core.DataplaneResource) (*mesh_core.FaultInjectionResourceList, error) {
+	faultInjections := &mesh_core.FaultInjectionResourceList{}
+	if err := f.ResourceManager.List(ctx, faultInjections, store.ListByMesh(dataplane.GetMeta().GetMesh())); err != nil {
+		return nil, errors.Wrap(err, "could not retrieve fault injections")
+	}
+	return faultInjections, nil
+}
+
+// outboundFaultInjection hides the protocol tag of destination selectors,
+// since outbound interfaces are tagged only with a service.
+// The protocol is taken into account when faults are applied to an outbound listener.
+type outboundFaultInjection struct {
+	*mesh_core.FaultInjectionResource
+}
+
+func (f *outboundFaultInjection) Destinations() []*mesh_proto.Selector {
+	var destinations []*mesh_proto.Selector
+	for _, selector := range f.Spec.GetDestinations() {
+		match := mesh_proto.TagSelector{}
+		for key, value := range selector.GetMatch() {
+			if key == mesh_proto.ProtocolTag {
+				continue
+			}
+			match[key] = value
+		}
+		destinations = append(destinations, &mesh_proto.Selector{Match: match})
+	}
+	return destinations
+}
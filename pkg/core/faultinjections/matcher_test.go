@@ -171,5 +171,158 @@ var _ = Describe("Match", func() {
 				}).Spec,
 			},
 		}),
+		Entry("should ignore policies applied on the source side", testCase{
+			dataplane: dataplaneWithInboundsFunc([]*mesh_proto.Dataplane_Networking_Inbound{
+				{
+					ServicePort: 8080,
+					Tags: map[string]string{
+						"service":  "web",
+						"protocol": "http",
+					},
+				},
+			}),
+			policies: []*mesh.FaultInjectionResource{
+				sourceSide(policyWithDestinationsFunc("fi1", time.Unix(1, 0), []*mesh_proto.Selector{
+					{
+						Match: map[string]string{
+							"service":  "web",
+							"protocol": "http",
+						},
+					},
+				})),
+			},
+			expected: core_xds.FaultInjectionMap{},
+		}),
 	)
+
+	Describe("MatchOutbound", func() {
+		It("should pick policies applied on the source side for each outbound", func() {
+			// given
+			manager := core_manager.NewResourceManager(memory.NewStore())
+			matcher := FaultInjectionMatcher{ResourceManager: manager}
+
+			err := manager.Create(context.Background(), &mesh.MeshResource{}, store.CreateByKey("default", "default"))
+			Expect(err).ToNot(HaveOccurred())
+
+			// and
+			dataplane := dataplaneWithInboundsFunc([]*mesh_proto.Dataplane_Networking_Inbound{
+				{
+					ServicePort: 8080,
+					Tags: map[string]string{
+						"service":  "web",
+						"protocol": "http",
+					},
+				},
+			})
+			dataplane.Spec.Networking.Outbound = []*mesh_proto.Dataplane_Networking_Outbound{
+				{Port: 10001, Service: "backend"},
+				{Port: 10002, Service: "redis"},
+			}
+
+			// and
+			backend := sourceSide(policyWithDestinationsFunc("fi1", time.Unix(1, 0), []*mesh_proto.Selector{
+				{
+					Match: map[string]string{
+						"service":  "backend",
+						"protocol": "http",
+					},
+				},
+			}))
+			redis := policyWithDestinationsFunc("fi2", time.Unix(1, 0), []*mesh_proto.Selector{
+				{
+					Match: map[string]string{
+						"service":  "redis",
+						"protocol": "http",
+					},
+				},
+			})
+			for _, p := range []*mesh.FaultInjectionResource{backend, redis} {
+				err := manager.Create(context.Background(), p, store.CreateByKey(p.Meta.GetName(), "default"))
+				Expect(err).ToNot(HaveOccurred())
+			}
+
+			// when
+			matched, err := matcher.MatchOutbound(context.Background(), dataplane, nil)
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			Expect(matched).To(HaveLen(1))
+			Expect(matched).To(HaveKey("backend"))
+			Expect(matched["backend"].GetDestinations()).To(Equal(backend.Spec.Destinations))
+			Expect(matched["backend"].IsSourceSide()).To(BeTrue())
+		})
+
+		It("should pick policies applied on either side for each ExternalService", func() {
+			// given
+			manager := core_manager.NewResourceManager(memory.NewStore())
+			matcher := FaultInjectionMatcher{ResourceManager: manager}
+
+			err := manager.Create(context.Background(), &mesh.MeshResource{}, store.CreateByKey("default", "default"))
+			Expect(err).ToNot(HaveOccurred())
+
+			// and
+			dataplane := dataplaneWithInboundsFunc([]*mesh_proto.Dataplane_Networking_Inbound{
+				{
+					ServicePort: 8080,
+					Tags: map[string]string{
+						"service":  "web",
+						"protocol": "http",
+					},
+				},
+			})
+			dataplane.Spec.Networking.Outbound = []*mesh_proto.Dataplane_Networking_Outbound{
+				{Port: 10001, Service: "backend"},
+				{Port: 10002, Service: "httpbin"},
+			}
+			outbound := core_xds.EndpointMap{
+				"backend": []core_xds.Endpoint{
+					{Target: "192.168.0.1", Port: 8080, Tags: map[string]string{"service": "backend"}},
+				},
+				"httpbin": []core_xds.Endpoint{
+					{
+						Target:          "httpbin.org",
+						Port:            80,
+						Tags:            map[string]string{"service": "httpbin", "protocol": "http"},
+						ExternalService: &core_xds.ExternalService{Name: "httpbin"},
+					},
+				},
+			}
+
+			// and
+			backend := policyWithDestinationsFunc("fi1", time.Unix(1, 0), []*mesh_proto.Selector{
+				{
+					Match: map[string]string{
+						"service":  "backend",
+						"protocol": "http",
+					},
+				},
+			})
+			httpbin := policyWithDestinationsFunc("fi2", time.Unix(1, 0), []*mesh_proto.Selector{
+				{
+					Match: map[string]string{
+						"service":  "httpbin",
+						"protocol": "http",
+					},
+				},
+			})
+			for _, p := range []*mesh.FaultInjectionResource{backend, httpbin} {
+				err := manager.Create(context.Background(), p, store.CreateByKey(p.Meta.GetName(), "default"))
+				Expect(err).ToNot(HaveOccurred())
+			}
+
+			// when
+			matched, err := matcher.MatchOutbound(context.Background(), dataplane, outbound)
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			Expect(matched).To(HaveLen(1))
+			Expect(matched).To(HaveKey("httpbin"))
+			Expect(matched["httpbin"].GetDestinations()).To(Equal(httpbin.Spec.Destinations))
+		})
+	})
 })
+
+func sourceSide(faultInjection *mesh.FaultInjectionResource) *mesh.FaultInjectionResource {
+	faultInjection.Spec.Conf.Side = mesh_proto.FaultInjectionSideSource
+	return faultInjection
+}
@@ -17,8 +17,12 @@ import (
 var logger = core.Log.WithName("logs")
 
 // Current limitations:
-// 1) Right now we only generate and place access logs for outbound listeners
-// 2) We match all tags in source section of TrafficLog but only service tag on destination
+// 1) On outbound listeners we match all tags in source section of TrafficLog but only service tag on destination
+// 2) On inbound listeners we match only TrafficLogs that enabled inbound logging explicitly (`conf.inbound`).
+//    We match destination section of TrafficLog against tags of an inbound interface
+//    but we don't take source section into account, since a source is only known at runtime.
+//    That is why such TrafficLogs are required to select all sources.
+//    Instead, an identity of a source is recorded in log entries from the mTLS peer.
 // 3) Let's assume we've got following dataplanes:
 //    Dataplane 1 with services: kong and kong-admin
 //    Dataplane 2 with services: backend
@@ -52,7 +56,51 @@ func (m *TrafficLogsMatcher) Match(ctx context.Context, dataplane *mesh_core.Dat
 			logger.Info("Logging backend is not found. Ignoring.", "name", log.Spec.GetConf().GetBackend(), "trafficLog", log.GetMeta())
 			continue
 		}
-		logMap[service] = backend
+		logMap[service] = &core_xds.AccessLog{
+			Backend: backend,
+			Filters: log.Spec.GetConf().GetFilters(),
+		}
+	}
+	return logMap, nil
+}
+
+// MatchInbound picks a single the most specific TrafficLog for each inbound interface of a given Dataplane
+// among TrafficLogs that enabled inbound logging.
+func (m *TrafficLogsMatcher) MatchInbound(ctx context.Context, dataplane *mesh_core.DataplaneResource) (core_xds.InboundLogMap, error) {
+	logs := &mesh_core.TrafficLogResourceList{}
+	if err := m.ResourceManager.List(ctx, logs, store.ListByMesh(dataplane.GetMeta().GetMesh())); err != nil {
+		return nil, errors.Wrap(err, "could not retrieve traffic logs")
+	}
+	backends, err := m.backendsByName(ctx, dataplane)
+	if err != nil {
+		return nil, err
+	}
+
+	var policies []policy.ConnectionPolicy
+	for _, log := range logs.Items {
+		// sources cannot be matched on the inbound side, that is why inbound logging is opt-in
+		if !log.Spec.GetConf().GetInbound() {
+			continue
+		}
+		policies = append(policies, log)
+	}
+	policyMap, err := policy.SelectInboundConnectionPolicies(dataplane, policies)
+	if err != nil {
+		return nil, err
+	}
+
+	logMap := core_xds.InboundLogMap{}
+	for inbound, policy := range policyMap {
+		log := policy.(*mesh_core.TrafficLogResource)
+		backend, found := backends[log.Spec.GetConf().GetBackend()]
+		if !found {
+			logger.Info("Logging backend is not found. Ignoring.", "name", log.Spec.GetConf().GetBackend(), "trafficLog", log.GetMeta())
+			continue
+		}
+		logMap[inbound] = &core_xds.AccessLog{
+			Backend: backend,
+			Filters: log.Spec.GetConf().GetFilters(),
+		}
 	}
 	return logMap, nil
 }
@@ -107,6 +107,9 @@ var _ = Describe("Matcher", func() {
 				},
 				Conf: &mesh_proto.TrafficLog_Conf{
 					Backend: "file2",
+					Filters: []*mesh_proto.TrafficLog_Conf_Filter{
+						{OnlyErrors: true},
+					},
 				},
 			},
 		}
@@ -141,11 +144,12 @@ var _ = Describe("Matcher", func() {
 		// then
 		Expect(err).ToNot(HaveOccurred())
 		// should match because kong->backend rule
-		Expect(log["backend"]).To(Equal(backendFile2))
+		Expect(log["backend"].Backend).To(Equal(backendFile2))
+		Expect(log["backend"].Filters).To(Equal([]*mesh_proto.TrafficLog_Conf_Filter{{OnlyErrors: true}}))
 		// should match because *->* rule and default backend file1
-		Expect(log["web"]).To(Equal(backendFile1))
+		Expect(log["web"].Backend).To(Equal(backendFile1))
 		// should match implicit pass through because service *->* rule and default backend file1
-		Expect(log[core_mesh.PassThroughService]).To(Equal(backendFile1))
+		Expect(log[core_mesh.PassThroughService].Backend).To(Equal(backendFile1))
 	})
 
 	It("should not match services", func() {
@@ -215,4 +219,124 @@ var _ = Describe("Matcher", func() {
 		Expect(err).ToNot(HaveOccurred())
 		Expect(log).To(HaveLen(0))
 	})
+
+	It("should match rules for inbound interfaces", func() {
+		// given
+		logRes1 := core_mesh.TrafficLogResource{
+			Spec: mesh_proto.TrafficLog{
+				Sources: []*mesh_proto.Selector{
+					{
+						Match: map[string]string{
+							"service": "*",
+						},
+					},
+				},
+				Destinations: []*mesh_proto.Selector{
+					{
+						Match: map[string]string{
+							"service": "kong-admin",
+						},
+					},
+				},
+				Conf: &mesh_proto.TrafficLog_Conf{
+					Backend: "file2",
+					Inbound: true,
+				},
+			},
+		}
+		err := manager.Create(context.Background(), &logRes1, store.CreateByKey("lr-1", "sample"))
+		Expect(err).ToNot(HaveOccurred())
+
+		// and
+		logRes2 := core_mesh.TrafficLogResource{
+			Spec: mesh_proto.TrafficLog{
+				Sources: []*mesh_proto.Selector{
+					{
+						Match: map[string]string{
+							"service": "*",
+						},
+					},
+				},
+				Destinations: []*mesh_proto.Selector{
+					{
+						Match: map[string]string{
+							"service": "*",
+						},
+					},
+				},
+				Conf: &mesh_proto.TrafficLog_Conf{
+					Inbound: true,
+				},
+			},
+		}
+		err = manager.Create(context.Background(), &logRes2, store.CreateByKey("lr-2", "sample"))
+		Expect(err).ToNot(HaveOccurred())
+
+		// and more specific rule that has not enabled inbound logging
+		logRes3 := core_mesh.TrafficLogResource{
+			Spec: mesh_proto.TrafficLog{
+				Sources: []*mesh_proto.Selector{
+					{
+						Match: map[string]string{
+							"service": "web",
+						},
+					},
+				},
+				Destinations: []*mesh_proto.Selector{
+					{
+						Match: map[string]string{
+							"service": "kong-admin",
+						},
+					},
+				},
+				Conf: &mesh_proto.TrafficLog_Conf{
+					Backend: "file1",
+				},
+			},
+		}
+		err = manager.Create(context.Background(), &logRes3, store.CreateByKey("lr-3", "sample"))
+		Expect(err).ToNot(HaveOccurred())
+
+		// when
+		log, err := matcher.MatchInbound(context.Background(), &dpRes)
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(log).To(HaveLen(2))
+		// should match because *->* rule and default backend file1
+		Expect(log[mesh_proto.InboundInterface{DataplaneIP: "127.0.0.1", DataplanePort: 8080, WorkloadPort: 8081}].Backend).To(Equal(backendFile1))
+		// should match because *->kong-admin rule, web->kong-admin rule is ignored since it has not enabled inbound logging
+		Expect(log[mesh_proto.InboundInterface{DataplaneIP: "127.0.0.1", DataplanePort: 8090, WorkloadPort: 8091}].Backend).To(Equal(backendFile2))
+	})
+
+	It("should not match rules for inbound interfaces unless inbound logging is enabled", func() {
+		// given
+		logRes := core_mesh.TrafficLogResource{
+			Spec: mesh_proto.TrafficLog{
+				Sources: []*mesh_proto.Selector{
+					{
+						Match: map[string]string{
+							"service": "web",
+						},
+					},
+				},
+				Destinations: []*mesh_proto.Selector{
+					{
+						Match: map[string]string{
+							"service": "*",
+						},
+					},
+				},
+			},
+		}
+		err := manager.Create(context.Background(), &logRes, store.CreateByKey("lr-1", "sample"))
+		Expect(err).ToNot(HaveOccurred())
+
+		// when
+		log, err := matcher.MatchInbound(context.Background(), &dpRes)
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(log).To(BeEmpty())
+	})
 })
@@ -5,6 +5,7 @@ import (
 
 	"github.com/pkg/errors"
 
+	mesh_proto "github.com/Kong/kuma/api/mesh/v1alpha1"
 	"github.com/Kong/kuma/pkg/core/policy"
 	mesh_core "github.com/Kong/kuma/pkg/core/resources/apis/mesh"
 	"github.com/Kong/kuma/pkg/core/resources/manager"
@@ -17,9 +18,9 @@ type TrafficPermissionsMatcher struct {
 }
 
 func (m *TrafficPermissionsMatcher) Match(ctx context.Context, dataplane *mesh_core.DataplaneResource) (core_xds.TrafficPermissionMap, error) {
-	permissions := &mesh_core.TrafficPermissionResourceList{}
-	if err := m.ResourceManager.List(ctx, permissions, store.ListByMesh(dataplane.GetMeta().GetMesh())); err != nil {
-		return nil, errors.Wrap(err, "could not retrieve traffic permissions")
+	permissions, err := m.list(ctx, dataplane)
+	if err != nil {
+		return nil, err
 	}
 
 	policies := make([]policy.ConnectionPolicy, len(permissions.Items))
@@ -38,3 +39,59 @@ func (m *TrafficPermissionsMatcher) Match(ctx context.Context, dataplane *mesh_c
 	}
 	return result, nil
 }
+
+// MatchExternalServices returns ExternalServices a given Dataplane is permitted to reach.
+//
+// Since ExternalServices have no inbound listener to enforce TrafficPermissions on,
+// a Dataplane is not let know about ExternalServices it is not permitted to reach.
+func (m *TrafficPermissionsMatcher) MatchExternalServices(ctx context.Context, dataplane *mesh_core.DataplaneResource, externalServices []*mesh_core.ExternalServiceResource) ([]*mesh_core.ExternalServiceResource, error) {
+	permissions, err := m.list(ctx, dataplane)
+	if err != nil {
+		return nil, err
+	}
+
+	var permitted []*mesh_core.ExternalServiceResource
+	for _, externalService := range externalServices {
+		for _, permission := range permissions.Items {
+			if permits(permission, dataplane, externalService) {
+				permitted = append(permitted, externalService)
+				break
+			}
+		}
+	}
+	return permitted, nil
+}
+
+// ReachableExternalServices returns ExternalServices a given Dataplane can reach.
+//
+// TrafficPermissions are in effect only if mTLS is enabled in a Mesh, that is why without mTLS
+// every ExternalService of a Mesh is reachable by every Dataplane, the same way services of Dataplanes are.
+func (m *TrafficPermissionsMatcher) ReachableExternalServices(ctx context.Context, mesh *mesh_core.MeshResource, dataplane *mesh_core.DataplaneResource, externalServices []*mesh_core.ExternalServiceResource) ([]*mesh_core.ExternalServiceResource, error) {
+	if !mesh.Spec.GetMtls().GetEnabled() {
+		return externalServices, nil
+	}
+	return m.MatchExternalServices(ctx, dataplane, externalServices)
+}
+
+// permits returns true if a given TrafficPermission lets a Dataplane reach an ExternalService.
+func permits(permission *mesh_core.TrafficPermissionResource, dataplane *mesh_core.DataplaneResource, externalService *mesh_core.ExternalServiceResource) bool {
+	for _, source := range permission.Sources() {
+		if !dataplane.Spec.Matches(mesh_proto.TagSelector(source.Match)) {
+			continue
+		}
+		for _, destination := range permission.Destinations() {
+			if mesh_proto.TagSelector(destination.Match).Matches(externalService.Spec.GetTags()) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func (m *TrafficPermissionsMatcher) list(ctx context.Context, dataplane *mesh_core.DataplaneResource) (*mesh_core.TrafficPermissionResourceList, error) {
+	permissions := &mesh_core.TrafficPermissionResourceList{}
+	if err := m.ResourceManager.List(ctx, permissions, store.ListByMesh(dataplane.GetMeta().GetMesh())); err != nil {
+		return nil, errors.Wrap(err, "could not retrieve traffic permissions")
+	}
+	return permissions, nil
+}
@@ -127,4 +127,66 @@ var _ = Describe("Match", func() {
 			},
 		}),
 	)
+
+	Describe("MatchExternalServices()", func() {
+		It("should return only ExternalServices a Dataplane is permitted to reach", func() {
+			// given
+			manager := core_manager.NewResourceManager(memory.NewStore())
+			matcher := permissions.TrafficPermissionsMatcher{ResourceManager: manager}
+
+			err := manager.Create(context.Background(), &mesh.MeshResource{}, store.CreateByKey("default", "default"))
+			Expect(err).ToNot(HaveOccurred())
+
+			// and
+			permission := &mesh.TrafficPermissionResource{
+				Spec: mesh_proto.TrafficPermission{
+					Sources: []*mesh_proto.Selector{
+						{Match: map[string]string{"service": "web"}},
+					},
+					Destinations: []*mesh_proto.Selector{
+						{Match: map[string]string{"service": "httpbin"}},
+					},
+				},
+			}
+			err = manager.Create(context.Background(), permission, store.CreateByKey("web-to-httpbin", "default"))
+			Expect(err).ToNot(HaveOccurred())
+
+			// and
+			dataplane := &mesh.DataplaneResource{
+				Meta: &model.ResourceMeta{
+					Mesh: "default",
+					Name: "dp1",
+				},
+				Spec: mesh_proto.Dataplane{
+					Networking: &mesh_proto.Dataplane_Networking{
+						Inbound: []*mesh_proto.Dataplane_Networking_Inbound{
+							{
+								ServicePort: 8080,
+								Tags:        map[string]string{"service": "web"},
+							},
+						},
+					},
+				},
+			}
+			httpbin := &mesh.ExternalServiceResource{
+				Meta: &model.ResourceMeta{Mesh: "default", Name: "httpbin"},
+				Spec: mesh_proto.ExternalService{
+					Tags: map[string]string{"service": "httpbin"},
+				},
+			}
+			postgres := &mesh.ExternalServiceResource{
+				Meta: &model.ResourceMeta{Mesh: "default", Name: "postgres"},
+				Spec: mesh_proto.ExternalService{
+					Tags: map[string]string{"service": "postgres"},
+				},
+			}
+
+			// when
+			permitted, err := matcher.MatchExternalServices(context.Background(), dataplane, []*mesh.ExternalServiceResource{httpbin, postgres})
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			Expect(permitted).To(ConsistOf(httpbin))
+		})
+	})
 })
@@ -61,6 +61,21 @@ func SelectOutboundConnectionPolicies(dataplane *mesh_core.DataplaneResource, po
 
 // SelectConnectionPolicies picks a single the most specific policy applicable to a connection between a given dataplane and given destination services.
 func SelectConnectionPolicies(dataplane *mesh_core.DataplaneResource, destinations ServiceIterator, policies []ConnectionPolicy) OutboundConnectionPolicyMap {
+	policyMap := OutboundConnectionPolicyMap{}
+	for service, ranked := range SelectRankedConnectionPolicies(dataplane, destinations, policies) {
+		policyMap[service] = ranked.Policy
+	}
+	return policyMap
+}
+
+// SelectRankedOutboundConnectionPolicies is like SelectOutboundConnectionPolicies but also returns a rank of every selected policy.
+func SelectRankedOutboundConnectionPolicies(dataplane *mesh_core.DataplaneResource, policies []ConnectionPolicy) OutboundRankedConnectionPolicyMap {
+	return SelectRankedConnectionPolicies(dataplane, ToOutboundServicesOf(dataplane), policies)
+}
+
+// SelectRankedConnectionPolicies is like SelectConnectionPolicies but also returns a rank of every selected policy.
+// The rank is an aggregate of the most specific `source` and `destination` selectors.
+func SelectRankedConnectionPolicies(dataplane *mesh_core.DataplaneResource, destinations ServiceIterator, policies []ConnectionPolicy) OutboundRankedConnectionPolicyMap {
 	sort.Stable(ConnectionPolicyByName(policies)) // sort to avoid flakiness
 
 	// First, select only those ConnectionPolicies that have a `source` selector matching a given Dataplane.
@@ -131,9 +146,9 @@ func SelectConnectionPolicies(dataplane *mesh_core.DataplaneResource, destinatio
 		}
 	}
 
-	policyMap := OutboundConnectionPolicyMap{}
+	policyMap := OutboundRankedConnectionPolicyMap{}
 	for service, candidate := range candidatesByDestination {
-		policyMap[service] = candidate.policy
+		policyMap[service] = RankedConnectionPolicy{Policy: candidate.policy, Rank: candidate.bestAggregateRank}
 	}
 	return policyMap
 }
@@ -142,9 +157,22 @@ func SelectConnectionPolicies(dataplane *mesh_core.DataplaneResource, destinatio
 // For each inbound we pick a policy that matches the most destination tags with inbound tags
 // Sources part of matched policies are later used in Envoy config to apply it only for connection that matches sources
 func SelectInboundConnectionPolicies(dataplane *mesh_core.DataplaneResource, policies []ConnectionPolicy) (InboundConnectionPolicyMap, error) {
+	rankedMap, err := SelectRankedInboundConnectionPolicies(dataplane, policies)
+	if err != nil {
+		return nil, err
+	}
+	policiesMap := make(InboundConnectionPolicyMap)
+	for inbound, ranked := range rankedMap {
+		policiesMap[inbound] = ranked.Policy
+	}
+	return policiesMap, nil
+}
+
+// SelectRankedInboundConnectionPolicies is like SelectInboundConnectionPolicies but also returns a rank of every selected policy.
+func SelectRankedInboundConnectionPolicies(dataplane *mesh_core.DataplaneResource, policies []ConnectionPolicy) (InboundRankedConnectionPolicyMap, error) {
 	sort.Stable(ConnectionPolicyByName(policies)) // sort to avoid flakiness
 
-	policiesMap := make(InboundConnectionPolicyMap)
+	policiesMap := make(InboundRankedConnectionPolicyMap)
 	ifaces, err := dataplane.Spec.GetNetworking().GetInboundInterfaces()
 	if err != nil {
 		return nil, err
@@ -171,7 +199,7 @@ func SelectInboundConnectionPolicies(dataplane *mesh_core.DataplaneResource, pol
 		}
 
 		if bestPolicy != nil {
-			policiesMap[ifaces[i]] = bestPolicy
+			policiesMap[ifaces[i]] = RankedConnectionPolicy{Policy: bestPolicy, Rank: bestRank}
 		}
 	}
 
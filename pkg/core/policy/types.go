@@ -18,6 +18,18 @@ type OutboundConnectionPolicyMap map[core_xds.ServiceName]ConnectionPolicy
 
 type InboundConnectionPolicyMap map[mesh_proto.InboundInterface]ConnectionPolicy
 
+// RankedConnectionPolicy is a ConnectionPolicy together with a rank of its most specific selectors that matched.
+type RankedConnectionPolicy struct {
+	Policy ConnectionPolicy
+	Rank   mesh_proto.TagSelectorRank
+}
+
+// OutboundRankedConnectionPolicyMap holds the most specific ConnectionPolicy and its rank for each outbound interface of a Dataplane.
+type OutboundRankedConnectionPolicyMap map[core_xds.ServiceName]RankedConnectionPolicy
+
+// InboundRankedConnectionPolicyMap holds the most specific ConnectionPolicy and its rank for each inbound interface of a Dataplane.
+type InboundRankedConnectionPolicyMap map[mesh_proto.InboundInterface]RankedConnectionPolicy
+
 // DataplanePolicy is a Policy that is applied on a selected Dataplane
 type DataplanePolicy interface {
 	core_model.Resource
@@ -0,0 +1,91 @@
+package mesh
+
+import (
+	"reflect"
+
+	"github.com/golang/protobuf/ptypes/wrappers"
+
+	mesh_proto "github.com/Kong/kuma/api/mesh/v1alpha1"
+	"github.com/Kong/kuma/pkg/core/validators"
+)
+
+func (c *CircuitBreakerResource) HasThresholds() bool {
+	thresholds := c.Spec.Conf.GetThresholds()
+	return thresholds != nil && !reflect.DeepEqual(*thresholds, mesh_proto.CircuitBreaker_Conf_Thresholds{})
+}
+
+func (c *CircuitBreakerResource) HasOutlierDetection() bool {
+	outlierDetection := c.Spec.Conf.GetOutlierDetection()
+	return outlierDetection != nil && !reflect.DeepEqual(*outlierDetection, mesh_proto.CircuitBreaker_Conf_OutlierDetection{})
+}
+
+func (c *CircuitBreakerResource) Validate() error {
+	var err validators.ValidationError
+	err.Add(c.validateSources())
+	err.Add(c.validateDestinations())
+	err.Add(c.validateConf())
+	return err.OrNil()
+}
+
+func (c *CircuitBreakerResource) validateSources() validators.ValidationError {
+	return ValidateSelectors(validators.RootedAt("sources"), c.Spec.Sources, ValidateSelectorsOpts{
+		RequireAtLeastOneSelector: true,
+		ValidateSelectorOpts: ValidateSelectorOpts{
+			RequireAtLeastOneTag: true,
+			RequireService:       true,
+		},
+	})
+}
+
+func (c *CircuitBreakerResource) validateDestinations() (err validators.ValidationError) {
+	return ValidateSelectors(validators.RootedAt("destinations"), c.Spec.Destinations, OnlyServiceTagAllowed)
+}
+
+func (c *CircuitBreakerResource) validateConf() (err validators.ValidationError) {
+	root := validators.RootedAt("conf")
+	if !c.HasThresholds() && !c.HasOutlierDetection() {
+		err.AddViolationAt(root, "must have either thresholds or outlier detection configured")
+	}
+	if c.HasThresholds() {
+		path := root.Field("thresholds")
+		thresholds := c.Spec.Conf.GetThresholds()
+		err.Add(validateOptionalThreshold(path.Field("maxConnections"), thresholds.GetMaxConnections()))
+		err.Add(validateOptionalThreshold(path.Field("maxPendingRequests"), thresholds.GetMaxPendingRequests()))
+		err.Add(validateOptionalThreshold(path.Field("maxRequests"), thresholds.GetMaxRequests()))
+		err.Add(validateOptionalThreshold(path.Field("maxRetries"), thresholds.GetMaxRetries()))
+	}
+	if c.HasOutlierDetection() {
+		err.Add(validateOutlierDetection(root.Field("outlierDetection"), c.Spec.Conf.GetOutlierDetection()))
+	}
+	return
+}
+
+func validateOutlierDetection(path validators.PathBuilder, conf *mesh_proto.CircuitBreaker_Conf_OutlierDetection) (err validators.ValidationError) {
+	if conf.GetConsecutive_5Xx() == nil && conf.GetConsecutiveGatewayFailure() == nil && conf.GetSuccessRate() == nil {
+		err.AddViolationAt(path, "must have at least one of consecutive5xx, consecutiveGatewayFailure or successRate configured")
+	}
+	err.Add(validateOptionalThreshold(path.Field("consecutive5xx"), conf.GetConsecutive_5Xx()))
+	err.Add(validateOptionalThreshold(path.Field("consecutiveGatewayFailure"), conf.GetConsecutiveGatewayFailure()))
+	if successRate := conf.GetSuccessRate(); successRate != nil {
+		err.Add(validateOptionalThreshold(path.Field("successRate").Field("minimumHosts"), successRate.GetMinimumHosts()))
+		err.Add(validateOptionalThreshold(path.Field("successRate").Field("requestVolume"), successRate.GetRequestVolume()))
+		err.Add(validateOptionalThreshold(path.Field("successRate").Field("stdevFactor"), successRate.GetStdevFactor()))
+	}
+	if conf.GetInterval() != nil {
+		err.Add(ValidateDuration(path.Field("interval"), conf.GetInterval()))
+	}
+	if conf.GetBaseEjectionTime() != nil {
+		err.Add(ValidateDuration(path.Field("baseEjectionTime"), conf.GetBaseEjectionTime()))
+	}
+	if conf.GetMaxEjectionPercent() != nil && conf.GetMaxEjectionPercent().GetValue() > 100 {
+		err.AddViolationAt(path.Field("maxEjectionPercent"), "must not be greater than 100")
+	}
+	return
+}
+
+func validateOptionalThreshold(path validators.PathBuilder, threshold *wrappers.UInt32Value) (err validators.ValidationError) {
+	if threshold == nil {
+		return
+	}
+	return ValidateThreshold(path, threshold.GetValue())
+}
@@ -0,0 +1,200 @@
+package mesh_test
+
+import (
+	"github.com/ghodss/yaml"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+
+	. "github.com/Kong/kuma/pkg/core/resources/apis/mesh"
+	util_proto "github.com/Kong/kuma/pkg/util/proto"
+)
+
+var _ = Describe("CircuitBreaker", func() {
+	Describe("Validate()", func() {
+		type testCase struct {
+			circuitBreaker string
+			expected       string
+		}
+		DescribeTable("should validate all fields and return as much individual errors as possible",
+			func(given testCase) {
+				// setup
+				circuitBreaker := CircuitBreakerResource{}
+
+				// when
+				err := util_proto.FromYAML([]byte(given.circuitBreaker), &circuitBreaker.Spec)
+				// then
+				Expect(err).ToNot(HaveOccurred())
+
+				// when
+				verr := circuitBreaker.Validate()
+				// and
+				actual, err := yaml.Marshal(verr)
+
+				// then
+				Expect(err).ToNot(HaveOccurred())
+				// and
+				Expect(actual).To(MatchYAML(given.expected))
+			},
+			Entry("empty spec", testCase{
+				circuitBreaker: ``,
+				expected: `
+                violations:
+                - field: sources
+                  message: must have at least one element
+                - field: destinations
+                  message: must have at least one element
+                - field: conf
+                  message: must have either thresholds or outlier detection configured
+`,
+			}),
+			Entry("selectors without tags", testCase{
+				circuitBreaker: `
+                sources:
+                - match: {}
+                destinations:
+                - match: {}
+                conf:
+                  thresholds: {}
+                  outlierDetection: {}
+`,
+				expected: `
+                violations:
+                - field: sources[0].match
+                  message: must have at least one tag
+                - field: sources[0].match
+                  message: mandatory tag "service" is missing
+                - field: destinations[0].match
+                  message: must consist of exactly one tag "service"
+                - field: destinations[0].match
+                  message: mandatory tag "service" is missing
+                - field: conf
+                  message: must have either thresholds or outlier detection configured
+`,
+			}),
+			Entry("invalid thresholds", testCase{
+				circuitBreaker: `
+                sources:
+                - match:
+                    service: web
+                destinations:
+                - match:
+                    service: backend
+                conf:
+                  thresholds:
+                    maxConnections: 0
+                    maxPendingRequests: 0
+                    maxRequests: 0
+                    maxRetries: 0
+`,
+				expected: `
+                violations:
+                - field: conf.thresholds.maxConnections
+                  message: must have a positive value
+                - field: conf.thresholds.maxPendingRequests
+                  message: must have a positive value
+                - field: conf.thresholds.maxRequests
+                  message: must have a positive value
+                - field: conf.thresholds.maxRetries
+                  message: must have a positive value
+`,
+			}),
+			Entry("outlier detection without detectors", testCase{
+				circuitBreaker: `
+                sources:
+                - match:
+                    service: web
+                destinations:
+                - match:
+                    service: backend
+                conf:
+                  outlierDetection:
+                    baseEjectionTime: 30s
+`,
+				expected: `
+                violations:
+                - field: conf.outlierDetection
+                  message: must have at least one of consecutive5xx, consecutiveGatewayFailure or successRate configured
+`,
+			}),
+			Entry("invalid outlier detection", testCase{
+				circuitBreaker: `
+                sources:
+                - match:
+                    service: web
+                destinations:
+                - match:
+                    service: backend
+                conf:
+                  outlierDetection:
+                    consecutive5xx: 0
+                    consecutiveGatewayFailure: 0
+                    successRate:
+                      minimumHosts: 0
+                      requestVolume: 0
+                      stdevFactor: 0
+                    interval: 0s
+                    baseEjectionTime: 0s
+                    maxEjectionPercent: 101
+`,
+				expected: `
+                violations:
+                - field: conf.outlierDetection.consecutive5xx
+                  message: must have a positive value
+                - field: conf.outlierDetection.consecutiveGatewayFailure
+                  message: must have a positive value
+                - field: conf.outlierDetection.successRate.minimumHosts
+                  message: must have a positive value
+                - field: conf.outlierDetection.successRate.requestVolume
+                  message: must have a positive value
+                - field: conf.outlierDetection.successRate.stdevFactor
+                  message: must have a positive value
+                - field: conf.outlierDetection.interval
+                  message: must have a positive value
+                - field: conf.outlierDetection.baseEjectionTime
+                  message: must have a positive value
+                - field: conf.outlierDetection.maxEjectionPercent
+                  message: must not be greater than 100
+`,
+			}),
+		)
+
+		It("should pass validation of a valid CircuitBreaker", func() {
+			// setup
+			circuitBreaker := CircuitBreakerResource{}
+
+			// when
+			err := util_proto.FromYAML([]byte(`
+            sources:
+            - match:
+                service: web
+            destinations:
+            - match:
+                service: backend
+            conf:
+              thresholds:
+                maxConnections: 1024
+                maxPendingRequests: 128
+                maxRequests: 1024
+                maxRetries: 3
+              outlierDetection:
+                consecutive5xx: 5
+                consecutiveGatewayFailure: 3
+                successRate:
+                  minimumHosts: 5
+                  requestVolume: 100
+                  stdevFactor: 1900
+                interval: 10s
+                baseEjectionTime: 30s
+                maxEjectionPercent: 50
+`), &circuitBreaker.Spec)
+			// then
+			Expect(err).ToNot(HaveOccurred())
+
+			// when
+			verr := circuitBreaker.Validate()
+			// then
+			Expect(verr).ToNot(HaveOccurred())
+		})
+	})
+})
@@ -3,6 +3,8 @@ package mesh
 import (
 	"fmt"
 	"net"
+	"regexp"
+	"strings"
 
 	mesh_proto "github.com/Kong/kuma/api/mesh/v1alpha1"
 	"github.com/Kong/kuma/pkg/core/validators"
@@ -11,6 +13,7 @@ import (
 func (d *DataplaneResource) Validate() error {
 	var err validators.ValidationError
 	err.Add(validateNetworking(d.Spec.GetNetworking()))
+	err.AddError("metrics", validateDataplaneMetrics(d.Spec.GetMetrics()))
 	return err.OrNil()
 }
 
@@ -141,3 +144,37 @@ func validateGateway(gateway *mesh_proto.Dataplane_Networking_Gateway) validator
 	}
 	return result
 }
+
+var applicationNameRegexp = regexp.MustCompile(`^[a-zA-Z0-9_-]+$`)
+
+func validateDataplaneMetrics(metrics *mesh_proto.Metrics) validators.ValidationError {
+	var result validators.ValidationError
+	if metrics.GetStatsd() != nil {
+		result.AddViolation("statsd", "can only be defined on a Mesh")
+	}
+	if metrics.GetDogstatsd() != nil {
+		result.AddViolation("dogstatsd", "can only be defined on a Mesh")
+	}
+	if len(metrics.GetTagExtractors()) > 0 {
+		result.AddViolation("tagExtractors", "can only be defined on a Mesh")
+	}
+	names := map[string]bool{}
+	for i, app := range metrics.GetPrometheus().GetApplications() {
+		path := validators.RootedAt("prometheus").Field("applications").Index(i)
+		if app.Name == "" {
+			result.AddViolationAt(path.Field("name"), "cannot be empty")
+		} else if !applicationNameRegexp.MatchString(app.Name) {
+			result.AddViolationAt(path.Field("name"), "has to consist of alphanumeric characters, '-' or '_'")
+		} else if names[app.Name] {
+			result.AddViolationAt(path.Field("name"), fmt.Sprintf("application %q is already defined", app.Name))
+		}
+		names[app.Name] = true
+		if app.Port < 1 || app.Port > 65535 {
+			result.AddViolationAt(path.Field("port"), "port has to be in range of [1, 65535]")
+		}
+		if app.Path != "" && !strings.HasPrefix(app.Path, "/") {
+			result.AddViolationAt(path.Field("path"), "has to start with '/'")
+		}
+	}
+	return result
+}
@@ -76,6 +76,25 @@ var _ = Describe("Dataplane", func() {
                   address: 127.0.0.1
                   service: redis`,
 		),
+		Entry("dataplane with metrics of applications", `
+            type: Dataplane
+            name: dp-1
+            mesh: default
+            networking:
+              address: 192.168.0.1
+              inbound:
+                - port: 8080
+                  tags:
+                    service: backend
+            metrics:
+              prometheus:
+                applications:
+                - name: backend
+                  port: 8081
+                - name: sidecar
+                  port: 9090
+                  path: /stats`,
+		),
 		Entry("dataplane with gateway", `
             type: Dataplane
             name: dp-1
@@ -400,6 +419,57 @@ var _ = Describe("Dataplane", func() {
                 - field: networking.outbound[1].port
                   message: port has to be in range of [1, 65535]`,
 		}),
+		Entry("metrics: invalid applications and settings of a Mesh", testCase{
+			dataplane: `
+                type: Dataplane
+                name: dp-1
+                mesh: default
+                networking:
+                  address: 192.168.0.1
+                  inbound:
+                    - port: 1234
+                      tags:
+                        service: backend
+                  outbound:
+                    - port: 3333
+                      service: redis
+                metrics:
+                  prometheus:
+                    applications:
+                    - port: 0
+                    - name: app 1
+                      port: 8080
+                      path: metrics
+                    - name: sidecar
+                      port: 9090
+                    - name: sidecar
+                      port: 9091
+                  statsd:
+                    address: 127.0.0.1:8125
+                  dogstatsd:
+                    address: 127.0.0.1:8125
+                  tagExtractors:
+                  - name: method
+                    regex: '^(.+)'`,
+			expected: `
+                violations:
+                - field: metrics.statsd
+                  message: can only be defined on a Mesh
+                - field: metrics.dogstatsd
+                  message: can only be defined on a Mesh
+                - field: metrics.tagExtractors
+                  message: can only be defined on a Mesh
+                - field: metrics.prometheus.applications[0].name
+                  message: cannot be empty
+                - field: metrics.prometheus.applications[0].port
+                  message: port has to be in range of [1, 65535]
+                - field: metrics.prometheus.applications[1].name
+                  message: has to consist of alphanumeric characters, '-' or '_'
+                - field: metrics.prometheus.applications[1].path
+                  message: has to start with '/'
+                - field: metrics.prometheus.applications[3].name
+                  message: application "sidecar" is already defined`,
+		}),
 		Entry("networking.outbound: invalid address", testCase{
 			dataplane: `
                 type: Dataplane
@@ -0,0 +1,92 @@
+package mesh
+
+import (
+	"errors"
+
+	"github.com/Kong/kuma/pkg/core/resources/registry"
+
+	mesh_proto "github.com/Kong/kuma/api/mesh/v1alpha1"
+	"github.com/Kong/kuma/pkg/core/resources/model"
+)
+
+const (
+	ExternalServiceType model.ResourceType = "ExternalService"
+)
+
+var _ model.Resource = &ExternalServiceResource{}
+
+type ExternalServiceResource struct {
+	Meta model.ResourceMeta
+	Spec mesh_proto.ExternalService
+}
+
+func (f *ExternalServiceResource) GetType() model.ResourceType {
+	return ExternalServiceType
+}
+
+func (f *ExternalServiceResource) GetMeta() model.ResourceMeta {
+	return f.Meta
+}
+
+func (f *ExternalServiceResource) SetMeta(m model.ResourceMeta) {
+	f.Meta = m
+}
+
+func (f *ExternalServiceResource) GetSpec() model.ResourceSpec {
+	return &f.Spec
+}
+
+func (f *ExternalServiceResource) SetSpec(spec model.ResourceSpec) error {
+	externalService, ok := spec.(*mesh_proto.ExternalService)
+	if !ok {
+		return errors.New("invalid type of spec")
+	} else {
+		f.Spec = *externalService
+		return nil
+	}
+}
+
+var _ model.ResourceList = &ExternalServiceResourceList{}
+
+type ExternalServiceResourceList struct {
+	Items      []*ExternalServiceResource
+	Pagination model.Pagination
+}
+
+func (l *ExternalServiceResourceList) GetItems() []model.Resource {
+	res := make([]model.Resource, len(l.Items))
+	for i, elem := range l.Items {
+		res[i] = elem
+	}
+	return res
+}
+
+func (l *ExternalServiceResourceList) GetItemType() model.ResourceType {
+	return ExternalServiceType
+}
+
+func (l *ExternalServiceResourceList) NewItem() model.Resource {
+	return &ExternalServiceResource{}
+}
+
+func (l *ExternalServiceResourceList) AddItem(r model.Resource) error {
+	if trr, ok := r.(*ExternalServiceResource); ok {
+		l.Items = append(l.Items, trr)
+		return nil
+	} else {
+		return model.ErrorInvalidItemType((*ExternalServiceResource)(nil), r)
+	}
+}
+
+func (l *ExternalServiceResourceList) GetPagination() model.Pagination {
+	return l.Pagination
+}
+
+func (l *ExternalServiceResourceList) SetPagination(pagination model.Pagination) {
+	l.Pagination = pagination
+}
+
+func init() {
+	registry.RegisterType(&ExternalServiceResource{})
+	registry.RegistryListType(&ExternalServiceResourceList{})
+}
@@ -0,0 +1,68 @@
+package mesh
+
+import (
+	"fmt"
+
+	mesh_proto "github.com/Kong/kuma/api/mesh/v1alpha1"
+	"github.com/Kong/kuma/pkg/core/validators"
+)
+
+func (es *ExternalServiceResource) Validate() error {
+	var err validators.ValidationError
+	err.Add(es.validateNetworking())
+	err.Add(es.validateTags())
+	return err.OrNil()
+}
+
+func (es *ExternalServiceResource) validateNetworking() (err validators.ValidationError) {
+	path := validators.RootedAt("networking")
+	networking := es.Spec.GetNetworking()
+	if networking.GetAddress() == "" {
+		err.AddViolationAt(path.Field("address"), "cannot be empty")
+	} else if _, _, parseErr := networking.GetHostAndPort(); parseErr != nil {
+		err.AddViolationAt(path.Field("address"), "invalid format: expected format is HOST:PORT, e.g. api.example.com:443, 192.168.0.1:5432 or [2001:db8::1]:8080")
+	}
+	err.Add(validateExternalServiceTls(path.Field("tls"), networking.GetTls()))
+	return
+}
+
+func validateExternalServiceTls(path validators.PathBuilder, tls *mesh_proto.ExternalService_Networking_TLS) (err validators.ValidationError) {
+	if tls == nil {
+		return
+	}
+	if !tls.Enabled {
+		if tls.ServerName != "" || tls.CaCertSecret != "" || tls.ClientCertSecret != "" || tls.ClientKeySecret != "" {
+			err.AddViolationAt(path.Field("enabled"), "must be true when TLS settings are specified")
+		}
+		return
+	}
+	if tls.CaCertSecret == "" {
+		err.AddViolationAt(path.Field("caCertSecret"), "cannot be empty when TLS is enabled")
+	}
+	if tls.ClientCertSecret != "" && tls.ClientKeySecret == "" {
+		err.AddViolationAt(path.Field("clientKeySecret"), "cannot be empty when clientCertSecret is specified")
+	}
+	if tls.ClientKeySecret != "" && tls.ClientCertSecret == "" {
+		err.AddViolationAt(path.Field("clientCertSecret"), "cannot be empty when clientKeySecret is specified")
+	}
+	return
+}
+
+func (es *ExternalServiceResource) validateTags() (err validators.ValidationError) {
+	path := validators.RootedAt("tags")
+	tags := es.Spec.GetTags()
+	if _, exist := tags[mesh_proto.ServiceTag]; !exist {
+		err.AddViolationAt(path.Key(mesh_proto.ServiceTag), "tag has to exist")
+	}
+	if value, exist := tags[mesh_proto.ProtocolTag]; exist {
+		if ParseProtocol(value) == ProtocolUnknown {
+			err.AddViolationAt(path.Key(mesh_proto.ProtocolTag), fmt.Sprintf("tag %q has an invalid value %q. %s", mesh_proto.ProtocolTag, value, AllowedValuesHint(SupportedProtocols.Strings()...)))
+		}
+	}
+	for _, name := range Keys(tags) {
+		if tags[name] == "" {
+			err.AddViolationAt(path.Key(name), "tag value cannot be empty")
+		}
+	}
+	return
+}
@@ -0,0 +1,143 @@
+package mesh_test
+
+import (
+	"github.com/ghodss/yaml"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+
+	. "github.com/Kong/kuma/pkg/core/resources/apis/mesh"
+	util_proto "github.com/Kong/kuma/pkg/util/proto"
+)
+
+var _ = Describe("ExternalService", func() {
+	Describe("Validate()", func() {
+		type testCase struct {
+			externalService string
+			expected        string
+		}
+		DescribeTable("should validate all fields and return as much individual errors as possible",
+			func(given testCase) {
+				// setup
+				externalService := ExternalServiceResource{}
+
+				// when
+				err := util_proto.FromYAML([]byte(given.externalService), &externalService.Spec)
+				// then
+				Expect(err).ToNot(HaveOccurred())
+
+				// when
+				verr := externalService.Validate()
+				// and
+				actual, err := yaml.Marshal(verr)
+
+				// then
+				Expect(err).ToNot(HaveOccurred())
+				// and
+				Expect(actual).To(MatchYAML(given.expected))
+			},
+			Entry("empty spec", testCase{
+				externalService: ``,
+				expected: `
+                violations:
+                - field: networking.address
+                  message: cannot be empty
+                - field: tags["service"]
+                  message: tag has to exist
+`,
+			}),
+			Entry("invalid address and tags", testCase{
+				externalService: `
+                networking:
+                  address: api.example.com
+                tags:
+                  service: ""
+                  protocol: smtp
+`,
+				expected: `
+                violations:
+                - field: networking.address
+                  message: 'invalid format: expected format is HOST:PORT, e.g. api.example.com:443, 192.168.0.1:5432 or [2001:db8::1]:8080'
+                - field: tags["protocol"]
+                  message: 'tag "protocol" has an invalid value "smtp". Allowed values: http, tcp'
+                - field: tags["service"]
+                  message: tag value cannot be empty
+`,
+			}),
+			Entry("TLS settings without TLS enabled", testCase{
+				externalService: `
+                networking:
+                  address: api.example.com:443
+                  tls:
+                    serverName: api.example.com
+                tags:
+                  service: example
+`,
+				expected: `
+                violations:
+                - field: networking.tls.enabled
+                  message: must be true when TLS settings are specified
+`,
+			}),
+			Entry("TLS enabled without CA certificate", testCase{
+				externalService: `
+                networking:
+                  address: api.example.com:443
+                  tls:
+                    enabled: true
+                tags:
+                  service: example
+`,
+				expected: `
+                violations:
+                - field: networking.tls.caCertSecret
+                  message: cannot be empty when TLS is enabled
+`,
+			}),
+			Entry("client certificate without client key", testCase{
+				externalService: `
+                networking:
+                  address: api.example.com:443
+                  tls:
+                    enabled: true
+                    caCertSecret: example-ca
+                    clientCertSecret: example-cert
+                tags:
+                  service: example
+`,
+				expected: `
+                violations:
+                - field: networking.tls.clientKeySecret
+                  message: cannot be empty when clientCertSecret is specified
+`,
+			}),
+		)
+
+		It("should pass validation of a valid ExternalService", func() {
+			// setup
+			externalService := ExternalServiceResource{}
+
+			// when
+			err := util_proto.FromYAML([]byte(`
+            networking:
+              address: api.example.com:443
+              tls:
+                enabled: true
+                serverName: api.example.com
+                caCertSecret: example-ca
+                clientCertSecret: example-cert
+                clientKeySecret: example-key
+            tags:
+              service: example
+              protocol: http
+`), &externalService.Spec)
+			// then
+			Expect(err).ToNot(HaveOccurred())
+
+			// when
+			verr := externalService.Validate()
+			// then
+			Expect(verr).ToNot(HaveOccurred())
+		})
+	})
+})
@@ -1,6 +1,7 @@
 package mesh
 
 import (
+	"fmt"
 	"net/http"
 	"reflect"
 	"regexp"
@@ -39,19 +40,27 @@ func (f *FaultInjectionResource) validateSources() validators.ValidationError {
 		RequireAtLeastOneSelector: true,
 		ValidateSelectorOpts: ValidateSelectorOpts{
 			RequireAtLeastOneTag:    true,
-			ExtraSelectorValidators: []SelectorValidatorFunc{ProtocolValidator("http")},
+			ExtraSelectorValidators: []SelectorValidatorFunc{ProtocolValidator(ProtocolHTTP, ProtocolTCP)},
 		},
 	})
 }
 
-func (f *FaultInjectionResource) validateDestinations() validators.ValidationError {
-	return ValidateSelectors(validators.RootedAt("destinations"), f.Spec.GetDestinations(), ValidateSelectorsOpts{
+func (f *FaultInjectionResource) validateDestinations() (err validators.ValidationError) {
+	path := validators.RootedAt("destinations")
+	err.Add(ValidateSelectors(path, f.Spec.GetDestinations(), ValidateSelectorsOpts{
 		RequireAtLeastOneSelector: true,
 		ValidateSelectorOpts: ValidateSelectorOpts{
 			RequireAtLeastOneTag:    true,
-			ExtraSelectorValidators: []SelectorValidatorFunc{ProtocolValidator("http")},
+			ExtraSelectorValidators: []SelectorValidatorFunc{ProtocolValidator(ProtocolHTTP, ProtocolTCP)},
 		},
-	})
+	}))
+	protocol := f.Spec.DestinationProtocol()
+	for i, selector := range f.Spec.GetDestinations() {
+		if p, ok := selector.GetMatch()[v1alpha1.ProtocolTag]; ok && p != protocol {
+			err.AddViolationAt(path.Index(i).Field("match").Key(v1alpha1.ProtocolTag), "must be the same in all destinations")
+		}
+	}
+	return
 }
 
 func (f *FaultInjectionResource) validateConf() (err validators.ValidationError) {
@@ -59,38 +68,72 @@ func (f *FaultInjectionResource) validateConf() (err validators.ValidationError)
 	if !f.HasFaultDelay() && !f.HasFaultAbort() && !f.HasFaultResponseBandwidth() {
 		err.AddViolationAt(root, "must have at least one of the faults configured")
 	}
+	conf := f.Spec.GetConf()
+	tcp := f.Spec.DestinationProtocol() == ProtocolTCP
+	headerControlled := conf.GetHeaderControlled()
 	if f.HasFaultDelay() {
-		err.Add(validateDelay(root.Field("delay"), f.Spec.GetConf().GetDelay()))
+		err.Add(validateDelay(root.Field("delay"), conf.GetDelay(), headerControlled))
 	}
 	if f.HasFaultAbort() {
-		err.Add(validateAbort(root.Field("abort"), f.Spec.GetConf().GetAbort()))
+		err.Add(validateAbort(root.Field("abort"), conf.GetAbort(), tcp))
 	}
 	if f.HasFaultResponseBandwidth() {
-		err.Add(validateResponseBandwidth(root.Field("responseBandwidth"), f.Spec.GetConf().GetResponseBandwidth()))
+		err.Add(validateResponseBandwidth(root.Field("responseBandwidth"), conf.GetResponseBandwidth(), headerControlled))
+	}
+	switch conf.GetSide() {
+	case "", v1alpha1.FaultInjectionSideDestination, v1alpha1.FaultInjectionSideSource:
+		if tcp && !f.Spec.IsSourceSide() {
+			err.AddViolationAt(root.Field("side"), fmt.Sprintf("has to be %q for TCP services", v1alpha1.FaultInjectionSideSource))
+		}
+	default:
+		err.AddViolationAt(root.Field("side"), fmt.Sprintf("must be one of the [%s, %s]", v1alpha1.FaultInjectionSideDestination, v1alpha1.FaultInjectionSideSource))
+	}
+	if headerControlled && tcp {
+		err.AddViolationAt(root.Field("headerControlled"), "is not supported for TCP services")
 	}
 	return
 }
 
-func validateDelay(path validators.PathBuilder, delay *v1alpha1.FaultInjection_Conf_Delay) (err validators.ValidationError) {
+func validateDelay(path validators.PathBuilder, delay *v1alpha1.FaultInjection_Conf_Delay, headerControlled bool) (err validators.ValidationError) {
 	err.Add(validatePercentage(path, delay.GetPercentage()))
+	if headerControlled {
+		if delay.GetValue() != nil {
+			err.AddViolationAt(path.Field("value"), headerControlledViolation)
+		}
+		return
+	}
 	if delay.GetValue() == nil {
 		err.AddViolationAt(path.Field("value"), "cannot be empty")
 	}
 	return
 }
 
-func validateAbort(path validators.PathBuilder, abort *v1alpha1.FaultInjection_Conf_Abort) (err validators.ValidationError) {
+func validateAbort(path validators.PathBuilder, abort *v1alpha1.FaultInjection_Conf_Abort, tcp bool) (err validators.ValidationError) {
 	err.Add(validatePercentage(path, abort.GetPercentage()))
+	if tcp {
+		if abort.GetHttpStatus() != nil {
+			err.AddViolationAt(path.Field("httpStatus"), "must not be set for TCP services")
+		}
+		return
+	}
 	err.Add(validateHttpStatus(path, abort.GetHttpStatus()))
 	return
 }
 
-func validateResponseBandwidth(path validators.PathBuilder, bandwidth *v1alpha1.FaultInjection_Conf_ResponseBandwidth) (err validators.ValidationError) {
+func validateResponseBandwidth(path validators.PathBuilder, bandwidth *v1alpha1.FaultInjection_Conf_ResponseBandwidth, headerControlled bool) (err validators.ValidationError) {
 	err.Add(validatePercentage(path, bandwidth.GetPercentage()))
+	if headerControlled {
+		if bandwidth.GetLimit() != nil {
+			err.AddViolationAt(path.Field("limit"), headerControlledViolation)
+		}
+		return
+	}
 	err.Add(validateLimit(path, bandwidth.GetLimit()))
 	return
 }
 
+const headerControlledViolation = "must not be set when headerControlled is enabled"
+
 func validatePercentage(path validators.PathBuilder, percentage *wrappers.DoubleValue) (err validators.ValidationError) {
 	if percentage == nil {
 		err.AddViolationAt(path.Field("percentage"), "cannot be empty")
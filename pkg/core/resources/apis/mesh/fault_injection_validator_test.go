@@ -47,6 +47,44 @@ var _ = Describe("FaultInjection", func() {
                   responseBandwidth:
                     percentage: 40
                     limit: 50kbps`),
+			Entry("TCP service", `
+                sources:
+                - match:
+                    service: frontend
+                    protocol: tcp
+                destinations:
+                - match:
+                    service: redis
+                    protocol: tcp
+                conf:
+                  side: source
+                  delay:
+                    percentage: 50
+                    value: 10ms
+                  abort:
+                    percentage: 40
+                  responseBandwidth:
+                    percentage: 40
+                    limit: 50kbps`),
+			Entry("header-controlled faults on the source side", `
+                sources:
+                - match:
+                    service: frontend
+                    protocol: http
+                destinations:
+                - match:
+                    service: backend
+                    protocol: http
+                conf:
+                  side: source
+                  headerControlled: true
+                  delay:
+                    percentage: 50
+                  abort:
+                    percentage: 40
+                    httpStatus: 503
+                  responseBandwidth:
+                    percentage: 40`),
 		)
 
 		type testCase struct {
@@ -199,7 +237,7 @@ var _ = Describe("FaultInjection", func() {
                 sources:
                 - match:
                     service: frontend
-                    protocol: tcp
+                    protocol: grpc
                 destinations:
                 - match:
                     service: backend
@@ -211,9 +249,99 @@ var _ = Describe("FaultInjection", func() {
 				expected: `
                violations:
                - field: sources[0].match["protocol"]
-                 message: must be one of the [http]
+                 message: must be one of the [http, tcp]
                - field: destinations[0].match
                  message: protocol must be specified`}),
+			Entry("protocol: different in destinations", testCase{
+				faultInjection: `
+                sources:
+                - match:
+                    service: frontend
+                    protocol: http
+                destinations:
+                - match:
+                    service: backend
+                    protocol: http
+                - match:
+                    service: redis
+                    protocol: tcp
+                conf:
+                  delay:
+                    percentage: 50
+                    value: 10ms`,
+				expected: `
+               violations:
+               - field: destinations[1].match["protocol"]
+                 message: must be the same in all destinations`}),
+			Entry("conf: faults not supported by TCP services", testCase{
+				faultInjection: `
+                sources:
+                - match:
+                    service: frontend
+                    protocol: tcp
+                destinations:
+                - match:
+                    service: redis
+                    protocol: tcp
+                conf:
+                  headerControlled: true
+                  abort:
+                    percentage: 50
+                    httpStatus: 500`,
+				expected: `
+               violations:
+               - field: conf.abort.httpStatus
+                 message: must not be set for TCP services
+               - field: conf.side
+                 message: has to be "source" for TCP services
+               - field: conf.headerControlled
+                 message: is not supported for TCP services`}),
+			Entry("conf: values set in header-controlled mode", testCase{
+				faultInjection: `
+                sources:
+                - match:
+                    service: frontend
+                    protocol: http
+                destinations:
+                - match:
+                    service: backend
+                    protocol: http
+                conf:
+                  headerControlled: true
+                  delay:
+                    percentage: 50
+                    value: 10ms
+                  abort:
+                    percentage: 50
+                    httpStatus: 500
+                  responseBandwidth:
+                    limit: 50mbps
+                    percentage: 100`,
+				expected: `
+               violations:
+               - field: conf.delay.value
+                 message: must not be set when headerControlled is enabled
+               - field: conf.responseBandwidth.limit
+                 message: must not be set when headerControlled is enabled`}),
+			Entry("conf.side: unknown value", testCase{
+				faultInjection: `
+                sources:
+                - match:
+                    service: frontend
+                    protocol: http
+                destinations:
+                - match:
+                    service: backend
+                    protocol: http
+                conf:
+                  side: both
+                  delay:
+                    percentage: 50
+                    value: 10ms`,
+				expected: `
+               violations:
+               - field: conf.side
+                 message: must be one of the [destination, source]`}),
 		)
 	})
 })
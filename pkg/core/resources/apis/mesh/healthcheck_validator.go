@@ -2,6 +2,7 @@ package mesh
 
 import (
 	"reflect"
+	"strings"
 
 	mesh_proto "github.com/Kong/kuma/api/mesh/v1alpha1"
 	"github.com/Kong/kuma/pkg/core/validators"
@@ -51,6 +52,12 @@ func (d *HealthCheckResource) validateConf() (err validators.ValidationError) {
 		err.Add(ValidateDuration(path.Field("timeout"), activeChecks.Timeout))
 		err.Add(ValidateThreshold(path.Field("unhealthyThreshold"), activeChecks.UnhealthyThreshold))
 		err.Add(ValidateThreshold(path.Field("healthyThreshold"), activeChecks.HealthyThreshold))
+		if activeChecks.NoTrafficInterval != nil {
+			err.Add(ValidateDuration(path.Field("noTrafficInterval"), activeChecks.NoTrafficInterval))
+		}
+		if activeChecks.Http != nil {
+			err.Add(validateHttpHealthCheck(path.Field("http"), activeChecks.Http))
+		}
 	}
 	if d.HasPassiveChecks() {
 		path := root.Field("passiveChecks")
@@ -58,5 +65,28 @@ func (d *HealthCheckResource) validateConf() (err validators.ValidationError) {
 		err.Add(ValidateThreshold(path.Field("unhealthyThreshold"), passiveChecks.UnhealthyThreshold))
 		err.Add(ValidateDuration(path.Field("penaltyInterval"), passiveChecks.PenaltyInterval))
 	}
+	if threshold := d.Spec.Conf.GetHealthyPanicThreshold(); threshold != nil && threshold.GetValue() > 100 {
+		err.AddViolationAt(root.Field("healthyPanicThreshold"), "must not be greater than 100")
+	}
+	return
+}
+
+func validateHttpHealthCheck(path validators.PathBuilder, conf *mesh_proto.HealthCheck_Conf_Active_Http) (err validators.ValidationError) {
+	if conf.Path != "" && !strings.HasPrefix(conf.Path, "/") {
+		err.AddViolationAt(path.Field("path"), "must start with /")
+	}
+	for i, status := range conf.ExpectedStatuses {
+		if status.Start < 100 || status.Start >= 600 {
+			err.AddViolationAt(path.Field("expectedStatuses").Index(i).Field("start"), "must be in inclusive range [100, 600)")
+		}
+		if status.End <= status.Start || status.End > 600 {
+			err.AddViolationAt(path.Field("expectedStatuses").Index(i).Field("end"), "must be greater than start and not greater than 600")
+		}
+	}
+	for i, header := range conf.RequestHeaders {
+		if header.Name == "" {
+			err.AddViolationAt(path.Field("requestHeaders").Index(i).Field("name"), "cannot be empty")
+		}
+	}
 	return
 }
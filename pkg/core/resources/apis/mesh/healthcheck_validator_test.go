@@ -168,6 +168,48 @@ var _ = Describe("HealthCheck", func() {
                 - field: conf.passiveChecks.penaltyInterval
                   message: must have a positive value`,
 			}),
+			Entry("invalid http active checks conf", testCase{
+				healthCheck: `
+                sources:
+                - match:
+                    service: web
+                    region: eu
+                destinations:
+                - match:
+                    service: backend
+                conf:
+                  activeChecks:
+                    interval: 10s
+                    timeout: 2s
+                    unhealthyThreshold: 3
+                    healthyThreshold: 1
+                    noTrafficInterval: 0s
+                    http:
+                      path: health
+                      expectedStatuses:
+                      - start: 99
+                        end: 200
+                      - start: 300
+                        end: 200
+                      requestHeaders:
+                      - value: "true"
+                  healthyPanicThreshold: 101
+`,
+				expected: `
+                violations:
+                - field: conf.activeChecks.noTrafficInterval
+                  message: must have a positive value
+                - field: conf.activeChecks.http.path
+                  message: must start with /
+                - field: conf.activeChecks.http.expectedStatuses[0].start
+                  message: must be in inclusive range [100, 600)
+                - field: conf.activeChecks.http.expectedStatuses[1].end
+                  message: must be greater than start and not greater than 600
+                - field: conf.activeChecks.http.requestHeaders[0].name
+                  message: cannot be empty
+                - field: conf.healthyPanicThreshold
+                  message: must not be greater than 100`,
+			}),
 		)
 	})
 })
@@ -4,20 +4,92 @@ import (
 	"fmt"
 	"net"
 	"net/url"
+	"regexp"
 
 	mesh_proto "github.com/Kong/kuma/api/mesh/v1alpha1"
 	"github.com/Kong/kuma/pkg/core/validators"
 	"github.com/Kong/kuma/pkg/envoy/accesslog"
 )
 
+// SyslogTransports is a list of transport protocols supported by the syslog logging backend.
+var SyslogTransports = []string{"udp", "tcp"}
+
+// SyslogFacilities is a list of facilities supported by the syslog logging backend.
+var SyslogFacilities = []string{
+	"kern", "user", "mail", "daemon", "auth", "syslog", "lpr", "news",
+	"uucp", "cron", "authpriv", "ftp",
+	"local0", "local1", "local2", "local3", "local4", "local5", "local6", "local7",
+}
+
+// StatsTags is a list of tags of a dataplane that are attached to its metrics.
+var StatsTags = []string{mesh_proto.ServiceTag, "version", mesh_proto.ZoneTag}
+
 func (m *MeshResource) Validate() error {
 	var verr validators.ValidationError
 	verr.AddError("mtls", validateMtls(m.Spec.Mtls))
 	verr.AddError("logging", validateLogging(m.Spec.Logging))
 	verr.AddError("tracing", validateTracing(m.Spec.Tracing))
+	verr.AddError("metrics", validateMetrics(m.Spec.Metrics))
 	return verr.OrNil()
 }
 
+func validateMetrics(metrics *mesh_proto.Metrics) validators.ValidationError {
+	var verr validators.ValidationError
+	if len(metrics.GetPrometheus().GetApplications()) > 0 {
+		verr.AddViolationAt(validators.RootedAt("prometheus").Field("applications"), "can only be defined on a Dataplane")
+	}
+	if metrics.GetStatsd() != nil {
+		verr.AddError("statsd", validateStatsAddress(metrics.GetStatsd().GetAddress()))
+	}
+	if metrics.GetDogstatsd() != nil {
+		verr.AddError("dogstatsd", validateStatsAddress(metrics.GetDogstatsd().GetAddress()))
+	}
+	if len(metrics.GetTagExtractors()) > 0 && metrics.GetStatsd() == nil && metrics.GetDogstatsd() == nil {
+		verr.AddViolation("tagExtractors", "can only be used together with statsd or dogstatsd")
+	}
+	names := map[string]bool{}
+	for i, extractor := range metrics.GetTagExtractors() {
+		path := validators.RootedAt("tagExtractors").Index(i)
+		switch {
+		case extractor.Name == "":
+			verr.AddViolationAt(path.Field("name"), "cannot be empty")
+		case isStatsTag(extractor.Name):
+			verr.AddViolationAt(path.Field("name"), fmt.Sprintf("tag %q is reserved for tags of a dataplane", extractor.Name))
+		case names[extractor.Name]:
+			verr.AddViolationAt(path.Field("name"), fmt.Sprintf("tag %q is already defined", extractor.Name))
+		}
+		names[extractor.Name] = true
+		if extractor.Regex == "" {
+			verr.AddViolationAt(path.Field("regex"), "cannot be empty")
+		} else if _, err := regexp.Compile(extractor.Regex); err != nil {
+			verr.AddViolationAt(path.Field("regex"), "has to be a valid regular expression")
+		}
+	}
+	return verr
+}
+
+func validateStatsAddress(address string) validators.ValidationError {
+	var verr validators.ValidationError
+	if address == "" {
+		verr.AddViolation("address", "cannot be empty")
+	} else {
+		host, port, err := net.SplitHostPort(address)
+		if net.ParseIP(host) == nil || port == "" || err != nil {
+			verr.AddViolation("address", "has to be in format of IP:PORT")
+		}
+	}
+	return verr
+}
+
+func isStatsTag(name string) bool {
+	for _, tag := range StatsTags {
+		if tag == name {
+			return true
+		}
+	}
+	return false
+}
+
 func validateMtls(mtls *mesh_proto.Mesh_Mtls) validators.ValidationError {
 	var verr validators.ValidationError
 	if mtls == nil {
@@ -56,10 +128,23 @@ func validateLoggingBackend(backend *mesh_proto.LoggingBackend) validators.Valid
 	if err := accesslog.ValidateFormat(backend.Format); err != nil {
 		verr.AddViolation("format", err.Error())
 	}
-	if file, ok := backend.GetType().(*mesh_proto.LoggingBackend_File_); ok {
-		verr.AddError("file", validateLoggingFile(file))
-	} else if tcp, ok := backend.GetType().(*mesh_proto.LoggingBackend_Tcp_); ok {
-		verr.AddError("tcp", validateLoggingTcp(tcp))
+	if len(backend.JsonFormat) > 0 {
+		if backend.Format != "" {
+			verr.AddViolation("jsonFormat", "cannot be used together with format")
+		}
+		if err := accesslog.ValidateJsonFormat(backend.JsonFormat); err != nil {
+			verr.AddViolation("jsonFormat", err.Error())
+		}
+	}
+	switch backendType := backend.GetType().(type) {
+	case *mesh_proto.LoggingBackend_File_:
+		verr.AddError("file", validateLoggingFile(backendType))
+	case *mesh_proto.LoggingBackend_Tcp_:
+		verr.AddError("tcp", validateLoggingTcp(backendType))
+	case *mesh_proto.LoggingBackend_Syslog_:
+		verr.AddError("syslog", validateLoggingSyslog(backendType.Syslog))
+	case *mesh_proto.LoggingBackend_Http_:
+		verr.AddError("http", validateLoggingHttp(backendType.Http))
 	}
 	return verr
 }
@@ -77,6 +162,59 @@ func validateLoggingTcp(tcp *mesh_proto.LoggingBackend_Tcp_) validators.Validati
 	return verr
 }
 
+func validateLoggingSyslog(syslog *mesh_proto.LoggingBackend_Syslog) validators.ValidationError {
+	var verr validators.ValidationError
+	if syslog.Address == "" {
+		verr.AddViolation("address", "cannot be empty")
+	} else {
+		host, port, err := net.SplitHostPort(syslog.Address)
+		if host == "" || port == "" || err != nil {
+			verr.AddViolation("address", "has to be in format of HOST:PORT")
+		}
+	}
+	if syslog.Transport != "" && !isSyslogTransport(syslog.Transport) {
+		verr.AddViolation("transport", fmt.Sprintf("has invalid value. %s", AllowedValuesHint(SyslogTransports...)))
+	}
+	if syslog.Facility != "" && !isSyslogFacility(syslog.Facility) {
+		verr.AddViolation("facility", fmt.Sprintf("has invalid value. %s", AllowedValuesHint(SyslogFacilities...)))
+	}
+	return verr
+}
+
+func isSyslogTransport(transport string) bool {
+	for _, known := range SyslogTransports {
+		if transport == known {
+			return true
+		}
+	}
+	return false
+}
+
+func isSyslogFacility(facility string) bool {
+	for _, known := range SyslogFacilities {
+		if facility == known {
+			return true
+		}
+	}
+	return false
+}
+
+func validateLoggingHttp(http *mesh_proto.LoggingBackend_Http) validators.ValidationError {
+	var verr validators.ValidationError
+	if http.Url == "" {
+		verr.AddViolation("url", "cannot be empty")
+	} else {
+		uri, err := url.ParseRequestURI(http.Url)
+		if err != nil || uri.Host == "" || (uri.Scheme != "http" && uri.Scheme != "https") {
+			verr.AddViolation("url", "has to be a valid http or https URL")
+		}
+	}
+	if http.FlushInterval != nil {
+		verr.Add(ValidateDuration(validators.RootedAt("flushInterval"), http.FlushInterval))
+	}
+	return verr
+}
+
 func validateLoggingFile(file *mesh_proto.LoggingBackend_File_) validators.ValidationError {
 	var veer validators.ValidationError
 	if file.File.Path == "" {
@@ -112,8 +250,19 @@ func validateTracingBackend(backend *mesh_proto.TracingBackend) validators.Valid
 	if backend.Sampling.GetValue() < 0.0 || backend.Sampling.GetValue() > 100.0 {
 		verr.AddViolation("sampling", "has to be in [0.0 - 100.0] range")
 	}
-	if zipkin, ok := backend.GetType().(*mesh_proto.TracingBackend_Zipkin_); ok {
-		verr.AddError("zipkin", validateZipkin(zipkin.Zipkin))
+	switch backendType := backend.GetType().(type) {
+	case *mesh_proto.TracingBackend_Zipkin_:
+		verr.AddError("zipkin", validateZipkin(backendType.Zipkin))
+	case *mesh_proto.TracingBackend_Datadog_:
+		verr.AddError("datadog", validateDatadog(backendType.Datadog))
+	case *mesh_proto.TracingBackend_Jaeger_:
+		verr.AddError("jaeger", validateJaeger(backendType.Jaeger))
+	case *mesh_proto.TracingBackend_OpenCensus_:
+		openCensus := backendType.OpenCensus
+		if openCensus.OcagentAddress == "" && openCensus.ZipkinUrl == "" && openCensus.StackdriverProjectId == "" && !openCensus.Stdout {
+			verr.AddViolation("openCensus", "must have at least one exporter enabled: ocagentAddress, zipkinUrl, stackdriverProjectId or stdout")
+		}
+		verr.AddError("openCensus", validateOpenCensus(openCensus))
 	}
 	return verr
 }
@@ -135,3 +284,44 @@ func validateZipkin(zipkin *mesh_proto.TracingBackend_Zipkin) validators.Validat
 	}
 	return verr
 }
+
+func validateDatadog(datadog *mesh_proto.TracingBackend_Datadog) validators.ValidationError {
+	var verr validators.ValidationError
+	if datadog.Address == "" {
+		verr.AddViolation("address", "cannot be empty")
+	}
+	if datadog.Port == 0 || datadog.Port > 65535 {
+		verr.AddViolation("port", "port has to be in range of [1, 65535]")
+	}
+	return verr
+}
+
+func validateJaeger(jaeger *mesh_proto.TracingBackend_Jaeger) validators.ValidationError {
+	var verr validators.ValidationError
+	if jaeger.Url == "" {
+		verr.AddViolation("url", "cannot be empty")
+	} else {
+		uri, err := url.ParseRequestURI(jaeger.Url)
+		if err != nil {
+			verr.AddViolation("url", "invalid URL")
+		} else if uri.Port() == "" {
+			verr.AddViolation("url", "port has to be explicitly specified")
+		}
+	}
+	return verr
+}
+
+func validateOpenCensus(openCensus *mesh_proto.TracingBackend_OpenCensus) validators.ValidationError {
+	var verr validators.ValidationError
+	if openCensus.OcagentAddress != "" {
+		if _, _, err := net.SplitHostPort(openCensus.OcagentAddress); err != nil {
+			verr.AddViolation("ocagentAddress", "has to be in host:port format")
+		}
+	}
+	if openCensus.ZipkinUrl != "" {
+		if _, err := url.ParseRequestURI(openCensus.ZipkinUrl); err != nil {
+			verr.AddViolation("zipkinUrl", "invalid URL")
+		}
+	}
+	return verr
+}
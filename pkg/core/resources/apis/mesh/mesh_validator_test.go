@@ -37,6 +37,23 @@ var _ = Describe("Mesh", func() {
                 format: '%START_TIME% %KUMA_DESTINATION_SERVICE%'
                 tcp:
                   address: kibana:1234
+              - name: syslog
+                syslog:
+                  address: syslog.local:514
+                  transport: tcp
+                  facility: local3
+                  appName: kuma
+              - name: http
+                http:
+                  url: https://logs.local/ingest
+                  maxBatchSize: 50
+                  flushInterval: 2s
+              - name: json
+                jsonFormat:
+                  start_time: '%START_TIME%'
+                  source: '%KUMA_SOURCE_SERVICE%'
+                tcp:
+                  address: logstash:5000
               defaultBackend: tcp-1
             tracing:
               backends:
@@ -49,7 +66,30 @@ var _ = Describe("Mesh", func() {
               - name: zipkin-eu
                 zipkin:
                   url: http://zipkin.local:9411/v2/spans
+              - name: datadog
+                datadog:
+                  address: datadog-agent.local
+                  port: 8126
+                  serviceName: backend
+              - name: jaeger
+                jaeger:
+                  url: http://jaeger-collector.local:9411
+              - name: opencensus
+                openCensus:
+                  ocagentAddress: ocagent.local:55678
+                  stdout: true
               defaultBackend: zipkin-us
+            metrics:
+              prometheus:
+                port: 5670
+              statsd:
+                address: 10.0.0.1:8125
+                prefix: kuma
+              dogstatsd:
+                address: 127.0.0.1:8125
+              tagExtractors:
+              - name: grpc_method
+                regex: '^cluster\.[^.]+\.grpc\.[^.]+\.((.+?)\.)'
 `
 			mesh := MeshResource{}
 
@@ -147,6 +187,39 @@ var _ = Describe("Mesh", func() {
                 violations:
                 - field: logging.backends[0].tcp.address
                   message: has to be in format of HOST:PORT`,
+			}),
+			Entry("syslog logging with invalid fields", testCase{
+				mesh: `
+                logging:
+                  backends:
+                  - name: backend-1
+                    syslog:
+                      address: syslog.local
+                      transport: sctp
+                      facility: local8`,
+				expected: `
+                violations:
+                - field: logging.backends[0].syslog.address
+                  message: has to be in format of HOST:PORT
+                - field: logging.backends[0].syslog.transport
+                  message: 'has invalid value. Allowed values: udp, tcp'
+                - field: logging.backends[0].syslog.facility
+                  message: 'has invalid value. Allowed values: kern, user, mail, daemon, auth, syslog, lpr, news, uucp, cron, authpriv, ftp, local0, local1, local2, local3, local4, local5, local6, local7'`,
+			}),
+			Entry("http logging with invalid fields", testCase{
+				mesh: `
+                logging:
+                  backends:
+                  - name: backend-1
+                    http:
+                      url: ftp://logs.local/ingest
+                      flushInterval: 0s`,
+				expected: `
+                violations:
+                - field: logging.backends[0].http.url
+                  message: has to be a valid http or https URL
+                - field: logging.backends[0].http.flushInterval
+                  message: must have a positive value`,
 			}),
 			Entry("file logging path is empty", testCase{
 				mesh: `
@@ -174,6 +247,37 @@ var _ = Describe("Mesh", func() {
                 violations:
                 - field: logging.backends[0].format
                   message: 'format string is not valid: expected a command operator to start at position 14, instead got: "%sent_bytes%"'`,
+			}),
+			Entry("invalid JSON access log format", testCase{
+				mesh: `
+                logging:
+                  backends:
+                  - name: backend-1
+                    jsonFormat:
+                      bytes: "%sent_bytes%"
+                    file:
+                      path: /var/logs
+                  defaultBackend: backend-1`,
+				expected: `
+                violations:
+                - field: logging.backends[0].jsonFormat
+                  message: 'JSON format of field "bytes" is not valid: format string is not valid: expected a command operator to start at position 1, instead got: "%sent_bytes%"'`,
+			}),
+			Entry("format and JSON format used together", testCase{
+				mesh: `
+                logging:
+                  backends:
+                  - name: backend-1
+                    format: "%START_TIME%"
+                    jsonFormat:
+                      start_time: "%START_TIME%"
+                    file:
+                      path: /var/logs
+                  defaultBackend: backend-1`,
+				expected: `
+                violations:
+                - field: logging.backends[0].jsonFormat
+                  message: cannot be used together with format`,
 			}),
 			Entry("default backend has to be set to one of the backends", testCase{
 				mesh: `
@@ -276,6 +380,58 @@ var _ = Describe("Mesh", func() {
                 violations:
                 - field: tracing.backends[0].zipkin.apiVersion
                   message: 'has invalid value. Allowed values: httpJsonV1, httpJson, httpProto'`,
+			}),
+			Entry("tracing with datadog without address and port", testCase{
+				mesh: `
+                tracing:
+                  backends:
+                  - name: datadog
+                    datadog:
+                      serviceName: backend`,
+				expected: `
+                violations:
+                - field: tracing.backends[0].datadog.address
+                  message: cannot be empty
+                - field: tracing.backends[0].datadog.port
+                  message: port has to be in range of [1, 65535]`,
+			}),
+			Entry("tracing with jaeger with valid url but without port", testCase{
+				mesh: `
+                tracing:
+                  backends:
+                  - name: jaeger
+                    jaeger:
+                      url: http://jaeger-collector.local/api/v2/spans`,
+				expected: `
+                violations:
+                - field: tracing.backends[0].jaeger.url
+                  message: port has to be explicitly specified`,
+			}),
+			Entry("tracing with opencensus without exporters", testCase{
+				mesh: `
+                tracing:
+                  backends:
+                  - name: opencensus
+                    openCensus: {}`,
+				expected: `
+                violations:
+                - field: tracing.backends[0].openCensus
+                  message: 'must have at least one exporter enabled: ocagentAddress, zipkinUrl, stackdriverProjectId or stdout'`,
+			}),
+			Entry("tracing with opencensus with invalid exporter addresses", testCase{
+				mesh: `
+                tracing:
+                  backends:
+                  - name: opencensus
+                    openCensus:
+                      ocagentAddress: ocagent.local
+                      zipkinUrl: not-a-url`,
+				expected: `
+                violations:
+                - field: tracing.backends[0].openCensus.ocagentAddress
+                  message: has to be in host:port format
+                - field: tracing.backends[0].openCensus.zipkinUrl
+                  message: invalid URL`,
 			}),
 			Entry("default backend has to be set to one of the backends", testCase{
 				mesh: `
@@ -289,6 +445,61 @@ var _ = Describe("Mesh", func() {
                 violations:
                 - field: tracing.defaultBackend
                   message: has to be set to one of the tracing backend in mesh`,
+			}),
+			Entry("metrics of applications defined on a Mesh", testCase{
+				mesh: `
+                metrics:
+                  prometheus:
+                    port: 5670
+                    applications:
+                    - name: backend
+                      port: 8081`,
+				expected: `
+                violations:
+                - field: metrics.prometheus.applications
+                  message: can only be defined on a Dataplane`,
+			}),
+			Entry("statsd and dogstatsd with invalid fields", testCase{
+				mesh: `
+                metrics:
+                  statsd:
+                    address: statsd.local:8125
+                  dogstatsd:
+                    prefix: kuma
+                  tagExtractors:
+                  - name: service
+                    regex: '^(.+)'
+                  - name: method
+                    regex: '^(.+'
+                  - name: method
+                  - regex: '^(.+)'`,
+				expected: `
+                violations:
+                - field: metrics.statsd.address
+                  message: has to be in format of IP:PORT
+                - field: metrics.dogstatsd.address
+                  message: cannot be empty
+                - field: metrics.tagExtractors[0].name
+                  message: tag "service" is reserved for tags of a dataplane
+                - field: metrics.tagExtractors[1].regex
+                  message: has to be a valid regular expression
+                - field: metrics.tagExtractors[2].name
+                  message: tag "method" is already defined
+                - field: metrics.tagExtractors[2].regex
+                  message: cannot be empty
+                - field: metrics.tagExtractors[3].name
+                  message: cannot be empty`,
+			}),
+			Entry("tag extractors without statsd or dogstatsd", testCase{
+				mesh: `
+                metrics:
+                  tagExtractors:
+                  - name: method
+                    regex: '^(.+)'`,
+				expected: `
+                violations:
+                - field: metrics.tagExtractors
+                  message: can only be used together with statsd or dogstatsd`,
 			}),
 			Entry("multiple errors", testCase{
 				mesh: `
@@ -0,0 +1,135 @@
+package mesh
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	envoy_api "github.com/envoyproxy/go-control-plane/envoy/api/v2"
+	envoy_listener "github.com/envoyproxy/go-control-plane/envoy/api/v2/listener"
+	envoy_route "github.com/envoyproxy/go-control-plane/envoy/api/v2/route"
+	envoy_hcm "github.com/envoyproxy/go-control-plane/envoy/config/filter/network/http_connection_manager/v2"
+	"github.com/ghodss/yaml"
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/golang/protobuf/proto"
+	"github.com/pkg/errors"
+
+	"github.com/Kong/kuma/api/mesh/v1alpha1"
+	"github.com/Kong/kuma/pkg/core/validators"
+)
+
+// Origins of xDS resources generated by profiles.
+const (
+	OriginInbound     = "inbound"
+	OriginOutbound    = "outbound"
+	OriginTransparent = "transparent"
+	OriginPrometheus  = "prometheus"
+)
+
+var AvailableOrigins = []string{OriginInbound, OriginOutbound, OriginTransparent, OriginPrometheus}
+
+// Operations of ProxyTemplate modifications.
+const (
+	OpAdd       = "add"
+	OpAddFirst  = "addFirst"
+	OpAddLast   = "addLast"
+	OpAddBefore = "addBefore"
+	OpAddAfter  = "addAfter"
+	OpPatch     = "patch"
+	OpRemove    = "remove"
+)
+
+var resourceOperations = []string{OpAdd, OpPatch, OpRemove}
+var filterOperations = []string{OpAddFirst, OpAddLast, OpAddBefore, OpAddAfter, OpPatch, OpRemove}
+
+func validateModifications(modifications []*v1alpha1.ProxyTemplate_Modifications) validators.ValidationError {
+	var verr validators.ValidationError
+	for i, modification := range modifications {
+		path := validators.RootedAt("modifications").Index(i)
+		switch mod := modification.GetType().(type) {
+		case *v1alpha1.ProxyTemplate_Modifications_Cluster_:
+			match := mod.Cluster.GetMatch()
+			verr.Add(validateModification(path.Field("cluster"), resourceOperations, mod.Cluster.GetOperation(), mod.Cluster.GetValue(), match.GetName(), match.GetOrigin(), &envoy_api.Cluster{}))
+		case *v1alpha1.ProxyTemplate_Modifications_Listener_:
+			match := mod.Listener.GetMatch()
+			verr.Add(validateModification(path.Field("listener"), resourceOperations, mod.Listener.GetOperation(), mod.Listener.GetValue(), match.GetName(), match.GetOrigin(), &envoy_api.Listener{}))
+		case *v1alpha1.ProxyTemplate_Modifications_NetworkFilter_:
+			match := mod.NetworkFilter.GetMatch()
+			verr.Add(validateModification(path.Field("networkFilter"), filterOperations, mod.NetworkFilter.GetOperation(), mod.NetworkFilter.GetValue(), match.GetName(), match.GetOrigin(), &envoy_listener.Filter{}))
+		case *v1alpha1.ProxyTemplate_Modifications_HttpFilter_:
+			match := mod.HttpFilter.GetMatch()
+			verr.Add(validateModification(path.Field("httpFilter"), filterOperations, mod.HttpFilter.GetOperation(), mod.HttpFilter.GetValue(), match.GetName(), match.GetOrigin(), &envoy_hcm.HttpFilter{}))
+		case *v1alpha1.ProxyTemplate_Modifications_VirtualHost_:
+			match := mod.VirtualHost.GetMatch()
+			verr.Add(validateModification(path.Field("virtualHost"), resourceOperations, mod.VirtualHost.GetOperation(), mod.VirtualHost.GetValue(), match.GetName(), match.GetOrigin(), &envoy_route.VirtualHost{}))
+		default:
+			verr.AddViolationAt(path, "has to have one of the [cluster, listener, networkFilter, httpFilter, virtualHost]")
+		}
+	}
+	return verr
+}
+
+func validateModification(path validators.PathBuilder, operations []string, operation string, value string, name string, origin string, added proto.Message) validators.ValidationError {
+	var verr validators.ValidationError
+	if !contains(operations, operation) {
+		verr.AddViolationAt(path.Field("operation"), fmt.Sprintf("must be one of the [%s]", strings.Join(operations, ", ")))
+	}
+	if origin != "" && !contains(AvailableOrigins, origin) {
+		verr.AddViolationAt(path.Field("match").Field("origin"), fmt.Sprintf("must be one of the [%s]", strings.Join(AvailableOrigins, ", ")))
+	}
+	if (operation == OpAddBefore || operation == OpAddAfter) && name == "" {
+		verr.AddViolationAt(path.Field("match").Field("name"), fmt.Sprintf("cannot be empty for the %q operation", operation))
+	}
+	switch operation {
+	case OpRemove:
+		if value != "" {
+			verr.AddViolationAt(path.Field("value"), fmt.Sprintf("must not be set for the %q operation", operation))
+		}
+	case OpPatch:
+		if value == "" {
+			verr.AddViolationAt(path.Field("value"), "cannot be empty")
+		} else if err := validatePatch(value); err != nil {
+			verr.AddViolationAt(path.Field("value"), err.Error())
+		}
+	case OpAdd, OpAddFirst, OpAddLast, OpAddBefore, OpAddAfter:
+		if value == "" {
+			verr.AddViolationAt(path.Field("value"), "cannot be empty")
+		} else if err := validateAddedValue(value, added); err != nil {
+			verr.AddViolationAt(path.Field("value"), err.Error())
+		}
+	}
+	return verr
+}
+
+func validatePatch(value string) error {
+	var patch map[string]interface{}
+	if err := yaml.Unmarshal([]byte(value), &patch); err != nil {
+		return errors.New("has to be a YAML object")
+	}
+	return nil
+}
+
+func validateAddedValue(value string, message proto.Message) error {
+	json, err := yaml.YAMLToJSON([]byte(value))
+	if err != nil {
+		return errors.Wrap(err, "native Envoy resource is not valid")
+	}
+	if err := (&jsonpb.Unmarshaler{}).Unmarshal(bytes.NewReader(json), message); err != nil {
+		return errors.Wrap(err, "native Envoy resource is not valid")
+	}
+	if v, ok := message.(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return errors.Wrap(err, "native Envoy resource is not valid")
+		}
+	}
+	return nil
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
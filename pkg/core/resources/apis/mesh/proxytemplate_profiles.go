@@ -2,6 +2,44 @@ package mesh
 
 const (
 	ProfileDefaultProxy = "default-proxy"
+	ProfileGatewayProxy = "gateway-proxy"
+	ProfileEgressOnly   = "egress-only"
+	ProfileMinimal      = "minimal"
 )
 
-var AvailableProfiles = []string{ProfileDefaultProxy}
+// BuiltinProfiles are names of profiles that are always available to ProxyTemplates.
+var BuiltinProfiles = []string{ProfileDefaultProxy, ProfileGatewayProxy, ProfileEgressOnly, ProfileMinimal}
+
+// ProfileRegistry tells which profiles ProxyTemplates can import.
+type ProfileRegistry interface {
+	// Names returns names of all profiles in the order of registration.
+	Names() []string
+	// Has returns true if a profile with a given name has been registered.
+	Has(name string) bool
+}
+
+// profiles is consulted by validation of ProxyTemplates.
+var profiles ProfileRegistry = builtinProfiles{}
+
+// UseProfileRegistry makes validation of ProxyTemplates accept profiles of a given registry.
+//
+// Profiles are generated by the xDS generator, which owns the registry and hands it over at Control Plane start up.
+// Until then, only built-in profiles are accepted.
+func UseProfileRegistry(registry ProfileRegistry) {
+	profiles = registry
+}
+
+type builtinProfiles struct{}
+
+func (builtinProfiles) Names() []string {
+	return append([]string(nil), BuiltinProfiles...)
+}
+
+func (builtinProfiles) Has(name string) bool {
+	for _, profile := range BuiltinProfiles {
+		if profile == name {
+			return true
+		}
+	}
+	return false
+}
@@ -9,19 +9,6 @@ import (
 	"github.com/Kong/kuma/pkg/util/envoy"
 )
 
-var availableProfiles map[string]bool
-var availableProfilesMsg string
-
-func init() {
-	profiles := []string{}
-	availableProfiles = map[string]bool{}
-	for _, profile := range AvailableProfiles {
-		availableProfiles[profile] = true
-		profiles = append(profiles, profile)
-	}
-	availableProfilesMsg = strings.Join(profiles, ",")
-}
-
 func (t *ProxyTemplateResource) Validate() error {
 	var verr validators.ValidationError
 	verr.Add(validateSelectors(t.Spec.Selectors))
@@ -33,6 +20,7 @@ func validateConfig(conf *v1alpha1.ProxyTemplate_Conf) validators.ValidationErro
 	var verr validators.ValidationError
 	verr.Add(validateImports(conf.GetImports()))
 	verr.Add(validateResources(conf.GetResources()))
+	verr.Add(validateModifications(conf.GetModifications()))
 	return verr
 }
 
@@ -43,8 +31,8 @@ func validateImports(imports []string) validators.ValidationError {
 			verr.AddViolationAt(validators.RootedAt("imports").Index(i), "cannot be empty")
 			continue
 		}
-		if !availableProfiles[imp] {
-			verr.AddViolationAt(validators.RootedAt("imports").Index(i), fmt.Sprintf("profile not found. Available profiles: %s", availableProfilesMsg))
+		if !profiles.Has(imp) {
+			verr.AddViolationAt(validators.RootedAt("imports").Index(i), fmt.Sprintf("profile not found. Available profiles: %s", strings.Join(profiles.Names(), ",")))
 		}
 	}
 	return verr
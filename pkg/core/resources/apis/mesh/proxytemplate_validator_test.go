@@ -50,6 +50,56 @@ var _ = Describe("ProxyTemplate", func() {
                                       portValue: 8443
                       name: localhost:8443
                       type: STATIC`,
+			),
+			Entry("modifications", `
+                selectors:
+                - match:
+                    service: backend
+                conf:
+                  imports:
+                  - default-proxy
+                  modifications:
+                  - cluster:
+                      operation: add
+                      value: |
+                        name: localhost:8443
+                        connectTimeout: 5s
+                        type: STATIC
+                  - listener:
+                      operation: patch
+                      match:
+                        origin: inbound
+                      value: |
+                        perConnectionBufferLimitBytes: 32768
+                  - networkFilter:
+                      operation: remove
+                      match:
+                        name: envoy.tcp_proxy
+                  - httpFilter:
+                      operation: addBefore
+                      match:
+                        name: envoy.router
+                        origin: outbound
+                      value: |
+                        name: envoy.cors
+                  - virtualHost:
+                      operation: add
+                      match:
+                        routeConfigurationName: outbound:backend
+                      value: |
+                        name: backend
+                        domains:
+                        - backend.mesh`,
+			),
+			Entry("built-in profiles", `
+                selectors:
+                - match:
+                    service: backend
+                conf:
+                  imports:
+                  - gateway-proxy
+                  - egress-only
+                  - minimal`,
 			),
 			Entry("empty conf", `
                 selectors:
@@ -106,7 +156,7 @@ var _ = Describe("ProxyTemplate", func() {
 				expected: `
                 violations:
                 - field: conf.imports[0]
-                  message: 'profile not found. Available profiles: default-proxy'`,
+                  message: 'profile not found. Available profiles: default-proxy,gateway-proxy,egress-only,minimal'`,
 			}),
 			Entry("resources empty fields", testCase{
 				proxyTemplate: `
@@ -184,6 +234,63 @@ var _ = Describe("ProxyTemplate", func() {
                 violations:
                 - field: conf.resources[0].resource
                   message: 'native Envoy resource is not valid: invalid Cluster.Name: value length must be at least 1 bytes'`,
+			}),
+			Entry("modifications with invalid fields", testCase{
+				proxyTemplate: `
+                selectors:
+                - match:
+                    service: backend
+                conf:
+                  modifications:
+                  - cluster:
+                      operation: addFirst
+                      match:
+                        origin: unknown
+                  - httpFilter:
+                      operation: addAfter
+                      value: |
+                        name: envoy.cors
+                  - listener:
+                      operation: remove
+                      value: |
+                        name: inbound:127.0.0.1:8080
+                  - virtualHost:
+                      operation: patch
+                      value: not-an-object
+                  - {}`,
+				expected: `
+                violations:
+                - field: conf.modifications[0].cluster.operation
+                  message: must be one of the [add, patch, remove]
+                - field: conf.modifications[0].cluster.match.origin
+                  message: must be one of the [inbound, outbound, transparent, prometheus]
+                - field: conf.modifications[0].cluster.value
+                  message: cannot be empty
+                - field: conf.modifications[1].httpFilter.match.name
+                  message: cannot be empty for the "addAfter" operation
+                - field: conf.modifications[2].listener.value
+                  message: must not be set for the "remove" operation
+                - field: conf.modifications[3].virtualHost.value
+                  message: has to be a YAML object
+                - field: conf.modifications[4]
+                  message: has to have one of the [cluster, listener, networkFilter, httpFilter, virtualHost]`,
+			}),
+			Entry("modification with invalid envoy resource", testCase{
+				proxyTemplate: `
+                selectors:
+                - match:
+                    service: backend
+                conf:
+                  modifications:
+                  - cluster:
+                      operation: add
+                      value: |
+                        connectTimeout: 5s
+                        unknownField: true`,
+				expected: `
+                violations:
+                - field: conf.modifications[0].cluster.value
+                  message: 'native Envoy resource is not valid: unknown field "unknownField" in envoy_api_v2.Cluster'`,
 			}),
 			Entry("invalid envoy resource", testCase{
 				proxyTemplate: `
@@ -0,0 +1,100 @@
+package mesh
+
+import (
+	"errors"
+
+	"github.com/Kong/kuma/pkg/core/resources/registry"
+
+	mesh_proto "github.com/Kong/kuma/api/mesh/v1alpha1"
+	"github.com/Kong/kuma/pkg/core/resources/model"
+)
+
+const (
+	RetryType model.ResourceType = "Retry"
+)
+
+var _ model.Resource = &RetryResource{}
+
+type RetryResource struct {
+	Meta model.ResourceMeta
+	Spec mesh_proto.Retry
+}
+
+func (f *RetryResource) GetType() model.ResourceType {
+	return RetryType
+}
+
+func (f *RetryResource) GetMeta() model.ResourceMeta {
+	return f.Meta
+}
+
+func (f *RetryResource) SetMeta(m model.ResourceMeta) {
+	f.Meta = m
+}
+
+func (f *RetryResource) GetSpec() model.ResourceSpec {
+	return &f.Spec
+}
+
+func (f *RetryResource) SetSpec(spec model.ResourceSpec) error {
+	retry, ok := spec.(*mesh_proto.Retry)
+	if !ok {
+		return errors.New("invalid type of spec")
+	} else {
+		f.Spec = *retry
+		return nil
+	}
+}
+
+var _ model.ResourceList = &RetryResourceList{}
+
+type RetryResourceList struct {
+	Items      []*RetryResource
+	Pagination model.Pagination
+}
+
+func (l *RetryResourceList) GetItems() []model.Resource {
+	res := make([]model.Resource, len(l.Items))
+	for i, elem := range l.Items {
+		res[i] = elem
+	}
+	return res
+}
+
+func (l *RetryResourceList) GetItemType() model.ResourceType {
+	return RetryType
+}
+
+func (l *RetryResourceList) NewItem() model.Resource {
+	return &RetryResource{}
+}
+
+func (l *RetryResourceList) AddItem(r model.Resource) error {
+	if trr, ok := r.(*RetryResource); ok {
+		l.Items = append(l.Items, trr)
+		return nil
+	} else {
+		return model.ErrorInvalidItemType((*RetryResource)(nil), r)
+	}
+}
+
+func (l *RetryResourceList) GetPagination() model.Pagination {
+	return l.Pagination
+}
+
+func (l *RetryResourceList) SetPagination(pagination model.Pagination) {
+	l.Pagination = pagination
+}
+
+func init() {
+	registry.RegisterType(&RetryResource{})
+	registry.RegistryListType(&RetryResourceList{})
+}
+
+func (f *RetryResource) Sources() []*mesh_proto.Selector {
+	return f.Spec.GetSources()
+}
+
+func (f *RetryResource) Destinations() []*mesh_proto.Selector {
+	return f.Spec.GetDestinations()
+}
@@ -0,0 +1,111 @@
+package mesh
+
+import (
+	"fmt"
+	"net/http"
+	"reflect"
+
+	"github.com/golang/protobuf/ptypes"
+
+	mesh_proto "github.com/Kong/kuma/api/mesh/v1alpha1"
+	"github.com/Kong/kuma/pkg/core/validators"
+)
+
+// RetryOnConditions is a list of conditions supported by Envoy under which an HTTP request can be retried.
+var RetryOnConditions = []string{
+	"5xx",
+	"gateway-error",
+	"reset",
+	"connect-failure",
+	"retriable-4xx",
+	"refused-stream",
+	"retriable-status-codes",
+	"retriable-headers",
+}
+
+func (r *RetryResource) HasHttpRetries() bool {
+	httpRetries := r.Spec.Conf.GetHttp()
+	return httpRetries != nil && !reflect.DeepEqual(*httpRetries, mesh_proto.Retry_Conf_Http{})
+}
+
+func (r *RetryResource) HasTcpRetries() bool {
+	tcpRetries := r.Spec.Conf.GetTcp()
+	return tcpRetries != nil && !reflect.DeepEqual(*tcpRetries, mesh_proto.Retry_Conf_Tcp{})
+}
+
+func (r *RetryResource) Validate() error {
+	var err validators.ValidationError
+	err.Add(r.validateSources())
+	err.Add(r.validateDestinations())
+	err.Add(r.validateConf())
+	return err.OrNil()
+}
+
+func (r *RetryResource) validateSources() validators.ValidationError {
+	return ValidateSelectors(validators.RootedAt("sources"), r.Spec.Sources, ValidateSelectorsOpts{
+		RequireAtLeastOneSelector: true,
+		ValidateSelectorOpts: ValidateSelectorOpts{
+			RequireAtLeastOneTag: true,
+			RequireService:       true,
+		},
+	})
+}
+
+func (r *RetryResource) validateDestinations() (err validators.ValidationError) {
+	return ValidateSelectors(validators.RootedAt("destinations"), r.Spec.Destinations, OnlyServiceTagAllowed)
+}
+
+func (r *RetryResource) validateConf() (err validators.ValidationError) {
+	root := validators.RootedAt("conf")
+	if !r.HasHttpRetries() && !r.HasTcpRetries() {
+		err.AddViolationAt(root, "must have either http or tcp retries configured")
+	}
+	if r.HasHttpRetries() {
+		err.Add(validateHttpRetries(root.Field("http"), r.Spec.Conf.GetHttp()))
+	}
+	if r.HasTcpRetries() {
+		err.Add(ValidateThreshold(root.Field("tcp").Field("maxConnectAttempts"), r.Spec.Conf.GetTcp().GetMaxConnectAttempts()))
+	}
+	return
+}
+
+func validateHttpRetries(path validators.PathBuilder, conf *mesh_proto.Retry_Conf_Http) (err validators.ValidationError) {
+	if conf.GetNumRetries() != nil && conf.GetNumRetries().GetValue() == 0 {
+		err.AddViolationAt(path.Field("numRetries"), "must have a positive value")
+	}
+	if conf.GetPerTryTimeout() != nil {
+		err.Add(ValidateDuration(path.Field("perTryTimeout"), conf.GetPerTryTimeout()))
+	}
+	if conf.GetBackOff() != nil {
+		backOff := conf.GetBackOff()
+		err.Add(ValidateDuration(path.Field("backOff").Field("baseInterval"), backOff.GetBaseInterval()))
+		if backOff.GetMaxInterval() != nil {
+			err.Add(ValidateDuration(path.Field("backOff").Field("maxInterval"), backOff.GetMaxInterval()))
+			base, baseErr := ptypes.Duration(backOff.GetBaseInterval())
+			max, maxErr := ptypes.Duration(backOff.GetMaxInterval())
+			if baseErr == nil && maxErr == nil && max < base {
+				err.AddViolationAt(path.Field("backOff").Field("maxInterval"), "must not be less than baseInterval")
+			}
+		}
+	}
+	for i, condition := range conf.GetRetryOn() {
+		if !isRetryOnCondition(condition) {
+			err.AddViolationAt(path.Field("retryOn").Index(i), fmt.Sprintf("unknown condition %q. %s", condition, AllowedValuesHint(RetryOnConditions...)))
+		}
+	}
+	for i, code := range conf.GetRetriableStatusCodes() {
+		if status := http.StatusText(int(code)); len(status) == 0 {
+			err.AddViolationAt(path.Field("retriableStatusCodes").Index(i), "http status code is incorrect")
+		}
+	}
+	return
+}
+
+func isRetryOnCondition(condition string) bool {
+	for _, known := range RetryOnConditions {
+		if condition == known {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,169 @@
+package mesh_test
+
+import (
+	"github.com/ghodss/yaml"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+
+	. "github.com/Kong/kuma/pkg/core/resources/apis/mesh"
+	util_proto "github.com/Kong/kuma/pkg/util/proto"
+)
+
+var _ = Describe("Retry", func() {
+	Describe("Validate()", func() {
+		type testCase struct {
+			retry    string
+			expected string
+		}
+		DescribeTable("should validate all fields and return as much individual errors as possible",
+			func(given testCase) {
+				// setup
+				retry := RetryResource{}
+
+				// when
+				err := util_proto.FromYAML([]byte(given.retry), &retry.Spec)
+				// then
+				Expect(err).ToNot(HaveOccurred())
+
+				// when
+				verr := retry.Validate()
+				// and
+				actual, err := yaml.Marshal(verr)
+
+				// then
+				Expect(err).ToNot(HaveOccurred())
+				// and
+				Expect(actual).To(MatchYAML(given.expected))
+			},
+			Entry("empty spec", testCase{
+				retry: ``,
+				expected: `
+                violations:
+                - field: sources
+                  message: must have at least one element
+                - field: destinations
+                  message: must have at least one element
+                - field: conf
+                  message: must have either http or tcp retries configured
+`,
+			}),
+			Entry("selectors without tags", testCase{
+				retry: `
+                sources:
+                - match: {}
+                destinations:
+                - match: {}
+                conf:
+                  http: {}
+                  tcp: {}
+`,
+				expected: `
+                violations:
+                - field: sources[0].match
+                  message: must have at least one tag
+                - field: sources[0].match
+                  message: mandatory tag "service" is missing
+                - field: destinations[0].match
+                  message: must consist of exactly one tag "service"
+                - field: destinations[0].match
+                  message: mandatory tag "service" is missing
+                - field: conf
+                  message: must have either http or tcp retries configured
+`,
+			}),
+			Entry("invalid http retries conf", testCase{
+				retry: `
+                sources:
+                - match:
+                    service: web
+                destinations:
+                - match:
+                    service: backend
+                conf:
+                  http:
+                    numRetries: 0
+                    perTryTimeout: 0s
+                    backOff:
+                      baseInterval: 1s
+                      maxInterval: 500ms
+                    retryOn:
+                    - 5xx
+                    - timeout
+                    retriableStatusCodes:
+                    - 503
+                    - 999
+`,
+				expected: `
+                violations:
+                - field: conf.http.numRetries
+                  message: must have a positive value
+                - field: conf.http.perTryTimeout
+                  message: must have a positive value
+                - field: conf.http.backOff.maxInterval
+                  message: must not be less than baseInterval
+                - field: conf.http.retryOn[1]
+                  message: 'unknown condition "timeout". Allowed values: 5xx, gateway-error, reset, connect-failure, retriable-4xx, refused-stream, retriable-status-codes, retriable-headers'
+                - field: conf.http.retriableStatusCodes[1]
+                  message: http status code is incorrect
+`,
+			}),
+			Entry("back-off without base interval", testCase{
+				retry: `
+                sources:
+                - match:
+                    service: web
+                destinations:
+                - match:
+                    service: backend
+                conf:
+                  http:
+                    backOff:
+                      maxInterval: 1s
+`,
+				expected: `
+                violations:
+                - field: conf.http.backOff.baseInterval
+                  message: must have a positive value
+`,
+			}),
+		)
+
+		It("should pass validation of a valid Retry", func() {
+			// setup
+			retry := RetryResource{}
+
+			// when
+			err := util_proto.FromYAML([]byte(`
+            sources:
+            - match:
+                service: web
+                region: eu
+            destinations:
+            - match:
+                service: backend
+            conf:
+              http:
+                numRetries: 3
+                perTryTimeout: 200ms
+                backOff:
+                  baseInterval: 20ms
+                  maxInterval: 1s
+                retryOn:
+                - gateway-error
+                - retriable-status-codes
+                retriableStatusCodes:
+                - 503
+              tcp:
+                maxConnectAttempts: 5
+`), &retry.Spec)
+			// then
+			Expect(err).ToNot(HaveOccurred())
+
+			// when
+			verr := retry.Validate()
+			// then
+			Expect(verr).ToNot(HaveOccurred())
+		})
+	})
+})
@@ -0,0 +1,100 @@
+package mesh
+
+import (
+	"errors"
+
+	"github.com/Kong/kuma/pkg/core/resources/registry"
+
+	mesh_proto "github.com/Kong/kuma/api/mesh/v1alpha1"
+	"github.com/Kong/kuma/pkg/core/resources/model"
+)
+
+const (
+	TimeoutType model.ResourceType = "Timeout"
+)
+
+var _ model.Resource = &TimeoutResource{}
+
+type TimeoutResource struct {
+	Meta model.ResourceMeta
+	Spec mesh_proto.Timeout
+}
+
+func (f *TimeoutResource) GetType() model.ResourceType {
+	return TimeoutType
+}
+
+func (f *TimeoutResource) GetMeta() model.ResourceMeta {
+	return f.Meta
+}
+
+func (f *TimeoutResource) SetMeta(m model.ResourceMeta) {
+	f.Meta = m
+}
+
+func (f *TimeoutResource) GetSpec() model.ResourceSpec {
+	return &f.Spec
+}
+
+func (f *TimeoutResource) SetSpec(spec model.ResourceSpec) error {
+	timeout, ok := spec.(*mesh_proto.Timeout)
+	if !ok {
+		return errors.New("invalid type of spec")
+	} else {
+		f.Spec = *timeout
+		return nil
+	}
+}
+
+var _ model.ResourceList = &TimeoutResourceList{}
+
+type TimeoutResourceList struct {
+	Items      []*TimeoutResource
+	Pagination model.Pagination
+}
+
+func (l *TimeoutResourceList) GetItems() []model.Resource {
+	res := make([]model.Resource, len(l.Items))
+	for i, elem := range l.Items {
+		res[i] = elem
+	}
+	return res
+}
+
+func (l *TimeoutResourceList) GetItemType() model.ResourceType {
+	return TimeoutType
+}
+
+func (l *TimeoutResourceList) NewItem() model.Resource {
+	return &TimeoutResource{}
+}
+
+func (l *TimeoutResourceList) AddItem(r model.Resource) error {
+	if trr, ok := r.(*TimeoutResource); ok {
+		l.Items = append(l.Items, trr)
+		return nil
+	} else {
+		return model.ErrorInvalidItemType((*TimeoutResource)(nil), r)
+	}
+}
+
+func (l *TimeoutResourceList) GetPagination() model.Pagination {
+	return l.Pagination
+}
+
+func (l *TimeoutResourceList) SetPagination(pagination model.Pagination) {
+	l.Pagination = pagination
+}
+
+func init() {
+	registry.RegisterType(&TimeoutResource{})
+	registry.RegistryListType(&TimeoutResourceList{})
+}
+
+func (f *TimeoutResource) Sources() []*mesh_proto.Selector {
+	return f.Spec.GetSources()
+}
+
+func (f *TimeoutResource) Destinations() []*mesh_proto.Selector {
+	return f.Spec.GetDestinations()
+}
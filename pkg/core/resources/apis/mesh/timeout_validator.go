@@ -0,0 +1,78 @@
+package mesh
+
+import (
+	"github.com/golang/protobuf/ptypes"
+	pduration "github.com/golang/protobuf/ptypes/duration"
+
+	"github.com/Kong/kuma/pkg/core/validators"
+)
+
+func (t *TimeoutResource) HasConnectTimeout() bool {
+	return t.Spec.GetConf().GetConnectTimeout() != nil
+}
+
+func (t *TimeoutResource) HasHttpTimeouts() bool {
+	http := t.Spec.GetConf().GetHttp()
+	return http.GetRequestTimeout() != nil || http.GetIdleTimeout() != nil
+}
+
+func (t *TimeoutResource) HasTcpTimeouts() bool {
+	return t.Spec.GetConf().GetTcp().GetIdleTimeout() != nil
+}
+
+func (t *TimeoutResource) Validate() error {
+	var err validators.ValidationError
+	err.Add(t.validateSources())
+	err.Add(t.validateDestinations())
+	err.Add(t.validateConf())
+	return err.OrNil()
+}
+
+func (t *TimeoutResource) validateSources() validators.ValidationError {
+	return ValidateSelectors(validators.RootedAt("sources"), t.Spec.Sources, ValidateSelectorsOpts{
+		RequireAtLeastOneSelector: true,
+		ValidateSelectorOpts: ValidateSelectorOpts{
+			RequireAtLeastOneTag: true,
+			RequireService:       true,
+		},
+	})
+}
+
+func (t *TimeoutResource) validateDestinations() (err validators.ValidationError) {
+	return ValidateSelectors(validators.RootedAt("destinations"), t.Spec.Destinations, OnlyServiceTagAllowed)
+}
+
+func (t *TimeoutResource) validateConf() (err validators.ValidationError) {
+	root := validators.RootedAt("conf")
+	if !t.HasConnectTimeout() && !t.HasHttpTimeouts() && !t.HasTcpTimeouts() {
+		err.AddViolationAt(root, "must have at least one timeout configured")
+		return
+	}
+	conf := t.Spec.GetConf()
+	if t.HasConnectTimeout() {
+		err.Add(ValidateDuration(root.Field("connectTimeout"), conf.GetConnectTimeout()))
+	}
+	if conf.GetTcp().GetIdleTimeout() != nil {
+		err.Add(validateOptionalTimeout(root.Field("tcp").Field("idleTimeout"), conf.GetTcp().GetIdleTimeout()))
+	}
+	if conf.GetHttp().GetRequestTimeout() != nil {
+		err.Add(validateOptionalTimeout(root.Field("http").Field("requestTimeout"), conf.GetHttp().GetRequestTimeout()))
+	}
+	if conf.GetHttp().GetIdleTimeout() != nil {
+		err.Add(validateOptionalTimeout(root.Field("http").Field("idleTimeout"), conf.GetHttp().GetIdleTimeout()))
+	}
+	return
+}
+
+// validateOptionalTimeout accepts a zero value, which means that a timeout is disabled.
+func validateOptionalTimeout(path validators.PathBuilder, timeout *pduration.Duration) (err validators.ValidationError) {
+	d, convErr := ptypes.Duration(timeout)
+	if convErr != nil {
+		err.AddViolationAt(path, "must have a valid value")
+		return
+	}
+	if d < 0 {
+		err.AddViolationAt(path, "must not be negative")
+	}
+	return
+}
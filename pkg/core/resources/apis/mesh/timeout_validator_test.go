@@ -0,0 +1,134 @@
+package mesh_test
+
+import (
+	"github.com/ghodss/yaml"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+
+	. "github.com/Kong/kuma/pkg/core/resources/apis/mesh"
+	util_proto "github.com/Kong/kuma/pkg/util/proto"
+)
+
+var _ = Describe("Timeout", func() {
+	Describe("Validate()", func() {
+		type testCase struct {
+			timeout  string
+			expected string
+		}
+		DescribeTable("should validate all fields and return as much individual errors as possible",
+			func(given testCase) {
+				// setup
+				timeout := TimeoutResource{}
+
+				// when
+				err := util_proto.FromYAML([]byte(given.timeout), &timeout.Spec)
+				// then
+				Expect(err).ToNot(HaveOccurred())
+
+				// when
+				verr := timeout.Validate()
+				// and
+				actual, err := yaml.Marshal(verr)
+
+				// then
+				Expect(err).ToNot(HaveOccurred())
+				// and
+				Expect(actual).To(MatchYAML(given.expected))
+			},
+			Entry("empty spec", testCase{
+				timeout: ``,
+				expected: `
+                violations:
+                - field: sources
+                  message: must have at least one element
+                - field: destinations
+                  message: must have at least one element
+                - field: conf
+                  message: must have at least one timeout configured
+`,
+			}),
+			Entry("selectors without tags", testCase{
+				timeout: `
+                sources:
+                - match: {}
+                destinations:
+                - match: {}
+                conf:
+                  http: {}
+                  tcp: {}
+`,
+				expected: `
+                violations:
+                - field: sources[0].match
+                  message: must have at least one tag
+                - field: sources[0].match
+                  message: mandatory tag "service" is missing
+                - field: destinations[0].match
+                  message: must consist of exactly one tag "service"
+                - field: destinations[0].match
+                  message: mandatory tag "service" is missing
+                - field: conf
+                  message: must have at least one timeout configured
+`,
+			}),
+			Entry("invalid timeouts", testCase{
+				timeout: `
+                sources:
+                - match:
+                    service: web
+                destinations:
+                - match:
+                    service: backend
+                conf:
+                  connectTimeout: 0s
+                  tcp:
+                    idleTimeout: -1s
+                  http:
+                    requestTimeout: -5s
+                    idleTimeout: -0.5s
+`,
+				expected: `
+                violations:
+                - field: conf.connectTimeout
+                  message: must have a positive value
+                - field: conf.tcp.idleTimeout
+                  message: must not be negative
+                - field: conf.http.requestTimeout
+                  message: must not be negative
+                - field: conf.http.idleTimeout
+                  message: must not be negative
+`,
+			}),
+		)
+
+		It("should pass validation of a valid Timeout", func() {
+			// setup
+			timeout := TimeoutResource{}
+
+			// when
+			err := util_proto.FromYAML([]byte(`
+            sources:
+            - match:
+                service: web
+            destinations:
+            - match:
+                service: backend
+            conf:
+              connectTimeout: 2s
+              tcp:
+                idleTimeout: 1h
+              http:
+                requestTimeout: 0s
+                idleTimeout: 10m
+`), &timeout.Spec)
+			// then
+			Expect(err).ToNot(HaveOccurred())
+
+			// when
+			verr := timeout.Validate()
+			// then
+			Expect(verr).ToNot(HaveOccurred())
+		})
+	})
+})
@@ -1,6 +1,9 @@
 package mesh
 
 import (
+	"fmt"
+
+	mesh_proto "github.com/Kong/kuma/api/mesh/v1alpha1"
 	"github.com/Kong/kuma/pkg/core/validators"
 )
 
@@ -9,6 +12,7 @@ func (d *TrafficLogResource) Validate() error {
 	err.Add(d.validateSources())
 	err.Add(d.validateDestinations())
 	// d.Spec.Conf and d.Spec.Conf.DefaultBackend can be empty, then default backend of the mesh is chosen.
+	err.Add(d.validateConf())
 	return err.OrNil()
 }
 
@@ -25,3 +29,53 @@ func (d *TrafficLogResource) validateSources() validators.ValidationError {
 func (d *TrafficLogResource) validateDestinations() (err validators.ValidationError) {
 	return ValidateSelectors(validators.RootedAt("destinations"), d.Spec.Destinations, OnlyServiceTagAllowed)
 }
+
+func (d *TrafficLogResource) validateConf() (err validators.ValidationError) {
+	if d.Spec.GetConf().GetInbound() {
+		// a source of inbound traffic is not known when logs are configured,
+		// so inbound logging would silently log traffic of all sources
+		for i, selector := range d.Spec.GetSources() {
+			for _, key := range Keys(selector.GetMatch()) {
+				if selector.GetMatch()[key] != mesh_proto.MatchAllTag {
+					err.AddViolationAt(validators.RootedAt("sources").Index(i).Field("match").Key(key), fmt.Sprintf("must be %q when inbound logging is enabled", mesh_proto.MatchAllTag))
+				}
+			}
+		}
+	}
+	for i, filter := range d.Spec.GetConf().GetFilters() {
+		err.Add(validateLogFilter(validators.RootedAt("conf").Field("filters").Index(i), filter))
+	}
+	return
+}
+
+func validateLogFilter(path validators.PathBuilder, filter *mesh_proto.TrafficLog_Conf_Filter) (err validators.ValidationError) {
+	if filter.GetStatusCodes() == nil && filter.GetMinDuration() == nil && !filter.GetOnlyErrors() &&
+		len(filter.GetHeaders()) == 0 && filter.GetSampling() == nil {
+		err.AddViolationAt(path, "must have at least one condition")
+		return
+	}
+	if statusCodes := filter.GetStatusCodes(); statusCodes != nil {
+		if statusCodes.Min < 100 || statusCodes.Min > 599 {
+			err.AddViolationAt(path.Field("statusCodes").Field("min"), "has to be in [100 - 599] range")
+		}
+		if statusCodes.Max < 100 || statusCodes.Max > 599 {
+			err.AddViolationAt(path.Field("statusCodes").Field("max"), "has to be in [100 - 599] range")
+		} else if statusCodes.Max < statusCodes.Min {
+			err.AddViolationAt(path.Field("statusCodes").Field("max"), "has to be greater than or equal to min")
+		}
+	}
+	if filter.GetMinDuration() != nil {
+		err.Add(ValidateDuration(path.Field("minDuration"), filter.GetMinDuration()))
+	}
+	for i, header := range filter.GetHeaders() {
+		if header == "" {
+			err.AddViolationAt(path.Field("headers").Index(i), "cannot be empty")
+		}
+	}
+	if sampling := filter.GetSampling(); sampling != nil {
+		if sampling.GetValue() < 0.0 || sampling.GetValue() > 100.0 {
+			err.AddViolationAt(path.Field("sampling"), "has to be in [0.0 - 100.0] range")
+		}
+	}
+	return
+}
@@ -127,8 +127,126 @@ var _ = Describe("TrafficLog", func() {
                   message: must consist of exactly one tag "service"
                 - field: destinations[1].match
                   message: mandatory tag "service" is missing
+`,
+			}),
+			Entry("invalid filters", testCase{
+				trafficLog: `
+                sources:
+                - match:
+                    service: web
+                destinations:
+                - match:
+                    service: backend
+                conf:
+                  filters:
+                  - {}
+                  - statusCodes:
+                      min: 500
+                      max: 400
+                    minDuration: 0s
+                    headers:
+                    - ""
+                    sampling: 101.0
+                  - statusCodes:
+                      min: 99
+                      max: 600
+`,
+				expected: `
+                violations:
+                - field: conf.filters[0]
+                  message: must have at least one condition
+                - field: conf.filters[1].statusCodes.max
+                  message: has to be greater than or equal to min
+                - field: conf.filters[1].minDuration
+                  message: must have a positive value
+                - field: conf.filters[1].headers[0]
+                  message: cannot be empty
+                - field: conf.filters[1].sampling
+                  message: has to be in [0.0 - 100.0] range
+                - field: conf.filters[2].statusCodes.min
+                  message: has to be in [100 - 599] range
+                - field: conf.filters[2].statusCodes.max
+                  message: has to be in [100 - 599] range
+`,
+			}),
+			Entry("sources of inbound logging", testCase{
+				trafficLog: `
+                sources:
+                - match:
+                    service: web
+                    version: '*'
+                destinations:
+                - match:
+                    service: backend
+                conf:
+                  inbound: true
+`,
+				expected: `
+                violations:
+                - field: sources[0].match["service"]
+                  message: must be "*" when inbound logging is enabled
 `,
 			}),
 		)
+
+		It("should pass validation of inbound logging of all sources", func() {
+			// given
+			trafficLog := TrafficLogResource{}
+			spec := `
+            sources:
+            - match:
+                service: '*'
+            destinations:
+            - match:
+                service: backend
+            conf:
+              inbound: true
+`
+			// when
+			err := util_proto.FromYAML([]byte(spec), &trafficLog.Spec)
+			// then
+			Expect(err).ToNot(HaveOccurred())
+
+			// when
+			err = trafficLog.Validate()
+			// then
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("should pass validation", func() {
+			// given
+			trafficLog := TrafficLogResource{}
+			spec := `
+            sources:
+            - match:
+                service: web
+            destinations:
+            - match:
+                service: backend
+            conf:
+              backend: logstash
+              filters:
+              - statusCodes:
+                  min: 500
+                  max: 599
+              - statusCodes:
+                  min: 200
+                  max: 299
+                sampling: 1.0
+              - minDuration: 1s
+                onlyErrors: true
+                headers:
+                - x-debug
+`
+			// when
+			err := util_proto.FromYAML([]byte(spec), &trafficLog.Spec)
+			// then
+			Expect(err).ToNot(HaveOccurred())
+
+			// when
+			err = trafficLog.Validate()
+			// then
+			Expect(err).ToNot(HaveOccurred())
+		})
 	})
 })
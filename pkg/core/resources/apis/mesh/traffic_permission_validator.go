@@ -1,13 +1,32 @@
 package mesh
 
 import (
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+
 	"github.com/Kong/kuma/pkg/core/validators"
 )
 
+// HttpMethods is a list of HTTP methods that can be used in TrafficPermission HTTP rules.
+var HttpMethods = []string{
+	http.MethodGet,
+	http.MethodHead,
+	http.MethodPost,
+	http.MethodPut,
+	http.MethodPatch,
+	http.MethodDelete,
+	http.MethodConnect,
+	http.MethodOptions,
+	http.MethodTrace,
+}
+
 func (d *TrafficPermissionResource) Validate() error {
 	var err validators.ValidationError
 	err.Add(d.validateSources())
 	err.Add(d.validateDestinations())
+	err.Add(d.validateHttp())
 	return err.OrNil()
 }
 
@@ -24,3 +43,41 @@ func (d *TrafficPermissionResource) validateDestinations() (err validators.Valid
 		},
 	})
 }
+
+func (d *TrafficPermissionResource) validateHttp() (err validators.ValidationError) {
+	root := validators.RootedAt("http")
+	for i, rule := range d.Spec.Http {
+		path := root.Index(i)
+		if rule.GetPathPrefix() == "" && len(rule.GetMethods()) == 0 && len(rule.GetHeaders()) == 0 {
+			err.AddViolationAt(path, "must have at least one of pathPrefix, methods or headers")
+		}
+		if prefix := rule.GetPathPrefix(); prefix != "" && !strings.HasPrefix(prefix, "/") {
+			err.AddViolationAt(path.Field("pathPrefix"), `must start with "/"`)
+		}
+		for j, method := range rule.GetMethods() {
+			if !isHttpMethod(method) {
+				err.AddViolationAt(path.Field("methods").Index(j), fmt.Sprintf("unknown method %q. %s", method, AllowedValuesHint(HttpMethods...)))
+			}
+		}
+		var names []string
+		for name := range rule.GetHeaders() {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			if name == "" {
+				err.AddViolationAt(path.Field("headers"), "header name must be non-empty")
+			}
+		}
+	}
+	return
+}
+
+func isHttpMethod(method string) bool {
+	for _, known := range HttpMethods {
+		if method == known {
+			return true
+		}
+	}
+	return false
+}
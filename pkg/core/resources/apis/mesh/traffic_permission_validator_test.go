@@ -127,6 +127,35 @@ var _ = Describe("TrafficPermission", func() {
                   message: must have at least one tag
                 - field: destinations[1].match
                   message: mandatory tag "service" is missing
+`,
+			}),
+			Entry("invalid http rules", testCase{
+				permission: `
+                sources:
+                - match:
+                    service: billing
+                destinations:
+                - match:
+                    service: ledger
+                http:
+                - {}
+                - pathPrefix: invoices
+                  methods:
+                  - GET
+                  - FETCH
+                - headers:
+                    "": value
+`,
+				expected: `
+                violations:
+                - field: http[0]
+                  message: must have at least one of pathPrefix, methods or headers
+                - field: http[1].pathPrefix
+                  message: must start with "/"
+                - field: http[1].methods[1]
+                  message: 'unknown method "FETCH". Allowed values: GET, HEAD, POST, PUT, PATCH, DELETE, CONNECT, OPTIONS, TRACE'
+                - field: http[2].headers
+                  message: header name must be non-empty
 `,
 			}),
 		)
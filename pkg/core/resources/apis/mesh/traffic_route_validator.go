@@ -1,6 +1,12 @@
 package mesh
 
 import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	mesh_proto "github.com/Kong/kuma/api/mesh/v1alpha1"
 	"github.com/Kong/kuma/pkg/core/validators"
 )
 
@@ -9,6 +15,9 @@ func (d *TrafficRouteResource) Validate() error {
 	err.Add(d.validateSources())
 	err.Add(d.validateDestinations())
 	err.Add(d.validateConf())
+	err.Add(d.validateHttp())
+	err.Add(d.validateLoadBalancer())
+	err.Add(d.validateMirror())
 	return err.OrNil()
 }
 
@@ -39,3 +48,164 @@ func (d *TrafficRouteResource) validateConf() (err validators.ValidationError) {
 	}
 	return
 }
+
+func (d *TrafficRouteResource) validateHttp() (err validators.ValidationError) {
+	root := validators.RootedAt("http")
+	for i, http := range d.Spec.Http {
+		err.Add(validateHttpMatch(root.Index(i).Field("match"), http.GetMatch()))
+		err.Add(validateHttpDestinations(root.Index(i).Field("destination"), http.GetDestination()))
+	}
+	return
+}
+
+// RingHashFunctions is a list of hash functions supported by Envoy to build a ring.
+var RingHashFunctions = []string{
+	"XX_HASH",
+	"MURMUR_HASH_2",
+}
+
+func (d *TrafficRouteResource) validateLoadBalancer() (err validators.ValidationError) {
+	lb := d.Spec.GetLoadBalancer()
+	if lb == nil {
+		return
+	}
+	root := validators.RootedAt("loadBalancer")
+	if lb.GetLeastRequest() != nil && lb.GetLeastRequest().GetChoiceCount() == 1 {
+		err.AddViolationAt(root.Field("leastRequest").Field("choiceCount"), "must be at least 2")
+	}
+	if ringHash := lb.GetRingHash(); ringHash != nil {
+		if fn := ringHash.GetHashFunction(); fn != "" && !isRingHashFunction(fn) {
+			err.AddViolationAt(root.Field("ringHash").Field("hashFunction"), fmt.Sprintf("unknown hash function %q. %s", fn, AllowedValuesHint(RingHashFunctions...)))
+		}
+		if ringHash.GetMaxRingSize() != 0 && ringHash.GetMinRingSize() > ringHash.GetMaxRingSize() {
+			err.AddViolationAt(root.Field("ringHash").Field("minRingSize"), "must not be greater than maxRingSize")
+		}
+	}
+	if len(lb.GetHashPolicies()) > 0 && lb.GetRingHash() == nil && lb.GetMaglev() == nil {
+		err.AddViolationAt(root.Field("hashPolicies"), "can only be used with ringHash or maglev load balancer")
+	}
+	for i, policy := range lb.GetHashPolicies() {
+		path := root.Field("hashPolicies").Index(i)
+		switch policy.GetType().(type) {
+		case *mesh_proto.TrafficRoute_LoadBalancer_HashPolicy_Header_:
+			if policy.GetHeader().GetName() == "" {
+				err.AddViolationAt(path.Field("header").Field("name"), "cannot be empty")
+			}
+		case *mesh_proto.TrafficRoute_LoadBalancer_HashPolicy_Cookie_:
+			if policy.GetCookie().GetName() == "" {
+				err.AddViolationAt(path.Field("cookie").Field("name"), "cannot be empty")
+			}
+			if policy.GetCookie().GetTtl() != nil {
+				err.Add(ValidateDuration(path.Field("cookie").Field("ttl"), policy.GetCookie().GetTtl()))
+			}
+		case *mesh_proto.TrafficRoute_LoadBalancer_HashPolicy_SourceIp_:
+		default:
+			err.AddViolationAt(path, "must have either header, cookie or sourceIp")
+		}
+	}
+	return
+}
+
+func (d *TrafficRouteResource) validateMirror() (err validators.ValidationError) {
+	mirror := d.Spec.GetMirror()
+	if mirror == nil {
+		return
+	}
+	root := validators.RootedAt("mirror")
+	err.Add(ValidateSelector(root.Field("destination"), mirror.GetDestination(), ValidateSelectorOpts{
+		RequireAtLeastOneTag: true,
+		RequireService:       true,
+	}))
+	if mirror.GetPercentage() != nil {
+		if value := mirror.GetPercentage().GetValue(); value < 0.0 || value > 100.0 {
+			err.AddViolationAt(root.Field("percentage"), "has to be in [0.0 - 100.0] range")
+		}
+	}
+	return
+}
+
+func isRingHashFunction(fn string) bool {
+	for _, known := range RingHashFunctions {
+		if fn == known {
+			return true
+		}
+	}
+	return false
+}
+
+func validateHttpMatch(path validators.PathBuilder, match *mesh_proto.TrafficRoute_Http_Match) (err validators.ValidationError) {
+	if match == nil {
+		err.AddViolationAt(path, "must be defined")
+		return
+	}
+	if match.GetPath() == nil && match.GetMethod() == nil && len(match.GetHeaders()) == 0 {
+		err.AddViolationAt(path, "must have at least one of path, method or headers")
+	}
+	if match.GetPath() != nil {
+		err.Add(validateStringMatcher(path.Field("path"), match.GetPath()))
+		if prefix := match.GetPath().GetPrefix(); prefix != "" && !strings.HasPrefix(prefix, "/") {
+			err.AddViolationAt(path.Field("path").Field("prefix"), `must start with "/"`)
+		}
+		if exact := match.GetPath().GetExact(); exact != "" && !strings.HasPrefix(exact, "/") {
+			err.AddViolationAt(path.Field("path").Field("exact"), `must start with "/"`)
+		}
+	}
+	if match.GetMethod() != nil {
+		err.Add(validateStringMatcher(path.Field("method"), match.GetMethod()))
+	}
+	var names []string
+	for name := range match.GetHeaders() {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		if name == "" {
+			err.AddViolationAt(path.Field("headers"), "header name must be non-empty")
+		}
+		err.Add(validateStringMatcher(path.Field("headers").Key(name), match.GetHeaders()[name]))
+	}
+	return
+}
+
+func validateStringMatcher(path validators.PathBuilder, matcher *mesh_proto.TrafficRoute_Http_Match_StringMatcher) (err validators.ValidationError) {
+	switch matcher.GetMatcherType().(type) {
+	case *mesh_proto.TrafficRoute_Http_Match_StringMatcher_Prefix:
+		if matcher.GetPrefix() == "" {
+			err.AddViolationAt(path.Field("prefix"), "must be non-empty")
+		}
+	case *mesh_proto.TrafficRoute_Http_Match_StringMatcher_Exact:
+		if matcher.GetExact() == "" {
+			err.AddViolationAt(path.Field("exact"), "must be non-empty")
+		}
+	case *mesh_proto.TrafficRoute_Http_Match_StringMatcher_Regex:
+		if matcher.GetRegex() == "" {
+			err.AddViolationAt(path.Field("regex"), "must be non-empty")
+		} else if _, e := regexp.Compile(matcher.GetRegex()); e != nil {
+			err.AddViolationAt(path.Field("regex"), fmt.Sprintf("must be a valid RE2 regular expression: %s", e))
+		}
+	default:
+		err.AddViolationAt(path, "must have either prefix, exact or regex")
+	}
+	return
+}
+
+func validateHttpDestinations(path validators.PathBuilder, destinations []*mesh_proto.TrafficRoute_WeightedDestination) (err validators.ValidationError) {
+	if len(destinations) == 0 {
+		err.AddViolationAt(path, "must have at least one element")
+	}
+	for i, routeEntry := range destinations {
+		err.Add(ValidateSelector(path.Index(i).Field("destination"), routeEntry.GetDestination(), ValidateSelectorOpts{
+			RequireAtLeastOneTag: true,
+			RequireService:       true,
+			ExtraTagValueValidators: []TagValueValidatorFunc{
+				func(path validators.PathBuilder, key, value string) (err validators.ValidationError) {
+					if key == mesh_proto.ProtocolTag && value != "" && ParseProtocol(value) != ProtocolHTTP {
+						err.AddViolationAt(path.Key(key), fmt.Sprintf("HTTP rules cannot be applied to a destination with protocol %q", value))
+					}
+					return
+				},
+			},
+		}))
+	}
+	return
+}
@@ -156,6 +156,139 @@ var _ = Describe("TrafficRoute", func() {
                   message: must have at least one tag
                 - field: conf[1].destination
                   message: mandatory tag "service" is missing
+`,
+			}),
+			Entry("invalid http rules", testCase{
+				route: `
+                sources:
+                - match:
+                    service: web
+                destinations:
+                - match:
+                    service: backend
+                conf:
+                - destination:
+                    service: backend
+                http:
+                - destination:
+                  - destination:
+                      service: backend
+                - match: {}
+                - match:
+                    path:
+                      prefix: api
+                    method: {}
+                    headers:
+                      x-canary:
+                        regex: "(unclosed"
+                  destination:
+                  - destination:
+                      service: backend
+                      protocol: tcp
+`,
+				expected: `
+                violations:
+                - field: http[0].match
+                  message: must be defined
+                - field: http[1].match
+                  message: must have at least one of path, method or headers
+                - field: http[1].destination
+                  message: must have at least one element
+                - field: http[2].match.path.prefix
+                  message: must start with "/"
+                - field: http[2].match.method
+                  message: must have either prefix, exact or regex
+                - field: http[2].match.headers["x-canary"].regex
+                  message: 'must be a valid RE2 regular expression: error parsing regexp: missing closing ): ` + "`(unclosed`" + `'
+                - field: http[2].destination[0].destination["protocol"]
+                  message: HTTP rules cannot be applied to a destination with protocol "tcp"
+`,
+			}),
+			Entry("invalid load balancer", testCase{
+				route: `
+                sources:
+                - match:
+                    service: web
+                destinations:
+                - match:
+                    service: backend
+                conf:
+                - destination:
+                    service: backend
+                loadBalancer:
+                  ringHash:
+                    hashFunction: SHA1
+                    minRingSize: 2048
+                    maxRingSize: 1024
+                  hashPolicies:
+                  - header: {}
+                  - cookie:
+                      ttl: 0s
+                  - terminal: true
+`,
+				expected: `
+                violations:
+                - field: loadBalancer.ringHash.hashFunction
+                  message: 'unknown hash function "SHA1". Allowed values: XX_HASH, MURMUR_HASH_2'
+                - field: loadBalancer.ringHash.minRingSize
+                  message: must not be greater than maxRingSize
+                - field: loadBalancer.hashPolicies[0].header.name
+                  message: cannot be empty
+                - field: loadBalancer.hashPolicies[1].cookie.name
+                  message: cannot be empty
+                - field: loadBalancer.hashPolicies[1].cookie.ttl
+                  message: must have a positive value
+                - field: loadBalancer.hashPolicies[2]
+                  message: must have either header, cookie or sourceIp
+`,
+			}),
+			Entry("invalid mirror", testCase{
+				route: `
+                sources:
+                - match:
+                    service: web
+                destinations:
+                - match:
+                    service: backend
+                conf:
+                - destination:
+                    service: backend
+                mirror:
+                  destination:
+                    version: v2
+                  percentage: 150
+`,
+				expected: `
+                violations:
+                - field: mirror.destination
+                  message: mandatory tag "service" is missing
+                - field: mirror.percentage
+                  message: has to be in [0.0 - 100.0] range
+`,
+			}),
+			Entry("hash policies without a hash-based load balancer", testCase{
+				route: `
+                sources:
+                - match:
+                    service: web
+                destinations:
+                - match:
+                    service: backend
+                conf:
+                - destination:
+                    service: backend
+                loadBalancer:
+                  leastRequest:
+                    choiceCount: 1
+                  hashPolicies:
+                  - sourceIp: {}
+`,
+				expected: `
+                violations:
+                - field: loadBalancer.leastRequest.choiceCount
+                  message: must be at least 2
+                - field: loadBalancer.hashPolicies
+                  message: can only be used with ringHash or maglev load balancer
 `,
 			}),
 		)
@@ -11,6 +11,7 @@ import (
 	"github.com/Kong/kuma/pkg/core/resources/store"
 	"github.com/Kong/kuma/pkg/core/rest/errors/types"
 	"github.com/Kong/kuma/pkg/core/validators"
+	envoy_admin "github.com/Kong/kuma/pkg/envoy/admin"
 )
 
 func HandleError(response *restful.Response, err error, title string) {
@@ -31,6 +32,8 @@ func HandleError(response *restful.Response, err error, title string) {
 		handleInvalidPageSize(title, response)
 	case err == api_server_types.PaginationNotSupported:
 		handlePaginationNotSupported(title, response)
+	case envoy_admin.IsDataplaneNotConnected(err):
+		handleDataplaneNotConnected(title, response)
 	default:
 		handleUnknownError(err, title, response)
 	}
@@ -130,6 +133,14 @@ func handleMaxPageSizeExceeded(title string, err error, response *restful.Respon
 	writeError(response, 400, kumaErr)
 }
 
+func handleDataplaneNotConnected(title string, response *restful.Response) {
+	kumaErr := types.Error{
+		Title:   title,
+		Details: "Dataplane is not connected to this instance of the Control Plane",
+	}
+	writeError(response, 503, kumaErr)
+}
+
 func handleUnknownError(err error, title string, response *restful.Response) {
 	core.Log.Error(err, title)
 	kumaErr := types.Error{
@@ -14,6 +14,8 @@ import (
 	"github.com/Kong/kuma/pkg/core/runtime/component"
 	secret_manager "github.com/Kong/kuma/pkg/core/secrets/manager"
 	core_xds "github.com/Kong/kuma/pkg/core/xds"
+	envoy_admin "github.com/Kong/kuma/pkg/envoy/admin"
+	xds_history "github.com/Kong/kuma/pkg/xds/history"
 )
 
 // BuilderContext provides access to Builder's interim state.
@@ -38,6 +40,8 @@ type Builder struct {
 	bcm builtin_ca.BuiltinCaManager
 	pcm provided_ca.ProvidedCaManager
 	xds core_xds.XdsContext
+	eac envoy_admin.Streams
+	sh  xds_history.SnapshotHistory
 	ext context.Context
 }
 
@@ -85,6 +89,16 @@ func (b *Builder) WithXdsContext(xds core_xds.XdsContext) *Builder {
 	return b
 }
 
+func (b *Builder) WithEnvoyAdminStreams(eac envoy_admin.Streams) *Builder {
+	b.eac = eac
+	return b
+}
+
+func (b *Builder) WithSnapshotHistory(sh xds_history.SnapshotHistory) *Builder {
+	b.sh = sh
+	return b
+}
+
 func (b *Builder) WithExtensions(ext context.Context) *Builder {
 	b.ext = ext
 	return b
@@ -115,6 +129,12 @@ func (b *Builder) Build() (Runtime, error) {
 	if b.xds == nil {
 		return nil, errors.Errorf("xDS Context has not been configured")
 	}
+	if b.eac == nil {
+		return nil, errors.Errorf("Envoy Admin streams have not been configured")
+	}
+	if b.sh == nil {
+		return nil, errors.Errorf("xDS snapshot history has not been configured")
+	}
 	if b.ext == nil {
 		return nil, errors.Errorf("Extensions have been misconfigured")
 	}
@@ -130,6 +150,8 @@ func (b *Builder) Build() (Runtime, error) {
 			bcm: b.bcm,
 			pcm: b.pcm,
 			xds: b.xds,
+			eac: b.eac,
+			sh:  b.sh,
 			ext: b.ext,
 		},
 		Manager: b.cm,
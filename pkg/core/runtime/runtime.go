@@ -10,6 +10,8 @@ import (
 	"github.com/Kong/kuma/pkg/core/runtime/component"
 	secret_manager "github.com/Kong/kuma/pkg/core/secrets/manager"
 	core_xds "github.com/Kong/kuma/pkg/core/xds"
+	envoy_admin "github.com/Kong/kuma/pkg/envoy/admin"
+	xds_history "github.com/Kong/kuma/pkg/xds/history"
 )
 
 // Runtime represents initialized application state.
@@ -31,6 +33,8 @@ type RuntimeContext interface {
 	SecretManager() secret_manager.SecretManager
 	BuiltinCaManager() builtin_ca.BuiltinCaManager
 	ProvidedCaManager() provided_ca.ProvidedCaManager
+	EnvoyAdmin() envoy_admin.Streams
+	SnapshotHistory() xds_history.SnapshotHistory
 	Extensions() context.Context
 }
 
@@ -62,6 +66,8 @@ type runtimeContext struct {
 	bcm builtin_ca.BuiltinCaManager
 	pcm provided_ca.ProvidedCaManager
 	xds core_xds.XdsContext
+	eac envoy_admin.Streams
+	sh  xds_history.SnapshotHistory
 	ext context.Context
 }
 
@@ -86,6 +92,12 @@ func (rc *runtimeContext) BuiltinCaManager() builtin_ca.BuiltinCaManager {
 func (rc *runtimeContext) ProvidedCaManager() provided_ca.ProvidedCaManager {
 	return rc.pcm
 }
+func (rc *runtimeContext) EnvoyAdmin() envoy_admin.Streams {
+	return rc.eac
+}
+func (rc *runtimeContext) SnapshotHistory() xds_history.SnapshotHistory {
+	return rc.sh
+}
 func (rc *runtimeContext) Extensions() context.Context {
 	return rc.ext
 }
@@ -0,0 +1,42 @@
+package manager
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	secret_model "github.com/Kong/kuma/pkg/core/resources/apis/system"
+	core_manager "github.com/Kong/kuma/pkg/core/resources/manager"
+	"github.com/Kong/kuma/pkg/core/resources/model"
+	core_store "github.com/Kong/kuma/pkg/core/resources/store"
+)
+
+// NewReadOnlySecretManager adapts a SecretManager to a ReadOnlyResourceManager,
+// e.g. to cache Secrets with core_manager.NewCachedManager.
+func NewReadOnlySecretManager(secretManager SecretManager) core_manager.ReadOnlyResourceManager {
+	return &readOnlySecretManager{
+		secretManager: secretManager,
+	}
+}
+
+var _ core_manager.ReadOnlyResourceManager = &readOnlySecretManager{}
+
+type readOnlySecretManager struct {
+	secretManager SecretManager
+}
+
+func (m *readOnlySecretManager) Get(ctx context.Context, res model.Resource, fs ...core_store.GetOptionsFunc) error {
+	secret, ok := res.(*secret_model.SecretResource)
+	if !ok {
+		return errors.Errorf("resource of type %q is not a Secret", res.GetType())
+	}
+	return m.secretManager.Get(ctx, secret, fs...)
+}
+
+func (m *readOnlySecretManager) List(ctx context.Context, list model.ResourceList, fs ...core_store.ListOptionsFunc) error {
+	secrets, ok := list.(*secret_model.SecretResourceList)
+	if !ok {
+		return errors.Errorf("list of type %q is not a list of Secrets", list.GetItemType())
+	}
+	return m.secretManager.List(ctx, secrets, fs...)
+}
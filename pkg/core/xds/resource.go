@@ -16,6 +16,8 @@ type Resource struct {
 	Name     string
 	Version  string
 	Resource ResourcePayload
+	// Origin identifies a generator of a resource, e.g. `inbound`.
+	Origin string
 }
 
 // ResourceList represents a list of generic xDS resources.
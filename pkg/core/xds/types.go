@@ -36,9 +36,53 @@ type DestinationMap map[ServiceName]TagSelectorSet
 
 // Endpoint holds routing-related information about a single endpoint.
 type Endpoint struct {
-	Target string
-	Port   uint32
-	Tags   map[string]string
+	Target          string
+	Port            uint32
+	Tags            map[string]string
+	ExternalService *ExternalService
+}
+
+// ExternalService holds settings of an endpoint that is located outside of the mesh.
+//
+// TLS material itself is not a part of the settings, instead it is served to Envoy over SDS.
+type ExternalService struct {
+	// Name of the ExternalService resource.
+	Name       string
+	TLSEnabled bool
+	ServerName string
+	// Names of Secrets with TLS material.
+	CaCertSecret     string
+	ClientCertSecret string
+	ClientKeySecret  string
+}
+
+// HasCaCert returns true if a CA certificate to verify the external service with has been specified.
+func (e *ExternalService) HasCaCert() bool {
+	return e.CaCertSecret != ""
+}
+
+// HasClientCert returns true if a client certificate must be presented to the external service.
+func (e *ExternalService) HasClientCert() bool {
+	return e.ClientCertSecret != "" && e.ClientKeySecret != ""
+}
+
+// IsExternalService returns true if the endpoint is located outside of the mesh.
+func (e Endpoint) IsExternalService() bool {
+	return e.ExternalService != nil
+}
+
+// Locality returns locality of the endpoint.
+func (e Endpoint) Locality() Locality {
+	return Locality{
+		Region: e.Tags[mesh_proto.RegionTag],
+		Zone:   e.Tags[mesh_proto.ZoneTag],
+	}
+}
+
+// Locality identifies a geographic location of an endpoint by its `region` and `zone` tags.
+type Locality struct {
+	Region string
+	Zone   string
 }
 
 // EndpointList is a list of Endpoints with convenience methods.
@@ -47,31 +91,57 @@ type EndpointList []Endpoint
 // EndpointMap holds routing-related information about a set of endpoints grouped by service name.
 type EndpointMap map[ServiceName][]Endpoint
 
+// AccessLog represents a logging backend and filters of log entries defined by a TrafficLog.
+type AccessLog struct {
+	Backend *mesh_proto.LoggingBackend
+	Filters []*mesh_proto.TrafficLog_Conf_Filter
+}
+
 // LogMap holds the most specific TrafficLog for each outbound interface of a Dataplane.
-type LogMap map[ServiceName]*mesh_proto.LoggingBackend
+type LogMap map[ServiceName]*AccessLog
+
+// InboundLogMap holds the most specific TrafficLog for each inbound interface of a Dataplane.
+type InboundLogMap map[mesh_proto.InboundInterface]*AccessLog
 
 // HealthCheckMap holds the most specific HealthCheck for each reachable service.
 type HealthCheckMap map[ServiceName]*mesh_core.HealthCheckResource
 
+// CircuitBreakerMap holds the most specific CircuitBreaker for each reachable service.
+type CircuitBreakerMap map[ServiceName]*mesh_core.CircuitBreakerResource
+
+// RetryMap holds the most specific Retry for each outbound interface of a Dataplane.
+type RetryMap map[ServiceName]*mesh_core.RetryResource
+
+// TimeoutMap holds the most specific Timeout for each outbound interface of a Dataplane.
+type TimeoutMap map[ServiceName]*mesh_core.TimeoutResource
+
 // FaultInjectionMap holds the most specific FaultInjectionResource for each InboundInterface
 type FaultInjectionMap map[mesh_proto.InboundInterface]*mesh_proto.FaultInjection
 
+// OutboundFaultInjectionMap holds the most specific FaultInjection applied on the source side for each outbound interface of a Dataplane.
+type OutboundFaultInjectionMap map[ServiceName]*mesh_proto.FaultInjection
+
 // TrafficPermissionMap holds the most specific TrafficPermissionResource for each InboundInterface
 type TrafficPermissionMap map[mesh_proto.InboundInterface]*mesh_core.TrafficPermissionResource
 
 type Proxy struct {
-	Id                 ProxyId
-	Dataplane          *mesh_core.DataplaneResource
-	TrafficPermissions TrafficPermissionMap
-	Logs               LogMap
-	TrafficRoutes      RouteMap
-	OutboundSelectors  DestinationMap
-	OutboundTargets    EndpointMap
-	HealthChecks       HealthCheckMap
-	TrafficTrace       *mesh_core.TrafficTraceResource
-	TracingBackend     *mesh_proto.TracingBackend
-	Metadata           *DataplaneMetadata
-	FaultInjections    FaultInjectionMap
+	Id                      ProxyId
+	Dataplane               *mesh_core.DataplaneResource
+	TrafficPermissions      TrafficPermissionMap
+	Logs                    LogMap
+	InboundLogs             InboundLogMap
+	TrafficRoutes           RouteMap
+	OutboundSelectors       DestinationMap
+	OutboundTargets         EndpointMap
+	HealthChecks            HealthCheckMap
+	CircuitBreakers         CircuitBreakerMap
+	TrafficTrace            *mesh_core.TrafficTraceResource
+	TracingBackend          *mesh_proto.TracingBackend
+	Metadata                *DataplaneMetadata
+	FaultInjections         FaultInjectionMap
+	OutboundFaultInjections OutboundFaultInjectionMap
+	Retries                 RetryMap
+	Timeouts                TimeoutMap
 }
 
 func (s TagSelectorSet) Add(new mesh_proto.TagSelector) TagSelectorSet {
@@ -68,6 +68,7 @@ const (
 	CMD_KUMA_SOURCE_ADDRESS              = "KUMA_SOURCE_ADDRESS"
 	CMD_KUMA_SOURCE_ADDRESS_WITHOUT_PORT = "KUMA_SOURCE_ADDRESS_WITHOUT_PORT"
 	CMD_KUMA_SOURCE_SERVICE              = "KUMA_SOURCE_SERVICE"
+	CMD_KUMA_SOURCE_IDENTITY             = "KUMA_SOURCE_IDENTITY"
 	CMD_KUMA_DESTINATION_SERVICE         = "KUMA_DESTINATION_SERVICE"
 	CMD_KUMA_MESH                        = "KUMA_MESH"
 )
@@ -171,6 +172,8 @@ func (o CommandOperatorDescriptor) String() string {
 		return "%KUMA_SOURCE_ADDRESS_WITHOUT_PORT%"
 	case CMD_KUMA_SOURCE_SERVICE:
 		return "%KUMA_SOURCE_SERVICE%"
+	case CMD_KUMA_SOURCE_IDENTITY:
+		return "%KUMA_SOURCE_IDENTITY%"
 	case CMD_KUMA_DESTINATION_SERVICE:
 		return "%KUMA_DESTINATION_SERVICE%"
 	case CMD_KUMA_MESH:
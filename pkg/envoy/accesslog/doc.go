@@ -7,6 +7,8 @@ Envoy access log command syntax.
 
 Use ParseFormat() function to parse a format string.
 
+Use ParseJsonFormat() function to parse a map of JSON field names to format strings.
+
 Use HttpLogEntryFormatter interface to format an HTTP log entry.
 
 Use TcpLogEntryFormatter interface to format a TCP log entry.
@@ -18,6 +18,15 @@ type AccessLogFragment interface {
 	String() string
 }
 
+// LogEntryFormatter formats both HTTP and TCP log entries,
+// e.g. according to a plain text or a JSON format.
+type LogEntryFormatter interface {
+	HttpLogEntryFormatter
+	TcpLogEntryFormatter
+	// String returns the canonical representation of the format.
+	String() string
+}
+
 // HttpLogEntryFormatter formats a given HTTP log entry
 // according to the format string.
 type HttpLogEntryFormatter interface {
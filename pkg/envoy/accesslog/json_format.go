@@ -0,0 +1,141 @@
+package accesslog
+
+import (
+	"bytes"
+	"encoding/json"
+	"sort"
+
+	accesslog_config "github.com/envoyproxy/go-control-plane/envoy/config/accesslog/v2"
+	accesslog_data "github.com/envoyproxy/go-control-plane/envoy/data/accesslog/v2"
+	"github.com/pkg/errors"
+)
+
+// JsonAccessLogFormat represents an access log format that renders
+// every log entry as a JSON object.
+type JsonAccessLogFormat struct {
+	// Fields are sorted by key to make output stable.
+	Fields []JsonField
+}
+
+// JsonField represents a single field of a JSON access log format.
+type JsonField struct {
+	Key    string
+	Format *AccessLogFormat
+}
+
+// ValidateJsonFormat validates whether a given JSON format is valid.
+func ValidateJsonFormat(fields map[string]string) error {
+	_, err := ParseJsonFormat(fields)
+	return err
+}
+
+// ParseJsonFormat parses a given map of field names to format strings.
+func ParseJsonFormat(fields map[string]string) (*JsonAccessLogFormat, error) {
+	keys := make([]string, 0, len(fields))
+	for key := range fields {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	format := &JsonAccessLogFormat{}
+	for _, key := range keys {
+		if key == "" {
+			return nil, errors.New("JSON format is not valid: field name cannot be empty")
+		}
+		fieldFormat, err := ParseFormat(fields[key])
+		if err != nil {
+			return nil, errors.Wrapf(err, "JSON format of field %q is not valid", key)
+		}
+		format.Fields = append(format.Fields, JsonField{Key: key, Format: fieldFormat})
+	}
+	return format, nil
+}
+
+func (f *JsonAccessLogFormat) FormatHttpLogEntry(entry *accesslog_data.HTTPAccessLogEntry) (string, error) {
+	return f.format(func(format *AccessLogFormat) (string, error) {
+		return format.FormatHttpLogEntry(entry)
+	})
+}
+
+func (f *JsonAccessLogFormat) FormatTcpLogEntry(entry *accesslog_data.TCPAccessLogEntry) (string, error) {
+	return f.format(func(format *AccessLogFormat) (string, error) {
+		return format.FormatTcpLogEntry(entry)
+	})
+}
+
+func (f *JsonAccessLogFormat) format(formatField func(*AccessLogFormat) (string, error)) (string, error) {
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+	for i, field := range f.Fields {
+		value, err := formatField(field.Format)
+		if err != nil {
+			return "", err
+		}
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		if err := writeJsonString(&buf, field.Key); err != nil {
+			return "", err
+		}
+		buf.WriteByte(':')
+		if err := writeJsonString(&buf, value); err != nil {
+			return "", err
+		}
+	}
+	buf.WriteByte('}')
+	return buf.String(), nil
+}
+
+func writeJsonString(buf *bytes.Buffer, value string) error {
+	encoded, err := json.Marshal(value)
+	if err != nil {
+		return err
+	}
+	buf.Write(encoded)
+	return nil
+}
+
+func (f *JsonAccessLogFormat) ConfigureHttpLog(config *accesslog_config.HttpGrpcAccessLogConfig) error {
+	for _, field := range f.Fields {
+		if err := field.Format.ConfigureHttpLog(config); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (f *JsonAccessLogFormat) ConfigureTcpLog(config *accesslog_config.TcpGrpcAccessLogConfig) error {
+	for _, field := range f.Fields {
+		if err := field.Format.ConfigureTcpLog(config); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (f *JsonAccessLogFormat) Interpolate(variables InterpolationVariables) (*JsonAccessLogFormat, error) {
+	newFields := make([]JsonField, len(f.Fields))
+	for i, field := range f.Fields {
+		newFormat, err := field.Format.Interpolate(variables)
+		if err != nil {
+			return nil, err
+		}
+		newFields[i] = JsonField{Key: field.Key, Format: newFormat}
+	}
+	return &JsonAccessLogFormat{Fields: newFields}, nil
+}
+
+// Map returns the canonical representation of this format as a map of field names to format strings.
+func (f *JsonAccessLogFormat) Map() map[string]string {
+	fields := make(map[string]string, len(f.Fields))
+	for _, field := range f.Fields {
+		fields[field.Key] = field.Format.String()
+	}
+	return fields
+}
+
+// String returns the canonical representation of this format as a JSON object.
+func (f *JsonAccessLogFormat) String() string {
+	encoded, _ := json.Marshal(f.Map()) // a map of strings can always be marshaled
+	return string(encoded)
+}
@@ -0,0 +1,130 @@
+package accesslog_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	. "github.com/Kong/kuma/pkg/envoy/accesslog"
+
+	envoy_core "github.com/envoyproxy/go-control-plane/envoy/api/v2/core"
+	accesslog_config "github.com/envoyproxy/go-control-plane/envoy/config/accesslog/v2"
+	accesslog_data "github.com/envoyproxy/go-control-plane/envoy/data/accesslog/v2"
+
+	util_proto "github.com/Kong/kuma/pkg/util/proto"
+)
+
+var _ = Describe("ParseJsonFormat()", func() {
+
+	fields := map[string]string{
+		"start_time":  "%START_TIME%",
+		"request":     `%REQ(:METHOD)% %REQ(:PATH)%`,
+		"user_agent":  "%REQ(USER-AGENT)%",
+		"source":      "%KUMA_SOURCE_SERVICE%",
+		"destination": "%KUMA_DESTINATION_SERVICE%",
+	}
+
+	It("should format HTTP log entry as a JSON object", func() {
+		// given
+		format, err := ParseJsonFormat(fields)
+		Expect(err).ToNot(HaveOccurred())
+
+		// when
+		format, err = format.Interpolate(InterpolationVariables{
+			CMD_KUMA_SOURCE_SERVICE:      "web",
+			CMD_KUMA_DESTINATION_SERVICE: "backend",
+		})
+		// then
+		Expect(err).ToNot(HaveOccurred())
+
+		// when
+		actual, err := format.FormatHttpLogEntry(&accesslog_data.HTTPAccessLogEntry{
+			CommonProperties: &accesslog_data.AccessLogCommon{
+				StartTime: util_proto.MustTimestampProto(time.Unix(1582062737, 987654321)),
+			},
+			Request: &accesslog_data.HTTPRequestProperties{
+				RequestMethod: envoy_core.RequestMethod_GET,
+				Path:          `/api?q="quoted"`,
+				UserAgent:     "curl/7.54.0",
+			},
+		})
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(actual).To(Equal(`{"destination":"backend","request":"GET /api?q=\"quoted\"","source":"web","start_time":"2020-02-18T21:52:17.987Z","user_agent":"curl/7.54.0"}`))
+	})
+
+	It("should format TCP log entry as a JSON object", func() {
+		// given
+		format, err := ParseJsonFormat(map[string]string{
+			"bytes_received": "%BYTES_RECEIVED%",
+			"bytes_sent":     "%BYTES_SENT%",
+			"request":        "%REQ(:PATH)%",
+		})
+		Expect(err).ToNot(HaveOccurred())
+
+		// when
+		actual, err := format.FormatTcpLogEntry(&accesslog_data.TCPAccessLogEntry{
+			ConnectionProperties: &accesslog_data.ConnectionProperties{
+				ReceivedBytes: 123,
+				SentBytes:     456,
+			},
+		})
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(actual).To(Equal(`{"bytes_received":"123","bytes_sent":"456","request":"-"}`))
+	})
+
+	It("should configure gRPC access log according to all fields", func() {
+		// given
+		format, err := ParseJsonFormat(map[string]string{
+			"origin": "%REQ(ORIGIN)%",
+			"server": "%RESP(SERVER)%",
+		})
+		Expect(err).ToNot(HaveOccurred())
+		// and
+		config := &accesslog_config.HttpGrpcAccessLogConfig{}
+
+		// when
+		err = format.ConfigureHttpLog(config)
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(config.AdditionalRequestHeadersToLog).To(Equal([]string{"origin"}))
+		Expect(config.AdditionalResponseHeadersToLog).To(Equal([]string{"server"}))
+	})
+
+	It("should have canonical representation", func() {
+		// given
+		format, err := ParseJsonFormat(map[string]string{
+			"request": "%REQ(:METHOD)% %REQ(X-REQUEST-ID)%",
+		})
+		Expect(err).ToNot(HaveOccurred())
+
+		// expect
+		Expect(format.Map()).To(Equal(map[string]string{"request": "%REQ(:method)% %REQ(x-request-id)%"}))
+		Expect(format.String()).To(Equal(`{"request":"%REQ(:method)% %REQ(x-request-id)%"}`))
+	})
+
+	It("should reject invalid format strings", func() {
+		// when
+		_, err := ParseJsonFormat(map[string]string{
+			"bytes": "%bytes_sent%",
+		})
+
+		// then
+		Expect(err).To(MatchError(`JSON format of field "bytes" is not valid: format string is not valid: expected a command operator to start at position 1, instead got: "%bytes_sent%"`))
+	})
+
+	It("should reject empty field names", func() {
+		// when
+		_, err := ParseJsonFormat(map[string]string{
+			"": "%BYTES_SENT%",
+		})
+
+		// then
+		Expect(err).To(MatchError(`JSON format is not valid: field name cannot be empty`))
+	})
+})
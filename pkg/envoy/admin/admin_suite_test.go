@@ -0,0 +1,13 @@
+package admin_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestEnvoyAdmin(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Envoy Admin Suite")
+}
@@ -0,0 +1,83 @@
+package rest
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/emicklei/go-restful"
+
+	"github.com/Kong/kuma/pkg/core"
+	core_mesh "github.com/Kong/kuma/pkg/core/resources/apis/mesh"
+	"github.com/Kong/kuma/pkg/core/resources/manager"
+	"github.com/Kong/kuma/pkg/core/resources/store"
+	rest_errors "github.com/Kong/kuma/pkg/core/rest/errors"
+	core_xds "github.com/Kong/kuma/pkg/core/xds"
+	envoy_admin "github.com/Kong/kuma/pkg/envoy/admin"
+)
+
+var logger = core.Log.WithName("envoy-admin-ws")
+
+const envoyAdminTimeout = 10 * time.Second
+
+type envoyAdminWebservice struct {
+	resManager manager.ReadOnlyResourceManager
+	envoyAdmin envoy_admin.EnvoyAdminClient
+}
+
+// NewWebservice exposes the Envoy Admin API of Dataplanes.
+//
+// Config dumps and stats reveal the whole configuration of a Dataplane, that is why
+// the webservice is meant to be served by the Admin Server only.
+//
+// Only Dataplanes connected to this instance of the Control Plane can be reached.
+// Requests for Dataplanes connected to another instance end up with 503.
+func NewWebservice(resManager manager.ReadOnlyResourceManager, envoyAdmin envoy_admin.EnvoyAdminClient) *restful.WebService {
+	envoyAdminWs := envoyAdminWebservice{
+		resManager: resManager,
+		envoyAdmin: envoyAdmin,
+	}
+	return envoyAdminWs.createWs()
+}
+
+func (e *envoyAdminWebservice) createWs() *restful.WebService {
+	ws := new(restful.WebService)
+	ws.Path("/meshes/{mesh}/dataplanes")
+	e.addEndpoint(ws, "/{name}/config-dump", "config dump", restful.MIME_JSON, e.envoyAdmin.ConfigDump)
+	e.addEndpoint(ws, "/{name}/clusters", "clusters", "text/plain", e.envoyAdmin.Clusters)
+	e.addEndpoint(ws, "/{name}/stats", "stats", "text/plain", e.envoyAdmin.Stats)
+	return ws
+}
+
+func (e *envoyAdminWebservice) addEndpoint(ws *restful.WebService, path string, what string, contentType string,
+	retrieve func(context.Context, core_xds.ProxyId) ([]byte, error)) {
+	title := fmt.Sprintf("Could not retrieve %s of a dataplane", what)
+	ws.Route(ws.GET(path).To(func(request *restful.Request, response *restful.Response) {
+		name := request.PathParameter("name")
+		meshName := request.PathParameter("mesh")
+
+		dataplane := &core_mesh.DataplaneResource{}
+		if err := e.resManager.Get(request.Request.Context(), dataplane, store.GetByKey(name, meshName)); err != nil {
+			rest_errors.HandleError(response, err, title)
+			return
+		}
+		ctx, cancel := context.WithTimeout(request.Request.Context(), envoyAdminTimeout)
+		defer cancel()
+		content, err := retrieve(ctx, core_xds.ProxyId{Mesh: meshName, Name: name})
+		if err != nil {
+			rest_errors.HandleError(response, err, title)
+			return
+		}
+		response.Header().Set(restful.HEADER_ContentType, contentType)
+		if _, err := response.Write(content); err != nil {
+			logger.Error(err, "could not write the response")
+		}
+	}).
+		Doc(fmt.Sprintf("Retrieve %s of a dataplane from the Envoy Admin API", what)).
+		Param(ws.PathParameter("name", "Name of a dataplane").DataType("string")).
+		Param(ws.PathParameter("mesh", "Name of a mesh").DataType("string")).
+		Produces(contentType).
+		Returns(200, "OK", nil).
+		Returns(404, "Not found", nil).
+		Returns(503, "Dataplane is not connected to this instance of the Control Plane", nil))
+}
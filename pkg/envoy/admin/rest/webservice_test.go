@@ -0,0 +1,142 @@
+package rest_test
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/emicklei/go-restful"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	mesh_proto "github.com/Kong/kuma/api/mesh/v1alpha1"
+	core_mesh "github.com/Kong/kuma/pkg/core/resources/apis/mesh"
+	"github.com/Kong/kuma/pkg/core/resources/manager"
+	"github.com/Kong/kuma/pkg/core/resources/store"
+	core_xds "github.com/Kong/kuma/pkg/core/xds"
+	envoy_admin "github.com/Kong/kuma/pkg/envoy/admin"
+	"github.com/Kong/kuma/pkg/envoy/admin/rest"
+	"github.com/Kong/kuma/pkg/plugins/resources/memory"
+)
+
+type staticEnvoyAdminClient struct {
+	connected map[core_xds.ProxyId]string
+}
+
+func (c *staticEnvoyAdminClient) content(proxyId core_xds.ProxyId, resource string) ([]byte, error) {
+	content, ok := c.connected[proxyId]
+	if !ok {
+		return nil, &envoy_admin.DataplaneNotConnectedError{ProxyId: proxyId}
+	}
+	return []byte(fmt.Sprintf(content, resource)), nil
+}
+
+func (c *staticEnvoyAdminClient) ConfigDump(_ context.Context, proxyId core_xds.ProxyId) ([]byte, error) {
+	return c.content(proxyId, "config_dump")
+}
+
+func (c *staticEnvoyAdminClient) Clusters(_ context.Context, proxyId core_xds.ProxyId) ([]byte, error) {
+	return c.content(proxyId, "clusters")
+}
+
+func (c *staticEnvoyAdminClient) Stats(_ context.Context, proxyId core_xds.ProxyId) ([]byte, error) {
+	return c.content(proxyId, "stats")
+}
+
+var _ envoy_admin.EnvoyAdminClient = &staticEnvoyAdminClient{}
+
+var _ = Describe("Envoy Admin WS", func() {
+
+	var srv *httptest.Server
+	var resourceStore store.ResourceStore
+
+	BeforeEach(func() {
+		resourceStore = memory.NewStore()
+		envoyAdmin := &staticEnvoyAdminClient{
+			connected: map[core_xds.ProxyId]string{
+				{Mesh: "mesh1", Name: "dp1"}: `{"resource": "%s"}`,
+			},
+		}
+		container := restful.NewContainer()
+		container.Add(rest.NewWebservice(manager.NewResourceManager(resourceStore), envoyAdmin))
+		srv = httptest.NewServer(container)
+
+		for _, name := range []string{"dp1", "dp2"} {
+			dataplane := &core_mesh.DataplaneResource{
+				Spec: mesh_proto.Dataplane{
+					Networking: &mesh_proto.Dataplane_Networking{
+						Address: "127.0.0.1",
+						Inbound: []*mesh_proto.Dataplane_Networking_Inbound{
+							{
+								Port:        9090,
+								ServicePort: 9091,
+								Tags: map[string]string{
+									"service": "sample",
+								},
+							},
+						},
+					},
+				},
+			}
+			err := resourceStore.Create(context.Background(), dataplane, store.CreateByKey(name, "mesh1"))
+			Expect(err).ToNot(HaveOccurred())
+		}
+	})
+
+	AfterEach(func() {
+		srv.Close()
+	})
+
+	It("should return config dump of a dataplane", func() {
+		// when
+		response, err := http.Get(srv.URL + "/meshes/mesh1/dataplanes/dp1/config-dump")
+		Expect(err).ToNot(HaveOccurred())
+
+		// then
+		Expect(response.StatusCode).To(Equal(200))
+		Expect(response.Header.Get("Content-Type")).To(Equal("application/json"))
+		body, err := ioutil.ReadAll(response.Body)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(body).To(MatchJSON(`{"resource": "config_dump"}`))
+	})
+
+	It("should return stats of a dataplane", func() {
+		// when
+		response, err := http.Get(srv.URL + "/meshes/mesh1/dataplanes/dp1/stats")
+		Expect(err).ToNot(HaveOccurred())
+
+		// then
+		Expect(response.StatusCode).To(Equal(200))
+		Expect(response.Header.Get("Content-Type")).To(Equal("text/plain"))
+		body, err := ioutil.ReadAll(response.Body)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(body)).To(Equal(`{"resource": "stats"}`))
+	})
+
+	It("should return 503 for a dataplane that is not connected to this instance of the Control Plane", func() {
+		// when
+		response, err := http.Get(srv.URL + "/meshes/mesh1/dataplanes/dp2/clusters")
+		Expect(err).ToNot(HaveOccurred())
+
+		// then
+		Expect(response.StatusCode).To(Equal(503))
+		body, err := ioutil.ReadAll(response.Body)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(body).To(MatchJSON(`
+		{
+			"title": "Could not retrieve clusters of a dataplane",
+			"details": "Dataplane is not connected to this instance of the Control Plane"
+		}`))
+	})
+
+	It("should return 404 for config dump of a missing dataplane", func() {
+		// when
+		response, err := http.Get(srv.URL + "/meshes/mesh1/dataplanes/non-existing/config-dump")
+		Expect(err).ToNot(HaveOccurred())
+
+		// then
+		Expect(response.StatusCode).To(Equal(404))
+	})
+})
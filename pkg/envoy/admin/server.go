@@ -0,0 +1,56 @@
+package admin
+
+import (
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	mesh_proto "github.com/Kong/kuma/api/mesh/v1alpha1"
+	"github.com/Kong/kuma/pkg/core"
+	core_xds "github.com/Kong/kuma/pkg/core/xds"
+	sds_auth "github.com/Kong/kuma/pkg/sds/auth"
+)
+
+var (
+	serverLog = core.Log.WithName("envoy-admin-server")
+)
+
+// NewServer returns a gRPC server of EnvoyAdminService that authenticates
+// kuma-dp the same way SDS does and hands over its stream to Streams.
+func NewServer(streams Streams, authenticator sds_auth.Authenticator) mesh_proto.EnvoyAdminServiceServer {
+	return &server{
+		streams:       streams,
+		authenticator: authenticator,
+	}
+}
+
+type server struct {
+	streams       Streams
+	authenticator sds_auth.Authenticator
+}
+
+func (s *server) StreamEnvoyAdmin(stream mesh_proto.EnvoyAdminService_StreamEnvoyAdminServer) error {
+	hello, err := stream.Recv()
+	if err != nil {
+		return err
+	}
+	if hello.Mesh == "" || hello.Name == "" {
+		return status.Error(codes.InvalidArgument, "the first message of a stream must identify a Dataplane by mesh and name")
+	}
+	proxyId := core_xds.ProxyId{Mesh: hello.Mesh, Name: hello.Name}
+	credential, err := sds_auth.ExtractCredential(stream.Context())
+	if err != nil {
+		return status.Error(codes.Unauthenticated, err.Error())
+	}
+	if _, err := s.authenticator.Authenticate(stream.Context(), proxyId, credential); err != nil {
+		return status.Error(codes.Unauthenticated, err.Error())
+	}
+
+	log := serverLog.WithValues("dataplane", proxyId.String())
+	log.V(1).Info("stream opened")
+	if err := s.streams.Serve(proxyId, stream); err != nil {
+		log.V(1).Info("stream closed with an error", "err", err)
+		return err
+	}
+	log.V(1).Info("stream closed")
+	return nil
+}
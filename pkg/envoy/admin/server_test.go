@@ -0,0 +1,93 @@
+package admin_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/metadata"
+
+	mesh_proto "github.com/Kong/kuma/api/mesh/v1alpha1"
+	core_xds "github.com/Kong/kuma/pkg/core/xds"
+	envoy_admin "github.com/Kong/kuma/pkg/envoy/admin"
+	sds_auth "github.com/Kong/kuma/pkg/sds/auth"
+)
+
+type staticAuthenticator struct {
+	credentials map[core_xds.ProxyId]sds_auth.Credential
+}
+
+func (a *staticAuthenticator) Authenticate(_ context.Context, proxyId core_xds.ProxyId, credential sds_auth.Credential) (sds_auth.Identity, error) {
+	if expected, ok := a.credentials[proxyId]; !ok || expected != credential {
+		return sds_auth.Identity{}, errors.New("invalid token")
+	}
+	return sds_auth.Identity{Mesh: proxyId.Mesh}, nil
+}
+
+var _ = Describe("Server", func() {
+
+	var streams envoy_admin.Streams
+	var server mesh_proto.EnvoyAdminServiceServer
+
+	BeforeEach(func() {
+		streams = envoy_admin.NewStreams()
+		server = envoy_admin.NewServer(streams, &staticAuthenticator{
+			credentials: map[core_xds.ProxyId]sds_auth.Credential{
+				{Mesh: "demo", Name: "backend-01"}: "token",
+			},
+		})
+	})
+
+	open := func(token string, hello *mesh_proto.EnvoyAdminResponse) (*fakeStream, chan error) {
+		stream := newFakeStream(metadata.NewIncomingContext(context.Background(), metadata.MD{"authorization": []string{token}}))
+		stream.responses <- hello
+		done := make(chan error, 1)
+		go func() {
+			done <- server.StreamEnvoyAdmin(stream)
+		}()
+		return stream, done
+	}
+
+	It("should serve a stream of an authenticated dataplane", func() {
+		// given
+		stream, done := open("token", &mesh_proto.EnvoyAdminResponse{Mesh: "demo", Name: "backend-01"})
+		go func() {
+			for req := range stream.requests {
+				stream.responses <- &mesh_proto.EnvoyAdminResponse{RequestId: req.RequestId, Content: []byte("{}")}
+			}
+		}()
+
+		// when
+		var configDump []byte
+		Eventually(func() error {
+			var err error
+			configDump, err = streams.ConfigDump(context.Background(), core_xds.ProxyId{Mesh: "demo", Name: "backend-01"})
+			return err
+		}).Should(Succeed())
+
+		// then
+		Expect(string(configDump)).To(Equal("{}"))
+
+		// when
+		close(stream.responses)
+		// then
+		Eventually(done).Should(Receive(BeNil()))
+	})
+
+	It("should reject a dataplane with invalid credentials", func() {
+		// when
+		_, done := open("another-token", &mesh_proto.EnvoyAdminResponse{Mesh: "demo", Name: "backend-01"})
+
+		// then
+		Eventually(done).Should(Receive(MatchError("rpc error: code = Unauthenticated desc = invalid token")))
+	})
+
+	It("should reject a stream that does not identify a dataplane", func() {
+		// when
+		_, done := open("token", &mesh_proto.EnvoyAdminResponse{Mesh: "demo"})
+
+		// then
+		Eventually(done).Should(Receive(MatchError("rpc error: code = InvalidArgument desc = the first message of a stream must identify a Dataplane by mesh and name")))
+	})
+})
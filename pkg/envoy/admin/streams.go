@@ -0,0 +1,188 @@
+package admin
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sync"
+
+	"github.com/pkg/errors"
+
+	mesh_proto "github.com/Kong/kuma/api/mesh/v1alpha1"
+	"github.com/Kong/kuma/pkg/core"
+	core_xds "github.com/Kong/kuma/pkg/core/xds"
+)
+
+// EnvoyAdminClient retrieves data from the Envoy Admin API of Dataplanes.
+type EnvoyAdminClient interface {
+	ConfigDump(ctx context.Context, proxyId core_xds.ProxyId) ([]byte, error)
+	Clusters(ctx context.Context, proxyId core_xds.ProxyId) ([]byte, error)
+	Stats(ctx context.Context, proxyId core_xds.ProxyId) ([]byte, error)
+}
+
+// Streams keeps track of streams opened by kuma-dp and forwards requests
+// to the Envoy Admin API down those streams.
+//
+// Only Dataplanes connected to this instance of the Control Plane can be reached.
+type Streams interface {
+	EnvoyAdminClient
+	// Serve handles a stream opened by a given Dataplane until the stream is closed.
+	Serve(proxyId core_xds.ProxyId, stream mesh_proto.EnvoyAdminService_StreamEnvoyAdminServer) error
+}
+
+// DataplaneNotConnectedError means that a Dataplane has no open stream to this instance of the Control Plane.
+type DataplaneNotConnectedError struct {
+	ProxyId core_xds.ProxyId
+}
+
+func (e *DataplaneNotConnectedError) Error() string {
+	return fmt.Sprintf("dataplane %q is not connected to this instance of the Control Plane", e.ProxyId.String())
+}
+
+func IsDataplaneNotConnected(err error) bool {
+	_, ok := err.(*DataplaneNotConnectedError)
+	return ok
+}
+
+func NewStreams() Streams {
+	return &streams{
+		streams: map[string]*stream{},
+	}
+}
+
+var _ Streams = &streams{}
+
+type streams struct {
+	sync.Mutex
+	streams map[string]*stream
+}
+
+func (s *streams) ConfigDump(ctx context.Context, proxyId core_xds.ProxyId) ([]byte, error) {
+	return s.request(ctx, proxyId, mesh_proto.EnvoyAdminRequest_CONFIG_DUMP)
+}
+
+func (s *streams) Clusters(ctx context.Context, proxyId core_xds.ProxyId) ([]byte, error) {
+	return s.request(ctx, proxyId, mesh_proto.EnvoyAdminRequest_CLUSTERS)
+}
+
+func (s *streams) Stats(ctx context.Context, proxyId core_xds.ProxyId) ([]byte, error) {
+	return s.request(ctx, proxyId, mesh_proto.EnvoyAdminRequest_STATS)
+}
+
+func (s *streams) request(ctx context.Context, proxyId core_xds.ProxyId, resource mesh_proto.EnvoyAdminRequest_Resource) ([]byte, error) {
+	s.Lock()
+	st, ok := s.streams[proxyId.String()]
+	s.Unlock()
+	if !ok {
+		return nil, &DataplaneNotConnectedError{ProxyId: proxyId}
+	}
+	content, err := st.request(ctx, resource)
+	if err == errStreamClosed {
+		return nil, &DataplaneNotConnectedError{ProxyId: proxyId}
+	}
+	return content, err
+}
+
+func (s *streams) Serve(proxyId core_xds.ProxyId, grpcStream mesh_proto.EnvoyAdminService_StreamEnvoyAdminServer) error {
+	key := proxyId.String()
+	st := &stream{
+		grpcStream: grpcStream,
+		pending:    map[string]chan *mesh_proto.EnvoyAdminResponse{},
+	}
+	// a new stream replaces the old one, e.g. when kuma-dp has reconnected
+	// before the Control Plane noticed that the old stream is broken
+	s.Lock()
+	s.streams[key] = st
+	s.Unlock()
+	defer func() {
+		s.Lock()
+		if s.streams[key] == st {
+			delete(s.streams, key)
+		}
+		s.Unlock()
+		st.close()
+	}()
+
+	for {
+		resp, err := grpcStream.Recv()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		st.deliver(resp)
+	}
+}
+
+var errStreamClosed = errors.New("stream has been closed")
+
+// stream represents a single stream opened by kuma-dp.
+type stream struct {
+	grpcStream mesh_proto.EnvoyAdminService_StreamEnvoyAdminServer
+	sendLock   sync.Mutex
+
+	sync.Mutex
+	pending map[string]chan *mesh_proto.EnvoyAdminResponse
+	closed  bool
+}
+
+func (s *stream) request(ctx context.Context, resource mesh_proto.EnvoyAdminRequest_Resource) ([]byte, error) {
+	requestId := core.NewUUID()
+	respCh := make(chan *mesh_proto.EnvoyAdminResponse, 1)
+	s.Lock()
+	if s.closed {
+		s.Unlock()
+		return nil, errStreamClosed
+	}
+	s.pending[requestId] = respCh
+	s.Unlock()
+	defer func() {
+		s.Lock()
+		delete(s.pending, requestId)
+		s.Unlock()
+	}()
+
+	s.sendLock.Lock()
+	err := s.grpcStream.Send(&mesh_proto.EnvoyAdminRequest{
+		RequestId: requestId,
+		Resource:  resource,
+	})
+	s.sendLock.Unlock()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to send a request to kuma-dp")
+	}
+
+	select {
+	case resp, ok := <-respCh:
+		if !ok {
+			return nil, errStreamClosed
+		}
+		if resp.Error != "" {
+			return nil, errors.Errorf("kuma-dp failed to retrieve data from Envoy Admin API: %s", resp.Error)
+		}
+		return resp.Content, nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+func (s *stream) deliver(resp *mesh_proto.EnvoyAdminResponse) {
+	s.Lock()
+	defer s.Unlock()
+	// responses to requests that have already timed out are dropped
+	if respCh, ok := s.pending[resp.RequestId]; ok {
+		respCh <- resp
+		delete(s.pending, resp.RequestId)
+	}
+}
+
+func (s *stream) close() {
+	s.Lock()
+	defer s.Unlock()
+	s.closed = true
+	for requestId, respCh := range s.pending {
+		close(respCh)
+		delete(s.pending, requestId)
+	}
+}
@@ -0,0 +1,172 @@
+package admin_test
+
+import (
+	"context"
+	"io"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	mesh_proto "github.com/Kong/kuma/api/mesh/v1alpha1"
+	core_xds "github.com/Kong/kuma/pkg/core/xds"
+	envoy_admin "github.com/Kong/kuma/pkg/envoy/admin"
+)
+
+// fakeStream is a stream of EnvoyAdminService as seen by the Control Plane.
+type fakeStream struct {
+	grpc.ServerStream
+	ctx       context.Context
+	requests  chan *mesh_proto.EnvoyAdminRequest
+	responses chan *mesh_proto.EnvoyAdminResponse
+}
+
+func newFakeStream(ctx context.Context) *fakeStream {
+	return &fakeStream{
+		ctx:       ctx,
+		requests:  make(chan *mesh_proto.EnvoyAdminRequest, 10),
+		responses: make(chan *mesh_proto.EnvoyAdminResponse, 10),
+	}
+}
+
+func (s *fakeStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *fakeStream) Send(req *mesh_proto.EnvoyAdminRequest) error {
+	s.requests <- req
+	return nil
+}
+
+func (s *fakeStream) Recv() (*mesh_proto.EnvoyAdminResponse, error) {
+	resp, ok := <-s.responses
+	if !ok {
+		return nil, io.EOF
+	}
+	return resp, nil
+}
+
+var _ mesh_proto.EnvoyAdminService_StreamEnvoyAdminServer = &fakeStream{}
+
+var _ = Describe("Streams", func() {
+
+	proxyId := core_xds.ProxyId{Mesh: "demo", Name: "backend-01"}
+
+	var streams envoy_admin.Streams
+	var stream *fakeStream
+	var served chan error
+
+	BeforeEach(func() {
+		streams = envoy_admin.NewStreams()
+		stream = newFakeStream(metadata.NewIncomingContext(context.Background(), metadata.MD{}))
+		served = make(chan error, 1)
+	})
+
+	serve := func() {
+		go func() {
+			served <- streams.Serve(proxyId, stream)
+		}()
+	}
+
+	// reply answers requests the same way kuma-dp does
+	reply := func(content func(*mesh_proto.EnvoyAdminRequest) *mesh_proto.EnvoyAdminResponse) {
+		go func() {
+			for req := range stream.requests {
+				resp := content(req)
+				resp.RequestId = req.RequestId
+				stream.responses <- resp
+			}
+		}()
+	}
+
+	It("should forward requests to a connected dataplane", func() {
+		// given
+		serve()
+		reply(func(req *mesh_proto.EnvoyAdminRequest) *mesh_proto.EnvoyAdminResponse {
+			return &mesh_proto.EnvoyAdminResponse{Content: []byte(req.Resource.String())}
+		})
+
+		// when
+		var configDump []byte
+		Eventually(func() error {
+			var err error
+			configDump, err = streams.ConfigDump(context.Background(), proxyId)
+			return err
+		}).Should(Succeed())
+		// then
+		Expect(string(configDump)).To(Equal("CONFIG_DUMP"))
+
+		// when
+		stats, err := streams.Stats(context.Background(), proxyId)
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(stats)).To(Equal("STATS"))
+
+		// when
+		close(stream.responses)
+		// then
+		Eventually(served).Should(Receive(BeNil()))
+		// and
+		_, err = streams.Clusters(context.Background(), proxyId)
+		Expect(envoy_admin.IsDataplaneNotConnected(err)).To(BeTrue())
+	})
+
+	It("should return an error of kuma-dp", func() {
+		// given
+		serve()
+		reply(func(*mesh_proto.EnvoyAdminRequest) *mesh_proto.EnvoyAdminResponse {
+			return &mesh_proto.EnvoyAdminResponse{Error: "connection refused"}
+		})
+
+		// when
+		var err error
+		Eventually(func() bool {
+			_, err = streams.Clusters(context.Background(), proxyId)
+			return envoy_admin.IsDataplaneNotConnected(err)
+		}).Should(BeFalse())
+
+		// then
+		Expect(err).To(MatchError("kuma-dp failed to retrieve data from Envoy Admin API: connection refused"))
+	})
+
+	It("should not wait for a response longer than allowed by a context", func() {
+		// given
+		serve()
+		Eventually(func() bool {
+			ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+			defer cancel()
+			_, err := streams.ConfigDump(ctx, proxyId)
+			return err == context.DeadlineExceeded
+		}).Should(BeTrue())
+	})
+
+	It("should fail pending requests when a stream is closed", func() {
+		// given
+		serve()
+		go func() {
+			<-stream.requests
+			close(stream.responses)
+		}()
+
+		// when
+		var err error
+		Eventually(func() error {
+			_, err = streams.ConfigDump(context.Background(), proxyId)
+			return err
+		}).Should(HaveOccurred())
+
+		// then
+		Expect(err).To(MatchError(`dataplane "demo.backend-01" is not connected to this instance of the Control Plane`))
+	})
+
+	It("should return an error for a dataplane that is not connected", func() {
+		// when
+		_, err := streams.ConfigDump(context.Background(), proxyId)
+
+		// then
+		Expect(envoy_admin.IsDataplaneNotConnected(err)).To(BeTrue())
+		Expect(err).To(MatchError(`dataplane "demo.backend-01" is not connected to this instance of the Control Plane`))
+	})
+})
@@ -0,0 +1,104 @@
+// Package faults defines a contract between Kuma CP and kuma-dp
+// for injecting faults into connections of TCP services.
+//
+// Kuma CP configures the network ext_authz filter of Envoy to check new connections
+// with the fault injection server of kuma-dp listening on a Unix socket
+// and passes faults in the initial metadata of a gRPC call.
+//
+// To limit bandwidth of responses, Kuma CP configures Envoy to forward connections
+// to the bandwidth limiter of kuma-dp, which forwards them back to a listener of Envoy
+// on another Unix socket and throttles data sent back to a client.
+package faults
+
+import (
+	"encoding/json"
+	"fmt"
+	"hash/fnv"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	// ConnectionFaultsMetadataKey is a key of gRPC metadata with faults
+	// that should be injected into a connection.
+	ConnectionFaultsMetadataKey = "x-kuma-connection-faults"
+
+	// ClusterName is a name of the bootstrap cluster that points to the fault injection server of kuma-dp.
+	ClusterName = "fault_injection_sink"
+)
+
+// ConnectionFaults represents faults that should be injected into a connection.
+type ConnectionFaults struct {
+	Delay             *ConnectionDelay             `json:"delay,omitempty"`
+	Abort             *ConnectionAbort             `json:"abort,omitempty"`
+	ResponseBandwidth *ConnectionResponseBandwidth `json:"responseBandwidth,omitempty"`
+}
+
+// ConnectionDelay postpones forwarding of data sent over a connection.
+type ConnectionDelay struct {
+	// Percentage of connections to delay, in [0.0 - 100.0] range.
+	Percentage float64       `json:"percentage"`
+	Value      time.Duration `json:"value"`
+}
+
+// ConnectionAbort closes a connection.
+type ConnectionAbort struct {
+	// Percentage of connections to close, in [0.0 - 100.0] range.
+	Percentage float64 `json:"percentage"`
+}
+
+// ConnectionResponseBandwidth limits the rate at which data is sent back to a client.
+type ConnectionResponseBandwidth struct {
+	// Percentage of connections to throttle, in [0.0 - 100.0] range.
+	Percentage float64 `json:"percentage"`
+	// LimitKbps is a limit in KiB/s, the same as for HTTP services.
+	LimitKbps uint64 `json:"limitKbps"`
+	// Listener is a name of the outbound listener that identifies Unix sockets of the bandwidth limiter.
+	Listener string `json:"listener"`
+}
+
+// SocketPath returns a path of a Unix socket the fault injection server of a given dataplane listens on.
+func SocketPath(dataplaneName, mesh string) string {
+	return fmt.Sprintf("/tmp/kuma-fault-injection-%s-%s.sock", dataplaneName, mesh)
+}
+
+// BandwidthLimiterSocketPath returns a path of a Unix socket the bandwidth limiter of a given dataplane
+// listens on for connections of a given outbound listener.
+func BandwidthLimiterSocketPath(dataplaneName, mesh, listenerName string) string {
+	return fmt.Sprintf("/tmp/kuma-fault-bandwidth-%s-%s-%s.sock", dataplaneName, mesh, listenerHash(listenerName))
+}
+
+// ThrottledListenerSocketPath returns a path of a Unix socket Envoy listens on for connections
+// of a given outbound listener that have been forwarded by the bandwidth limiter.
+func ThrottledListenerSocketPath(dataplaneName, mesh, listenerName string) string {
+	return fmt.Sprintf("/tmp/kuma-fault-bandwidth-%s-%s-%s.envoy.sock", dataplaneName, mesh, listenerHash(listenerName))
+}
+
+// listenerHash keeps paths of Unix sockets short, since their length is limited.
+func listenerHash(listenerName string) string {
+	hash := fnv.New32a()
+	_, _ = hash.Write([]byte(listenerName))
+	return fmt.Sprintf("%08x", hash.Sum32())
+}
+
+// EncodeConnectionFaults encodes faults as a value of ConnectionFaultsMetadataKey.
+func EncodeConnectionFaults(faults ConnectionFaults) (string, error) {
+	encoded, err := json.Marshal(faults)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to encode connection faults")
+	}
+	return string(encoded), nil
+}
+
+// DecodeConnectionFaults decodes faults from a value of ConnectionFaultsMetadataKey.
+func DecodeConnectionFaults(value string) (ConnectionFaults, error) {
+	faults := ConnectionFaults{}
+	if value == "" {
+		return faults, nil
+	}
+	if err := json.Unmarshal([]byte(value), &faults); err != nil {
+		return faults, errors.Wrapf(err, "failed to decode connection faults from %q metadata", ConnectionFaultsMetadataKey)
+	}
+	return faults, nil
+}
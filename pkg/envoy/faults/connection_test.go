@@ -0,0 +1,74 @@
+package faults_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	. "github.com/Kong/kuma/pkg/envoy/faults"
+)
+
+var _ = Describe("ConnectionFaults", func() {
+
+	It("should encode and decode connection faults", func() {
+		// given
+		faults := ConnectionFaults{
+			Delay: &ConnectionDelay{Percentage: 50, Value: 5 * time.Second},
+			Abort: &ConnectionAbort{Percentage: 10.5},
+			ResponseBandwidth: &ConnectionResponseBandwidth{
+				Percentage: 20,
+				LimitKbps:  100,
+				Listener:   "outbound:127.0.0.1:54321",
+			},
+		}
+
+		// when
+		value, err := EncodeConnectionFaults(faults)
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(value).To(Equal(`{"delay":{"percentage":50,"value":5000000000},"abort":{"percentage":10.5},"responseBandwidth":{"percentage":20,"limitKbps":100,"listener":"outbound:127.0.0.1:54321"}}`))
+
+		// when
+		actual, err := DecodeConnectionFaults(value)
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(actual).To(Equal(faults))
+	})
+
+	It("should decode empty value as no faults", func() {
+		// when
+		actual, err := DecodeConnectionFaults("")
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(actual).To(Equal(ConnectionFaults{}))
+	})
+
+	It("should fail to decode invalid value", func() {
+		// when
+		_, err := DecodeConnectionFaults("{")
+
+		// then
+		Expect(err).To(MatchError(`failed to decode connection faults from "x-kuma-connection-faults" metadata: unexpected end of JSON input`))
+	})
+})
+
+var _ = Describe("BandwidthLimiterSocketPath", func() {
+
+	It("should return different paths for the bandwidth limiter and Envoy", func() {
+		// when
+		limiter := BandwidthLimiterSocketPath("side-car", "default", "outbound:127.0.0.1:54321")
+		envoy := ThrottledListenerSocketPath("side-car", "default", "outbound:127.0.0.1:54321")
+
+		// then
+		Expect(limiter).To(Equal("/tmp/kuma-fault-bandwidth-side-car-default-bce69bab.sock"))
+		Expect(envoy).To(Equal("/tmp/kuma-fault-bandwidth-side-car-default-bce69bab.envoy.sock"))
+	})
+
+	It("should return different paths for different listeners", func() {
+		// expect
+		Expect(BandwidthLimiterSocketPath("side-car", "default", "outbound:127.0.0.1:54321")).
+			ToNot(Equal(BandwidthLimiterSocketPath("side-car", "default", "outbound:127.0.0.1:54322")))
+	})
+})
@@ -0,0 +1,62 @@
+// Package metrics defines a contract between Kuma CP and kuma-dp
+// for merging metrics of applications with metrics of Envoy.
+//
+// Kuma CP routes requests of the Prometheus endpoint of a dataplane
+// to the metrics merger of kuma-dp listening on a Unix socket
+// and passes the list of applications in a request header.
+package metrics
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	// ApplicationsHeader is a request header with a list of applications
+	// whose metrics should be merged with metrics of Envoy.
+	ApplicationsHeader = "x-kuma-metrics-applications"
+
+	// DefaultApplicationPath is a path on which an application is expected
+	// to expose Prometheus metrics unless specified otherwise.
+	DefaultApplicationPath = "/metrics"
+)
+
+// Application represents an HTTP endpoint with Prometheus metrics of an application.
+type Application struct {
+	Name string `json:"name"`
+	Port uint32 `json:"port"`
+	Path string `json:"path"`
+}
+
+// MergerSocketPath returns a path of a Unix socket the metrics merger of a given dataplane listens on.
+func MergerSocketPath(dataplaneName, mesh string) string {
+	return fmt.Sprintf("/tmp/kuma-metrics-merger-%s-%s.sock", dataplaneName, mesh)
+}
+
+// EncodeApplications encodes a list of applications as a value of ApplicationsHeader.
+func EncodeApplications(apps []Application) (string, error) {
+	encoded, err := json.Marshal(apps)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to encode applications")
+	}
+	return string(encoded), nil
+}
+
+// DecodeApplications decodes a list of applications from a value of ApplicationsHeader.
+func DecodeApplications(value string) ([]Application, error) {
+	if value == "" {
+		return nil, nil
+	}
+	var apps []Application
+	if err := json.Unmarshal([]byte(value), &apps); err != nil {
+		return nil, errors.Wrapf(err, "failed to decode applications from %q header", ApplicationsHeader)
+	}
+	for i := range apps {
+		if apps[i].Path == "" {
+			apps[i].Path = DefaultApplicationPath
+		}
+	}
+	return apps, nil
+}
@@ -0,0 +1,48 @@
+package metrics_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	. "github.com/Kong/kuma/pkg/envoy/metrics"
+)
+
+var _ = Describe("Applications", func() {
+
+	It("should encode and decode a list of applications", func() {
+		// given
+		apps := []Application{
+			{Name: "backend", Port: 8081, Path: "/stats"},
+		}
+
+		// when
+		value, err := EncodeApplications(apps)
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(value).To(Equal(`[{"name":"backend","port":8081,"path":"/stats"}]`))
+
+		// when
+		actual, err := DecodeApplications(value)
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(actual).To(Equal(apps))
+	})
+
+	It("should use the default path", func() {
+		// when
+		actual, err := DecodeApplications(`[{"name":"backend","port":8081}]`)
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(actual).To(Equal([]Application{{Name: "backend", Port: 8081, Path: "/metrics"}}))
+	})
+
+	It("should handle an empty header", func() {
+		// when
+		actual, err := DecodeApplications("")
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(actual).To(BeNil())
+	})
+})
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"net"
 	"sort"
+	"strconv"
 	"strings"
 
 	"github.com/pkg/errors"
@@ -78,9 +79,63 @@ func DataplaneFor(pod *kube_core.Pod, services []*kube_core.Service, others []*m
 	}
 	dataplane.Networking.Outbound = ofaces
 
+	metrics, err := MetricsFor(pod)
+	if err != nil {
+		return nil, err
+	}
+	dataplane.Metrics = metrics
+
 	return dataplane, nil
 }
 
+// MetricsFor returns metrics configuration of a Dataplane based on
+// `prometheus.metrics.kuma.io/aggregate-<name>-port` and
+// `prometheus.metrics.kuma.io/aggregate-<name>-path` annotations on a Pod.
+func MetricsFor(pod *kube_core.Pod) (*mesh_proto.Metrics, error) {
+	apps := map[string]*mesh_proto.Metrics_Prometheus_Application{}
+	appFor := func(name string) *mesh_proto.Metrics_Prometheus_Application {
+		if _, ok := apps[name]; !ok {
+			apps[name] = &mesh_proto.Metrics_Prometheus_Application{Name: name}
+		}
+		return apps[name]
+	}
+	for key, value := range pod.Annotations {
+		if !strings.HasPrefix(key, injector_metadata.KumaMetricsPrometheusAggregatePrefix) {
+			continue
+		}
+		suffix := strings.TrimPrefix(key, injector_metadata.KumaMetricsPrometheusAggregatePrefix)
+		switch {
+		case strings.HasSuffix(suffix, injector_metadata.KumaMetricsPrometheusAggregatePortSuffix):
+			name := strings.TrimSuffix(suffix, injector_metadata.KumaMetricsPrometheusAggregatePortSuffix)
+			port, err := strconv.ParseUint(value, 10, 32)
+			if err != nil {
+				return nil, errors.Errorf("value of the %q annotation has to be a port number, got %q", key, value)
+			}
+			appFor(name).Port = uint32(port)
+		case strings.HasSuffix(suffix, injector_metadata.KumaMetricsPrometheusAggregatePathSuffix):
+			name := strings.TrimSuffix(suffix, injector_metadata.KumaMetricsPrometheusAggregatePathSuffix)
+			appFor(name).Path = value
+		}
+	}
+	if len(apps) == 0 {
+		return nil, nil
+	}
+	names := make([]string, 0, len(apps))
+	for name, app := range apps {
+		if app.Port == 0 {
+			return nil, errors.Errorf("application %q is missing the %q annotation", name,
+				injector_metadata.KumaMetricsPrometheusAggregatePrefix+name+injector_metadata.KumaMetricsPrometheusAggregatePortSuffix)
+		}
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	prometheus := &mesh_proto.Metrics_Prometheus{}
+	for _, name := range names {
+		prometheus.Applications = append(prometheus.Applications, apps[name])
+	}
+	return &mesh_proto.Metrics{Prometheus: prometheus}, nil
+}
+
 func GatewayFor(pod *kube_core.Pod, services []*kube_core.Service) (*mesh_proto.Dataplane_Networking_Gateway, error) {
 	interfaces, err := InboundInterfacesFor(pod, services, true)
 	if err != nil {
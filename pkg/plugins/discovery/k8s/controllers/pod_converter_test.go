@@ -450,6 +450,87 @@ var _ = Describe("MeshFor(..)", func() {
 	)
 })
 
+var _ = Describe("MetricsFor(..)", func() {
+
+	type testCase struct {
+		podAnnotations map[string]string
+		expected       string
+	}
+
+	DescribeTable("should declare applications based on `prometheus.metrics.kuma.io/aggregate-<name>-*` annotations on a Pod",
+		func(given testCase) {
+			// given
+			pod := &kube_core.Pod{
+				ObjectMeta: kube_meta.ObjectMeta{
+					Annotations: given.podAnnotations,
+				},
+			}
+
+			// when
+			metrics, err := MetricsFor(pod)
+			// then
+			Expect(err).ToNot(HaveOccurred())
+
+			// when
+			actual, err := json.Marshal(metrics)
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			// and
+			Expect(actual).To(MatchYAML(given.expected))
+		},
+		Entry("Pod without annotations", testCase{
+			podAnnotations: nil,
+			expected:       `null`,
+		}),
+		Entry("Pod with applications", testCase{
+			podAnnotations: map[string]string{
+				"prometheus.metrics.kuma.io/port":                   "5670",
+				"prometheus.metrics.kuma.io/aggregate-sidecar-port": "9090",
+				"prometheus.metrics.kuma.io/aggregate-sidecar-path": "/stats",
+				"prometheus.metrics.kuma.io/aggregate-my-app-port":  "8081",
+			},
+			expected: `
+            prometheus:
+              applications:
+              - name: my-app
+                port: 8081
+              - name: sidecar
+                port: 9090
+                path: /stats
+`,
+		}),
+	)
+
+	DescribeTable("should return a descriptive error",
+		func(podAnnotations map[string]string, expectedErr string) {
+			// given
+			pod := &kube_core.Pod{
+				ObjectMeta: kube_meta.ObjectMeta{
+					Annotations: podAnnotations,
+				},
+			}
+
+			// when
+			_, err := MetricsFor(pod)
+
+			// then
+			Expect(err).To(MatchError(expectedErr))
+		},
+		Entry("invalid port",
+			map[string]string{
+				"prometheus.metrics.kuma.io/aggregate-app-port": "http",
+			},
+			`value of the "prometheus.metrics.kuma.io/aggregate-app-port" annotation has to be a port number, got "http"`,
+		),
+		Entry("path without port",
+			map[string]string{
+				"prometheus.metrics.kuma.io/aggregate-app-path": "/metrics",
+			},
+			`application "app" is missing the "prometheus.metrics.kuma.io/aggregate-app-port" annotation`,
+		),
+	)
+})
+
 var _ = Describe("InboundTagsFor(..)", func() {
 
 	type testCase struct {
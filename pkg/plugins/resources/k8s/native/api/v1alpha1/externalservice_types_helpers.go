@@ -0,0 +1,60 @@
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	proto "github.com/Kong/kuma/api/mesh/v1alpha1"
+	"github.com/Kong/kuma/pkg/plugins/resources/k8s/native/pkg/model"
+	"github.com/Kong/kuma/pkg/plugins/resources/k8s/native/pkg/registry"
+)
+
+func (o *ExternalService) GetObjectMeta() *metav1.ObjectMeta {
+	return &o.ObjectMeta
+}
+
+func (o *ExternalService) SetObjectMeta(m *metav1.ObjectMeta) {
+	o.ObjectMeta = *m
+}
+
+func (o *ExternalService) GetMesh() string {
+	return o.Mesh
+}
+
+func (o *ExternalService) SetMesh(mesh string) {
+	o.Mesh = mesh
+}
+
+func (o *ExternalService) GetSpec() map[string]interface{} {
+	return o.Spec
+}
+
+func (o *ExternalService) SetSpec(spec map[string]interface{}) {
+	o.Spec = spec
+}
+
+func (o *ExternalService) Scope() model.Scope {
+	return model.ScopeNamespace
+}
+
+func (l *ExternalServiceList) GetItems() []model.KubernetesObject {
+	result := make([]model.KubernetesObject, len(l.Items))
+	for i := range l.Items {
+		result[i] = &l.Items[i]
+	}
+	return result
+}
+
+func init() {
+	registry.RegisterObjectType(&proto.ExternalService{}, &ExternalService{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: GroupVersion.String(),
+			Kind:       "ExternalService",
+		},
+	})
+	registry.RegisterListType(&proto.ExternalService{}, &ExternalServiceList{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: GroupVersion.String(),
+			Kind:       "ExternalServiceList",
+		},
+	})
+}
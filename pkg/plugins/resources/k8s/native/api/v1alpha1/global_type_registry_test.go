@@ -84,6 +84,26 @@ var _ = Describe("global TypeRegistry", func() {
 				expectedType: &FaultInjection{},
 				expectedKind: "FaultInjection",
 			}),
+			Entry("Retry", testCase{
+				inputType:    &mesh_proto.Retry{},
+				expectedType: &Retry{},
+				expectedKind: "Retry",
+			}),
+			Entry("Timeout", testCase{
+				inputType:    &mesh_proto.Timeout{},
+				expectedType: &Timeout{},
+				expectedKind: "Timeout",
+			}),
+			Entry("CircuitBreaker", testCase{
+				inputType:    &mesh_proto.CircuitBreaker{},
+				expectedType: &CircuitBreaker{},
+				expectedKind: "CircuitBreaker",
+			}),
+			Entry("ExternalService", testCase{
+				inputType:    &mesh_proto.ExternalService{},
+				expectedType: &ExternalService{},
+				expectedKind: "ExternalService",
+			}),
 		)
 	})
 
@@ -151,6 +171,26 @@ var _ = Describe("global TypeRegistry", func() {
 				expectedType: &TrafficTraceList{},
 				expectedKind: "TrafficTraceList",
 			}),
+			Entry("RetryList", testCase{
+				inputType:    &mesh_proto.Retry{},
+				expectedType: &RetryList{},
+				expectedKind: "RetryList",
+			}),
+			Entry("TimeoutList", testCase{
+				inputType:    &mesh_proto.Timeout{},
+				expectedType: &TimeoutList{},
+				expectedKind: "TimeoutList",
+			}),
+			Entry("CircuitBreakerList", testCase{
+				inputType:    &mesh_proto.CircuitBreaker{},
+				expectedType: &CircuitBreakerList{},
+				expectedKind: "CircuitBreakerList",
+			}),
+			Entry("ExternalServiceList", testCase{
+				inputType:    &mesh_proto.ExternalService{},
+				expectedType: &ExternalServiceList{},
+				expectedKind: "ExternalServiceList",
+			}),
 		)
 	})
 })
@@ -0,0 +1,60 @@
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	proto "github.com/Kong/kuma/api/mesh/v1alpha1"
+	"github.com/Kong/kuma/pkg/plugins/resources/k8s/native/pkg/model"
+	"github.com/Kong/kuma/pkg/plugins/resources/k8s/native/pkg/registry"
+)
+
+func (o *Timeout) GetObjectMeta() *metav1.ObjectMeta {
+	return &o.ObjectMeta
+}
+
+func (o *Timeout) SetObjectMeta(m *metav1.ObjectMeta) {
+	o.ObjectMeta = *m
+}
+
+func (o *Timeout) GetMesh() string {
+	return o.Mesh
+}
+
+func (o *Timeout) SetMesh(mesh string) {
+	o.Mesh = mesh
+}
+
+func (o *Timeout) GetSpec() map[string]interface{} {
+	return o.Spec
+}
+
+func (o *Timeout) SetSpec(spec map[string]interface{}) {
+	o.Spec = spec
+}
+
+func (o *Timeout) Scope() model.Scope {
+	return model.ScopeNamespace
+}
+
+func (l *TimeoutList) GetItems() []model.KubernetesObject {
+	result := make([]model.KubernetesObject, len(l.Items))
+	for i := range l.Items {
+		result[i] = &l.Items[i]
+	}
+	return result
+}
+
+func init() {
+	registry.RegisterObjectType(&proto.Timeout{}, &Timeout{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: GroupVersion.String(),
+			Kind:       "Timeout",
+		},
+	})
+	registry.RegisterListType(&proto.Timeout{}, &TimeoutList{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: GroupVersion.String(),
+			Kind:       "TimeoutList",
+		},
+	})
+}
@@ -9,4 +9,12 @@ package controllers
 
 // +kubebuilder:rbac:groups=kuma.io,resources=healthchecks,verbs=get;list;watch
 
+// +kubebuilder:rbac:groups=kuma.io,resources=retries,verbs=get;list;watch
+
+// +kubebuilder:rbac:groups=kuma.io,resources=timeouts,verbs=get;list;watch
+
+// +kubebuilder:rbac:groups=kuma.io,resources=circuitbreakers,verbs=get;list;watch
+
+// +kubebuilder:rbac:groups=kuma.io,resources=externalservices,verbs=get;list;watch
+
 // +kubebuilder:rbac:groups=kuma.io,resources=trafficroutes,verbs=get;list;watch
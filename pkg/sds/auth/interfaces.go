@@ -11,6 +11,8 @@ type Credential string
 type Identity struct {
 	Mesh    string
 	Service string
+	// Name of the Dataplane that has been authenticated.
+	Dataplane string
 }
 
 type Authenticator interface {
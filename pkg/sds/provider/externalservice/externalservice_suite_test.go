@@ -0,0 +1,13 @@
+package externalservice_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestExternalService(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "ExternalService Secret Provider Suite")
+}
@@ -0,0 +1,130 @@
+package externalservice
+
+import (
+	"context"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/Kong/kuma/pkg/core/permissions"
+	core_mesh "github.com/Kong/kuma/pkg/core/resources/apis/mesh"
+	core_system "github.com/Kong/kuma/pkg/core/resources/apis/system"
+	core_manager "github.com/Kong/kuma/pkg/core/resources/manager"
+	core_store "github.com/Kong/kuma/pkg/core/resources/store"
+	secret_manager "github.com/Kong/kuma/pkg/core/secrets/manager"
+	sds_auth "github.com/Kong/kuma/pkg/sds/auth"
+	sds_provider "github.com/Kong/kuma/pkg/sds/provider"
+)
+
+const (
+	// CaResourcePrefix is a prefix of SDS resources with a CA bundle of an ExternalService,
+	// e.g. `external_service_ca:httpbin`.
+	CaResourcePrefix = "external_service_ca:"
+	// ClientCertResourcePrefix is a prefix of SDS resources with a client certificate of an ExternalService,
+	// e.g. `external_service_client_cert:httpbin`.
+	ClientCertResourcePrefix = "external_service_client_cert:"
+)
+
+// CaResource returns a name of SDS resource with a CA bundle of a given ExternalService.
+func CaResource(externalService string) string {
+	return CaResourcePrefix + externalService
+}
+
+// ClientCertResource returns a name of SDS resource with a client certificate of a given ExternalService.
+func ClientCertResource(externalService string) string {
+	return ClientCertResourcePrefix + externalService
+}
+
+// IsResource returns true if a given SDS resource holds TLS material of an ExternalService.
+func IsResource(resource string) bool {
+	return strings.HasPrefix(resource, CaResourcePrefix) || strings.HasPrefix(resource, ClientCertResourcePrefix)
+}
+
+func New(resourceManager core_manager.ReadOnlyResourceManager, secretManager secret_manager.SecretManager) sds_provider.SecretProvider {
+	return &externalServiceProvider{
+		resourceManager: resourceManager,
+		secretManager:   secretManager,
+	}
+}
+
+type externalServiceProvider struct {
+	resourceManager core_manager.ReadOnlyResourceManager
+	secretManager   secret_manager.SecretManager
+}
+
+func (s *externalServiceProvider) RequiresIdentity() bool {
+	return true
+}
+
+func (s *externalServiceProvider) Get(ctx context.Context, name string, requestor sds_auth.Identity) (sds_provider.Secret, error) {
+	switch {
+	case strings.HasPrefix(name, CaResourcePrefix):
+		externalService, err := s.externalService(ctx, strings.TrimPrefix(name, CaResourcePrefix), requestor)
+		if err != nil {
+			return nil, err
+		}
+		tls := externalService.Spec.GetNetworking().GetTls()
+		if tls.GetCaCertSecret() == "" {
+			return nil, errors.Errorf("ExternalService %q has no CA certificate", externalService.Meta.GetName())
+		}
+		caCert, err := s.secret(ctx, externalService, tls.GetCaCertSecret())
+		if err != nil {
+			return nil, err
+		}
+		return &CaSecret{PemCerts: caCert}, nil
+	case strings.HasPrefix(name, ClientCertResourcePrefix):
+		externalService, err := s.externalService(ctx, strings.TrimPrefix(name, ClientCertResourcePrefix), requestor)
+		if err != nil {
+			return nil, err
+		}
+		tls := externalService.Spec.GetNetworking().GetTls()
+		if tls.GetClientCertSecret() == "" || tls.GetClientKeySecret() == "" {
+			return nil, errors.Errorf("ExternalService %q has no client certificate", externalService.Meta.GetName())
+		}
+		clientCert, err := s.secret(ctx, externalService, tls.GetClientCertSecret())
+		if err != nil {
+			return nil, err
+		}
+		clientKey, err := s.secret(ctx, externalService, tls.GetClientKeySecret())
+		if err != nil {
+			return nil, err
+		}
+		return &ClientCertSecret{PemCert: clientCert, PemKey: clientKey}, nil
+	default:
+		return nil, errors.Errorf("SDS resource %q doesn't refer to an ExternalService", name)
+	}
+}
+
+// externalService returns an ExternalService only if it is reachable by the requestor,
+// i.e. the same way it is done when ExternalServices are resolved into outbound targets.
+func (s *externalServiceProvider) externalService(ctx context.Context, name string, requestor sds_auth.Identity) (*core_mesh.ExternalServiceResource, error) {
+	externalService := &core_mesh.ExternalServiceResource{}
+	if err := s.resourceManager.Get(ctx, externalService, core_store.GetByKey(name, requestor.Mesh)); err != nil {
+		return nil, errors.Wrapf(err, "failed to find an ExternalService %q", name)
+	}
+	mesh := &core_mesh.MeshResource{}
+	if err := s.resourceManager.Get(ctx, mesh, core_store.GetByKey(requestor.Mesh, requestor.Mesh)); err != nil {
+		return nil, errors.Wrapf(err, "failed to find a Mesh %q", requestor.Mesh)
+	}
+	dataplane := &core_mesh.DataplaneResource{}
+	if err := s.resourceManager.Get(ctx, dataplane, core_store.GetByKey(requestor.Dataplane, requestor.Mesh)); err != nil {
+		return nil, errors.Wrapf(err, "failed to find a Dataplane %q", requestor.Dataplane)
+	}
+	permissionsMatcher := permissions.TrafficPermissionsMatcher{ResourceManager: s.resourceManager}
+	reachable, err := permissionsMatcher.ReachableExternalServices(ctx, mesh, dataplane, []*core_mesh.ExternalServiceResource{externalService})
+	if err != nil {
+		return nil, err
+	}
+	if len(reachable) == 0 {
+		return nil, errors.Errorf("Dataplane %q is not permitted to reach ExternalService %q", requestor.Dataplane, name)
+	}
+	return externalService, nil
+}
+
+func (s *externalServiceProvider) secret(ctx context.Context, externalService *core_mesh.ExternalServiceResource, name string) ([]byte, error) {
+	secret := &core_system.SecretResource{}
+	if err := s.secretManager.Get(ctx, secret, core_store.GetByKey(name, externalService.Meta.GetMesh())); err != nil {
+		return nil, errors.Wrapf(err, "could not load secret %q referenced by ExternalService %q", name, externalService.Meta.GetName())
+	}
+	return secret.Spec.GetValue(), nil
+}
@@ -0,0 +1,162 @@
+package externalservice_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	mesh_proto "github.com/Kong/kuma/api/mesh/v1alpha1"
+	mesh_core "github.com/Kong/kuma/pkg/core/resources/apis/mesh"
+	core_system "github.com/Kong/kuma/pkg/core/resources/apis/system"
+	core_manager "github.com/Kong/kuma/pkg/core/resources/manager"
+	core_model "github.com/Kong/kuma/pkg/core/resources/model"
+	core_store "github.com/Kong/kuma/pkg/core/resources/store"
+	secret_cipher "github.com/Kong/kuma/pkg/core/secrets/cipher"
+	secret_manager "github.com/Kong/kuma/pkg/core/secrets/manager"
+	secret_store "github.com/Kong/kuma/pkg/core/secrets/store"
+	memory_resources "github.com/Kong/kuma/pkg/plugins/resources/memory"
+	sds_auth "github.com/Kong/kuma/pkg/sds/auth"
+	sds_provider "github.com/Kong/kuma/pkg/sds/provider"
+	. "github.com/Kong/kuma/pkg/sds/provider/externalservice"
+	util_proto "github.com/Kong/kuma/pkg/util/proto"
+)
+
+var _ = Describe("ExternalService Secret Provider", func() {
+
+	var ctx context.Context
+	var provider sds_provider.SecretProvider
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		memStore := memory_resources.NewStore()
+		rm := core_manager.NewResourceManager(memStore)
+		secretManager := secret_manager.NewSecretManager(secret_store.NewSecretStore(memStore), secret_cipher.None())
+		provider = New(rm, secretManager)
+
+		mesh := &mesh_core.MeshResource{
+			Spec: mesh_proto.Mesh{
+				Mtls: &mesh_proto.Mesh_Mtls{
+					Enabled: true,
+					Ca: &mesh_proto.CertificateAuthority{
+						Type: &mesh_proto.CertificateAuthority_Builtin_{
+							Builtin: &mesh_proto.CertificateAuthority_Builtin{},
+						},
+					},
+				},
+			},
+		}
+		err := rm.Create(ctx, mesh, core_store.CreateByKey("demo", "demo"))
+		Expect(err).ToNot(HaveOccurred())
+
+		for _, service := range []string{"backend", "web"} {
+			dataplane := &mesh_core.DataplaneResource{
+				Spec: mesh_proto.Dataplane{
+					Networking: &mesh_proto.Dataplane_Networking{
+						Address: "192.168.0.1",
+						Inbound: []*mesh_proto.Dataplane_Networking_Inbound{
+							{
+								Tags:        map[string]string{"service": service},
+								Port:        8080,
+								ServicePort: 18080,
+							},
+						},
+					},
+				},
+			}
+			err := rm.Create(ctx, dataplane, core_store.CreateByKey(service, "demo"))
+			Expect(err).ToNot(HaveOccurred())
+		}
+
+		permission := &mesh_core.TrafficPermissionResource{
+			Spec: mesh_proto.TrafficPermission{
+				Sources: []*mesh_proto.Selector{
+					{Match: map[string]string{"service": "backend"}},
+				},
+				Destinations: []*mesh_proto.Selector{
+					{Match: map[string]string{"service": "httpbin"}},
+				},
+			},
+		}
+		err = rm.Create(ctx, permission, core_store.CreateByKey("backend-to-httpbin", "demo"))
+		Expect(err).ToNot(HaveOccurred())
+
+		httpbin := &mesh_core.ExternalServiceResource{
+			Spec: mesh_proto.ExternalService{
+				Networking: &mesh_proto.ExternalService_Networking{
+					Address: "httpbin.org:443",
+					Tls: &mesh_proto.ExternalService_Networking_TLS{
+						Enabled:          true,
+						CaCertSecret:     "httpbin-ca",
+						ClientCertSecret: "httpbin-cert",
+						ClientKeySecret:  "httpbin-key",
+					},
+				},
+				Tags: map[string]string{"service": "httpbin"},
+			},
+		}
+		err = rm.Create(ctx, httpbin, core_store.CreateByKey("httpbin", "demo"))
+		Expect(err).ToNot(HaveOccurred())
+
+		for name, value := range map[string]string{"httpbin-ca": "CA", "httpbin-cert": "CERT", "httpbin-key": "KEY"} {
+			secret := &core_system.SecretResource{}
+			secret.Spec.Value = []byte(value)
+			err := secretManager.Create(ctx, secret, core_store.CreateBy(core_model.ResourceKey{Mesh: "demo", Name: name}))
+			Expect(err).ToNot(HaveOccurred())
+		}
+	})
+
+	It("should serve a CA bundle of an ExternalService", func() {
+		// when
+		secret, err := provider.Get(ctx, CaResource("httpbin"), sds_auth.Identity{Mesh: "demo", Service: "backend", Dataplane: "backend"})
+		// then
+		Expect(err).ToNot(HaveOccurred())
+
+		// when
+		actual, err := util_proto.ToYAML(secret.ToResource(CaResource("httpbin")))
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(actual).To(MatchYAML(`
+        name: external_service_ca:httpbin
+        validationContext:
+          trustedCa:
+            inlineBytes: Q0E=
+`))
+	})
+
+	It("should serve a client certificate of an ExternalService", func() {
+		// when
+		secret, err := provider.Get(ctx, ClientCertResource("httpbin"), sds_auth.Identity{Mesh: "demo", Service: "backend", Dataplane: "backend"})
+		// then
+		Expect(err).ToNot(HaveOccurred())
+
+		// when
+		actual, err := util_proto.ToYAML(secret.ToResource(ClientCertResource("httpbin")))
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(actual).To(MatchYAML(`
+        name: external_service_client_cert:httpbin
+        tlsCertificate:
+          certificateChain:
+            inlineBytes: Q0VSVA==
+          privateKey:
+            inlineBytes: S0VZ
+`))
+	})
+
+	It("should not serve TLS material of an ExternalService to a Dataplane that is not permitted to reach it", func() {
+		for _, resource := range []string{CaResource("httpbin"), ClientCertResource("httpbin")} {
+			// when
+			_, err := provider.Get(ctx, resource, sds_auth.Identity{Mesh: "demo", Service: "web", Dataplane: "web"})
+			// then
+			Expect(err).To(MatchError(`Dataplane "web" is not permitted to reach ExternalService "httpbin"`))
+		}
+	})
+
+	It("should not serve TLS material of an ExternalService from another Mesh", func() {
+		// when
+		_, err := provider.Get(ctx, CaResource("httpbin"), sds_auth.Identity{Mesh: "default", Service: "backend", Dataplane: "backend"})
+		// then
+		Expect(err).To(MatchError(`failed to find an ExternalService "httpbin": Resource not found: type="ExternalService" name="httpbin" mesh="default"`))
+	})
+})
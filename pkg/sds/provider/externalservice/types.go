@@ -0,0 +1,58 @@
+package externalservice
+
+import (
+	envoy_auth "github.com/envoyproxy/go-control-plane/envoy/api/v2/auth"
+	envoy_core "github.com/envoyproxy/go-control-plane/envoy/api/v2/core"
+
+	sds_provider "github.com/Kong/kuma/pkg/sds/provider"
+)
+
+// CaSecret is a CA bundle used to verify the certificate presented by an ExternalService.
+type CaSecret struct {
+	PemCerts []byte
+}
+
+var _ sds_provider.Secret = &CaSecret{}
+
+func (s *CaSecret) ToResource(name string) *envoy_auth.Secret {
+	return &envoy_auth.Secret{
+		Name: name,
+		Type: &envoy_auth.Secret_ValidationContext{
+			ValidationContext: &envoy_auth.CertificateValidationContext{
+				TrustedCa: &envoy_core.DataSource{
+					Specifier: &envoy_core.DataSource_InlineBytes{
+						InlineBytes: s.PemCerts,
+					},
+				},
+			},
+		},
+	}
+}
+
+// ClientCertSecret is a client certificate presented to an ExternalService.
+type ClientCertSecret struct {
+	PemCert []byte
+	PemKey  []byte
+}
+
+var _ sds_provider.Secret = &ClientCertSecret{}
+
+func (s *ClientCertSecret) ToResource(name string) *envoy_auth.Secret {
+	return &envoy_auth.Secret{
+		Name: name,
+		Type: &envoy_auth.Secret_TlsCertificate{
+			TlsCertificate: &envoy_auth.TlsCertificate{
+				CertificateChain: &envoy_core.DataSource{
+					Specifier: &envoy_core.DataSource_InlineBytes{
+						InlineBytes: s.PemCert,
+					},
+				},
+				PrivateKey: &envoy_core.DataSource{
+					Specifier: &envoy_core.DataSource_InlineBytes{
+						InlineBytes: s.PemKey,
+					},
+				},
+			},
+		},
+	}
+}
@@ -20,6 +20,7 @@ import (
 	universal_sds_auth "github.com/Kong/kuma/pkg/sds/auth/universal"
 	sds_provider "github.com/Kong/kuma/pkg/sds/provider"
 	ca_sds_provider "github.com/Kong/kuma/pkg/sds/provider/ca"
+	external_service_sds_provider "github.com/Kong/kuma/pkg/sds/provider/externalservice"
 	identity_sds_provider "github.com/Kong/kuma/pkg/sds/provider/identity"
 	"github.com/Kong/kuma/pkg/tokens/builtin"
 )
@@ -81,15 +82,22 @@ func DefaultIdentityCertProvider(rt core_runtime.Runtime) sds_provider.SecretPro
 	return identity_sds_provider.New(rt.ResourceManager(), rt.BuiltinCaManager(), rt.ProvidedCaManager())
 }
 
+func DefaultExternalServiceProvider(rt core_runtime.Runtime) sds_provider.SecretProvider {
+	return external_service_sds_provider.New(rt.ReadOnlyResourceManager(), rt.SecretManager())
+}
+
 func DefaultSecretProviderSelector(rt core_runtime.Runtime) func(string) (sds_provider.SecretProvider, error) {
 	meshCaProvider := DefaultMeshCaProvider(rt)
 	identityCertProvider := DefaultIdentityCertProvider(rt)
+	externalServiceProvider := DefaultExternalServiceProvider(rt)
 	return func(resource string) (sds_provider.SecretProvider, error) {
-		switch resource {
-		case MeshCaResource:
+		switch {
+		case resource == MeshCaResource:
 			return meshCaProvider, nil
-		case IdentityCertResource:
+		case resource == IdentityCertResource:
 			return identityCertProvider, nil
+		case external_service_sds_provider.IsResource(resource):
+			return externalServiceProvider, nil
 		default:
 			return nil, errors.Errorf("SDS request for %q resource is not supported", resource)
 		}
@@ -16,7 +16,10 @@ import (
 
 	kuma_cp "github.com/Kong/kuma/pkg/config/app/kuma-cp"
 	core_xds "github.com/Kong/kuma/pkg/core/xds"
+	envoy_admin "github.com/Kong/kuma/pkg/envoy/admin"
 	resources_memory "github.com/Kong/kuma/pkg/plugins/resources/memory"
+	xds_generator "github.com/Kong/kuma/pkg/xds/generator"
+	xds_history "github.com/Kong/kuma/pkg/xds/history"
 )
 
 var _ core_runtime.RuntimeInfo = TestRuntimeInfo{}
@@ -33,7 +36,10 @@ func BuilderFor(cfg kuma_cp.Config) *core_runtime.Builder {
 	builder := core_runtime.BuilderFor(cfg).
 		WithComponentManager(component.NewManager()).
 		WithResourceStore(resources_memory.NewStore()).
-		WithXdsContext(core_xds.NewXdsContext())
+		WithXdsContext(core_xds.NewXdsContext()).
+		WithEnvoyAdminStreams(envoy_admin.NewStreams()).
+		WithSnapshotHistory(xds_history.NewSnapshotHistory(cfg.XdsServer.SnapshotHistorySize, cfg.XdsServer.SnapshotHistoryRetention))
+	builder.WithExtensions(xds_generator.NewProfilesContext(builder.Extensions(), xds_generator.NewProfileRegistry()))
 
 	builder.WithSecretManager(newSecretManager(builder)).
 		WithBuiltinCaManager(newBuiltinCaManager(builder)).
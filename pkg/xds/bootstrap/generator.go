@@ -18,6 +18,7 @@ import (
 	core_manager "github.com/Kong/kuma/pkg/core/resources/manager"
 	core_store "github.com/Kong/kuma/pkg/core/resources/store"
 	core_xds "github.com/Kong/kuma/pkg/core/xds"
+	"github.com/Kong/kuma/pkg/envoy/faults"
 	util_proto "github.com/Kong/kuma/pkg/util/proto"
 	"github.com/Kong/kuma/pkg/xds/bootstrap/types"
 	"github.com/Kong/kuma/pkg/xds/topology"
@@ -57,16 +58,36 @@ func (b *bootstrapGenerator) Generate(ctx context.Context, request types.Bootstr
 	if err != nil {
 		return nil, err
 	}
-	tracingBackend, err := b.fetchTracingBackend(ctx, dataplane)
+	mesh, err := b.fetchMesh(ctx, dataplane)
+	if err != nil {
+		return nil, err
+	}
+	tracingBackend, err := b.fetchTracingBackend(ctx, dataplane, mesh)
 	if err != nil {
 		return nil, err
 	}
 	if err := AddTracingConfig(bootstrapCfg, tracingBackend); err != nil {
 		return nil, err
 	}
+	if err := AddStatsConfig(bootstrapCfg, mesh.Spec.GetMetrics(), statsTagsFor(dataplane)); err != nil {
+		return nil, err
+	}
 	return bootstrapCfg, nil
 }
 
+// statsTagsFor returns tags of a dataplane that should be attached to its metrics.
+// If a dataplane has multiple values of a tag, the first one is used.
+func statsTagsFor(dataplane *core_mesh.DataplaneResource) []StatsTag {
+	var tags []StatsTag
+	dataplaneTags := dataplane.Spec.Tags()
+	for _, name := range core_mesh.StatsTags {
+		if values := dataplaneTags.Values(name); len(values) > 0 {
+			tags = append(tags, StatsTag{Name: name, Value: values[0]})
+		}
+	}
+	return tags
+}
+
 func (b *bootstrapGenerator) generateFor(proxyId core_xds.ProxyId, dataplane *core_mesh.DataplaneResource, request types.BootstrapRequest) (*envoy_bootstrap.Bootstrap, error) {
 	// if dataplane has no service - fill this with placeholder. Otherwise take the first service
 	service := dataplane.Spec.GetIdentifyingService()
@@ -94,6 +115,7 @@ func (b *bootstrapGenerator) generateFor(proxyId core_xds.ProxyId, dataplane *co
 		XdsPort:            b.config.XdsPort,
 		XdsConnectTimeout:  b.config.XdsConnectTimeout,
 		AccessLogPipe:      accessLogPipe,
+		FaultInjectionPipe: faults.SocketPath(request.Name, request.Mesh),
 		DataplaneTokenPath: request.DataplaneTokenPath,
 		CertBytes:          certBytes,
 	}
@@ -109,12 +131,15 @@ func (b *bootstrapGenerator) fetchDataplane(ctx context.Context, proxyId *core_x
 	return &res, nil
 }
 
-func (b *bootstrapGenerator) fetchTracingBackend(ctx context.Context, dataplane *core_mesh.DataplaneResource) (*mesh_proto.TracingBackend, error) {
+func (b *bootstrapGenerator) fetchMesh(ctx context.Context, dataplane *core_mesh.DataplaneResource) (*core_mesh.MeshResource, error) {
 	mesh := core_mesh.MeshResource{}
-	if err := b.resManager.Get(context.Background(), &mesh, core_store.GetByKey(dataplane.GetMeta().GetMesh(), dataplane.GetMeta().GetMesh())); err != nil {
+	if err := b.resManager.Get(ctx, &mesh, core_store.GetByKey(dataplane.GetMeta().GetMesh(), dataplane.GetMeta().GetMesh())); err != nil {
 		return nil, err
 	}
+	return &mesh, nil
+}
 
+func (b *bootstrapGenerator) fetchTracingBackend(ctx context.Context, dataplane *core_mesh.DataplaneResource, mesh *core_mesh.MeshResource) (*mesh_proto.TracingBackend, error) {
 	trafficTrace, err := topology.GetTrafficTrace(ctx, dataplane, b.resManager)
 	if err != nil {
 		return nil, err
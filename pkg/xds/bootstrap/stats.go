@@ -0,0 +1,123 @@
+package bootstrap
+
+import (
+	"net"
+	"strconv"
+
+	envoy_api_v2_core "github.com/envoyproxy/go-control-plane/envoy/api/v2/core"
+	envoy_bootstrap "github.com/envoyproxy/go-control-plane/envoy/config/bootstrap/v2"
+	envoy_config_metrics_v2 "github.com/envoyproxy/go-control-plane/envoy/config/metrics/v2"
+	envoy_wellknown "github.com/envoyproxy/go-control-plane/pkg/wellknown"
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/pkg/errors"
+
+	mesh_proto "github.com/Kong/kuma/api/mesh/v1alpha1"
+)
+
+// StatsTag represents a tag of a dataplane that should be attached to all its metrics.
+type StatsTag struct {
+	Name  string
+	Value string
+}
+
+// AddStatsConfig configures Envoy to push metrics to StatsD and DogStatsD sinks
+// defined in a given metrics configuration of a Mesh.
+//
+// Tags of a dataplane are attached to all metrics as fixed stat tags,
+// so DogStatsD sinks push them along with every metric.
+func AddStatsConfig(bootstrap *envoy_bootstrap.Bootstrap, metrics *mesh_proto.Metrics, tags []StatsTag) error {
+	var sinks []*envoy_config_metrics_v2.StatsSink
+	if statsd := metrics.GetStatsd(); statsd != nil {
+		address, err := statsAddress(statsd.Address)
+		if err != nil {
+			return errors.Wrap(err, "invalid address of StatsD")
+		}
+		sink, err := statsSink(envoy_wellknown.Statsd, &envoy_config_metrics_v2.StatsdSink{
+			StatsdSpecifier: &envoy_config_metrics_v2.StatsdSink_Address{
+				Address: address,
+			},
+			Prefix: statsd.Prefix,
+		})
+		if err != nil {
+			return err
+		}
+		sinks = append(sinks, sink)
+	}
+	if dogstatsd := metrics.GetDogstatsd(); dogstatsd != nil {
+		address, err := statsAddress(dogstatsd.Address)
+		if err != nil {
+			return errors.Wrap(err, "invalid address of DogStatsD")
+		}
+		sink, err := statsSink(envoy_wellknown.DogStatsd, &envoy_config_metrics_v2.DogStatsdSink{
+			DogStatsdSpecifier: &envoy_config_metrics_v2.DogStatsdSink_Address{
+				Address: address,
+			},
+			Prefix: dogstatsd.Prefix,
+		})
+		if err != nil {
+			return err
+		}
+		sinks = append(sinks, sink)
+	}
+	if len(sinks) == 0 {
+		return nil
+	}
+	bootstrap.StatsSinks = append(bootstrap.StatsSinks, sinks...)
+
+	if bootstrap.StatsConfig == nil {
+		bootstrap.StatsConfig = &envoy_config_metrics_v2.StatsConfig{}
+	}
+	for _, tag := range tags {
+		bootstrap.StatsConfig.StatsTags = append(bootstrap.StatsConfig.StatsTags, &envoy_config_metrics_v2.TagSpecifier{
+			TagName: tag.Name,
+			TagValue: &envoy_config_metrics_v2.TagSpecifier_FixedValue{
+				FixedValue: tag.Value,
+			},
+		})
+	}
+	for _, extractor := range metrics.GetTagExtractors() {
+		bootstrap.StatsConfig.StatsTags = append(bootstrap.StatsConfig.StatsTags, &envoy_config_metrics_v2.TagSpecifier{
+			TagName: extractor.Name,
+			TagValue: &envoy_config_metrics_v2.TagSpecifier_Regex{
+				Regex: extractor.Regex,
+			},
+		})
+	}
+	return nil
+}
+
+func statsSink(name string, config proto.Message) (*envoy_config_metrics_v2.StatsSink, error) {
+	configAny, err := ptypes.MarshalAny(config)
+	if err != nil {
+		return nil, err
+	}
+	return &envoy_config_metrics_v2.StatsSink{
+		Name: name,
+		ConfigType: &envoy_config_metrics_v2.StatsSink_TypedConfig{
+			TypedConfig: configAny,
+		},
+	}, nil
+}
+
+func statsAddress(address string) (*envoy_api_v2_core.Address, error) {
+	host, portValue, err := net.SplitHostPort(address)
+	if err != nil {
+		return nil, err
+	}
+	port, err := strconv.ParseUint(portValue, 10, 32)
+	if err != nil {
+		return nil, err
+	}
+	return &envoy_api_v2_core.Address{
+		Address: &envoy_api_v2_core.Address_SocketAddress{
+			SocketAddress: &envoy_api_v2_core.SocketAddress{
+				Protocol: envoy_api_v2_core.SocketAddress_UDP,
+				Address:  host,
+				PortSpecifier: &envoy_api_v2_core.SocketAddress_PortValue{
+					PortValue: uint32(port),
+				},
+			},
+		},
+	}, nil
+}
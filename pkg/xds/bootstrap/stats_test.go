@@ -0,0 +1,143 @@
+package bootstrap
+
+import (
+	envoy_config_bootstrap_v2 "github.com/envoyproxy/go-control-plane/envoy/config/bootstrap/v2"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+
+	mesh_proto "github.com/Kong/kuma/api/mesh/v1alpha1"
+	core_mesh "github.com/Kong/kuma/pkg/core/resources/apis/mesh"
+	util_proto "github.com/Kong/kuma/pkg/util/proto"
+)
+
+var _ = Describe("Bootstrap Stats", func() {
+
+	type testCase struct {
+		metrics      *mesh_proto.Metrics
+		tags         []StatsTag
+		expectedYAML string
+	}
+
+	DescribeTable("should enrich bootstrap config with stats sinks",
+		func(given testCase) {
+			// given
+			bootstrap := &envoy_config_bootstrap_v2.Bootstrap{}
+
+			// when
+			err := AddStatsConfig(bootstrap, given.metrics, given.tags)
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+
+			// and
+			actual, err := util_proto.ToYAML(bootstrap)
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+
+			// and
+			Expect(actual).To(MatchYAML(given.expectedYAML))
+		},
+		Entry("no sinks", testCase{
+			metrics: &mesh_proto.Metrics{
+				Prometheus: &mesh_proto.Metrics_Prometheus{
+					Port: 5670,
+				},
+			},
+			tags: []StatsTag{
+				{Name: "service", Value: "backend"},
+			},
+			expectedYAML: `{}`,
+		}),
+		Entry("statsd sink", testCase{
+			metrics: &mesh_proto.Metrics{
+				Statsd: &mesh_proto.Metrics_StatsD{
+					Address: "10.0.0.1:8125",
+					Prefix:  "kuma",
+				},
+			},
+			tags: []StatsTag{
+				{Name: "service", Value: "backend"},
+			},
+			expectedYAML: `
+                statsConfig:
+                  statsTags:
+                  - fixedValue: backend
+                    tagName: service
+                statsSinks:
+                - name: envoy.statsd
+                  typedConfig:
+                    '@type': type.googleapis.com/envoy.config.metrics.v2.StatsdSink
+                    address:
+                      socketAddress:
+                        address: 10.0.0.1
+                        portValue: 8125
+                        protocol: UDP
+                    prefix: kuma
+`,
+		}),
+		Entry("dogstatsd sink with tag extractors", testCase{
+			metrics: &mesh_proto.Metrics{
+				Dogstatsd: &mesh_proto.Metrics_DogStatsD{
+					Address: "127.0.0.1:8125",
+				},
+				TagExtractors: []*mesh_proto.Metrics_TagExtractor{
+					{Name: "grpc_method", Regex: `^cluster\.[^.]+\.grpc\.[^.]+\.((.+?)\.)`},
+				},
+			},
+			tags: []StatsTag{
+				{Name: "service", Value: "backend"},
+				{Name: "version", Value: "v1"},
+				{Name: "zone", Value: "us-east-1a"},
+			},
+			expectedYAML: `
+                statsConfig:
+                  statsTags:
+                  - fixedValue: backend
+                    tagName: service
+                  - fixedValue: v1
+                    tagName: version
+                  - fixedValue: us-east-1a
+                    tagName: zone
+                  - regex: ^cluster\.[^.]+\.grpc\.[^.]+\.((.+?)\.)
+                    tagName: grpc_method
+                statsSinks:
+                - name: envoy.dog_statsd
+                  typedConfig:
+                    '@type': type.googleapis.com/envoy.config.metrics.v2.DogStatsdSink
+                    address:
+                      socketAddress:
+                        address: 127.0.0.1
+                        portValue: 8125
+                        protocol: UDP
+`,
+		}),
+	)
+
+	It("should take tags of a dataplane", func() {
+		// given
+		dataplane := &core_mesh.DataplaneResource{
+			Spec: mesh_proto.Dataplane{
+				Networking: &mesh_proto.Dataplane_Networking{
+					Inbound: []*mesh_proto.Dataplane_Networking_Inbound{
+						{
+							Port: 8080,
+							Tags: map[string]string{
+								"service": "backend",
+								"version": "v1",
+								"region":  "us-east-1",
+							},
+						},
+					},
+				},
+			},
+		}
+
+		// expect
+		Expect(statsTagsFor(dataplane)).To(Equal([]StatsTag{
+			{Name: "service", Value: "backend"},
+			{Name: "version", Value: "v1"},
+		}))
+	})
+})
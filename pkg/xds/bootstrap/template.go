@@ -12,6 +12,7 @@ type configParameters struct {
 	XdsPort            uint32
 	XdsConnectTimeout  time.Duration
 	AccessLogPipe      string
+	FaultInjectionPipe string
 	DataplaneTokenPath string
 	CertBytes          string
 }
@@ -108,4 +109,17 @@ static_resources:
             address:
               pipe:
                 path: {{ .AccessLogPipe }}
+  - name: fault_injection_sink
+    connect_timeout: {{ .XdsConnectTimeout }}
+    type: STATIC
+    lb_policy: ROUND_ROBIN
+    http2_protocol_options: {}
+    load_assignment:
+      cluster_name: fault_injection_sink
+      endpoints:
+      - lb_endpoints:
+        - endpoint:
+            address:
+              pipe:
+                path: {{ .FaultInjectionPipe }}
 `
@@ -10,6 +10,7 @@ import (
 	envoy_api_v2_endpoint "github.com/envoyproxy/go-control-plane/envoy/api/v2/endpoint"
 	envoy_bootstrap "github.com/envoyproxy/go-control-plane/envoy/config/bootstrap/v2"
 	envoy_config_trace_v2 "github.com/envoyproxy/go-control-plane/envoy/config/trace/v2"
+	"github.com/golang/protobuf/proto"
 	"github.com/golang/protobuf/ptypes"
 	"github.com/golang/protobuf/ptypes/duration"
 	"github.com/pkg/errors"
@@ -17,51 +18,153 @@ import (
 	mesh_proto "github.com/Kong/kuma/api/mesh/v1alpha1"
 )
 
+const (
+	zipkinTracerName     = "envoy.zipkin"
+	datadogTracerName    = "envoy.tracers.datadog"
+	openCensusTracerName = "envoy.tracers.opencensus"
+)
+
+const (
+	defaultJaegerPath         = "/api/v2/spans"
+	defaultDatadogServiceName = "kuma"
+)
+
 func AddTracingConfig(bootstrap *envoy_bootstrap.Bootstrap, backend *mesh_proto.TracingBackend) error {
-	if zipkin, ok := backend.GetType().(*mesh_proto.TracingBackend_Zipkin_); ok {
-		cluster, tracingCfg, err := zipkinConfig(bootstrap, zipkin.Zipkin, backend.Name)
-		if err != nil {
-			return err
-		}
+	var cluster *envoy_api.Cluster
+	var tracingCfg *envoy_config_trace_v2.Tracing
+	var err error
+	switch backendType := backend.GetType().(type) {
+	case *mesh_proto.TracingBackend_Zipkin_:
+		cluster, tracingCfg, err = zipkinConfig(backendType.Zipkin, backend.Name)
+	case *mesh_proto.TracingBackend_Jaeger_:
+		cluster, tracingCfg, err = jaegerConfig(backendType.Jaeger, backend.Name)
+	case *mesh_proto.TracingBackend_Datadog_:
+		cluster, tracingCfg, err = datadogConfig(backendType.Datadog, backend.Name)
+	case *mesh_proto.TracingBackend_OpenCensus_:
+		tracingCfg, err = openCensusConfig(backendType.OpenCensus)
+	default:
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if cluster != nil {
 		if bootstrap.StaticResources == nil {
 			bootstrap.StaticResources = &envoy_bootstrap.Bootstrap_StaticResources{}
 		}
 		bootstrap.StaticResources.Clusters = append(bootstrap.StaticResources.Clusters, cluster)
-		bootstrap.Tracing = tracingCfg
 	}
+	bootstrap.Tracing = tracingCfg
 	return nil
 }
 
-func zipkinConfig(bootstrap *envoy_bootstrap.Bootstrap, zipkin *mesh_proto.TracingBackend_Zipkin, backendName string) (*envoy_api.Cluster, *envoy_config_trace_v2.Tracing, error) {
+func zipkinConfig(zipkin *mesh_proto.TracingBackend_Zipkin, backendName string) (*envoy_api.Cluster, *envoy_config_trace_v2.Tracing, error) {
 	url, err := net_url.ParseRequestURI(zipkin.Url)
 	if err != nil {
 		return nil, nil, errors.Wrap(err, "invalid URL of Zipkin")
 	}
 
-	cluster, err := zipkinCluster(backendName, url)
+	cluster, err := tracingCluster(backendName, url.Hostname(), url.Port())
 	if err != nil {
 		return nil, nil, err
 	}
 
-	zipkinConfig := envoy_config_trace_v2.ZipkinConfig{
+	zipkinConfig := &envoy_config_trace_v2.ZipkinConfig{
 		CollectorCluster:         cluster.Name,
 		CollectorEndpoint:        url.Path,
 		TraceId_128Bit:           zipkin.TraceId128Bit,
 		CollectorEndpointVersion: apiVersion(zipkin, url),
 	}
-	zipkinConfigAny, err := ptypes.MarshalAny(&zipkinConfig)
+	tracingConfig, err := tracingConfig(zipkinTracerName, zipkinConfig)
+	if err != nil {
+		return nil, nil, err
+	}
+	return cluster, tracingConfig, nil
+}
+
+// jaegerConfig sends spans to the Zipkin-compatible endpoint of Jaeger collector.
+func jaegerConfig(jaeger *mesh_proto.TracingBackend_Jaeger, backendName string) (*envoy_api.Cluster, *envoy_config_trace_v2.Tracing, error) {
+	url, err := net_url.ParseRequestURI(jaeger.Url)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "invalid URL of Jaeger")
+	}
+
+	cluster, err := tracingCluster(backendName, url.Hostname(), url.Port())
+	if err != nil {
+		return nil, nil, err
+	}
+
+	path := url.Path
+	if path == "" || path == "/" {
+		path = defaultJaegerPath
+	}
+	zipkinConfig := &envoy_config_trace_v2.ZipkinConfig{
+		CollectorCluster:         cluster.Name,
+		CollectorEndpoint:        path,
+		TraceId_128Bit:           jaeger.TraceId128Bit,
+		CollectorEndpointVersion: envoy_config_trace_v2.ZipkinConfig_HTTP_JSON,
+	}
+	tracingConfig, err := tracingConfig(zipkinTracerName, zipkinConfig)
 	if err != nil {
 		return nil, nil, err
 	}
-	tracingConfig := &envoy_config_trace_v2.Tracing{
+	return cluster, tracingConfig, nil
+}
+
+func datadogConfig(datadog *mesh_proto.TracingBackend_Datadog, backendName string) (*envoy_api.Cluster, *envoy_config_trace_v2.Tracing, error) {
+	cluster, err := tracingCluster(backendName, datadog.Address, strconv.Itoa(int(datadog.Port)))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	serviceName := datadog.ServiceName
+	if serviceName == "" {
+		serviceName = defaultDatadogServiceName
+	}
+	datadogConfig := &envoy_config_trace_v2.DatadogConfig{
+		CollectorCluster: cluster.Name,
+		ServiceName:      serviceName,
+	}
+	tracingConfig, err := tracingConfig(datadogTracerName, datadogConfig)
+	if err != nil {
+		return nil, nil, err
+	}
+	return cluster, tracingConfig, nil
+}
+
+// openCensusConfig does not need a cluster since exporters connect to their backends on their own.
+func openCensusConfig(openCensus *mesh_proto.TracingBackend_OpenCensus) (*envoy_config_trace_v2.Tracing, error) {
+	traceContexts := []envoy_config_trace_v2.OpenCensusConfig_TraceContext{
+		envoy_config_trace_v2.OpenCensusConfig_TRACE_CONTEXT,
+		envoy_config_trace_v2.OpenCensusConfig_B3,
+	}
+	openCensusConfig := &envoy_config_trace_v2.OpenCensusConfig{
+		StdoutExporterEnabled:      openCensus.Stdout,
+		StackdriverExporterEnabled: openCensus.StackdriverProjectId != "",
+		StackdriverProjectId:       openCensus.StackdriverProjectId,
+		ZipkinExporterEnabled:      openCensus.ZipkinUrl != "",
+		ZipkinUrl:                  openCensus.ZipkinUrl,
+		OcagentExporterEnabled:     openCensus.OcagentAddress != "",
+		OcagentAddress:             openCensus.OcagentAddress,
+		IncomingTraceContext:       traceContexts,
+		OutgoingTraceContext:       traceContexts,
+	}
+	return tracingConfig(openCensusTracerName, openCensusConfig)
+}
+
+func tracingConfig(name string, config proto.Message) (*envoy_config_trace_v2.Tracing, error) {
+	configAny, err := ptypes.MarshalAny(config)
+	if err != nil {
+		return nil, err
+	}
+	return &envoy_config_trace_v2.Tracing{
 		Http: &envoy_config_trace_v2.Tracing_Http{
-			Name: "envoy.zipkin",
+			Name: name,
 			ConfigType: &envoy_config_trace_v2.Tracing_Http_TypedConfig{
-				TypedConfig: zipkinConfigAny,
+				TypedConfig: configAny,
 			},
 		},
-	}
-	return cluster, tracingConfig, nil
+	}, nil
 }
 
 func apiVersion(zipkin *mesh_proto.TracingBackend_Zipkin, url *net_url.URL) envoy_config_trace_v2.ZipkinConfig_CollectorEndpointVersion {
@@ -84,17 +187,17 @@ func apiVersion(zipkin *mesh_proto.TracingBackend_Zipkin, url *net_url.URL) envo
 	return envoy_config_trace_v2.ZipkinConfig_HTTP_JSON
 }
 
-const zipkinClusterTimeout = 10 * time.Second
+const tracingClusterTimeout = 10 * time.Second
 
-func zipkinCluster(backendName string, url *net_url.URL) (*envoy_api.Cluster, error) {
-	port, err := strconv.Atoi(url.Port())
+func tracingCluster(backendName string, address string, portValue string) (*envoy_api.Cluster, error) {
+	port, err := strconv.Atoi(portValue)
 	if err != nil {
 		return nil, err
 	}
 
 	cluster := &envoy_api.Cluster{
 		Name:                 backendName,
-		ConnectTimeout:       &duration.Duration{Seconds: int64(zipkinClusterTimeout.Seconds())},
+		ConnectTimeout:       &duration.Duration{Seconds: int64(tracingClusterTimeout.Seconds())},
 		ClusterDiscoveryType: &envoy_api.Cluster_Type{Type: envoy_api.Cluster_STRICT_DNS},
 		LbPolicy:             envoy_api.Cluster_ROUND_ROBIN,
 		LoadAssignment: &envoy_api.ClusterLoadAssignment{
@@ -108,7 +211,7 @@ func zipkinCluster(backendName string, url *net_url.URL) (*envoy_api.Cluster, er
 									Address: &envoy_api_v2_core.Address{
 										Address: &envoy_api_v2_core.Address_SocketAddress{
 											SocketAddress: &envoy_api_v2_core.SocketAddress{
-												Address: url.Hostname(),
+												Address: address,
 												PortSpecifier: &envoy_api_v2_core.SocketAddress_PortValue{
 													PortValue: uint32(port),
 												},
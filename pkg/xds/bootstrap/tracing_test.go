@@ -276,6 +276,137 @@ var _ = Describe("Bootstrap Tracing", func() {
                       collectorEndpoint: /api/v2/spans
                       collectorEndpointVersion: HTTP_JSON
                       traceId128bit: true
+`,
+		}),
+		Entry("jaeger with default path", testCase{
+			backend: &mesh_proto.TracingBackend{
+				Name: "jaeger",
+				Type: &mesh_proto.TracingBackend_Jaeger_{
+					Jaeger: &mesh_proto.TracingBackend_Jaeger{
+						Url: "http://jaeger-collector:9411",
+					},
+				},
+			},
+			expectedYAML: `
+                staticResources:
+                  clusters:
+                  - connectTimeout: 10s
+                    loadAssignment:
+                      clusterName: jaeger
+                      endpoints:
+                      - lbEndpoints:
+                        - endpoint:
+                            address:
+                              socketAddress:
+                                address: jaeger-collector
+                                portValue: 9411
+                    name: jaeger
+                    type: STRICT_DNS
+                tracing:
+                  http:
+                    name: envoy.zipkin
+                    typedConfig:
+                      '@type': type.googleapis.com/envoy.config.trace.v2.ZipkinConfig
+                      collectorCluster: jaeger
+                      collectorEndpoint: /api/v2/spans
+                      collectorEndpointVersion: HTTP_JSON
+`,
+		}),
+		Entry("datadog", testCase{
+			backend: &mesh_proto.TracingBackend{
+				Name: "datadog",
+				Type: &mesh_proto.TracingBackend_Datadog_{
+					Datadog: &mesh_proto.TracingBackend_Datadog{
+						Address:     "datadog-agent",
+						Port:        8126,
+						ServiceName: "backend",
+					},
+				},
+			},
+			expectedYAML: `
+                staticResources:
+                  clusters:
+                  - connectTimeout: 10s
+                    loadAssignment:
+                      clusterName: datadog
+                      endpoints:
+                      - lbEndpoints:
+                        - endpoint:
+                            address:
+                              socketAddress:
+                                address: datadog-agent
+                                portValue: 8126
+                    name: datadog
+                    type: STRICT_DNS
+                tracing:
+                  http:
+                    name: envoy.tracers.datadog
+                    typedConfig:
+                      '@type': type.googleapis.com/envoy.config.trace.v2.DatadogConfig
+                      collectorCluster: datadog
+                      serviceName: backend
+`,
+		}),
+		Entry("datadog with default service name", testCase{
+			backend: &mesh_proto.TracingBackend{
+				Name: "datadog",
+				Type: &mesh_proto.TracingBackend_Datadog_{
+					Datadog: &mesh_proto.TracingBackend_Datadog{
+						Address: "datadog-agent",
+						Port:    8126,
+					},
+				},
+			},
+			expectedYAML: `
+                staticResources:
+                  clusters:
+                  - connectTimeout: 10s
+                    loadAssignment:
+                      clusterName: datadog
+                      endpoints:
+                      - lbEndpoints:
+                        - endpoint:
+                            address:
+                              socketAddress:
+                                address: datadog-agent
+                                portValue: 8126
+                    name: datadog
+                    type: STRICT_DNS
+                tracing:
+                  http:
+                    name: envoy.tracers.datadog
+                    typedConfig:
+                      '@type': type.googleapis.com/envoy.config.trace.v2.DatadogConfig
+                      collectorCluster: datadog
+                      serviceName: kuma
+`,
+		}),
+		Entry("opencensus", testCase{
+			backend: &mesh_proto.TracingBackend{
+				Name: "opencensus",
+				Type: &mesh_proto.TracingBackend_OpenCensus_{
+					OpenCensus: &mesh_proto.TracingBackend_OpenCensus{
+						OcagentAddress: "ocagent:55678",
+						ZipkinUrl:      "http://zipkin:9411/api/v2/spans",
+					},
+				},
+			},
+			expectedYAML: `
+                tracing:
+                  http:
+                    name: envoy.tracers.opencensus
+                    typedConfig:
+                      '@type': type.googleapis.com/envoy.config.trace.v2.OpenCensusConfig
+                      incomingTraceContext:
+                      - TRACE_CONTEXT
+                      - B3
+                      outgoingTraceContext:
+                      - TRACE_CONTEXT
+                      - B3
+                      ocagentAddress: ocagent:55678
+                      ocagentExporterEnabled: true
+                      zipkinExporterEnabled: true
+                      zipkinUrl: http://zipkin:9411/api/v2/spans
 `,
 		}),
 	)
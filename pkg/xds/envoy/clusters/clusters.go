@@ -4,15 +4,19 @@ import (
 	"time"
 
 	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/duration"
 	"github.com/golang/protobuf/ptypes/wrappers"
 
 	v2 "github.com/envoyproxy/go-control-plane/envoy/api/v2"
+	envoy_auth "github.com/envoyproxy/go-control-plane/envoy/api/v2/auth"
 	envoy_cluster "github.com/envoyproxy/go-control-plane/envoy/api/v2/cluster"
 	envoy_core "github.com/envoyproxy/go-control-plane/envoy/api/v2/core"
+	envoy_type "github.com/envoyproxy/go-control-plane/envoy/type"
 
 	"github.com/Kong/kuma/pkg/xds/envoy"
 	envoy_endpoints "github.com/Kong/kuma/pkg/xds/envoy/endpoints"
 
+	mesh_proto "github.com/Kong/kuma/api/mesh/v1alpha1"
 	mesh_core "github.com/Kong/kuma/pkg/core/resources/apis/mesh"
 	core_xds "github.com/Kong/kuma/pkg/core/xds"
 	util_xds "github.com/Kong/kuma/pkg/util/xds"
@@ -32,14 +36,27 @@ func CreateLocalCluster(clusterName string, address string, port uint32) *v2.Clu
 	})
 }
 
-func CreateEdsCluster(ctx xds_context.Context, clusterName string, metadata *core_xds.DataplaneMetadata) (*v2.Cluster, error) {
+// CreatePipeCluster creates a Cluster with a single endpoint that represents a Unix socket at a given path.
+func CreatePipeCluster(clusterName string, path string) *v2.Cluster {
+	return clusterWithAltStatName(&v2.Cluster{
+		Name:                 clusterName,
+		ConnectTimeout:       ptypes.DurationProto(defaultConnectTimeout),
+		ClusterDiscoveryType: &v2.Cluster_Type{Type: v2.Cluster_STATIC},
+		LoadAssignment:       envoy_endpoints.CreatePipeEndpoint(clusterName, path),
+	})
+}
+
+// CreateEdsCluster creates a Cluster with endpoints provided over EDS.
+//
+// Connect timeout is taken from a given Timeout policy, if any.
+func CreateEdsCluster(ctx xds_context.Context, clusterName string, metadata *core_xds.DataplaneMetadata, timeout *mesh_core.TimeoutResource) (*v2.Cluster, error) {
 	tlsContext, err := envoy.CreateUpstreamTlsContext(ctx, metadata)
 	if err != nil {
 		return nil, err
 	}
 	cluster := clusterWithAltStatName(&v2.Cluster{
 		Name:                 clusterName,
-		ConnectTimeout:       ptypes.DurationProto(defaultConnectTimeout),
+		ConnectTimeout:       connectTimeout(timeout),
 		ClusterDiscoveryType: &v2.Cluster_Type{Type: v2.Cluster_EDS},
 		EdsClusterConfig: &v2.Cluster_EdsClusterConfig{
 			EdsConfig: &envoy_core.ConfigSource{
@@ -50,20 +67,63 @@ func CreateEdsCluster(ctx xds_context.Context, clusterName string, metadata *cor
 		},
 	})
 	if tlsContext != nil {
-		pbst, err := ptypes.MarshalAny(tlsContext)
+		if err := clusterWithTlsContext(cluster, tlsContext); err != nil {
+			return nil, err
+		}
+	}
+	return cluster, nil
+}
+
+// CreateDnsCluster creates a Cluster with endpoints of an ExternalService
+// resolved by Envoy using DNS.
+//
+// Connect timeout is taken from a given Timeout policy, if any.
+func CreateDnsCluster(ctx xds_context.Context, clusterName string, metadata *core_xds.DataplaneMetadata, endpoints []core_xds.Endpoint, timeout *mesh_core.TimeoutResource) (*v2.Cluster, error) {
+	clusterType := v2.Cluster_STRICT_DNS
+	if len(endpoints) == 1 {
+		clusterType = v2.Cluster_LOGICAL_DNS
+	}
+	cluster := clusterWithAltStatName(&v2.Cluster{
+		Name:                 clusterName,
+		ConnectTimeout:       connectTimeout(timeout),
+		ClusterDiscoveryType: &v2.Cluster_Type{Type: clusterType},
+		LoadAssignment:       envoy_endpoints.CreateClusterLoadAssignment(clusterName, endpoints),
+	})
+	if len(endpoints) > 0 && endpoints[0].IsExternalService() {
+		tlsContext, err := envoy.CreateUpstreamTlsContextOutsideMesh(ctx, metadata, endpoints[0].ExternalService, endpoints[0].Target)
 		if err != nil {
 			return nil, err
 		}
-		cluster.TransportSocket = &envoy_core.TransportSocket{
-			Name: "envoy.transport_sockets.tls",
-			ConfigType: &envoy_core.TransportSocket_TypedConfig{
-				TypedConfig: pbst,
-			},
+		if tlsContext != nil {
+			if err := clusterWithTlsContext(cluster, tlsContext); err != nil {
+				return nil, err
+			}
 		}
 	}
 	return cluster, nil
 }
 
+func clusterWithTlsContext(cluster *v2.Cluster, tlsContext *envoy_auth.UpstreamTlsContext) error {
+	pbst, err := ptypes.MarshalAny(tlsContext)
+	if err != nil {
+		return err
+	}
+	cluster.TransportSocket = &envoy_core.TransportSocket{
+		Name: "envoy.transport_sockets.tls",
+		ConfigType: &envoy_core.TransportSocket_TypedConfig{
+			TypedConfig: pbst,
+		},
+	}
+	return nil
+}
+
+func connectTimeout(timeout *mesh_core.TimeoutResource) *duration.Duration {
+	if timeout != nil && timeout.HasConnectTimeout() {
+		return timeout.Spec.GetConf().GetConnectTimeout()
+	}
+	return ptypes.DurationProto(defaultConnectTimeout)
+}
+
 func clusterWithAltStatName(cluster *v2.Cluster) *v2.Cluster {
 	sanitizedName := util_xds.SanitizeMetric(cluster.Name)
 	if sanitizedName != cluster.Name {
@@ -72,13 +132,16 @@ func clusterWithAltStatName(cluster *v2.Cluster) *v2.Cluster {
 	return cluster
 }
 
-func ClusterWithHealthChecks(cluster *v2.Cluster, healthCheck *mesh_core.HealthCheckResource) *v2.Cluster {
+// ClusterWithHealthChecks applies a given HealthCheck to a Cluster of a given service.
+//
+// Active checks use TCP health checking unless HTTP health checking is configured explicitly.
+func ClusterWithHealthChecks(cluster *v2.Cluster, healthCheck *mesh_core.HealthCheckResource, service string) *v2.Cluster {
 	if healthCheck == nil {
 		return cluster
 	}
 	if healthCheck.HasActiveChecks() {
 		activeChecks := healthCheck.Spec.Conf.GetActiveChecks()
-		cluster.HealthChecks = append(cluster.HealthChecks, &envoy_core.HealthCheck{
+		envoyHealthCheck := &envoy_core.HealthCheck{
 			HealthChecker: &envoy_core.HealthCheck_TcpHealthCheck_{
 				TcpHealthCheck: &envoy_core.HealthCheck_TcpHealthCheck{},
 			},
@@ -86,7 +149,14 @@ func ClusterWithHealthChecks(cluster *v2.Cluster, healthCheck *mesh_core.HealthC
 			Timeout:            activeChecks.Timeout,
 			UnhealthyThreshold: &wrappers.UInt32Value{Value: activeChecks.UnhealthyThreshold},
 			HealthyThreshold:   &wrappers.UInt32Value{Value: activeChecks.HealthyThreshold},
-		})
+			NoTrafficInterval:  activeChecks.NoTrafficInterval,
+		}
+		if activeChecks.Http != nil {
+			envoyHealthCheck.HealthChecker = &envoy_core.HealthCheck_HttpHealthCheck_{
+				HttpHealthCheck: httpHealthCheck(activeChecks.Http, service),
+			}
+		}
+		cluster.HealthChecks = append(cluster.HealthChecks, envoyHealthCheck)
 	}
 	if healthCheck.HasPassiveChecks() {
 		passiveChecks := healthCheck.Spec.Conf.GetPassiveChecks()
@@ -95,9 +165,137 @@ func ClusterWithHealthChecks(cluster *v2.Cluster, healthCheck *mesh_core.HealthC
 			Consecutive_5Xx: &wrappers.UInt32Value{Value: passiveChecks.UnhealthyThreshold},
 		}
 	}
+	if threshold := healthCheck.Spec.Conf.GetHealthyPanicThreshold(); threshold != nil {
+		cluster.CommonLbConfig = &v2.Cluster_CommonLbConfig{
+			HealthyPanicThreshold: &envoy_type.Percent{Value: float64(threshold.GetValue())},
+		}
+	}
 	return cluster
 }
 
+func httpHealthCheck(conf *mesh_proto.HealthCheck_Conf_Active_Http, service string) *envoy_core.HealthCheck_HttpHealthCheck {
+	httpHealthCheck := &envoy_core.HealthCheck_HttpHealthCheck{
+		Path: conf.GetPath(),
+		Host: conf.GetHost(),
+	}
+	if httpHealthCheck.Path == "" {
+		httpHealthCheck.Path = "/"
+	}
+	if httpHealthCheck.Host == "" {
+		httpHealthCheck.Host = service
+	}
+	for _, status := range conf.GetExpectedStatuses() {
+		httpHealthCheck.ExpectedStatuses = append(httpHealthCheck.ExpectedStatuses, &envoy_type.Int64Range{
+			Start: int64(status.Start),
+			End:   int64(status.End),
+		})
+	}
+	for _, header := range conf.GetRequestHeaders() {
+		httpHealthCheck.RequestHeadersToAdd = append(httpHealthCheck.RequestHeadersToAdd, &envoy_core.HeaderValueOption{
+			Header: &envoy_core.HeaderValue{
+				Key:   header.Name,
+				Value: header.Value,
+			},
+		})
+	}
+	return httpHealthCheck
+}
+
+// ClusterWithCircuitBreaker applies thresholds and outlier detection of a given CircuitBreaker to a Cluster.
+//
+// Outlier detection of a CircuitBreaker takes precedence over passive checks of a HealthCheck,
+// that is why ClusterWithCircuitBreaker must be applied after ClusterWithHealthChecks.
+func ClusterWithCircuitBreaker(cluster *v2.Cluster, circuitBreaker *mesh_core.CircuitBreakerResource) *v2.Cluster {
+	if circuitBreaker == nil {
+		return cluster
+	}
+	if circuitBreaker.HasThresholds() {
+		thresholds := circuitBreaker.Spec.Conf.GetThresholds()
+		cluster.CircuitBreakers = &envoy_cluster.CircuitBreakers{
+			Thresholds: []*envoy_cluster.CircuitBreakers_Thresholds{
+				{
+					MaxConnections:     thresholds.MaxConnections,
+					MaxPendingRequests: thresholds.MaxPendingRequests,
+					MaxRequests:        thresholds.MaxRequests,
+					MaxRetries:         thresholds.MaxRetries,
+				},
+			},
+		}
+	}
+	if circuitBreaker.HasOutlierDetection() {
+		cluster.OutlierDetection = outlierDetection(circuitBreaker.Spec.Conf.GetOutlierDetection())
+	}
+	return cluster
+}
+
+// outlierDetection enables only those detectors that have been explicitly configured,
+// unlike Envoy that enables consecutive 5xx and success rate detectors by default.
+func outlierDetection(conf *mesh_proto.CircuitBreaker_Conf_OutlierDetection) *envoy_cluster.OutlierDetection {
+	disabled := &wrappers.UInt32Value{Value: 0}
+	enforced := &wrappers.UInt32Value{Value: 100}
+	outlierDetection := &envoy_cluster.OutlierDetection{
+		Interval:           conf.Interval,
+		BaseEjectionTime:   conf.BaseEjectionTime,
+		MaxEjectionPercent: conf.MaxEjectionPercent,
+	}
+	if conf.Consecutive_5Xx != nil {
+		outlierDetection.Consecutive_5Xx = conf.Consecutive_5Xx
+	} else {
+		outlierDetection.EnforcingConsecutive_5Xx = disabled
+	}
+	if conf.ConsecutiveGatewayFailure != nil {
+		outlierDetection.ConsecutiveGatewayFailure = conf.ConsecutiveGatewayFailure
+		outlierDetection.EnforcingConsecutiveGatewayFailure = enforced
+	}
+	if successRate := conf.SuccessRate; successRate != nil {
+		outlierDetection.SuccessRateMinimumHosts = successRate.MinimumHosts
+		outlierDetection.SuccessRateRequestVolume = successRate.RequestVolume
+		outlierDetection.SuccessRateStdevFactor = successRate.StdevFactor
+	} else {
+		outlierDetection.EnforcingSuccessRate = disabled
+	}
+	return outlierDetection
+}
+
+// ClusterWithLoadBalancer configures a given Cluster to use a load balancing algorithm
+// of a given TrafficRoute.
+func ClusterWithLoadBalancer(cluster *v2.Cluster, lb *mesh_proto.TrafficRoute_LoadBalancer) *v2.Cluster {
+	switch lb.GetType().(type) {
+	case *mesh_proto.TrafficRoute_LoadBalancer_LeastRequest_:
+		cluster.LbPolicy = v2.Cluster_LEAST_REQUEST
+		if choiceCount := lb.GetLeastRequest().GetChoiceCount(); choiceCount != 0 {
+			cluster.LbConfig = &v2.Cluster_LeastRequestLbConfig_{
+				LeastRequestLbConfig: &v2.Cluster_LeastRequestLbConfig{
+					ChoiceCount: &wrappers.UInt32Value{Value: choiceCount},
+				},
+			}
+		}
+	case *mesh_proto.TrafficRoute_LoadBalancer_Random_:
+		cluster.LbPolicy = v2.Cluster_RANDOM
+	case *mesh_proto.TrafficRoute_LoadBalancer_RingHash_:
+		cluster.LbPolicy = v2.Cluster_RING_HASH
+		cluster.LbConfig = &v2.Cluster_RingHashLbConfig_{
+			RingHashLbConfig: ringHashLbConfig(lb.GetRingHash()),
+		}
+	case *mesh_proto.TrafficRoute_LoadBalancer_Maglev_:
+		cluster.LbPolicy = v2.Cluster_MAGLEV
+	}
+	return cluster
+}
+
+func ringHashLbConfig(conf *mesh_proto.TrafficRoute_LoadBalancer_RingHash) *v2.Cluster_RingHashLbConfig {
+	config := &v2.Cluster_RingHashLbConfig{
+		HashFunction: v2.Cluster_RingHashLbConfig_HashFunction(v2.Cluster_RingHashLbConfig_HashFunction_value[conf.GetHashFunction()]),
+	}
+	if conf.GetMinRingSize() != 0 {
+		config.MinimumRingSize = &wrappers.UInt64Value{Value: conf.GetMinRingSize()}
+	}
+	if conf.GetMaxRingSize() != 0 {
+		config.MaximumRingSize = &wrappers.UInt64Value{Value: conf.GetMaxRingSize()}
+	}
+	return config
+}
+
 func CreatePassThroughCluster(clusterName string) *v2.Cluster {
 	return clusterWithAltStatName(&v2.Cluster{
 		Name:                 clusterName,
@@ -10,6 +10,7 @@ import (
 	. "github.com/Kong/kuma/pkg/xds/envoy/clusters"
 
 	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/wrappers"
 
 	envoy_v2 "github.com/envoyproxy/go-control-plane/envoy/api/v2"
 
@@ -50,6 +51,32 @@ var _ = Describe("Clusters", func() {
 		Expect(actual).To(MatchYAML(expected))
 	})
 
+	It("should generate 'pipe' Cluster", func() {
+		// given
+		expected := `
+        name: kuma:metrics:merger
+        altStatName: kuma_metrics_merger
+        type: STATIC
+        connectTimeout: 5s
+        loadAssignment:
+          clusterName: kuma:metrics:merger
+          endpoints:
+          - lbEndpoints:
+            - endpoint:
+                address:
+                  pipe:
+                    path: /tmp/kuma-metrics-merger-backend-01-demo.sock
+`
+		// when
+		resource := CreatePipeCluster("kuma:metrics:merger", "/tmp/kuma-metrics-merger-backend-01-demo.sock")
+
+		// then
+		actual, err := util_proto.ToYAML(resource)
+
+		Expect(err).ToNot(HaveOccurred())
+		Expect(actual).To(MatchYAML(expected))
+	})
+
 	It("should generate 'pass-through' Cluster", func() {
 		// given
 		expected := `
@@ -73,13 +100,14 @@ var _ = Describe("Clusters", func() {
 		type testCase struct {
 			ctx      xds_context.Context
 			metadata core_xds.DataplaneMetadata
+			timeout  *mesh_core.TimeoutResource
 			expected string
 		}
 
 		DescribeTable("should generate 'EDS' Cluster",
 			func(given testCase) {
 				// when
-				resource, err := CreateEdsCluster(given.ctx, "192.168.0.1:8080", &given.metadata)
+				resource, err := CreateEdsCluster(given.ctx, "192.168.0.1:8080", &given.metadata, given.timeout)
 				// then
 				Expect(err).ToNot(HaveOccurred())
 
@@ -106,6 +134,31 @@ var _ = Describe("Clusters", func() {
                 name: 192.168.0.1:8080
                 altStatName: "192_168_0_1_8080"
                 type: EDS
+`,
+			}),
+			Entry("with connect timeout", testCase{
+				ctx: xds_context.Context{
+					ControlPlane: &xds_context.ControlPlaneContext{},
+					Mesh: xds_context.MeshContext{
+						Resource: &mesh_core.MeshResource{},
+					},
+				},
+				metadata: core_xds.DataplaneMetadata{},
+				timeout: &mesh_core.TimeoutResource{
+					Spec: mesh_proto.Timeout{
+						Conf: &mesh_proto.Timeout_Conf{
+							ConnectTimeout: ptypes.DurationProto(500 * time.Millisecond),
+						},
+					},
+				},
+				expected: `
+                connectTimeout: 0.500s
+                edsClusterConfig:
+                  edsConfig:
+                    ads: {}
+                name: 192.168.0.1:8080
+                altStatName: "192_168_0_1_8080"
+                type: EDS
 `,
 			}),
 			Entry("with mTLS", testCase{
@@ -245,6 +298,214 @@ var _ = Describe("Clusters", func() {
 		)
 	})
 
+	Describe("'DNS' Cluster", func() {
+
+		ctx := xds_context.Context{
+			ControlPlane: &xds_context.ControlPlaneContext{
+				SdsLocation: "kuma-control-plane:5677",
+				SdsTlsCert:  []byte("CERTIFICATE"),
+			},
+		}
+
+		type testCase struct {
+			endpoints []core_xds.Endpoint
+			timeout   *mesh_core.TimeoutResource
+			expected  string
+		}
+
+		DescribeTable("should generate 'DNS' Cluster",
+			func(given testCase) {
+				// when
+				resource, err := CreateDnsCluster(ctx, "httpbin", &core_xds.DataplaneMetadata{}, given.endpoints, given.timeout)
+				// then
+				Expect(err).ToNot(HaveOccurred())
+
+				// when
+				actual, err := util_proto.ToYAML(resource)
+				// then
+				Expect(err).ToNot(HaveOccurred())
+
+				Expect(actual).To(MatchYAML(given.expected))
+			},
+			Entry("single endpoint without TLS", testCase{
+				endpoints: []core_xds.Endpoint{
+					{
+						Target:          "httpbin.org",
+						Port:            80,
+						Tags:            map[string]string{"service": "httpbin"},
+						ExternalService: &core_xds.ExternalService{},
+					},
+				},
+				expected: `
+                name: httpbin
+                type: LOGICAL_DNS
+                connectTimeout: 5s
+                loadAssignment:
+                  clusterName: httpbin
+                  endpoints:
+                  - lbEndpoints:
+                    - endpoint:
+                        address:
+                          socketAddress:
+                            address: httpbin.org
+                            portValue: 80
+                      metadata:
+                        filterMetadata:
+                          envoy.lb:
+                            service: httpbin
+`,
+			}),
+			Entry("multiple endpoints with connect timeout", testCase{
+				endpoints: []core_xds.Endpoint{
+					{
+						Target:          "10.0.0.10",
+						Port:            5432,
+						ExternalService: &core_xds.ExternalService{},
+					},
+					{
+						Target:          "10.0.0.11",
+						Port:            5432,
+						ExternalService: &core_xds.ExternalService{},
+					},
+				},
+				timeout: &mesh_core.TimeoutResource{
+					Spec: mesh_proto.Timeout{
+						Conf: &mesh_proto.Timeout_Conf{
+							ConnectTimeout: ptypes.DurationProto(500 * time.Millisecond),
+						},
+					},
+				},
+				expected: `
+                name: httpbin
+                type: STRICT_DNS
+                connectTimeout: 0.500s
+                loadAssignment:
+                  clusterName: httpbin
+                  endpoints:
+                  - lbEndpoints:
+                    - endpoint:
+                        address:
+                          socketAddress:
+                            address: 10.0.0.10
+                            portValue: 5432
+                    - endpoint:
+                        address:
+                          socketAddress:
+                            address: 10.0.0.11
+                            portValue: 5432
+`,
+			}),
+			Entry("with TLS", testCase{
+				endpoints: []core_xds.Endpoint{
+					{
+						Target: "httpbin.org",
+						Port:   443,
+						ExternalService: &core_xds.ExternalService{
+							Name:             "httpbin",
+							TLSEnabled:       true,
+							CaCertSecret:     "httpbin-ca",
+							ClientCertSecret: "httpbin-cert",
+							ClientKeySecret:  "httpbin-key",
+						},
+					},
+				},
+				expected: `
+                name: httpbin
+                type: LOGICAL_DNS
+                connectTimeout: 5s
+                loadAssignment:
+                  clusterName: httpbin
+                  endpoints:
+                  - lbEndpoints:
+                    - endpoint:
+                        address:
+                          socketAddress:
+                            address: httpbin.org
+                            portValue: 443
+                transportSocket:
+                  name: envoy.transport_sockets.tls
+                  typedConfig:
+                    '@type': type.googleapis.com/envoy.api.v2.auth.UpstreamTlsContext
+                    commonTlsContext:
+                      tlsCertificateSdsSecretConfigs:
+                      - name: external_service_client_cert:httpbin
+                        sdsConfig:
+                          apiConfigSource:
+                            apiType: GRPC
+                            grpcServices:
+                            - googleGrpc:
+                                channelCredentials:
+                                  sslCredentials:
+                                    rootCerts:
+                                      inlineBytes: Q0VSVElGSUNBVEU=
+                                statPrefix: sds_external_service_client_cert_httpbin
+                                targetUri: kuma-control-plane:5677
+                      validationContextSdsSecretConfig:
+                        name: external_service_ca:httpbin
+                        sdsConfig:
+                          apiConfigSource:
+                            apiType: GRPC
+                            grpcServices:
+                            - googleGrpc:
+                                channelCredentials:
+                                  sslCredentials:
+                                    rootCerts:
+                                      inlineBytes: Q0VSVElGSUNBVEU=
+                                statPrefix: sds_external_service_ca_httpbin
+                                targetUri: kuma-control-plane:5677
+                    sni: httpbin.org
+`,
+			}),
+			Entry("with TLS and explicit server name", testCase{
+				endpoints: []core_xds.Endpoint{
+					{
+						Target: "10.0.0.20",
+						Port:   443,
+						ExternalService: &core_xds.ExternalService{
+							Name:         "httpbin",
+							TLSEnabled:   true,
+							ServerName:   "api.example.com",
+							CaCertSecret: "httpbin-ca",
+						},
+					},
+				},
+				expected: `
+                name: httpbin
+                type: LOGICAL_DNS
+                connectTimeout: 5s
+                loadAssignment:
+                  clusterName: httpbin
+                  endpoints:
+                  - lbEndpoints:
+                    - endpoint:
+                        address:
+                          socketAddress:
+                            address: 10.0.0.20
+                            portValue: 443
+                transportSocket:
+                  name: envoy.transport_sockets.tls
+                  typedConfig:
+                    '@type': type.googleapis.com/envoy.api.v2.auth.UpstreamTlsContext
+                    commonTlsContext:
+                      validationContextSdsSecretConfig:
+                        name: external_service_ca:httpbin
+                        sdsConfig:
+                          apiConfigSource:
+                            apiType: GRPC
+                            grpcServices:
+                            - googleGrpc:
+                                channelCredentials:
+                                  sslCredentials:
+                                    rootCerts:
+                                      inlineBytes: Q0VSVElGSUNBVEU=
+                                statPrefix: sds_external_service_ca_httpbin
+                                targetUri: kuma-control-plane:5677
+                    sni: api.example.com
+`,
+			}),
+		)
+	})
+
 	Describe("ClusterWithHealthChecks()", func() {
 
 		type testCase struct {
@@ -258,7 +519,7 @@ var _ = Describe("Clusters", func() {
 					Name: "example",
 				}
 				// when
-				metadata := ClusterWithHealthChecks(cluster, given.healthCheck)
+				metadata := ClusterWithHealthChecks(cluster, given.healthCheck, "redis")
 				// and
 				actual, err := util_proto.ToYAML(metadata)
 				// then
@@ -364,9 +625,262 @@ var _ = Describe("Clusters", func() {
                 outlierDetection:
                   consecutive5xx: 20
                   interval: 30s
+`,
+			}),
+			Entry("HealthCheck with HTTP active checks with default settings", testCase{
+				healthCheck: &mesh_core.HealthCheckResource{
+					Spec: mesh_proto.HealthCheck{
+						Conf: &mesh_proto.HealthCheck_Conf{
+							ActiveChecks: &mesh_proto.HealthCheck_Conf_Active{
+								Interval:           ptypes.DurationProto(5 * time.Second),
+								Timeout:            ptypes.DurationProto(4 * time.Second),
+								UnhealthyThreshold: 3,
+								HealthyThreshold:   2,
+								Http:               &mesh_proto.HealthCheck_Conf_Active_Http{},
+							},
+						},
+					},
+				},
+				expected: `
+                healthChecks:
+                - healthyThreshold: 2
+                  httpHealthCheck:
+                    host: redis
+                    path: /
+                  interval: 5s
+                  timeout: 4s
+                  unhealthyThreshold: 3
+                name: example
+`,
+			}),
+			Entry("HealthCheck with HTTP active checks and healthy panic threshold", testCase{
+				healthCheck: &mesh_core.HealthCheckResource{
+					Spec: mesh_proto.HealthCheck{
+						Conf: &mesh_proto.HealthCheck_Conf{
+							ActiveChecks: &mesh_proto.HealthCheck_Conf_Active{
+								Interval:           ptypes.DurationProto(5 * time.Second),
+								Timeout:            ptypes.DurationProto(4 * time.Second),
+								UnhealthyThreshold: 3,
+								HealthyThreshold:   2,
+								NoTrafficInterval:  ptypes.DurationProto(120 * time.Second),
+								Http: &mesh_proto.HealthCheck_Conf_Active_Http{
+									Path: "/health",
+									Host: "redis.internal",
+									ExpectedStatuses: []*mesh_proto.HealthCheck_Conf_Active_Http_StatusRange{
+										{Start: 200, End: 300},
+									},
+									RequestHeaders: []*mesh_proto.HealthCheck_Conf_Active_Http_Header{
+										{Name: "x-health-check", Value: "true"},
+									},
+								},
+							},
+							HealthyPanicThreshold: &wrappers.UInt32Value{Value: 0},
+						},
+					},
+				},
+				expected: `
+                commonLbConfig:
+                  healthyPanicThreshold: {}
+                healthChecks:
+                - healthyThreshold: 2
+                  httpHealthCheck:
+                    expectedStatuses:
+                    - end: "300"
+                      start: "200"
+                    host: redis.internal
+                    path: /health
+                    requestHeadersToAdd:
+                    - header:
+                        key: x-health-check
+                        value: "true"
+                  interval: 5s
+                  noTrafficInterval: 120s
+                  timeout: 4s
+                  unhealthyThreshold: 3
+                name: example
 `,
 			}),
 		)
 	})
 
+	Describe("ClusterWithCircuitBreaker()", func() {
+
+		type testCase struct {
+			circuitBreaker string
+			expected       string
+		}
+		DescribeTable("should add circuit breaker to a given Cluster",
+			func(given testCase) {
+				// given
+				cluster := &envoy_v2.Cluster{
+					Name: "example",
+				}
+				var circuitBreaker *mesh_core.CircuitBreakerResource
+				if given.circuitBreaker != "" {
+					circuitBreaker = &mesh_core.CircuitBreakerResource{}
+					Expect(util_proto.FromYAML([]byte(given.circuitBreaker), &circuitBreaker.Spec)).To(Succeed())
+				}
+				// when
+				metadata := ClusterWithCircuitBreaker(cluster, circuitBreaker)
+				// and
+				actual, err := util_proto.ToYAML(metadata)
+				// then
+				Expect(err).ToNot(HaveOccurred())
+				Expect(actual).To(MatchYAML(given.expected))
+			},
+			Entry("`nil` CircuitBreaker", testCase{
+				circuitBreaker: "",
+				expected: `
+                name: example
+`,
+			}),
+			Entry("CircuitBreaker with thresholds", testCase{
+				circuitBreaker: `
+                conf:
+                  thresholds:
+                    maxConnections: 1024
+                    maxPendingRequests: 128
+                    maxRequests: 1024
+                    maxRetries: 3
+`,
+				expected: `
+                circuitBreakers:
+                  thresholds:
+                  - maxConnections: 1024
+                    maxPendingRequests: 128
+                    maxRequests: 1024
+                    maxRetries: 3
+                name: example
+`,
+			}),
+			Entry("CircuitBreaker with consecutive 5xx detector", testCase{
+				circuitBreaker: `
+                conf:
+                  outlierDetection:
+                    consecutive5xx: 5
+                    interval: 10s
+                    baseEjectionTime: 30s
+                    maxEjectionPercent: 50
+`,
+				expected: `
+                name: example
+                outlierDetection:
+                  baseEjectionTime: 30s
+                  consecutive5xx: 5
+                  enforcingSuccessRate: 0
+                  interval: 10s
+                  maxEjectionPercent: 50
+`,
+			}),
+			Entry("CircuitBreaker with gateway failure and success rate detectors", testCase{
+				circuitBreaker: `
+                conf:
+                  outlierDetection:
+                    consecutiveGatewayFailure: 3
+                    successRate:
+                      minimumHosts: 5
+                      requestVolume: 100
+                      stdevFactor: 1900
+`,
+				expected: `
+                name: example
+                outlierDetection:
+                  consecutiveGatewayFailure: 3
+                  enforcingConsecutive5xx: 0
+                  enforcingConsecutiveGatewayFailure: 100
+                  successRateMinimumHosts: 5
+                  successRateRequestVolume: 100
+                  successRateStdevFactor: 1900
+`,
+			}),
+		)
+	})
+
+	Describe("ClusterWithLoadBalancer()", func() {
+
+		type testCase struct {
+			loadBalancer string
+			expected     string
+		}
+		DescribeTable("should configure load balancing algorithm of a given Cluster",
+			func(given testCase) {
+				// given
+				cluster := &envoy_v2.Cluster{
+					Name: "example",
+				}
+				var loadBalancer *mesh_proto.TrafficRoute_LoadBalancer
+				if given.loadBalancer != "" {
+					loadBalancer = &mesh_proto.TrafficRoute_LoadBalancer{}
+					Expect(util_proto.FromYAML([]byte(given.loadBalancer), loadBalancer)).To(Succeed())
+				}
+				// when
+				resource := ClusterWithLoadBalancer(cluster, loadBalancer)
+				// and
+				actual, err := util_proto.ToYAML(resource)
+				// then
+				Expect(err).ToNot(HaveOccurred())
+				Expect(actual).To(MatchYAML(given.expected))
+			},
+			Entry("`nil` LoadBalancer", testCase{
+				loadBalancer: "",
+				expected: `
+                name: example
+`,
+			}),
+			Entry("round robin", testCase{
+				loadBalancer: `
+                roundRobin: {}
+`,
+				expected: `
+                name: example
+`,
+			}),
+			Entry("least request", testCase{
+				loadBalancer: `
+                leastRequest:
+                  choiceCount: 4
+`,
+				expected: `
+                name: example
+                lbPolicy: LEAST_REQUEST
+                leastRequestLbConfig:
+                  choiceCount: 4
+`,
+			}),
+			Entry("random", testCase{
+				loadBalancer: `
+                random: {}
+`,
+				expected: `
+                name: example
+                lbPolicy: RANDOM
+`,
+			}),
+			Entry("ring hash", testCase{
+				loadBalancer: `
+                ringHash:
+                  hashFunction: MURMUR_HASH_2
+                  minRingSize: 64
+                  maxRingSize: 1024
+`,
+				expected: `
+                name: example
+                lbPolicy: RING_HASH
+                ringHashLbConfig:
+                  hashFunction: MURMUR_HASH_2
+                  minimumRingSize: "64"
+                  maximumRingSize: "1024"
+`,
+			}),
+			Entry("maglev", testCase{
+				loadBalancer: `
+                maglev: {}
+`,
+				expected: `
+                name: example
+                lbPolicy: MAGLEV
+`,
+			}),
+		)
+	})
 })
@@ -1,6 +1,8 @@
 package endpoints
 
 import (
+	"sort"
+
 	pstruct "github.com/golang/protobuf/ptypes/struct"
 
 	v2 "github.com/envoyproxy/go-control-plane/envoy/api/v2"
@@ -35,7 +37,98 @@ func CreateStaticEndpoint(clusterName string, address string, port uint32) *v2.C
 	}
 }
 
+// CreatePipeEndpoint creates a static endpoint that represents a Unix socket at a given path.
+func CreatePipeEndpoint(clusterName string, path string) *v2.ClusterLoadAssignment {
+	return &v2.ClusterLoadAssignment{
+		ClusterName: clusterName,
+		Endpoints: []*envoy_endpoint.LocalityLbEndpoints{{
+			LbEndpoints: []*envoy_endpoint.LbEndpoint{{
+				HostIdentifier: &envoy_endpoint.LbEndpoint_Endpoint{
+					Endpoint: &envoy_endpoint.Endpoint{
+						Address: &envoy_core.Address{
+							Address: &envoy_core.Address_Pipe{
+								Pipe: &envoy_core.Pipe{
+									Path: path,
+								},
+							},
+						},
+					},
+				},
+			}},
+		}},
+	}
+}
+
 func CreateClusterLoadAssignment(clusterName string, endpoints []core_xds.Endpoint) *v2.ClusterLoadAssignment {
+	return &v2.ClusterLoadAssignment{
+		ClusterName: clusterName,
+		Endpoints: []*envoy_endpoint.LocalityLbEndpoints{{
+			LbEndpoints: createLbEndpoints(endpoints),
+		}},
+	}
+}
+
+// CreateLocalityAwareClusterLoadAssignment creates a ClusterLoadAssignment where endpoints
+// are grouped into localities according to their `region` and `zone` tags.
+//
+// Localities are prioritized relative to a given locality of a source dataplane:
+// the same zone comes first, then other zones of the same region, then other regions.
+// Envoy fails over to a locality with a lower priority once there are not enough
+// healthy endpoints in localities with a higher one.
+func CreateLocalityAwareClusterLoadAssignment(clusterName string, endpoints []core_xds.Endpoint, local core_xds.Locality) *v2.ClusterLoadAssignment {
+	byLocality := map[core_xds.Locality][]core_xds.Endpoint{}
+	var localities []core_xds.Locality
+	for _, endpoint := range endpoints {
+		locality := endpoint.Locality()
+		if _, ok := byLocality[locality]; !ok {
+			localities = append(localities, locality)
+		}
+		byLocality[locality] = append(byLocality[locality], endpoint)
+	}
+	sort.SliceStable(localities, func(i, j int) bool {
+		pi, pj := localityPriority(local, localities[i]), localityPriority(local, localities[j])
+		if pi != pj {
+			return pi < pj
+		}
+		if localities[i].Region != localities[j].Region {
+			return localities[i].Region < localities[j].Region
+		}
+		return localities[i].Zone < localities[j].Zone
+	})
+	localityLbEndpoints := make([]*envoy_endpoint.LocalityLbEndpoints, 0, len(localities))
+	// Envoy requires priorities to be contiguous, starting from 0
+	priority := uint32(0)
+	for i, locality := range localities {
+		if i > 0 && localityPriority(local, locality) != localityPriority(local, localities[i-1]) {
+			priority++
+		}
+		localityLbEndpoints = append(localityLbEndpoints, &envoy_endpoint.LocalityLbEndpoints{
+			Locality: &envoy_core.Locality{
+				Region: locality.Region,
+				Zone:   locality.Zone,
+			},
+			LbEndpoints: createLbEndpoints(byLocality[locality]),
+			Priority:    priority,
+		})
+	}
+	return &v2.ClusterLoadAssignment{
+		ClusterName: clusterName,
+		Endpoints:   localityLbEndpoints,
+	}
+}
+
+func localityPriority(local, remote core_xds.Locality) int {
+	switch {
+	case local.Region == remote.Region && local.Zone == remote.Zone:
+		return 0
+	case local.Region == remote.Region:
+		return 1
+	default:
+		return 2
+	}
+}
+
+func createLbEndpoints(endpoints []core_xds.Endpoint) []*envoy_endpoint.LbEndpoint {
 	lbEndpoints := make([]*envoy_endpoint.LbEndpoint, 0, len(endpoints))
 	for _, ep := range endpoints {
 		lbEndpoints = append(lbEndpoints, &envoy_endpoint.LbEndpoint{
@@ -56,12 +149,7 @@ func CreateClusterLoadAssignment(clusterName string, endpoints []core_xds.Endpoi
 				}},
 		})
 	}
-	return &v2.ClusterLoadAssignment{
-		ClusterName: clusterName,
-		Endpoints: []*envoy_endpoint.LocalityLbEndpoints{{
-			LbEndpoints: lbEndpoints,
-		}},
-	}
+	return lbEndpoints
 }
 
 func CreateLbMetadata(tags map[string]string) *envoy_core.Metadata {
@@ -112,6 +112,150 @@ var _ = Describe("Endpoints", func() {
 		)
 	})
 
+	Describe("CreateLocalityAwareClusterLoadAssignment()", func() {
+		type testCase struct {
+			endpoints []core_xds.Endpoint
+			local     core_xds.Locality
+			expected  string
+		}
+		DescribeTable("should group endpoints into prioritized localities",
+			func(given testCase) {
+				// when
+				resource := CreateLocalityAwareClusterLoadAssignment("backend", given.endpoints, given.local)
+
+				// then
+				actual, err := util_proto.ToYAML(resource)
+
+				Expect(err).ToNot(HaveOccurred())
+				Expect(actual).To(MatchYAML(given.expected))
+			},
+			Entry("same zone, other zone of the same region, other region", testCase{
+				endpoints: []core_xds.Endpoint{
+					{Target: "192.168.0.1", Port: 8081, Tags: map[string]string{"region": "us", "zone": "us-east-1"}},
+					{Target: "192.168.0.2", Port: 8082, Tags: map[string]string{"region": "eu", "zone": "eu-west-1"}},
+					{Target: "192.168.0.3", Port: 8083, Tags: map[string]string{"region": "eu", "zone": "eu-west-2"}},
+					{Target: "192.168.0.4", Port: 8084, Tags: map[string]string{"region": "eu", "zone": "eu-west-1"}},
+				},
+				local: core_xds.Locality{Region: "eu", Zone: "eu-west-1"},
+				expected: `
+                clusterName: backend
+                endpoints:
+                - locality:
+                    region: eu
+                    zone: eu-west-1
+                  lbEndpoints:
+                  - endpoint:
+                      address:
+                        socketAddress:
+                          address: 192.168.0.2
+                          portValue: 8082
+                    metadata:
+                      filterMetadata:
+                        envoy.lb:
+                          region: eu
+                          zone: eu-west-1
+                  - endpoint:
+                      address:
+                        socketAddress:
+                          address: 192.168.0.4
+                          portValue: 8084
+                    metadata:
+                      filterMetadata:
+                        envoy.lb:
+                          region: eu
+                          zone: eu-west-1
+                - locality:
+                    region: eu
+                    zone: eu-west-2
+                  lbEndpoints:
+                  - endpoint:
+                      address:
+                        socketAddress:
+                          address: 192.168.0.3
+                          portValue: 8083
+                    metadata:
+                      filterMetadata:
+                        envoy.lb:
+                          region: eu
+                          zone: eu-west-2
+                  priority: 1
+                - locality:
+                    region: us
+                    zone: us-east-1
+                  lbEndpoints:
+                  - endpoint:
+                      address:
+                        socketAddress:
+                          address: 192.168.0.1
+                          portValue: 8081
+                    metadata:
+                      filterMetadata:
+                        envoy.lb:
+                          region: us
+                          zone: us-east-1
+                  priority: 2
+`,
+			}),
+			Entry("no endpoints in the same zone", testCase{
+				endpoints: []core_xds.Endpoint{
+					{Target: "192.168.0.1", Port: 8081, Tags: map[string]string{"region": "us", "zone": "us-east-1"}},
+					{Target: "192.168.0.2", Port: 8082, Tags: map[string]string{"region": "eu", "zone": "eu-west-2"}},
+				},
+				local: core_xds.Locality{Region: "eu", Zone: "eu-west-1"},
+				expected: `
+                clusterName: backend
+                endpoints:
+                - locality:
+                    region: eu
+                    zone: eu-west-2
+                  lbEndpoints:
+                  - endpoint:
+                      address:
+                        socketAddress:
+                          address: 192.168.0.2
+                          portValue: 8082
+                    metadata:
+                      filterMetadata:
+                        envoy.lb:
+                          region: eu
+                          zone: eu-west-2
+                - locality:
+                    region: us
+                    zone: us-east-1
+                  lbEndpoints:
+                  - endpoint:
+                      address:
+                        socketAddress:
+                          address: 192.168.0.1
+                          portValue: 8081
+                    metadata:
+                      filterMetadata:
+                        envoy.lb:
+                          region: us
+                          zone: us-east-1
+                  priority: 1
+`,
+			}),
+			Entry("endpoints without locality tags", testCase{
+				endpoints: []core_xds.Endpoint{
+					{Target: "192.168.0.1", Port: 8081},
+				},
+				local: core_xds.Locality{},
+				expected: `
+                clusterName: backend
+                endpoints:
+                - locality: {}
+                  lbEndpoints:
+                  - endpoint:
+                      address:
+                        socketAddress:
+                          address: 192.168.0.1
+                          portValue: 8081
+`,
+			}),
+		)
+	})
+
 	Describe("CreateLbMetadata()", func() {
 
 		It("should handle `nil` map of tags", func() {
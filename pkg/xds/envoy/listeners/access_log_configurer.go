@@ -2,36 +2,101 @@ package listeners
 
 import (
 	"fmt"
+	"math"
 	"net"
+	"strings"
 
 	"github.com/pkg/errors"
 
 	"github.com/golang/protobuf/ptypes"
+	pstruct "github.com/golang/protobuf/ptypes/struct"
+	"github.com/golang/protobuf/ptypes/wrappers"
 
 	envoy_core "github.com/envoyproxy/go-control-plane/envoy/api/v2/core"
+	envoy_route "github.com/envoyproxy/go-control-plane/envoy/api/v2/route"
 	envoy_accesslog "github.com/envoyproxy/go-control-plane/envoy/config/accesslog/v2"
 	filter_accesslog "github.com/envoyproxy/go-control-plane/envoy/config/filter/accesslog/v2"
+	envoy_type "github.com/envoyproxy/go-control-plane/envoy/type"
 	envoy_wellknown "github.com/envoyproxy/go-control-plane/pkg/wellknown"
 
 	mesh_proto "github.com/Kong/kuma/api/mesh/v1alpha1"
 	core_xds "github.com/Kong/kuma/pkg/core/xds"
 	"github.com/Kong/kuma/pkg/envoy/accesslog"
+	util_proto "github.com/Kong/kuma/pkg/util/proto"
 )
 
 const accessLogSink = "access_log_sink"
 
+// unknownValue is what Envoy renders in access logs for values that are not available.
+const unknownValue = "-"
+
+// Envoy requires runtime keys for access log filters. Since Kuma doesn't use runtime overrides,
+// these keys are never set and default values are used instead.
+const (
+	accessLogFilterRuntimeKey   = "kuma.access_log.filter"
+	accessLogSamplingRuntimeKey = "kuma.access_log.sampling"
+)
+
 type AccessLogConfigurer struct {
 	mesh               string
 	sourceService      string
 	destinationService string
 	backend            *mesh_proto.LoggingBackend
+	filters            []*mesh_proto.TrafficLog_Conf_Filter
 	proxy              *core_xds.Proxy
+	// inbound is true if access logs are configured for traffic received by a Dataplane.
+	inbound bool
+}
+
+func (c *AccessLogConfigurer) accessLog(defaultFormat string) (*filter_accesslog.AccessLog, error) {
+	accessLog, err := convertLoggingBackend(c.backend, c.interpolationVariables(), defaultFormat)
+	if err != nil {
+		return nil, err
+	}
+	filter, err := convertFilters(c.filters)
+	if err != nil {
+		return nil, err
+	}
+	accessLog.Filter = filter
+	return accessLog, nil
+}
+
+// interpolationVariables returns values of Kuma-specific placeholders.
+//
+// A source of inbound traffic is not known in advance, that is why on the inbound side
+// placeholders are resolved into Envoy command operators, e.g. a source identity is taken
+// from the URI SAN of the mTLS peer (spiffe://<mesh>/<service>).
+// Envoy cannot extract a service name out of it, so a source service is unknown ("-") on the inbound side.
+func (c *AccessLogConfigurer) interpolationVariables() accesslog.InterpolationVariables {
+	if c.inbound {
+		return accesslog.InterpolationVariables{
+			accesslog.CMD_KUMA_SOURCE_ADDRESS:              "%" + accesslog.CMD_DOWNSTREAM_REMOTE_ADDRESS + "%",
+			accesslog.CMD_KUMA_SOURCE_ADDRESS_WITHOUT_PORT: "%" + accesslog.CMD_DOWNSTREAM_REMOTE_ADDRESS_WITHOUT_PORT + "%",
+			accesslog.CMD_KUMA_SOURCE_SERVICE:              unknownValue,
+			accesslog.CMD_KUMA_SOURCE_IDENTITY:             "%" + accesslog.CMD_DOWNSTREAM_PEER_URI_SAN + "%",
+			accesslog.CMD_KUMA_DESTINATION_SERVICE:         c.destinationService,
+			accesslog.CMD_KUMA_MESH:                        c.mesh,
+		}
+	}
+	return accesslog.InterpolationVariables{
+		accesslog.CMD_KUMA_SOURCE_ADDRESS:              net.JoinHostPort(c.proxy.Dataplane.GetIP(), "0"), // deprecated variable
+		accesslog.CMD_KUMA_SOURCE_ADDRESS_WITHOUT_PORT: c.proxy.Dataplane.GetIP(),                        // replacement variable
+		accesslog.CMD_KUMA_SOURCE_SERVICE:              c.sourceService,
+		accesslog.CMD_KUMA_SOURCE_IDENTITY:             fmt.Sprintf("spiffe://%s/%s", c.mesh, c.sourceService),
+		accesslog.CMD_KUMA_DESTINATION_SERVICE:         c.destinationService,
+		accesslog.CMD_KUMA_MESH:                        c.mesh,
+	}
 }
 
-func convertLoggingBackend(mesh string, sourceService string, destinationService string, backend *mesh_proto.LoggingBackend, proxy *core_xds.Proxy, defaultFormat string) (*filter_accesslog.AccessLog, error) {
+func convertLoggingBackend(backend *mesh_proto.LoggingBackend, variables accesslog.InterpolationVariables, defaultFormat string) (*filter_accesslog.AccessLog, error) {
 	if backend == nil {
 		return nil, nil
 	}
+
+	if len(backend.JsonFormat) > 0 {
+		return convertJsonLoggingBackend(backend, variables)
+	}
+
 	formatString := defaultFormat
 	if backend.Format != "" {
 		formatString = backend.Format
@@ -41,32 +106,86 @@ func convertLoggingBackend(mesh string, sourceService string, destinationService
 		return nil, errors.Wrapf(err, "invalid access log format string: %s", formatString)
 	}
 
-	variables := accesslog.InterpolationVariables{
-		accesslog.CMD_KUMA_SOURCE_ADDRESS:              net.JoinHostPort(proxy.Dataplane.GetIP(), "0"), // deprecated variable
-		accesslog.CMD_KUMA_SOURCE_ADDRESS_WITHOUT_PORT: proxy.Dataplane.GetIP(),                        // replacement variable
-		accesslog.CMD_KUMA_SOURCE_SERVICE:              sourceService,
-		accesslog.CMD_KUMA_DESTINATION_SERVICE:         destinationService,
-		accesslog.CMD_KUMA_MESH:                        mesh,
+	interpolated, err := format.Interpolate(variables)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to interpolate access log format string with Kuma-specific variables: %s", formatString)
+	}
+	// placeholders might have been resolved into command operators
+	format, err = accesslog.ParseFormat(interpolated.String())
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid interpolated access log format string: %s", interpolated)
+	}
+
+	switch backend.GetType().(type) {
+	case *mesh_proto.LoggingBackend_File_:
+		return fileAccessLog(format, backend.GetFile())
+	case *mesh_proto.LoggingBackend_Tcp_:
+		return grpcAccessLog(format, fmt.Sprintf("%s;%s", backend.GetTcp().Address, format.String()))
+	case *mesh_proto.LoggingBackend_Syslog_, *mesh_proto.LoggingBackend_Http_:
+		backendSpec, err := loggingBackendSpec(backend, nil)
+		if err != nil {
+			return nil, err
+		}
+		return grpcAccessLog(format, fmt.Sprintf("%s;%s", backendSpec, format.String()))
+	default:
+		return nil, errors.Errorf("could not convert LoggingBackend of type %T to AccessLog", backend.GetType())
+	}
+}
+
+// convertJsonLoggingBackend converts a logging backend with JSON format.
+// Since the format is not a plain string, it is passed to kuma-dp as a part of the backend spec.
+func convertJsonLoggingBackend(backend *mesh_proto.LoggingBackend, variables accesslog.InterpolationVariables) (*filter_accesslog.AccessLog, error) {
+	format, err := accesslog.ParseJsonFormat(backend.JsonFormat)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid JSON access log format")
 	}
 
 	format, err = format.Interpolate(variables)
 	if err != nil {
-		return nil, errors.Wrapf(err, "failed to interpolate access log format string with Kuma-specific variables: %s", formatString)
+		return nil, errors.Wrap(err, "failed to interpolate JSON access log format with Kuma-specific variables")
+	}
+	// placeholders might have been resolved into command operators
+	format, err = accesslog.ParseJsonFormat(format.Map())
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid interpolated JSON access log format")
 	}
 
-	if file, ok := backend.GetType().(*mesh_proto.LoggingBackend_File_); ok {
-		return fileAccessLog(format, file)
-	} else if tcp, ok := backend.GetType().(*mesh_proto.LoggingBackend_Tcp_); ok {
-		return tcpAccessLog(format, tcp)
-	} else {
+	switch backend.GetType().(type) {
+	case *mesh_proto.LoggingBackend_File_:
+		return fileJsonAccessLog(format, backend.GetFile())
+	case *mesh_proto.LoggingBackend_Tcp_, *mesh_proto.LoggingBackend_Syslog_, *mesh_proto.LoggingBackend_Http_:
+		backendSpec, err := loggingBackendSpec(backend, format.Map())
+		if err != nil {
+			return nil, err
+		}
+		return grpcAccessLog(format, fmt.Sprintf("%s;", backendSpec))
+	default:
 		return nil, errors.Errorf("could not convert LoggingBackend of type %T to AccessLog", backend.GetType())
 	}
 }
 
-func tcpAccessLog(format *accesslog.AccessLogFormat, tcp *mesh_proto.LoggingBackend_Tcp_) (*filter_accesslog.AccessLog, error) {
+// loggingBackendSpec serializes a logging backend handled by kuma-dp into JSON,
+// which is then passed to kuma-dp as the first component of a log name.
+// Since components of a log name are separated by ';', occurrences of ';'
+// (which can only appear inside JSON strings) are escaped.
+func loggingBackendSpec(backend *mesh_proto.LoggingBackend, jsonFormat map[string]string) (string, error) {
+	spec, err := util_proto.ToJSON(&mesh_proto.LoggingBackend{
+		Name:       backend.Name,
+		Type:       backend.Type,
+		JsonFormat: jsonFormat,
+	})
+	if err != nil {
+		return "", errors.Wrapf(err, "could not marshal LoggingBackend %q", backend.Name)
+	}
+	return strings.ReplaceAll(string(spec), ";", `\u003b`), nil
+}
+
+// grpcAccessLog configures Envoy to stream access logs to kuma-dp, which then
+// delivers them to the actual backend identified by a log name.
+func grpcAccessLog(format accesslog.HttpLogConfigurer, logName string) (*filter_accesslog.AccessLog, error) {
 	httpGrpcAccessLog := &envoy_accesslog.HttpGrpcAccessLogConfig{
 		CommonConfig: &envoy_accesslog.CommonGrpcAccessLogConfig{
-			LogName: fmt.Sprintf("%s;%s", tcp.Tcp.Address, format.String()),
+			LogName: logName,
 			GrpcService: &envoy_core.GrpcService{
 				TargetSpecifier: &envoy_core.GrpcService_EnvoyGrpc_{
 					EnvoyGrpc: &envoy_core.GrpcService_EnvoyGrpc{
@@ -91,12 +210,12 @@ func tcpAccessLog(format *accesslog.AccessLogFormat, tcp *mesh_proto.LoggingBack
 	}, nil
 }
 
-func fileAccessLog(format *accesslog.AccessLogFormat, file *mesh_proto.LoggingBackend_File_) (*filter_accesslog.AccessLog, error) {
+func fileAccessLog(format *accesslog.AccessLogFormat, file *mesh_proto.LoggingBackend_File) (*filter_accesslog.AccessLog, error) {
 	fileAccessLog := &envoy_accesslog.FileAccessLog{
 		AccessLogFormat: &envoy_accesslog.FileAccessLog_Format{
 			Format: format.String(),
 		},
-		Path: file.File.Path,
+		Path: file.Path,
 	}
 	marshalled, err := ptypes.MarshalAny(fileAccessLog)
 	if err != nil {
@@ -109,3 +228,158 @@ func fileAccessLog(format *accesslog.AccessLogFormat, file *mesh_proto.LoggingBa
 		},
 	}, nil
 }
+
+func fileJsonAccessLog(format *accesslog.JsonAccessLogFormat, file *mesh_proto.LoggingBackend_File) (*filter_accesslog.AccessLog, error) {
+	fields := map[string]*pstruct.Value{}
+	for key, value := range format.Map() {
+		fields[key] = &pstruct.Value{Kind: &pstruct.Value_StringValue{StringValue: value}}
+	}
+	fileAccessLog := &envoy_accesslog.FileAccessLog{
+		AccessLogFormat: &envoy_accesslog.FileAccessLog_JsonFormat{
+			JsonFormat: &pstruct.Struct{Fields: fields},
+		},
+		Path: file.Path,
+	}
+	marshalled, err := ptypes.MarshalAny(fileAccessLog)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not marshall %T", fileAccessLog)
+	}
+	return &filter_accesslog.AccessLog{
+		Name: envoy_wellknown.FileAccessLog,
+		ConfigType: &filter_accesslog.AccessLog_TypedConfig{
+			TypedConfig: marshalled,
+		},
+	}, nil
+}
+
+// convertFilters converts filters of a TrafficLog into an Envoy access log filter.
+// Log entry is sent if it meets any of the filters, while a filter is met if all its conditions are.
+func convertFilters(filters []*mesh_proto.TrafficLog_Conf_Filter) (*filter_accesslog.AccessLogFilter, error) {
+	if len(filters) == 0 {
+		return nil, nil
+	}
+	converted := make([]*filter_accesslog.AccessLogFilter, len(filters))
+	for i, filter := range filters {
+		conditions, err := convertFilterConditions(filter)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not convert filters[%d]", i)
+		}
+		if len(conditions) == 0 {
+			return nil, nil // a filter without conditions is met by every log entry
+		}
+		converted[i] = allOf(conditions)
+	}
+	if len(converted) == 1 {
+		return converted[0], nil
+	}
+	return &filter_accesslog.AccessLogFilter{
+		FilterSpecifier: &filter_accesslog.AccessLogFilter_OrFilter{
+			OrFilter: &filter_accesslog.OrFilter{
+				Filters: converted,
+			},
+		},
+	}, nil
+}
+
+func convertFilterConditions(filter *mesh_proto.TrafficLog_Conf_Filter) ([]*filter_accesslog.AccessLogFilter, error) {
+	var conditions []*filter_accesslog.AccessLogFilter
+	if statusCodes := filter.GetStatusCodes(); statusCodes != nil {
+		conditions = append(conditions,
+			statusCodeCondition(filter_accesslog.ComparisonFilter_GE, statusCodes.Min),
+			statusCodeCondition(filter_accesslog.ComparisonFilter_LE, statusCodes.Max),
+		)
+	}
+	if filter.GetMinDuration() != nil {
+		minDuration, err := ptypes.Duration(filter.GetMinDuration())
+		if err != nil {
+			return nil, errors.Wrap(err, "invalid minDuration")
+		}
+		conditions = append(conditions, &filter_accesslog.AccessLogFilter{
+			FilterSpecifier: &filter_accesslog.AccessLogFilter_DurationFilter{
+				DurationFilter: &filter_accesslog.DurationFilter{
+					Comparison: comparison(filter_accesslog.ComparisonFilter_GE, uint32(minDuration.Milliseconds())),
+				},
+			},
+		})
+	}
+	if filter.GetOnlyErrors() {
+		conditions = append(conditions, &filter_accesslog.AccessLogFilter{
+			FilterSpecifier: &filter_accesslog.AccessLogFilter_ResponseFlagFilter{
+				ResponseFlagFilter: &filter_accesslog.ResponseFlagFilter{}, // no flags means any flag
+			},
+		})
+	}
+	for _, header := range filter.GetHeaders() {
+		conditions = append(conditions, &filter_accesslog.AccessLogFilter{
+			FilterSpecifier: &filter_accesslog.AccessLogFilter_HeaderFilter{
+				HeaderFilter: &filter_accesslog.HeaderFilter{
+					Header: &envoy_route.HeaderMatcher{
+						Name: header,
+						HeaderMatchSpecifier: &envoy_route.HeaderMatcher_PresentMatch{
+							PresentMatch: true,
+						},
+					},
+				},
+			},
+		})
+	}
+	if filter.GetSampling() != nil {
+		conditions = append(conditions, &filter_accesslog.AccessLogFilter{
+			FilterSpecifier: &filter_accesslog.AccessLogFilter_RuntimeFilter{
+				RuntimeFilter: &filter_accesslog.RuntimeFilter{
+					RuntimeKey:               accessLogSamplingRuntimeKey,
+					PercentSampled:           samplingFraction(filter.GetSampling()),
+					UseIndependentRandomness: true,
+				},
+			},
+		})
+	}
+	return conditions, nil
+}
+
+func samplingFraction(percentage *wrappers.DoubleValue) *envoy_type.FractionalPercent {
+	value := percentage.GetValue()
+	if math.Floor(value) == value {
+		return &envoy_type.FractionalPercent{
+			Numerator:   uint32(value),
+			Denominator: envoy_type.FractionalPercent_HUNDRED,
+		}
+	}
+	return &envoy_type.FractionalPercent{
+		Numerator:   uint32(math.Round(value * 10000)),
+		Denominator: envoy_type.FractionalPercent_MILLION,
+	}
+}
+
+func allOf(conditions []*filter_accesslog.AccessLogFilter) *filter_accesslog.AccessLogFilter {
+	if len(conditions) == 1 {
+		return conditions[0]
+	}
+	return &filter_accesslog.AccessLogFilter{
+		FilterSpecifier: &filter_accesslog.AccessLogFilter_AndFilter{
+			AndFilter: &filter_accesslog.AndFilter{
+				Filters: conditions,
+			},
+		},
+	}
+}
+
+func statusCodeCondition(op filter_accesslog.ComparisonFilter_Op, value uint32) *filter_accesslog.AccessLogFilter {
+	return &filter_accesslog.AccessLogFilter{
+		FilterSpecifier: &filter_accesslog.AccessLogFilter_StatusCodeFilter{
+			StatusCodeFilter: &filter_accesslog.StatusCodeFilter{
+				Comparison: comparison(op, value),
+			},
+		},
+	}
+}
+
+func comparison(op filter_accesslog.ComparisonFilter_Op, value uint32) *filter_accesslog.ComparisonFilter {
+	return &filter_accesslog.ComparisonFilter{
+		Op: op,
+		Value: &envoy_core.RuntimeUInt32{
+			DefaultValue: value,
+			RuntimeKey:   accessLogFilterRuntimeKey,
+		},
+	}
+}
@@ -28,43 +28,97 @@ type FaultInjectionConfigurer struct {
 	faultInjection *mesh_proto.FaultInjection
 }
 
+// FaultAbortRequestHeader is a request header that asks for an abort in the header-controlled mode.
+//
+// Unlike delays and bandwidth limits, an HTTP status of an abort cannot be taken from a request header,
+// so the status configured in FaultInjection is returned.
+const FaultAbortRequestHeader = "x-envoy-fault-abort-request"
+
 func (f *FaultInjectionConfigurer) Configure(filterChain *envoy_listener.FilterChain) error {
 	if f.faultInjection == nil {
 		return nil
 	}
 
-	config := &envoy_http_fault.HTTPFault{
-		Delay: convertDelay(f.faultInjection.Conf.GetDelay()),
-		Abort: convertAbort(f.faultInjection.Conf.GetAbort()),
-		Headers: []*envoy_api_v2_route.HeaderMatcher{
-			createHeaders(f.faultInjection.SourceTags()),
-		},
+	var headers []*envoy_api_v2_route.HeaderMatcher
+	if !f.faultInjection.IsSourceSide() {
+		// on the outbound listener of a source there is no need to match tags of the source
+		headers = append(headers, createHeaders(f.faultInjection.SourceTags()))
 	}
 
-	rrl, err := convertResponseRateLimit(f.faultInjection.Conf.GetResponseBandwidth())
-	if err != nil {
-		return err
+	var configs []*envoy_http_fault.HTTPFault
+	if f.faultInjection.Conf.GetHeaderControlled() {
+		configs = headerControlledFaults(f.faultInjection.Conf, headers)
+	} else {
+		config := &envoy_http_fault.HTTPFault{
+			Delay:   convertDelay(f.faultInjection.Conf.GetDelay()),
+			Abort:   convertAbort(f.faultInjection.Conf.GetAbort()),
+			Headers: headers,
+		}
+
+		rrl, err := convertResponseRateLimit(f.faultInjection.Conf.GetResponseBandwidth())
+		if err != nil {
+			return err
+		}
+		config.ResponseRateLimit = rrl
+		configs = append(configs, config)
 	}
-	config.ResponseRateLimit = rrl
 
-	pbst, err := ptypes.MarshalAny(config)
-	if err != nil {
-		return err
+	var filters []*envoy_hcm.HttpFilter
+	for _, config := range configs {
+		pbst, err := ptypes.MarshalAny(config)
+		if err != nil {
+			return err
+		}
+		filters = append(filters, &envoy_hcm.HttpFilter{
+			Name: envoy_wellknown.Fault,
+			ConfigType: &envoy_hcm.HttpFilter_TypedConfig{
+				TypedConfig: pbst,
+			},
+		})
 	}
 
 	return UpdateHTTPConnectionManager(filterChain, func(manager *envoy_hcm.HttpConnectionManager) error {
-		manager.HttpFilters = append([]*envoy_hcm.HttpFilter{
-			{
-				Name: envoy_wellknown.Fault,
-				ConfigType: &envoy_hcm.HttpFilter_TypedConfig{
-					TypedConfig: pbst,
-				},
-			},
-		}, manager.HttpFilters...)
+		manager.HttpFilters = append(filters, manager.HttpFilters...)
 		return nil
 	})
 }
 
+// headerControlledFaults creates faults that are injected only into requests with Envoy fault headers.
+// An abort needs a separate filter, since it is triggered by a presence of FaultAbortRequestHeader.
+func headerControlledFaults(conf *mesh_proto.FaultInjection_Conf, headers []*envoy_api_v2_route.HeaderMatcher) []*envoy_http_fault.HTTPFault {
+	var configs []*envoy_http_fault.HTTPFault
+	if conf.GetDelay() != nil || conf.GetResponseBandwidth() != nil {
+		config := &envoy_http_fault.HTTPFault{
+			Headers: headers,
+		}
+		if delay := conf.GetDelay(); delay != nil {
+			config.Delay = &envoy_filter_fault.FaultDelay{
+				FaultDelaySecifier: &envoy_filter_fault.FaultDelay_HeaderDelay_{HeaderDelay: &envoy_filter_fault.FaultDelay_HeaderDelay{}},
+				Percentage:         ConvertPercentage(delay.GetPercentage()),
+			}
+		}
+		if responseBandwidth := conf.GetResponseBandwidth(); responseBandwidth != nil {
+			config.ResponseRateLimit = &envoy_filter_fault.FaultRateLimit{
+				LimitType:  &envoy_filter_fault.FaultRateLimit_HeaderLimit_{HeaderLimit: &envoy_filter_fault.FaultRateLimit_HeaderLimit{}},
+				Percentage: ConvertPercentage(responseBandwidth.GetPercentage()),
+			}
+		}
+		configs = append(configs, config)
+	}
+	if conf.GetAbort() != nil {
+		abortHeaders := append([]*envoy_api_v2_route.HeaderMatcher{}, headers...)
+		abortHeaders = append(abortHeaders, &envoy_api_v2_route.HeaderMatcher{
+			Name:                 FaultAbortRequestHeader,
+			HeaderMatchSpecifier: &envoy_api_v2_route.HeaderMatcher_PresentMatch{PresentMatch: true},
+		})
+		configs = append(configs, &envoy_http_fault.HTTPFault{
+			Abort:   convertAbort(conf.GetAbort()),
+			Headers: abortHeaders,
+		})
+	}
+	return configs
+}
+
 func createHeaders(selectors []mesh_proto.SingleValueTagSet) *envoy_api_v2_route.HeaderMatcher {
 	var selectorRegexs []string
 	for _, selector := range selectors {
@@ -118,5 +118,104 @@ var _ = Describe("FaultInjectionConfigurer", func() {
                 - name: envoy.router
                 statPrefix: stats`,
 		}),
+		Entry("source side", testCase{
+			input: &mesh_proto.FaultInjection{
+				Sources: []*mesh_proto.Selector{
+					{
+						Match: map[string]string{
+							"tag1": "value1",
+						},
+					},
+				},
+				Conf: &mesh_proto.FaultInjection_Conf{
+					Side: mesh_proto.FaultInjectionSideSource,
+					Abort: &mesh_proto.FaultInjection_Conf_Abort{
+						Percentage: &wrappers.DoubleValue{Value: 50},
+						HttpStatus: &wrappers.UInt32Value{Value: 503},
+					},
+				},
+			},
+
+			expected: `
+            filters:
+            - name: envoy.http_connection_manager
+              typedConfig:
+                '@type': type.googleapis.com/envoy.config.filter.network.http_connection_manager.v2.HttpConnectionManager
+                httpFilters:
+                - name: envoy.fault
+                  typedConfig:
+                    '@type': type.googleapis.com/envoy.config.filter.http.fault.v2.HTTPFault
+                    abort:
+                      httpStatus: 503
+                      percentage:
+                        numerator: 50
+                - name: envoy.router
+                statPrefix: stats`,
+		}),
+		Entry("header-controlled mode", testCase{
+			input: &mesh_proto.FaultInjection{
+				Sources: []*mesh_proto.Selector{
+					{
+						Match: map[string]string{
+							"tag1": "value1",
+						},
+					},
+				},
+				Conf: &mesh_proto.FaultInjection_Conf{
+					HeaderControlled: true,
+					Delay: &mesh_proto.FaultInjection_Conf_Delay{
+						Percentage: &wrappers.DoubleValue{Value: 50},
+					},
+					Abort: &mesh_proto.FaultInjection_Conf_Abort{
+						Percentage: &wrappers.DoubleValue{Value: 10},
+						HttpStatus: &wrappers.UInt32Value{Value: 503},
+					},
+					ResponseBandwidth: &mesh_proto.FaultInjection_Conf_ResponseBandwidth{
+						Percentage: &wrappers.DoubleValue{Value: 100},
+					},
+				},
+			},
+
+			expected: `
+            filters:
+            - name: envoy.http_connection_manager
+              typedConfig:
+                '@type': type.googleapis.com/envoy.config.filter.network.http_connection_manager.v2.HttpConnectionManager
+                httpFilters:
+                - name: envoy.fault
+                  typedConfig:
+                    '@type': type.googleapis.com/envoy.config.filter.http.fault.v2.HTTPFault
+                    delay:
+                      headerDelay: {}
+                      percentage:
+                        numerator: 50
+                    headers:
+                    - name: x-kuma-tags
+                      safeRegexMatch:
+                        googleRe2:
+                          maxProgramSize: 500
+                        regex: '&tag1=[^&]*value1[,&].*'
+                    responseRateLimit:
+                      headerLimit: {}
+                      percentage:
+                        numerator: 100
+                - name: envoy.fault
+                  typedConfig:
+                    '@type': type.googleapis.com/envoy.config.filter.http.fault.v2.HTTPFault
+                    abort:
+                      httpStatus: 503
+                      percentage:
+                        numerator: 10
+                    headers:
+                    - name: x-kuma-tags
+                      safeRegexMatch:
+                        googleRe2:
+                          maxProgramSize: 500
+                        regex: '&tag1=[^&]*value1[,&].*'
+                    - name: x-envoy-fault-abort-request
+                      presentMatch: true
+                - name: envoy.router
+                statPrefix: stats`,
+		}),
 	)
 })
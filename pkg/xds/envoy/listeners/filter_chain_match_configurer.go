@@ -0,0 +1,28 @@
+package listeners
+
+import (
+	envoy_listener "github.com/envoyproxy/go-control-plane/envoy/api/v2/listener"
+)
+
+func MatchTransportProtocol(transportProtocol string) FilterChainBuilderOpt {
+	return FilterChainBuilderOptFunc(func(config *FilterChainBuilderConfig) {
+		config.Add(&FilterChainMatchConfigurer{
+			transportProtocol: transportProtocol,
+		})
+	})
+}
+
+// FilterChainMatchConfigurer restricts a filter chain to connections of a given transport protocol.
+//
+// Transport protocol of a connection is only known when TLSInspector is configured on the listener.
+type FilterChainMatchConfigurer struct {
+	transportProtocol string
+}
+
+func (c *FilterChainMatchConfigurer) Configure(filterChain *envoy_listener.FilterChain) error {
+	if filterChain.FilterChainMatch == nil {
+		filterChain.FilterChainMatch = &envoy_listener.FilterChainMatch{}
+	}
+	filterChain.FilterChainMatch.TransportProtocol = c.transportProtocol
+	return nil
+}
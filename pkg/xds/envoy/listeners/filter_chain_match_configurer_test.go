@@ -0,0 +1,40 @@
+package listeners_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	. "github.com/Kong/kuma/pkg/xds/envoy/listeners"
+
+	envoy_common "github.com/Kong/kuma/pkg/xds/envoy"
+
+	util_proto "github.com/Kong/kuma/pkg/util/proto"
+)
+
+var _ = Describe("FilterChainMatchConfigurer", func() {
+
+	It("should generate proper Envoy config", func() {
+		// when
+		filterChain, err := NewFilterChainBuilder().
+			Configure(TcpProxy("localhost:8080", envoy_common.ClusterInfo{Name: "localhost:8080"})).
+			Configure(MatchTransportProtocol("tls")).
+			Build()
+		// then
+		Expect(err).ToNot(HaveOccurred())
+
+		// when
+		actual, err := util_proto.ToYAML(filterChain)
+		Expect(err).ToNot(HaveOccurred())
+		// and
+		Expect(actual).To(MatchYAML(`
+            filterChainMatch:
+              transportProtocol: tls
+            filters:
+            - name: envoy.tcp_proxy
+              typedConfig:
+                '@type': type.googleapis.com/envoy.config.filter.network.tcp_proxy.v2.TcpProxy
+                cluster: localhost:8080
+                statPrefix: localhost_8080
+`))
+	})
+})
@@ -0,0 +1,39 @@
+package listeners
+
+import (
+	envoy_listener "github.com/envoyproxy/go-control-plane/envoy/api/v2/listener"
+	envoy_tcp "github.com/envoyproxy/go-control-plane/envoy/config/filter/network/tcp_proxy/v2"
+	envoy_type "github.com/envoyproxy/go-control-plane/envoy/type"
+
+	mesh_proto "github.com/Kong/kuma/api/mesh/v1alpha1"
+)
+
+// HashPolicies applies hash policies of a load balancer to a TCP proxy.
+//
+// Only source IP hash policies are applicable to TCP traffic, other ones are ignored.
+func HashPolicies(lb *mesh_proto.TrafficRoute_LoadBalancer) FilterChainBuilderOpt {
+	return FilterChainBuilderOptFunc(func(config *FilterChainBuilderConfig) {
+		for _, policy := range lb.GetHashPolicies() {
+			if policy.GetSourceIp() != nil {
+				config.Add(&HashPolicyConfigurer{})
+				return
+			}
+		}
+	})
+}
+
+type HashPolicyConfigurer struct {
+}
+
+func (c *HashPolicyConfigurer) Configure(filterChain *envoy_listener.FilterChain) error {
+	return UpdateTCPProxy(filterChain, func(tcpProxy *envoy_tcp.TcpProxy) error {
+		tcpProxy.HashPolicy = []*envoy_type.HashPolicy{
+			{
+				PolicySpecifier: &envoy_type.HashPolicy_SourceIp_{
+					SourceIp: &envoy_type.HashPolicy_SourceIp{},
+				},
+			},
+		}
+		return nil
+	})
+}
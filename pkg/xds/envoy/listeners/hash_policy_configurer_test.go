@@ -0,0 +1,93 @@
+package listeners_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+
+	. "github.com/Kong/kuma/pkg/xds/envoy/listeners"
+
+	mesh_proto "github.com/Kong/kuma/api/mesh/v1alpha1"
+	util_proto "github.com/Kong/kuma/pkg/util/proto"
+	envoy_common "github.com/Kong/kuma/pkg/xds/envoy"
+)
+
+var _ = Describe("HashPolicyConfigurer", func() {
+
+	type testCase struct {
+		loadBalancer string
+		expected     string
+	}
+
+	DescribeTable("should generate proper Envoy config",
+		func(given testCase) {
+			// setup
+			loadBalancer := &mesh_proto.TrafficRoute_LoadBalancer{}
+			Expect(util_proto.FromYAML([]byte(given.loadBalancer), loadBalancer)).To(Succeed())
+
+			// when
+			listener, err := NewListenerBuilder().
+				Configure(OutboundListener("outbound:127.0.0.1:5432", "127.0.0.1", 5432)).
+				Configure(FilterChain(NewFilterChainBuilder().
+					Configure(TcpProxy("db", envoy_common.ClusterInfo{Name: "db"})).
+					Configure(HashPolicies(loadBalancer)))).
+				Build()
+			// then
+			Expect(err).ToNot(HaveOccurred())
+
+			// when
+			actual, err := util_proto.ToYAML(listener)
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			// and
+			Expect(actual).To(MatchYAML(given.expected))
+		},
+		Entry("without source ip hash policy", testCase{
+			loadBalancer: `
+            ringHash: {}
+            hashPolicies:
+            - header:
+                name: x-session-id
+`,
+			expected: `
+            name: outbound:127.0.0.1:5432
+            address:
+              socketAddress:
+                address: 127.0.0.1
+                portValue: 5432
+            filterChains:
+            - filters:
+              - name: envoy.tcp_proxy
+                typedConfig:
+                  '@type': type.googleapis.com/envoy.config.filter.network.tcp_proxy.v2.TcpProxy
+                  cluster: db
+                  statPrefix: db
+            trafficDirection: OUTBOUND
+`,
+		}),
+		Entry("with source ip hash policy", testCase{
+			loadBalancer: `
+            maglev: {}
+            hashPolicies:
+            - sourceIp: {}
+`,
+			expected: `
+            name: outbound:127.0.0.1:5432
+            address:
+              socketAddress:
+                address: 127.0.0.1
+                portValue: 5432
+            filterChains:
+            - filters:
+              - name: envoy.tcp_proxy
+                typedConfig:
+                  '@type': type.googleapis.com/envoy.config.filter.network.tcp_proxy.v2.TcpProxy
+                  cluster: db
+                  hashPolicy:
+                  - sourceIp: {}
+                  statPrefix: db
+            trafficDirection: OUTBOUND
+`,
+		}),
+	)
+})
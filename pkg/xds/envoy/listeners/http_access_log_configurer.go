@@ -4,22 +4,26 @@ import (
 	envoy_listener "github.com/envoyproxy/go-control-plane/envoy/api/v2/listener"
 	envoy_hcm "github.com/envoyproxy/go-control-plane/envoy/config/filter/network/http_connection_manager/v2"
 
-	mesh_proto "github.com/Kong/kuma/api/mesh/v1alpha1"
 	core_xds "github.com/Kong/kuma/pkg/core/xds"
 )
 
 const defaultHttpAccessLogFormat = `[%START_TIME%] %KUMA_MESH% "%REQ(:METHOD)% %REQ(X-ENVOY-ORIGINAL-PATH?:PATH)% %PROTOCOL%" %RESPONSE_CODE% %RESPONSE_FLAGS% %BYTES_RECEIVED% %BYTES_SENT% %DURATION% %RESP(X-ENVOY-UPSTREAM-SERVICE-TIME)% "%REQ(X-FORWARDED-FOR)%" "%REQ(USER-AGENT)%" "%REQ(X-REQUEST-ID)%" "%REQ(:AUTHORITY)%" "%KUMA_SOURCE_SERVICE%" "%KUMA_DESTINATION_SERVICE%" "%KUMA_SOURCE_ADDRESS_WITHOUT_PORT%" "%UPSTREAM_HOST%"
 ` // intentional newline at the end
 
-func HttpAccessLog(mesh string, sourceService string, destinationService string, backend *mesh_proto.LoggingBackend, proxy *core_xds.Proxy) FilterChainBuilderOpt {
+// defaultHttpInboundAccessLogFormat identifies a source by its mTLS identity since a source service is not known on the inbound side.
+const defaultHttpInboundAccessLogFormat = `[%START_TIME%] %KUMA_MESH% "%REQ(:METHOD)% %REQ(X-ENVOY-ORIGINAL-PATH?:PATH)% %PROTOCOL%" %RESPONSE_CODE% %RESPONSE_FLAGS% %BYTES_RECEIVED% %BYTES_SENT% %DURATION% %RESP(X-ENVOY-UPSTREAM-SERVICE-TIME)% "%REQ(X-FORWARDED-FOR)%" "%REQ(USER-AGENT)%" "%REQ(X-REQUEST-ID)%" "%REQ(:AUTHORITY)%" "%KUMA_SOURCE_IDENTITY%" "%KUMA_DESTINATION_SERVICE%" "%KUMA_SOURCE_ADDRESS_WITHOUT_PORT%" "%UPSTREAM_HOST%"
+` // intentional newline at the end
+
+func HttpAccessLog(mesh string, sourceService string, destinationService string, log *core_xds.AccessLog, proxy *core_xds.Proxy) FilterChainBuilderOpt {
 	return FilterChainBuilderOptFunc(func(config *FilterChainBuilderConfig) {
-		if backend != nil {
+		if log != nil && log.Backend != nil {
 			config.Add(&HttpAccessLogConfigurer{
 				AccessLogConfigurer: AccessLogConfigurer{
 					mesh:               mesh,
 					sourceService:      sourceService,
 					destinationService: destinationService,
-					backend:            backend,
+					backend:            log.Backend,
+					filters:            log.Filters,
 					proxy:              proxy,
 				},
 			})
@@ -27,12 +31,35 @@ func HttpAccessLog(mesh string, sourceService string, destinationService string,
 	})
 }
 
+// HttpInboundAccessLog configures access logs for traffic received by a Dataplane.
+// Since a source of inbound traffic is not known in advance, its identity is taken from the mTLS peer.
+func HttpInboundAccessLog(mesh string, destinationService string, log *core_xds.AccessLog, proxy *core_xds.Proxy) FilterChainBuilderOpt {
+	return FilterChainBuilderOptFunc(func(config *FilterChainBuilderConfig) {
+		if log != nil && log.Backend != nil {
+			config.Add(&HttpAccessLogConfigurer{
+				AccessLogConfigurer: AccessLogConfigurer{
+					mesh:               mesh,
+					destinationService: destinationService,
+					backend:            log.Backend,
+					filters:            log.Filters,
+					proxy:              proxy,
+					inbound:            true,
+				},
+			})
+		}
+	})
+}
+
 type HttpAccessLogConfigurer struct {
 	AccessLogConfigurer
 }
 
 func (c *HttpAccessLogConfigurer) Configure(filterChain *envoy_listener.FilterChain) error {
-	accessLog, err := convertLoggingBackend(c.AccessLogConfigurer.mesh, c.AccessLogConfigurer.sourceService, c.AccessLogConfigurer.destinationService, c.AccessLogConfigurer.backend, c.AccessLogConfigurer.proxy, defaultHttpAccessLogFormat)
+	defaultFormat := defaultHttpAccessLogFormat
+	if c.inbound {
+		defaultFormat = defaultHttpInboundAccessLogFormat
+	}
+	accessLog, err := c.AccessLogConfigurer.accessLog(defaultFormat)
 	if err != nil {
 		return err
 	}
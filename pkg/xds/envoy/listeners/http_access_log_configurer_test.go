@@ -61,7 +61,7 @@ var _ = Describe("HttpAccessLogConfigurer", func() {
 				Configure(FilterChain(NewFilterChainBuilder().
 					Configure(HttpConnectionManager(given.statsName)).
 					Configure(HttpOutboundRoute(given.routeName)).
-					Configure(HttpAccessLog(mesh, sourceService, destinationService, given.backend, proxy)))).
+					Configure(HttpAccessLog(mesh, sourceService, destinationService, &core_xds.AccessLog{Backend: given.backend}, proxy)))).
 				Build()
 			// then
 			Expect(err).ToNot(HaveOccurred())
@@ -198,7 +198,238 @@ var _ = Describe("HttpAccessLogConfigurer", func() {
                     routeConfigName: outbound:backend
                   statPrefix: backend
             trafficDirection: OUTBOUND
+`,
+		}),
+		Entry("basic http_connection_manager with syslog access log", testCase{
+			listenerName:    "outbound:127.0.0.1:27070",
+			listenerAddress: "127.0.0.1",
+			listenerPort:    27070,
+			statsName:       "backend",
+			routeName:       "outbound:backend",
+			backend: &mesh_proto.LoggingBackend{
+				Name:   "syslog",
+				Format: `[%START_TIME%] %KUMA_SOURCE_SERVICE%(%KUMA_SOURCE_IDENTITY%);%KUMA_DESTINATION_SERVICE%`,
+				Type: &mesh_proto.LoggingBackend_Syslog_{
+					Syslog: &mesh_proto.LoggingBackend_Syslog{
+						Address:   "syslog.local:514",
+						Transport: "tcp",
+						AppName:   "web;api",
+					},
+				},
+			},
+			expected: `
+            name: outbound:127.0.0.1:27070
+            address:
+              socketAddress:
+                address: 127.0.0.1
+                portValue: 27070
+            filterChains:
+            - filters:
+              - name: envoy.http_connection_manager
+                typedConfig:
+                  '@type': type.googleapis.com/envoy.config.filter.network.http_connection_manager.v2.HttpConnectionManager
+                  accessLog:
+                  - name: envoy.http_grpc_access_log
+                    typedConfig:
+                      '@type': type.googleapis.com/envoy.config.accesslog.v2.HttpGrpcAccessLogConfig
+                      commonConfig:
+                        grpcService:
+                          envoyGrpc:
+                            clusterName: access_log_sink
+                        logName: '{"name":"syslog","syslog":{"address":"syslog.local:514","transport":"tcp","appName":"web\u003bapi"}};[%START_TIME%] web(spiffe://demo/web);backend'
+                  httpFilters:
+                  - name: envoy.router
+                  rds:
+                    configSource:
+                      ads: {}
+                    routeConfigName: outbound:backend
+                  statPrefix: backend
+            trafficDirection: OUTBOUND
+`,
+		}),
+		Entry("basic http_connection_manager with file access log in JSON format", testCase{
+			listenerName:    "outbound:127.0.0.1:27070",
+			listenerAddress: "127.0.0.1",
+			listenerPort:    27070,
+			statsName:       "backend",
+			routeName:       "outbound:backend",
+			backend: &mesh_proto.LoggingBackend{
+				Name: "file",
+				JsonFormat: map[string]string{
+					"start_time":  "%START_TIME%",
+					"method":      "%REQ(:METHOD)%",
+					"source":      "%KUMA_SOURCE_SERVICE%",
+					"destination": "%KUMA_DESTINATION_SERVICE%",
+				},
+				Type: &mesh_proto.LoggingBackend_File_{
+					File: &mesh_proto.LoggingBackend_File{
+						Path: "/tmp/log",
+					},
+				},
+			},
+			expected: `
+            name: outbound:127.0.0.1:27070
+            address:
+              socketAddress:
+                address: 127.0.0.1
+                portValue: 27070
+            filterChains:
+            - filters:
+              - name: envoy.http_connection_manager
+                typedConfig:
+                  '@type': type.googleapis.com/envoy.config.filter.network.http_connection_manager.v2.HttpConnectionManager
+                  accessLog:
+                  - name: envoy.file_access_log
+                    typedConfig:
+                      '@type': type.googleapis.com/envoy.config.accesslog.v2.FileAccessLog
+                      jsonFormat:
+                        destination: backend
+                        method: '%REQ(:method)%'
+                        source: web
+                        start_time: '%START_TIME%'
+                      path: /tmp/log
+                  httpFilters:
+                  - name: envoy.router
+                  rds:
+                    configSource:
+                      ads: {}
+                    routeConfigName: outbound:backend
+                  statPrefix: backend
+            trafficDirection: OUTBOUND
 `,
 		}),
 	)
+
+	It("should render filters of log entries", func() {
+		// given
+		proxy := &core_xds.Proxy{
+			Id: xds.ProxyId{
+				Name: "web",
+				Mesh: "demo",
+			},
+			Dataplane: &mesh_core.DataplaneResource{
+				Spec: mesh_proto.Dataplane{
+					Networking: &mesh_proto.Dataplane_Networking{
+						Address: "192.168.0.1",
+					},
+				},
+			},
+		}
+		conf := &mesh_proto.TrafficLog_Conf{}
+		err := util_proto.FromYAML([]byte(`
+        filters:
+        - statusCodes:
+            min: 500
+            max: 599
+        - statusCodes:
+            min: 200
+            max: 299
+          sampling: 1.5
+        - minDuration: 2s
+          onlyErrors: true
+          headers:
+          - x-debug
+`), conf)
+		Expect(err).ToNot(HaveOccurred())
+		// and
+		log := &core_xds.AccessLog{
+			Backend: &mesh_proto.LoggingBackend{
+				Name:   "file",
+				Format: "%RESPONSE_CODE%",
+				Type: &mesh_proto.LoggingBackend_File_{
+					File: &mesh_proto.LoggingBackend_File{
+						Path: "/tmp/log",
+					},
+				},
+			},
+			Filters: conf.Filters,
+		}
+
+		// when
+		listener, err := NewListenerBuilder().
+			Configure(OutboundListener("outbound:127.0.0.1:27070", "127.0.0.1", 27070)).
+			Configure(FilterChain(NewFilterChainBuilder().
+				Configure(HttpConnectionManager("backend")).
+				Configure(HttpAccessLog("demo", "web", "backend", log, proxy)))).
+			Build()
+		// then
+		Expect(err).ToNot(HaveOccurred())
+
+		// when
+		actual, err := util_proto.ToYAML(listener)
+		Expect(err).ToNot(HaveOccurred())
+		// and
+		Expect(actual).To(MatchYAML(`
+            name: outbound:127.0.0.1:27070
+            trafficDirection: OUTBOUND
+            address:
+              socketAddress:
+                address: 127.0.0.1
+                portValue: 27070
+            filterChains:
+            - filters:
+              - name: envoy.http_connection_manager
+                typedConfig:
+                  '@type': type.googleapis.com/envoy.config.filter.network.http_connection_manager.v2.HttpConnectionManager
+                  accessLog:
+                  - name: envoy.file_access_log
+                    filter:
+                      orFilter:
+                        filters:
+                        - andFilter:
+                            filters:
+                            - statusCodeFilter:
+                                comparison:
+                                  op: GE
+                                  value:
+                                    defaultValue: 500
+                                    runtimeKey: kuma.access_log.filter
+                            - statusCodeFilter:
+                                comparison:
+                                  op: LE
+                                  value:
+                                    defaultValue: 599
+                                    runtimeKey: kuma.access_log.filter
+                        - andFilter:
+                            filters:
+                            - statusCodeFilter:
+                                comparison:
+                                  op: GE
+                                  value:
+                                    defaultValue: 200
+                                    runtimeKey: kuma.access_log.filter
+                            - statusCodeFilter:
+                                comparison:
+                                  op: LE
+                                  value:
+                                    defaultValue: 299
+                                    runtimeKey: kuma.access_log.filter
+                            - runtimeFilter:
+                                percentSampled:
+                                  denominator: MILLION
+                                  numerator: 15000
+                                runtimeKey: kuma.access_log.sampling
+                                useIndependentRandomness: true
+                        - andFilter:
+                            filters:
+                            - durationFilter:
+                                comparison:
+                                  op: GE
+                                  value:
+                                    defaultValue: 2000
+                                    runtimeKey: kuma.access_log.filter
+                            - responseFlagFilter: {}
+                            - headerFilter:
+                                header:
+                                  name: x-debug
+                                  presentMatch: true
+                    typedConfig:
+                      '@type': type.googleapis.com/envoy.config.accesslog.v2.FileAccessLog
+                      format: '%RESPONSE_CODE%'
+                      path: /tmp/log
+                  httpFilters:
+                  - name: envoy.router
+                  statPrefix: backend
+`))
+	})
 })
@@ -0,0 +1,127 @@
+package listeners
+
+import (
+	"sort"
+
+	"github.com/golang/protobuf/ptypes"
+
+	envoy_listener "github.com/envoyproxy/go-control-plane/envoy/api/v2/listener"
+	envoy_route "github.com/envoyproxy/go-control-plane/envoy/api/v2/route"
+	http_rbac "github.com/envoyproxy/go-control-plane/envoy/config/filter/http/rbac/v2"
+	envoy_hcm "github.com/envoyproxy/go-control-plane/envoy/config/filter/network/http_connection_manager/v2"
+	rbac_config "github.com/envoyproxy/go-control-plane/envoy/config/rbac/v2"
+	envoy_wellknown "github.com/envoyproxy/go-control-plane/pkg/wellknown"
+
+	mesh_proto "github.com/Kong/kuma/api/mesh/v1alpha1"
+	mesh_core "github.com/Kong/kuma/pkg/core/resources/apis/mesh"
+)
+
+// HttpRBAC restricts HTTP requests permitted by HTTP rules of a given TrafficPermission.
+//
+// It complements NetworkRBAC, which permits or denies connections as a whole,
+// and is only added if a TrafficPermission has HTTP rules.
+func HttpRBAC(rbacEnabled bool, permission *mesh_core.TrafficPermissionResource) FilterChainBuilderOpt {
+	return FilterChainBuilderOptFunc(func(config *FilterChainBuilderConfig) {
+		if rbacEnabled && permission != nil && len(permission.Spec.GetHttp()) > 0 {
+			config.Add(&HttpRBACConfigurer{
+				permission: permission,
+			})
+		}
+	})
+}
+
+type HttpRBACConfigurer struct {
+	permission *mesh_core.TrafficPermissionResource
+}
+
+func (c *HttpRBACConfigurer) Configure(filterChain *envoy_listener.FilterChain) error {
+	rbacRule := &http_rbac.RBAC{
+		Rules: &rbac_config.RBAC{
+			Action: rbac_config.RBAC_ALLOW,
+			Policies: map[string]*rbac_config.Policy{
+				c.permission.GetMeta().GetName(): {
+					Permissions: createHttpPermissions(c.permission.Spec.GetHttp()),
+					Principals:  createPrincipals(c.permission),
+				},
+			},
+		},
+	}
+	pbst, err := ptypes.MarshalAny(rbacRule)
+	if err != nil {
+		return err
+	}
+	return UpdateHTTPConnectionManager(filterChain, func(manager *envoy_hcm.HttpConnectionManager) error {
+		// RBAC filter should be the first in the chain
+		manager.HttpFilters = append([]*envoy_hcm.HttpFilter{
+			{
+				Name: envoy_wellknown.HTTPRoleBasedAccessControl,
+				ConfigType: &envoy_hcm.HttpFilter_TypedConfig{
+					TypedConfig: pbst,
+				},
+			},
+		}, manager.HttpFilters...)
+		return nil
+	})
+}
+
+func createHttpPermissions(rules []*mesh_proto.TrafficPermission_Http) []*rbac_config.Permission {
+	var permissions []*rbac_config.Permission
+	for _, rule := range rules {
+		var conditions []*rbac_config.Permission
+		if rule.GetPathPrefix() != "" {
+			conditions = append(conditions, headerPermission(&envoy_route.HeaderMatcher{
+				Name: ":path",
+				HeaderMatchSpecifier: &envoy_route.HeaderMatcher_PrefixMatch{
+					PrefixMatch: rule.GetPathPrefix(),
+				},
+			}))
+		}
+		if len(rule.GetMethods()) > 0 {
+			var methods []*rbac_config.Permission
+			for _, method := range rule.GetMethods() {
+				methods = append(methods, headerPermission(&envoy_route.HeaderMatcher{
+					Name: ":method",
+					HeaderMatchSpecifier: &envoy_route.HeaderMatcher_ExactMatch{
+						ExactMatch: method,
+					},
+				}))
+			}
+			conditions = append(conditions, &rbac_config.Permission{
+				Rule: &rbac_config.Permission_OrRules{
+					OrRules: &rbac_config.Permission_Set{
+						Rules: methods,
+					},
+				},
+			})
+		}
+		var names []string
+		for name := range rule.GetHeaders() {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			conditions = append(conditions, headerPermission(&envoy_route.HeaderMatcher{
+				Name: name,
+				HeaderMatchSpecifier: &envoy_route.HeaderMatcher_ExactMatch{
+					ExactMatch: rule.GetHeaders()[name],
+				},
+			}))
+		}
+		permissions = append(permissions, &rbac_config.Permission{
+			Rule: &rbac_config.Permission_AndRules{
+				AndRules: &rbac_config.Permission_Set{
+					Rules: conditions,
+				},
+			},
+		})
+	}
+	return permissions
+}
+
+func headerPermission(matcher *envoy_route.HeaderMatcher) *rbac_config.Permission {
+	return &rbac_config.Permission{
+		Rule: &rbac_config.Permission_Header{
+			Header: matcher,
+		},
+	}
+}
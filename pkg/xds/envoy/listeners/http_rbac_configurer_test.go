@@ -0,0 +1,191 @@
+package listeners_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+
+	. "github.com/Kong/kuma/pkg/xds/envoy/listeners"
+
+	mesh_proto "github.com/Kong/kuma/api/mesh/v1alpha1"
+	mesh_core "github.com/Kong/kuma/pkg/core/resources/apis/mesh"
+
+	test_model "github.com/Kong/kuma/pkg/test/resources/model"
+	util_proto "github.com/Kong/kuma/pkg/util/proto"
+)
+
+var _ = Describe("HttpRbacConfigurer", func() {
+
+	type testCase struct {
+		rbacEnabled bool
+		permission  *mesh_core.TrafficPermissionResource
+		expected    string
+	}
+
+	DescribeTable("should generate proper Envoy config",
+		func(given testCase) {
+			// when
+			listener, err := NewListenerBuilder().
+				Configure(InboundListener("inbound:192.168.0.1:8080", "192.168.0.1", 8080)).
+				Configure(FilterChain(NewFilterChainBuilder().
+					Configure(HttpConnectionManager("localhost:8080")).
+					Configure(HttpRBAC(given.rbacEnabled, given.permission)))).
+				Build()
+			// then
+			Expect(err).ToNot(HaveOccurred())
+
+			// when
+			actual, err := util_proto.ToYAML(listener)
+			Expect(err).ToNot(HaveOccurred())
+			// and
+			Expect(actual).To(MatchYAML(given.expected))
+		},
+		Entry("TrafficPermission without HTTP rules", testCase{
+			rbacEnabled: true,
+			permission: &mesh_core.TrafficPermissionResource{
+				Meta: &test_model.ResourceMeta{
+					Name: "tp-1",
+					Mesh: "default",
+				},
+				Spec: mesh_proto.TrafficPermission{
+					Sources: []*mesh_proto.Selector{
+						{
+							Match: map[string]string{
+								"service": "billing",
+							},
+						},
+					},
+				},
+			},
+			expected: `
+            name: inbound:192.168.0.1:8080
+            address:
+              socketAddress:
+                address: 192.168.0.1
+                portValue: 8080
+            filterChains:
+            - filters:
+              - name: envoy.http_connection_manager
+                typedConfig:
+                  '@type': type.googleapis.com/envoy.config.filter.network.http_connection_manager.v2.HttpConnectionManager
+                  httpFilters:
+                  - name: envoy.router
+                  statPrefix: localhost_8080
+            trafficDirection: INBOUND
+`,
+		}),
+		Entry("TrafficPermission with HTTP rules and RBAC disabled", testCase{
+			rbacEnabled: false,
+			permission: &mesh_core.TrafficPermissionResource{
+				Meta: &test_model.ResourceMeta{
+					Name: "tp-1",
+					Mesh: "default",
+				},
+				Spec: mesh_proto.TrafficPermission{
+					Sources: []*mesh_proto.Selector{
+						{
+							Match: map[string]string{
+								"service": "billing",
+							},
+						},
+					},
+					Http: []*mesh_proto.TrafficPermission_Http{
+						{
+							PathPrefix: "/invoices",
+						},
+					},
+				},
+			},
+			expected: `
+            name: inbound:192.168.0.1:8080
+            address:
+              socketAddress:
+                address: 192.168.0.1
+                portValue: 8080
+            filterChains:
+            - filters:
+              - name: envoy.http_connection_manager
+                typedConfig:
+                  '@type': type.googleapis.com/envoy.config.filter.network.http_connection_manager.v2.HttpConnectionManager
+                  httpFilters:
+                  - name: envoy.router
+                  statPrefix: localhost_8080
+            trafficDirection: INBOUND
+`,
+		}),
+		Entry("TrafficPermission with HTTP rules", testCase{
+			rbacEnabled: true,
+			permission: &mesh_core.TrafficPermissionResource{
+				Meta: &test_model.ResourceMeta{
+					Name: "tp-1",
+					Mesh: "default",
+				},
+				Spec: mesh_proto.TrafficPermission{
+					Sources: []*mesh_proto.Selector{
+						{
+							Match: map[string]string{
+								"service": "billing",
+							},
+						},
+					},
+					Http: []*mesh_proto.TrafficPermission_Http{
+						{
+							PathPrefix: "/invoices",
+							Methods:    []string{"GET", "HEAD"},
+						},
+						{
+							Headers: map[string]string{
+								"x-role": "admin",
+							},
+						},
+					},
+				},
+			},
+			expected: `
+            name: inbound:192.168.0.1:8080
+            address:
+              socketAddress:
+                address: 192.168.0.1
+                portValue: 8080
+            filterChains:
+            - filters:
+              - name: envoy.http_connection_manager
+                typedConfig:
+                  '@type': type.googleapis.com/envoy.config.filter.network.http_connection_manager.v2.HttpConnectionManager
+                  httpFilters:
+                  - name: envoy.filters.http.rbac
+                    typedConfig:
+                      '@type': type.googleapis.com/envoy.config.filter.http.rbac.v2.RBAC
+                      rules:
+                        policies:
+                          tp-1:
+                            permissions:
+                            - andRules:
+                                rules:
+                                - header:
+                                    name: :path
+                                    prefixMatch: /invoices
+                                - orRules:
+                                    rules:
+                                    - header:
+                                        exactMatch: GET
+                                        name: :method
+                                    - header:
+                                        exactMatch: HEAD
+                                        name: :method
+                            - andRules:
+                                rules:
+                                - header:
+                                    exactMatch: admin
+                                    name: x-role
+                            principals:
+                            - authenticated:
+                                principalName:
+                                  exact: spiffe://default/billing
+                  - name: envoy.router
+                  statPrefix: localhost_8080
+            trafficDirection: INBOUND
+`,
+		}),
+	)
+})
@@ -4,22 +4,26 @@ import (
 	envoy_listener "github.com/envoyproxy/go-control-plane/envoy/api/v2/listener"
 	envoy_tcp "github.com/envoyproxy/go-control-plane/envoy/config/filter/network/tcp_proxy/v2"
 
-	"github.com/Kong/kuma/api/mesh/v1alpha1"
 	core_xds "github.com/Kong/kuma/pkg/core/xds"
 )
 
 const defaultNetworkAccessLogFormat = `[%START_TIME%] %RESPONSE_FLAGS% %KUMA_MESH% %KUMA_SOURCE_ADDRESS_WITHOUT_PORT%(%KUMA_SOURCE_SERVICE%)->%UPSTREAM_HOST%(%KUMA_DESTINATION_SERVICE%) took %DURATION%ms, sent %BYTES_SENT% bytes, received: %BYTES_RECEIVED% bytes
 ` // intentional newline at the end
 
-func NetworkAccessLog(mesh string, sourceService string, destinationService string, backend *v1alpha1.LoggingBackend, proxy *core_xds.Proxy) FilterChainBuilderOpt {
+// defaultNetworkInboundAccessLogFormat identifies a source by its mTLS identity since a source service is not known on the inbound side.
+const defaultNetworkInboundAccessLogFormat = `[%START_TIME%] %RESPONSE_FLAGS% %KUMA_MESH% %KUMA_SOURCE_ADDRESS_WITHOUT_PORT%(%KUMA_SOURCE_IDENTITY%)->%UPSTREAM_HOST%(%KUMA_DESTINATION_SERVICE%) took %DURATION%ms, sent %BYTES_SENT% bytes, received: %BYTES_RECEIVED% bytes
+` // intentional newline at the end
+
+func NetworkAccessLog(mesh string, sourceService string, destinationService string, log *core_xds.AccessLog, proxy *core_xds.Proxy) FilterChainBuilderOpt {
 	return FilterChainBuilderOptFunc(func(config *FilterChainBuilderConfig) {
-		if backend != nil {
+		if log != nil && log.Backend != nil {
 			config.Add(&NetworkAccessLogConfigurer{
 				AccessLogConfigurer: AccessLogConfigurer{
 					mesh:               mesh,
 					sourceService:      sourceService,
 					destinationService: destinationService,
-					backend:            backend,
+					backend:            log.Backend,
+					filters:            log.Filters,
 					proxy:              proxy,
 				},
 			})
@@ -27,12 +31,35 @@ func NetworkAccessLog(mesh string, sourceService string, destinationService stri
 	})
 }
 
+// NetworkInboundAccessLog configures access logs for traffic received by a Dataplane.
+// Since a source of inbound traffic is not known in advance, its identity is taken from the mTLS peer.
+func NetworkInboundAccessLog(mesh string, destinationService string, log *core_xds.AccessLog, proxy *core_xds.Proxy) FilterChainBuilderOpt {
+	return FilterChainBuilderOptFunc(func(config *FilterChainBuilderConfig) {
+		if log != nil && log.Backend != nil {
+			config.Add(&NetworkAccessLogConfigurer{
+				AccessLogConfigurer: AccessLogConfigurer{
+					mesh:               mesh,
+					destinationService: destinationService,
+					backend:            log.Backend,
+					filters:            log.Filters,
+					proxy:              proxy,
+					inbound:            true,
+				},
+			})
+		}
+	})
+}
+
 type NetworkAccessLogConfigurer struct {
 	AccessLogConfigurer
 }
 
 func (c *NetworkAccessLogConfigurer) Configure(filterChain *envoy_listener.FilterChain) error {
-	accessLog, err := convertLoggingBackend(c.AccessLogConfigurer.mesh, c.AccessLogConfigurer.sourceService, c.AccessLogConfigurer.destinationService, c.AccessLogConfigurer.backend, c.AccessLogConfigurer.proxy, defaultNetworkAccessLogFormat)
+	defaultFormat := defaultNetworkAccessLogFormat
+	if c.inbound {
+		defaultFormat = defaultNetworkInboundAccessLogFormat
+	}
+	accessLog, err := c.AccessLogConfigurer.accessLog(defaultFormat)
 	if err != nil {
 		return err
 	}
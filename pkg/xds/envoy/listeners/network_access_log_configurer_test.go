@@ -63,7 +63,7 @@ var _ = Describe("NetworkAccessLogConfigurer", func() {
 				Configure(OutboundListener(given.listenerName, given.listenerAddress, given.listenerPort)).
 				Configure(FilterChain(NewFilterChainBuilder().
 					Configure(TcpProxy(given.statsName, given.clusters...)).
-					Configure(NetworkAccessLog(meshName, sourceService, destinationService, given.backend, proxy)))).
+					Configure(NetworkAccessLog(meshName, sourceService, destinationService, &core_xds.AccessLog{Backend: given.backend}, proxy)))).
 				Build()
 			// then
 			Expect(err).ToNot(HaveOccurred())
@@ -185,7 +185,171 @@ var _ = Describe("NetworkAccessLogConfigurer", func() {
                           "%RESP(server):5%" "%TRAILER(grpc-message):7%" "DYNAMIC_METADATA(namespace:object:key):9" "FILTER_STATE(filter.state.key):12"
                   cluster: db
                   statPrefix: db
+`,
+		}),
+		Entry("basic tcp_proxy with http access log", testCase{
+			listenerName:    "outbound:127.0.0.1:5432",
+			listenerAddress: "127.0.0.1",
+			listenerPort:    5432,
+			statsName:       "db",
+			clusters:        []envoy_common.ClusterInfo{{Name: "db", Weight: 200}},
+			backend: &mesh_proto.LoggingBackend{
+				Name:   "http",
+				Format: `[%START_TIME%] %KUMA_SOURCE_SERVICE% %KUMA_DESTINATION_SERVICE%`,
+				Type: &mesh_proto.LoggingBackend_Http_{
+					Http: &mesh_proto.LoggingBackend_Http{
+						Url:          "https://logs.local/ingest",
+						MaxBatchSize: 10,
+					},
+				},
+			},
+			expected: `
+            name: outbound:127.0.0.1:5432
+            trafficDirection: OUTBOUND
+            address:
+              socketAddress:
+                address: 127.0.0.1
+                portValue: 5432
+            filterChains:
+            - filters:
+              - name: envoy.tcp_proxy
+                typedConfig:
+                  '@type': type.googleapis.com/envoy.config.filter.network.tcp_proxy.v2.TcpProxy
+                  accessLog:
+                  - name: envoy.http_grpc_access_log
+                    typedConfig:
+                      '@type': type.googleapis.com/envoy.config.accesslog.v2.HttpGrpcAccessLogConfig
+                      commonConfig:
+                        grpcService:
+                          envoyGrpc:
+                            clusterName: access_log_sink
+                        logName: '{"name":"http","http":{"url":"https://logs.local/ingest","maxBatchSize":10}};[%START_TIME%] backend db'
+                  cluster: db
+                  statPrefix: db
+`,
+		}),
+		Entry("basic tcp_proxy with tcp access log in JSON format", testCase{
+			listenerName:    "outbound:127.0.0.1:5432",
+			listenerAddress: "127.0.0.1",
+			listenerPort:    5432,
+			statsName:       "db",
+			clusters:        []envoy_common.ClusterInfo{{Name: "db", Weight: 200}},
+			backend: &mesh_proto.LoggingBackend{
+				Name: "tcp",
+				JsonFormat: map[string]string{
+					"bytes_sent": "%BYTES_SENT%",
+					"origin":     "%REQ(ORIGIN)%",
+					"route":      "%KUMA_SOURCE_SERVICE%;%KUMA_DESTINATION_SERVICE%",
+				},
+				Type: &mesh_proto.LoggingBackend_Tcp_{
+					Tcp: &mesh_proto.LoggingBackend_Tcp{
+						Address: "127.0.0.1:1234",
+					},
+				},
+			},
+			expected: `
+            name: outbound:127.0.0.1:5432
+            trafficDirection: OUTBOUND
+            address:
+              socketAddress:
+                address: 127.0.0.1
+                portValue: 5432
+            filterChains:
+            - filters:
+              - name: envoy.tcp_proxy
+                typedConfig:
+                  '@type': type.googleapis.com/envoy.config.filter.network.tcp_proxy.v2.TcpProxy
+                  accessLog:
+                  - name: envoy.http_grpc_access_log
+                    typedConfig:
+                      '@type': type.googleapis.com/envoy.config.accesslog.v2.HttpGrpcAccessLogConfig
+                      additionalRequestHeadersToLog:
+                      - origin
+                      commonConfig:
+                        grpcService:
+                          envoyGrpc:
+                            clusterName: access_log_sink
+                        logName: '{"name":"tcp","jsonFormat":{"bytes_sent":"%BYTES_SENT%","origin":"%REQ(origin)%","route":"backend\u003bdb"},"tcp":{"address":"127.0.0.1:1234"}};'
+                  cluster: db
+                  statPrefix: db
 `,
 		}),
 	)
+
+	It("should take an identity of a source of inbound traffic from the mTLS peer", func() {
+		// given
+		proxy := &core_xds.Proxy{
+			Id: xds.ProxyId{
+				Name: "db",
+				Mesh: "demo",
+			},
+			Dataplane: &mesh_core.DataplaneResource{
+				Spec: mesh_proto.Dataplane{
+					Networking: &mesh_proto.Dataplane_Networking{
+						Address: "192.168.0.1",
+						Inbound: []*mesh_proto.Dataplane_Networking_Inbound{{
+							Port:        5432,
+							ServicePort: 15432,
+							Tags: map[string]string{
+								"service": "db",
+							},
+						}},
+					},
+				},
+			},
+		}
+		backend := &mesh_proto.LoggingBackend{
+			Name: "tcp",
+			JsonFormat: map[string]string{
+				"source":      "%KUMA_SOURCE_SERVICE%",
+				"identity":    "%KUMA_SOURCE_IDENTITY%",
+				"address":     "%KUMA_SOURCE_ADDRESS_WITHOUT_PORT%",
+				"destination": "%KUMA_DESTINATION_SERVICE%",
+			},
+			Type: &mesh_proto.LoggingBackend_Tcp_{
+				Tcp: &mesh_proto.LoggingBackend_Tcp{
+					Address: "127.0.0.1:1234",
+				},
+			},
+		}
+
+		// when
+		listener, err := NewListenerBuilder().
+			Configure(InboundListener("inbound:192.168.0.1:5432", "192.168.0.1", 5432)).
+			Configure(FilterChain(NewFilterChainBuilder().
+				Configure(TcpProxy("localhost:15432", envoy_common.ClusterInfo{Name: "localhost:15432"})).
+				Configure(NetworkInboundAccessLog("demo", "db", &core_xds.AccessLog{Backend: backend}, proxy)))).
+			Build()
+		// then
+		Expect(err).ToNot(HaveOccurred())
+
+		// when
+		actual, err := util_proto.ToYAML(listener)
+		Expect(err).ToNot(HaveOccurred())
+		// and
+		Expect(actual).To(MatchYAML(`
+            name: inbound:192.168.0.1:5432
+            trafficDirection: INBOUND
+            address:
+              socketAddress:
+                address: 192.168.0.1
+                portValue: 5432
+            filterChains:
+            - filters:
+              - name: envoy.tcp_proxy
+                typedConfig:
+                  '@type': type.googleapis.com/envoy.config.filter.network.tcp_proxy.v2.TcpProxy
+                  accessLog:
+                  - name: envoy.http_grpc_access_log
+                    typedConfig:
+                      '@type': type.googleapis.com/envoy.config.accesslog.v2.HttpGrpcAccessLogConfig
+                      commonConfig:
+                        grpcService:
+                          envoyGrpc:
+                            clusterName: access_log_sink
+                        logName: '{"name":"tcp","jsonFormat":{"address":"%DOWNSTREAM_REMOTE_ADDRESS_WITHOUT_PORT%","destination":"db","identity":"%DOWNSTREAM_PEER_URI_SAN%","source":"-"},"tcp":{"address":"127.0.0.1:1234"}};'
+                  cluster: localhost:15432
+                  statPrefix: localhost_15432
+`))
+	})
 })
@@ -0,0 +1,113 @@
+package listeners
+
+import (
+	"time"
+
+	envoy_core "github.com/envoyproxy/go-control-plane/envoy/api/v2/core"
+	envoy_listener "github.com/envoyproxy/go-control-plane/envoy/api/v2/listener"
+	envoy_ext_authz "github.com/envoyproxy/go-control-plane/envoy/config/filter/network/ext_authz/v2"
+	envoy_wellknown "github.com/envoyproxy/go-control-plane/pkg/wellknown"
+	"github.com/golang/protobuf/ptypes"
+
+	mesh_proto "github.com/Kong/kuma/api/mesh/v1alpha1"
+	"github.com/Kong/kuma/pkg/envoy/faults"
+)
+
+// connectionFaultsCheckTimeout is a time the fault injection server of kuma-dp has
+// to respond on top of a delay.
+const connectionFaultsCheckTimeout = 1 * time.Second
+
+func NetworkFaultInjection(statsName string, listenerName string, faultInjection *mesh_proto.FaultInjection) FilterChainBuilderOpt {
+	return FilterChainBuilderOptFunc(func(config *FilterChainBuilderConfig) {
+		config.Add(&NetworkFaultInjectionConfigurer{
+			statsName:      statsName,
+			listenerName:   listenerName,
+			faultInjection: faultInjection,
+		})
+	})
+}
+
+// NetworkFaultInjectionConfigurer injects faults into connections of TCP services.
+//
+// Envoy has no network filter for fault injection, so every new connection is checked
+// by the fault injection server of kuma-dp via the network ext_authz filter.
+// The server delays the check or denies it, which makes Envoy close the connection.
+// A check of a connection with limited bandwidth makes sure that the bandwidth limiter
+// of kuma-dp is ready to accept the connection from a listener with a given name.
+type NetworkFaultInjectionConfigurer struct {
+	statsName      string
+	listenerName   string
+	faultInjection *mesh_proto.FaultInjection
+}
+
+func (c *NetworkFaultInjectionConfigurer) Configure(filterChain *envoy_listener.FilterChain) error {
+	if c.faultInjection == nil {
+		return nil
+	}
+
+	connectionFaults := faults.ConnectionFaults{}
+	timeout := connectionFaultsCheckTimeout
+	if delay := c.faultInjection.Conf.GetDelay(); delay != nil {
+		value, err := ptypes.Duration(delay.GetValue())
+		if err != nil {
+			return err
+		}
+		connectionFaults.Delay = &faults.ConnectionDelay{
+			Percentage: delay.GetPercentage().GetValue(),
+			Value:      value,
+		}
+		timeout += value
+	}
+	if abort := c.faultInjection.Conf.GetAbort(); abort != nil {
+		connectionFaults.Abort = &faults.ConnectionAbort{
+			Percentage: abort.GetPercentage().GetValue(),
+		}
+	}
+	if responseBandwidth := c.faultInjection.Conf.GetResponseBandwidth(); responseBandwidth != nil {
+		limitKbps, err := ConvertBandwidthToKbps(responseBandwidth.GetLimit().GetValue())
+		if err != nil {
+			return err
+		}
+		connectionFaults.ResponseBandwidth = &faults.ConnectionResponseBandwidth{
+			Percentage: responseBandwidth.GetPercentage().GetValue(),
+			LimitKbps:  limitKbps,
+			Listener:   c.listenerName,
+		}
+	}
+	value, err := faults.EncodeConnectionFaults(connectionFaults)
+	if err != nil {
+		return err
+	}
+
+	config := &envoy_ext_authz.ExtAuthz{
+		StatPrefix: c.statsName,
+		GrpcService: &envoy_core.GrpcService{
+			TargetSpecifier: &envoy_core.GrpcService_EnvoyGrpc_{
+				EnvoyGrpc: &envoy_core.GrpcService_EnvoyGrpc{
+					ClusterName: faults.ClusterName,
+				},
+			},
+			Timeout: ptypes.DurationProto(timeout),
+			InitialMetadata: []*envoy_core.HeaderValue{
+				{Key: faults.ConnectionFaultsMetadataKey, Value: value},
+			},
+		},
+		// faults must not break connections when kuma-dp is not available
+		FailureModeAllow: true,
+	}
+	pbst, err := ptypes.MarshalAny(config)
+	if err != nil {
+		return err
+	}
+
+	// faults should be injected before a connection is forwarded
+	filterChain.Filters = append([]*envoy_listener.Filter{
+		{
+			Name: envoy_wellknown.ExternalAuthorization,
+			ConfigType: &envoy_listener.Filter_TypedConfig{
+				TypedConfig: pbst,
+			},
+		},
+	}, filterChain.Filters...)
+	return nil
+}
@@ -0,0 +1,129 @@
+package listeners_test
+
+import (
+	"github.com/golang/protobuf/ptypes/duration"
+	"github.com/golang/protobuf/ptypes/wrappers"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+
+	mesh_proto "github.com/Kong/kuma/api/mesh/v1alpha1"
+	util_proto "github.com/Kong/kuma/pkg/util/proto"
+	envoy_common "github.com/Kong/kuma/pkg/xds/envoy"
+	. "github.com/Kong/kuma/pkg/xds/envoy/listeners"
+)
+
+var _ = Describe("NetworkFaultInjectionConfigurer", func() {
+	type testCase struct {
+		input    *mesh_proto.FaultInjection
+		expected string
+	}
+	DescribeTable("should generate proper Envoy config",
+		func(given testCase) {
+			// when
+			filterChain, err := NewFilterChainBuilder().
+				Configure(TcpProxy("redis", envoy_common.ClusterInfo{Name: "redis"})).
+				Configure(NetworkFaultInjection("redis", "outbound:127.0.0.1:6379", given.input)).
+				Build()
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			// when
+			actual, err := util_proto.ToYAML(filterChain)
+			Expect(err).ToNot(HaveOccurred())
+			// and
+			Expect(actual).To(MatchYAML(given.expected))
+		},
+		Entry("delay and abort", testCase{
+			input: &mesh_proto.FaultInjection{
+				Conf: &mesh_proto.FaultInjection_Conf{
+					Side: mesh_proto.FaultInjectionSideSource,
+					Delay: &mesh_proto.FaultInjection_Conf_Delay{
+						Percentage: &wrappers.DoubleValue{Value: 50},
+						Value:      &duration.Duration{Seconds: 5},
+					},
+					Abort: &mesh_proto.FaultInjection_Conf_Abort{
+						Percentage: &wrappers.DoubleValue{Value: 10},
+					},
+				},
+			},
+			expected: `
+            filters:
+            - name: envoy.ext_authz
+              typedConfig:
+                '@type': type.googleapis.com/envoy.config.filter.network.ext_authz.v2.ExtAuthz
+                failureModeAllow: true
+                grpcService:
+                  envoyGrpc:
+                    clusterName: fault_injection_sink
+                  initialMetadata:
+                  - key: x-kuma-connection-faults
+                    value: '{"delay":{"percentage":50,"value":5000000000},"abort":{"percentage":10}}'
+                  timeout: 6s
+                statPrefix: redis
+            - name: envoy.tcp_proxy
+              typedConfig:
+                '@type': type.googleapis.com/envoy.config.filter.network.tcp_proxy.v2.TcpProxy
+                cluster: redis
+                statPrefix: redis`,
+		}),
+		Entry("abort only", testCase{
+			input: &mesh_proto.FaultInjection{
+				Conf: &mesh_proto.FaultInjection_Conf{
+					Side: mesh_proto.FaultInjectionSideSource,
+					Abort: &mesh_proto.FaultInjection_Conf_Abort{
+						Percentage: &wrappers.DoubleValue{Value: 100},
+					},
+				},
+			},
+			expected: `
+            filters:
+            - name: envoy.ext_authz
+              typedConfig:
+                '@type': type.googleapis.com/envoy.config.filter.network.ext_authz.v2.ExtAuthz
+                failureModeAllow: true
+                grpcService:
+                  envoyGrpc:
+                    clusterName: fault_injection_sink
+                  initialMetadata:
+                  - key: x-kuma-connection-faults
+                    value: '{"abort":{"percentage":100}}'
+                  timeout: 1s
+                statPrefix: redis
+            - name: envoy.tcp_proxy
+              typedConfig:
+                '@type': type.googleapis.com/envoy.config.filter.network.tcp_proxy.v2.TcpProxy
+                cluster: redis
+                statPrefix: redis`,
+		}),
+		Entry("response bandwidth", testCase{
+			input: &mesh_proto.FaultInjection{
+				Conf: &mesh_proto.FaultInjection_Conf{
+					Side: mesh_proto.FaultInjectionSideSource,
+					ResponseBandwidth: &mesh_proto.FaultInjection_Conf_ResponseBandwidth{
+						Percentage: &wrappers.DoubleValue{Value: 20},
+						Limit:      &wrappers.StringValue{Value: "1mbps"},
+					},
+				},
+			},
+			expected: `
+            filters:
+            - name: envoy.ext_authz
+              typedConfig:
+                '@type': type.googleapis.com/envoy.config.filter.network.ext_authz.v2.ExtAuthz
+                failureModeAllow: true
+                grpcService:
+                  envoyGrpc:
+                    clusterName: fault_injection_sink
+                  initialMetadata:
+                  - key: x-kuma-connection-faults
+                    value: '{"responseBandwidth":{"percentage":20,"limitKbps":1000,"listener":"outbound:127.0.0.1:6379"}}'
+                  timeout: 1s
+                statPrefix: redis
+            - name: envoy.tcp_proxy
+              typedConfig:
+                '@type': type.googleapis.com/envoy.config.filter.network.tcp_proxy.v2.TcpProxy
+                cluster: redis
+                statPrefix: redis`,
+		}),
+	)
+})
@@ -75,6 +75,19 @@ func createRbacRule(statsName string, permission *mesh_core.TrafficPermissionRes
 }
 
 func createPolicy(permission *mesh_core.TrafficPermissionResource) *rbac_config.Policy {
+	return &rbac_config.Policy{
+		Permissions: []*rbac_config.Permission{
+			{
+				Rule: &rbac_config.Permission_Any{
+					Any: true,
+				},
+			},
+		},
+		Principals: createPrincipals(permission),
+	}
+}
+
+func createPrincipals(permission *mesh_core.TrafficPermissionResource) []*rbac_config.Principal {
 	principals := []*rbac_config.Principal{}
 
 	// build principals list: one per sources/destinations rule
@@ -98,15 +111,5 @@ func createPolicy(permission *mesh_core.TrafficPermissionResource) *rbac_config.
 		}
 		principals = append(principals, principal)
 	}
-
-	return &rbac_config.Policy{
-		Permissions: []*rbac_config.Permission{
-			{
-				Rule: &rbac_config.Permission_Any{
-					Any: true,
-				},
-			},
-		},
-		Principals: principals,
-	}
+	return principals
 }
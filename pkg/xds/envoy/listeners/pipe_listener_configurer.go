@@ -0,0 +1,37 @@
+package listeners
+
+import (
+	v2 "github.com/envoyproxy/go-control-plane/envoy/api/v2"
+	envoy_core "github.com/envoyproxy/go-control-plane/envoy/api/v2/core"
+)
+
+// PipeListener configures a listener on a Unix socket at a given path.
+// Such listeners accept connections forwarded back to Envoy by kuma-dp.
+func PipeListener(listenerName string, path string) ListenerBuilderOpt {
+	return ListenerBuilderOptFunc(func(config *ListenerBuilderConfig) {
+		config.Add(&PipeListenerConfigurer{
+			listenerName: listenerName,
+			path:         path,
+		})
+	})
+}
+
+type PipeListenerConfigurer struct {
+	listenerName string
+	path         string
+}
+
+func (c *PipeListenerConfigurer) Configure(l *v2.Listener) error {
+	l.Name = c.listenerName
+	l.TrafficDirection = envoy_core.TrafficDirection_OUTBOUND
+	l.Address = &envoy_core.Address{
+		Address: &envoy_core.Address_Pipe{
+			Pipe: &envoy_core.Pipe{
+				Path: c.path,
+			},
+		},
+	}
+	// notice that filter chain configuration is left up to other configurers
+
+	return nil
+}
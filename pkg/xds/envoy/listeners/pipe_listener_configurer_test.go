@@ -0,0 +1,34 @@
+package listeners_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	. "github.com/Kong/kuma/pkg/xds/envoy/listeners"
+
+	util_proto "github.com/Kong/kuma/pkg/util/proto"
+)
+
+var _ = Describe("PipeListenerConfigurer", func() {
+
+	It("should generate proper Envoy config", func() {
+		// when
+		listener, err := NewListenerBuilder().
+			Configure(PipeListener("outbound:127.0.0.1:54321:throttled", "/tmp/kuma-fault-bandwidth-side-car-default-bce69bab.envoy.sock")).
+			Build()
+		// then
+		Expect(err).ToNot(HaveOccurred())
+
+		// when
+		actual, err := util_proto.ToYAML(listener)
+		Expect(err).ToNot(HaveOccurred())
+		// and
+		Expect(actual).To(MatchYAML(`
+            name: outbound:127.0.0.1:54321:throttled
+            trafficDirection: OUTBOUND
+            address:
+              pipe:
+                path: /tmp/kuma-fault-bandwidth-side-car-default-bce69bab.envoy.sock
+`))
+	})
+})
@@ -2,33 +2,82 @@ package listeners
 
 import (
 	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/wrappers"
 
 	v2 "github.com/envoyproxy/go-control-plane/envoy/api/v2"
+	envoy_core "github.com/envoyproxy/go-control-plane/envoy/api/v2/core"
 	envoy_listener "github.com/envoyproxy/go-control-plane/envoy/api/v2/listener"
 	envoy_route "github.com/envoyproxy/go-control-plane/envoy/api/v2/route"
 	envoy_hcm "github.com/envoyproxy/go-control-plane/envoy/config/filter/network/http_connection_manager/v2"
 	envoy_wellknown "github.com/envoyproxy/go-control-plane/pkg/wellknown"
 
+	"github.com/Kong/kuma/pkg/envoy/metrics"
 	util_xds "github.com/Kong/kuma/pkg/util/xds"
 )
 
+const (
+	// envoyPrometheusPath is a well-known Admin API endpoint.
+	envoyPrometheusPath = "/stats/prometheus"
+)
+
 func PrometheusEndpoint(statsName string, path string, clusterName string) FilterChainBuilderOpt {
 	return FilterChainBuilderOptFunc(func(config *FilterChainBuilderConfig) {
 		config.Add(&PrometheusEndpointConfigurer{
-			statsName:   statsName,
-			path:        path,
-			clusterName: clusterName,
+			statsName:     statsName,
+			path:          path,
+			clusterName:   clusterName,
+			prefixRewrite: envoyPrometheusPath,
+		})
+	})
+}
+
+// MergedPrometheusEndpoint forwards HTTP requests into the metrics merger of kuma-dp
+// that merges metrics of Envoy with metrics of given applications.
+func MergedPrometheusEndpoint(statsName string, path string, clusterName string, applications []metrics.Application) FilterChainBuilderOpt {
+	return FilterChainBuilderOptFunc(func(config *FilterChainBuilderConfig) {
+		config.Add(&PrometheusEndpointConfigurer{
+			statsName:     statsName,
+			path:          path,
+			clusterName:   clusterName,
+			prefixRewrite: "/",
+			merged:        true,
+			applications:  applications,
 		})
 	})
 }
 
 type PrometheusEndpointConfigurer struct {
-	statsName   string
-	path        string
-	clusterName string
+	statsName     string
+	path          string
+	clusterName   string
+	prefixRewrite string
+	// merged is true if requests are forwarded into the metrics merger of kuma-dp
+	merged bool
+	// applications whose metrics should be merged with metrics of Envoy
+	applications []metrics.Application
 }
 
 func (c *PrometheusEndpointConfigurer) Configure(filterChain *envoy_listener.FilterChain) error {
+	var requestHeaders []*envoy_core.HeaderValueOption
+	var requestHeadersToRemove []string
+	if c.merged && len(c.applications) == 0 {
+		// the metrics merger must not scrape applications set by a client
+		requestHeadersToRemove = append(requestHeadersToRemove, metrics.ApplicationsHeader)
+	}
+	if len(c.applications) > 0 {
+		value, err := metrics.EncodeApplications(c.applications)
+		if err != nil {
+			return err
+		}
+		// the header must override a value that might be set by a client
+		requestHeaders = append(requestHeaders, &envoy_core.HeaderValueOption{
+			Header: &envoy_core.HeaderValue{
+				Key:   metrics.ApplicationsHeader,
+				Value: value,
+			},
+			Append: &wrappers.BoolValue{Value: false},
+		})
+	}
 	config := &envoy_hcm.HttpConnectionManager{
 		StatPrefix: util_xds.SanitizeMetric(c.statsName),
 		CodecType:  envoy_hcm.HttpConnectionManager_AUTO,
@@ -51,9 +100,11 @@ func (c *PrometheusEndpointConfigurer) Configure(filterChain *envoy_listener.Fil
 								ClusterSpecifier: &envoy_route.RouteAction_Cluster{
 									Cluster: c.clusterName,
 								},
-								PrefixRewrite: "/stats/prometheus", // well-known Admin API endpoint
+								PrefixRewrite: c.prefixRewrite,
 							},
 						},
+						RequestHeadersToAdd:    requestHeaders,
+						RequestHeadersToRemove: requestHeadersToRemove,
 					}},
 				}},
 			},
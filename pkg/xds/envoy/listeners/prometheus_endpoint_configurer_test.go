@@ -7,6 +7,7 @@ import (
 
 	. "github.com/Kong/kuma/pkg/xds/envoy/listeners"
 
+	"github.com/Kong/kuma/pkg/envoy/metrics"
 	util_proto "github.com/Kong/kuma/pkg/util/proto"
 )
 
@@ -74,4 +75,53 @@ var _ = Describe("PrometheusEndpointConfigurer", func() {
 		}),
 	)
 
+	It("should forward requests to the metrics merger when applications are declared", func() {
+		// when
+		listener, err := NewListenerBuilder().
+			Configure(InboundListener("kuma:metrics:prometheus", "0.0.0.0", 5670)).
+			Configure(FilterChain(NewFilterChainBuilder().
+				Configure(MergedPrometheusEndpoint("kuma:metrics:prometheus", "/metrics", "kuma:metrics:merger", []metrics.Application{
+					{Name: "backend", Port: 8081, Path: "/stats"},
+				})))).
+			Build()
+		// then
+		Expect(err).ToNot(HaveOccurred())
+
+		// when
+		actual, err := util_proto.ToYAML(listener)
+		Expect(err).ToNot(HaveOccurred())
+		// and
+		Expect(actual).To(MatchYAML(`
+            name: kuma:metrics:prometheus
+            trafficDirection: INBOUND
+            address:
+              socketAddress:
+                address: 0.0.0.0
+                portValue: 5670
+            filterChains:
+            - filters:
+              - name: envoy.http_connection_manager
+                typedConfig:
+                  '@type': type.googleapis.com/envoy.config.filter.network.http_connection_manager.v2.HttpConnectionManager
+                  httpFilters:
+                  - name: envoy.router
+                  routeConfig:
+                    virtualHosts:
+                    - domains:
+                      - '*'
+                      name: envoy_admin
+                      routes:
+                      - match:
+                          prefix: /metrics
+                        requestHeadersToAdd:
+                        - append: false
+                          header:
+                            key: x-kuma-metrics-applications
+                            value: '[{"name":"backend","port":8081,"path":"/stats"}]'
+                        route:
+                          cluster: kuma:metrics:merger
+                          prefixRewrite: /
+                  statPrefix: kuma_metrics_prometheus
+`))
+	})
 })
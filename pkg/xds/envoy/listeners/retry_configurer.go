@@ -0,0 +1,32 @@
+package listeners
+
+import (
+	envoy_listener "github.com/envoyproxy/go-control-plane/envoy/api/v2/listener"
+	envoy_tcp "github.com/envoyproxy/go-control-plane/envoy/config/filter/network/tcp_proxy/v2"
+	"github.com/golang/protobuf/ptypes/wrappers"
+
+	mesh_core "github.com/Kong/kuma/pkg/core/resources/apis/mesh"
+)
+
+func Retry(retry *mesh_core.RetryResource) FilterChainBuilderOpt {
+	return FilterChainBuilderOptFunc(func(config *FilterChainBuilderConfig) {
+		if retry != nil && retry.HasTcpRetries() {
+			config.Add(&RetryConfigurer{
+				retry: retry,
+			})
+		}
+	})
+}
+
+type RetryConfigurer struct {
+	retry *mesh_core.RetryResource
+}
+
+func (c *RetryConfigurer) Configure(filterChain *envoy_listener.FilterChain) error {
+	return UpdateTCPProxy(filterChain, func(tcpProxy *envoy_tcp.TcpProxy) error {
+		tcpProxy.MaxConnectAttempts = &wrappers.UInt32Value{
+			Value: c.retry.Spec.GetConf().GetTcp().GetMaxConnectAttempts(),
+		}
+		return nil
+	})
+}
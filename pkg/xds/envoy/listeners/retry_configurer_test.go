@@ -0,0 +1,91 @@
+package listeners_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+
+	. "github.com/Kong/kuma/pkg/xds/envoy/listeners"
+
+	mesh_core "github.com/Kong/kuma/pkg/core/resources/apis/mesh"
+	util_proto "github.com/Kong/kuma/pkg/util/proto"
+	envoy_common "github.com/Kong/kuma/pkg/xds/envoy"
+)
+
+var _ = Describe("RetryConfigurer", func() {
+
+	type testCase struct {
+		retry    string
+		expected string
+	}
+
+	DescribeTable("should generate proper Envoy config",
+		func(given testCase) {
+			// setup
+			retry := &mesh_core.RetryResource{}
+			Expect(util_proto.FromYAML([]byte(given.retry), &retry.Spec)).To(Succeed())
+
+			// when
+			listener, err := NewListenerBuilder().
+				Configure(OutboundListener("outbound:127.0.0.1:5432", "127.0.0.1", 5432)).
+				Configure(FilterChain(NewFilterChainBuilder().
+					Configure(TcpProxy("db", envoy_common.ClusterInfo{Name: "db"})).
+					Configure(Retry(retry)))).
+				Build()
+			// then
+			Expect(err).ToNot(HaveOccurred())
+
+			// when
+			actual, err := util_proto.ToYAML(listener)
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			// and
+			Expect(actual).To(MatchYAML(given.expected))
+		},
+		Entry("without tcp retries", testCase{
+			retry: `
+            conf:
+              http:
+                numRetries: 3
+`,
+			expected: `
+            name: outbound:127.0.0.1:5432
+            address:
+              socketAddress:
+                address: 127.0.0.1
+                portValue: 5432
+            filterChains:
+            - filters:
+              - name: envoy.tcp_proxy
+                typedConfig:
+                  '@type': type.googleapis.com/envoy.config.filter.network.tcp_proxy.v2.TcpProxy
+                  cluster: db
+                  statPrefix: db
+            trafficDirection: OUTBOUND
+`,
+		}),
+		Entry("with tcp retries", testCase{
+			retry: `
+            conf:
+              tcp:
+                maxConnectAttempts: 5
+`,
+			expected: `
+            name: outbound:127.0.0.1:5432
+            address:
+              socketAddress:
+                address: 127.0.0.1
+                portValue: 5432
+            filterChains:
+            - filters:
+              - name: envoy.tcp_proxy
+                typedConfig:
+                  '@type': type.googleapis.com/envoy.config.filter.network.tcp_proxy.v2.TcpProxy
+                  cluster: db
+                  maxConnectAttempts: 5
+                  statPrefix: db
+            trafficDirection: OUTBOUND
+`,
+		}),
+	)
+})
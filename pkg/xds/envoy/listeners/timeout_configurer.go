@@ -0,0 +1,29 @@
+package listeners
+
+import (
+	envoy_listener "github.com/envoyproxy/go-control-plane/envoy/api/v2/listener"
+	envoy_tcp "github.com/envoyproxy/go-control-plane/envoy/config/filter/network/tcp_proxy/v2"
+
+	mesh_core "github.com/Kong/kuma/pkg/core/resources/apis/mesh"
+)
+
+func Timeout(timeout *mesh_core.TimeoutResource) FilterChainBuilderOpt {
+	return FilterChainBuilderOptFunc(func(config *FilterChainBuilderConfig) {
+		if timeout != nil && timeout.HasTcpTimeouts() {
+			config.Add(&TimeoutConfigurer{
+				timeout: timeout,
+			})
+		}
+	})
+}
+
+type TimeoutConfigurer struct {
+	timeout *mesh_core.TimeoutResource
+}
+
+func (c *TimeoutConfigurer) Configure(filterChain *envoy_listener.FilterChain) error {
+	return UpdateTCPProxy(filterChain, func(tcpProxy *envoy_tcp.TcpProxy) error {
+		tcpProxy.IdleTimeout = c.timeout.Spec.GetConf().GetTcp().GetIdleTimeout()
+		return nil
+	})
+}
@@ -0,0 +1,113 @@
+package listeners_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+
+	. "github.com/Kong/kuma/pkg/xds/envoy/listeners"
+
+	mesh_core "github.com/Kong/kuma/pkg/core/resources/apis/mesh"
+	util_proto "github.com/Kong/kuma/pkg/util/proto"
+	envoy_common "github.com/Kong/kuma/pkg/xds/envoy"
+)
+
+var _ = Describe("TimeoutConfigurer", func() {
+
+	type testCase struct {
+		timeout  string
+		expected string
+	}
+
+	DescribeTable("should generate proper Envoy config",
+		func(given testCase) {
+			// setup
+			timeout := &mesh_core.TimeoutResource{}
+			Expect(util_proto.FromYAML([]byte(given.timeout), &timeout.Spec)).To(Succeed())
+
+			// when
+			listener, err := NewListenerBuilder().
+				Configure(OutboundListener("outbound:127.0.0.1:5432", "127.0.0.1", 5432)).
+				Configure(FilterChain(NewFilterChainBuilder().
+					Configure(TcpProxy("db", envoy_common.ClusterInfo{Name: "db"})).
+					Configure(Timeout(timeout)))).
+				Build()
+			// then
+			Expect(err).ToNot(HaveOccurred())
+
+			// when
+			actual, err := util_proto.ToYAML(listener)
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			// and
+			Expect(actual).To(MatchYAML(given.expected))
+		},
+		Entry("without tcp timeouts", testCase{
+			timeout: `
+            conf:
+              connectTimeout: 2s
+`,
+			expected: `
+            name: outbound:127.0.0.1:5432
+            address:
+              socketAddress:
+                address: 127.0.0.1
+                portValue: 5432
+            filterChains:
+            - filters:
+              - name: envoy.tcp_proxy
+                typedConfig:
+                  '@type': type.googleapis.com/envoy.config.filter.network.tcp_proxy.v2.TcpProxy
+                  cluster: db
+                  statPrefix: db
+            trafficDirection: OUTBOUND
+`,
+		}),
+		Entry("with tcp idle timeout", testCase{
+			timeout: `
+            conf:
+              tcp:
+                idleTimeout: 600s
+`,
+			expected: `
+            name: outbound:127.0.0.1:5432
+            address:
+              socketAddress:
+                address: 127.0.0.1
+                portValue: 5432
+            filterChains:
+            - filters:
+              - name: envoy.tcp_proxy
+                typedConfig:
+                  '@type': type.googleapis.com/envoy.config.filter.network.tcp_proxy.v2.TcpProxy
+                  cluster: db
+                  idleTimeout: 600s
+                  statPrefix: db
+            trafficDirection: OUTBOUND
+`,
+		}),
+		Entry("with tcp idle timeout disabled", testCase{
+			timeout: `
+            conf:
+              tcp:
+                idleTimeout: 0s
+`,
+			expected: `
+            name: outbound:127.0.0.1:5432
+            address:
+              socketAddress:
+                address: 127.0.0.1
+                portValue: 5432
+            filterChains:
+            - filters:
+              - name: envoy.tcp_proxy
+                typedConfig:
+                  '@type': type.googleapis.com/envoy.config.filter.network.tcp_proxy.v2.TcpProxy
+                  cluster: db
+                  idleTimeout: 0s
+                  statPrefix: db
+            trafficDirection: OUTBOUND
+`,
+		}),
+	)
+})
@@ -0,0 +1,25 @@
+package listeners
+
+import (
+	v2 "github.com/envoyproxy/go-control-plane/envoy/api/v2"
+	envoy_listener "github.com/envoyproxy/go-control-plane/envoy/api/v2/listener"
+	envoy_wellknown "github.com/envoyproxy/go-control-plane/pkg/wellknown"
+)
+
+func TLSInspector() ListenerBuilderOpt {
+	return ListenerBuilderOptFunc(func(config *ListenerBuilderConfig) {
+		config.Add(&TLSInspectorConfigurer{})
+	})
+}
+
+// TLSInspectorConfigurer detects whether a connection uses TLS,
+// so that filter chains can be matched on a transport protocol.
+type TLSInspectorConfigurer struct {
+}
+
+func (c *TLSInspectorConfigurer) Configure(l *v2.Listener) error {
+	l.ListenerFilters = append(l.ListenerFilters, &envoy_listener.ListenerFilter{
+		Name: envoy_wellknown.TlsInspector,
+	})
+	return nil
+}
@@ -0,0 +1,38 @@
+package listeners_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	. "github.com/Kong/kuma/pkg/xds/envoy/listeners"
+
+	util_proto "github.com/Kong/kuma/pkg/util/proto"
+)
+
+var _ = Describe("TLSInspectorConfigurer", func() {
+
+	It("should generate proper Envoy config", func() {
+		// when
+		listener, err := NewListenerBuilder().
+			Configure(InboundListener("inbound:192.168.0.1:8080", "192.168.0.1", 8080)).
+			Configure(TLSInspector()).
+			Build()
+		// then
+		Expect(err).ToNot(HaveOccurred())
+
+		// when
+		actual, err := util_proto.ToYAML(listener)
+		Expect(err).ToNot(HaveOccurred())
+		// and
+		Expect(actual).To(MatchYAML(`
+            name: inbound:192.168.0.1:8080
+            trafficDirection: INBOUND
+            address:
+              socketAddress:
+                address: 192.168.0.1
+                portValue: 8080
+            listenerFilters:
+            - name: envoy.listener.tls_inspector
+`))
+	})
+})
@@ -8,6 +8,9 @@ import (
 	envoy_type "github.com/envoyproxy/go-control-plane/envoy/type"
 )
 
+// Tracing enables tracing in HTTP Connection Manager. The tracer itself
+// (Zipkin, Jaeger, Datadog or OpenCensus) is configured in the bootstrap
+// config, since Envoy allows only one tracer per process.
 func Tracing(backend *mesh_proto.TracingBackend) FilterChainBuilderOpt {
 	return FilterChainBuilderOptFunc(func(config *FilterChainBuilderConfig) {
 		config.Add(&TracingConfigurer{
@@ -91,6 +91,37 @@ var _ = Describe("TracingConfigurer", func() {
                   tracing: {}
                   httpFilters:
                   - name: envoy.router
+`,
+		}),
+		Entry("datadog backend specified with sampling", testCase{
+			backend: &mesh_proto.TracingBackend{
+				Name:     "datadog",
+				Sampling: &wrappers.DoubleValue{Value: 50.0},
+				Type: &mesh_proto.TracingBackend_Datadog_{
+					Datadog: &mesh_proto.TracingBackend_Datadog{
+						Address: "datadog-agent",
+						Port:    8126,
+					},
+				},
+			},
+			expected: `
+            name: inbound:192.168.0.1:8080
+            trafficDirection: INBOUND
+            address:
+              socketAddress:
+                address: 192.168.0.1
+                portValue: 8080
+            filterChains:
+            - filters:
+              - name: envoy.http_connection_manager
+                typedConfig:
+                  '@type': type.googleapis.com/envoy.config.filter.network.http_connection_manager.v2.HttpConnectionManager
+                  statPrefix: localhost_8080
+                  tracing:
+                    overallSampling:
+                      value: 50
+                  httpFilters:
+                  - name: envoy.router
 `,
 		}),
 		Entry("no backend specified", testCase{
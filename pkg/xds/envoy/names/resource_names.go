@@ -20,6 +20,12 @@ func GetOutboundListenerName(address string, port uint32) string {
 	return fmt.Sprintf("outbound:%s:%d", address, port)
 }
 
+// GetThrottledListenerName returns a name of the listener that accepts connections
+// of a given outbound listener forwarded back to Envoy by the bandwidth limiter of kuma-dp.
+func GetThrottledListenerName(outboundListenerName string) string {
+	return fmt.Sprintf("%s:throttled", outboundListenerName)
+}
+
 func GetInboundRouteName(service string) string {
 	return fmt.Sprintf("inbound:%s", service)
 }
@@ -36,6 +42,14 @@ func GetPrometheusListenerName() string {
 	return "kuma:metrics:prometheus"
 }
 
+func GetMetricsMergerClusterName() string {
+	return "kuma:metrics:merger"
+}
+
+func GetBandwidthLimiterClusterName(outboundListenerName string) string {
+	return fmt.Sprintf("kuma:fault-injection:bandwidth:%s", outboundListenerName)
+}
+
 func GetDestinationClusterName(service string, selector map[string]string) string {
 	var pairs []string
 	for key, value := range selector {
@@ -0,0 +1,64 @@
+package routes
+
+import (
+	envoy_route "github.com/envoyproxy/go-control-plane/envoy/api/v2/route"
+
+	mesh_proto "github.com/Kong/kuma/api/mesh/v1alpha1"
+)
+
+// HashPolicies applies hash policies of a load balancer to all Routes of a VirtualHost.
+func HashPolicies(lb *mesh_proto.TrafficRoute_LoadBalancer) VirtualHostBuilderOpt {
+	return VirtualHostBuilderOptFunc(func(config *VirtualHostBuilderConfig) {
+		if len(lb.GetHashPolicies()) > 0 {
+			config.Add(&HashPolicyConfigurer{
+				policies: lb.GetHashPolicies(),
+			})
+		}
+	})
+}
+
+type HashPolicyConfigurer struct {
+	policies []*mesh_proto.TrafficRoute_LoadBalancer_HashPolicy
+}
+
+func (c HashPolicyConfigurer) Configure(virtualHost *envoy_route.VirtualHost) error {
+	var hashPolicies []*envoy_route.RouteAction_HashPolicy
+	for _, policy := range c.policies {
+		hashPolicy := &envoy_route.RouteAction_HashPolicy{
+			Terminal: policy.GetTerminal(),
+		}
+		switch policy.GetType().(type) {
+		case *mesh_proto.TrafficRoute_LoadBalancer_HashPolicy_Header_:
+			hashPolicy.PolicySpecifier = &envoy_route.RouteAction_HashPolicy_Header_{
+				Header: &envoy_route.RouteAction_HashPolicy_Header{
+					HeaderName: policy.GetHeader().GetName(),
+				},
+			}
+		case *mesh_proto.TrafficRoute_LoadBalancer_HashPolicy_Cookie_:
+			hashPolicy.PolicySpecifier = &envoy_route.RouteAction_HashPolicy_Cookie_{
+				Cookie: &envoy_route.RouteAction_HashPolicy_Cookie{
+					Name: policy.GetCookie().GetName(),
+					Ttl:  policy.GetCookie().GetTtl(),
+					Path: policy.GetCookie().GetPath(),
+				},
+			}
+		case *mesh_proto.TrafficRoute_LoadBalancer_HashPolicy_SourceIp_:
+			hashPolicy.PolicySpecifier = &envoy_route.RouteAction_HashPolicy_ConnectionProperties_{
+				ConnectionProperties: &envoy_route.RouteAction_HashPolicy_ConnectionProperties{
+					SourceIp: true,
+				},
+			}
+		default:
+			continue
+		}
+		hashPolicies = append(hashPolicies, hashPolicy)
+	}
+	for _, route := range virtualHost.Routes {
+		routeAction := route.GetRoute()
+		if routeAction == nil {
+			continue
+		}
+		routeAction.HashPolicy = hashPolicies
+	}
+	return nil
+}
@@ -0,0 +1,94 @@
+package routes_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+
+	. "github.com/Kong/kuma/pkg/xds/envoy/routes"
+
+	mesh_proto "github.com/Kong/kuma/api/mesh/v1alpha1"
+	util_proto "github.com/Kong/kuma/pkg/util/proto"
+	envoy_common "github.com/Kong/kuma/pkg/xds/envoy"
+)
+
+var _ = Describe("HashPolicyConfigurer", func() {
+
+	type testCase struct {
+		loadBalancer string
+		expected     string
+	}
+
+	DescribeTable("should generate proper Envoy config",
+		func(given testCase) {
+			// setup
+			loadBalancer := &mesh_proto.TrafficRoute_LoadBalancer{}
+			Expect(util_proto.FromYAML([]byte(given.loadBalancer), loadBalancer)).To(Succeed())
+
+			// when
+			virtualHost, err := NewVirtualHostBuilder().
+				Configure(CommonVirtualHost("backend")).
+				Configure(DefaultRoute(envoy_common.ClusterInfo{Name: "backend"})).
+				Configure(HashPolicies(loadBalancer)).
+				Build()
+			// then
+			Expect(err).ToNot(HaveOccurred())
+
+			// when
+			actual, err := util_proto.ToYAML(virtualHost)
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			// and
+			Expect(actual).To(MatchYAML(given.expected))
+		},
+		Entry("without hash policies", testCase{
+			loadBalancer: `
+            leastRequest: {}
+`,
+			expected: `
+            name: backend
+            domains:
+            - '*'
+            routes:
+            - match:
+                prefix: /
+              route:
+                cluster: backend
+`,
+		}),
+		Entry("with hash policies", testCase{
+			loadBalancer: `
+            ringHash: {}
+            hashPolicies:
+            - header:
+                name: x-session-id
+              terminal: true
+            - cookie:
+                name: session
+                ttl: 3600s
+                path: /
+            - sourceIp: {}
+`,
+			expected: `
+            name: backend
+            domains:
+            - '*'
+            routes:
+            - match:
+                prefix: /
+              route:
+                cluster: backend
+                hashPolicy:
+                - header:
+                    headerName: x-session-id
+                  terminal: true
+                - cookie:
+                    name: session
+                    ttl: 3600s
+                    path: /
+                - connectionProperties:
+                    sourceIp: true
+`,
+		}),
+	)
+})
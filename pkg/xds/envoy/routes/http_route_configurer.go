@@ -0,0 +1,111 @@
+package routes
+
+import (
+	"sort"
+
+	envoy_route "github.com/envoyproxy/go-control-plane/envoy/api/v2/route"
+	envoy_type_matcher "github.com/envoyproxy/go-control-plane/envoy/type/matcher"
+
+	mesh_proto "github.com/Kong/kuma/api/mesh/v1alpha1"
+	envoy_common "github.com/Kong/kuma/pkg/xds/envoy"
+)
+
+// HttpRoute adds a Route that forwards HTTP requests matching given criteria to the given clusters.
+//
+// Routes are evaluated by Envoy in the order they were added, so HttpRoute must precede DefaultRoute.
+func HttpRoute(match *mesh_proto.TrafficRoute_Http_Match, clusters ...envoy_common.ClusterInfo) VirtualHostBuilderOpt {
+	return VirtualHostBuilderOptFunc(func(config *VirtualHostBuilderConfig) {
+		config.Add(&HttpRouteConfigurer{
+			match: match,
+			RouteConfigurer: RouteConfigurer{
+				clusters: clusters,
+			},
+		})
+	})
+}
+
+type HttpRouteConfigurer struct {
+	RouteConfigurer
+	// Criteria to match HTTP requests against.
+	match *mesh_proto.TrafficRoute_Http_Match
+}
+
+func (c HttpRouteConfigurer) Configure(virtualHost *envoy_route.VirtualHost) error {
+	route := &envoy_route.Route{
+		Match: c.routeMatch(),
+		Action: &envoy_route.Route_Route{
+			Route: c.routeAction(),
+		},
+	}
+	virtualHost.Routes = append(virtualHost.Routes, route)
+	return nil
+}
+
+func (c HttpRouteConfigurer) routeMatch() *envoy_route.RouteMatch {
+	routeMatch := &envoy_route.RouteMatch{}
+	path := c.match.GetPath()
+	switch path.GetMatcherType().(type) {
+	case *mesh_proto.TrafficRoute_Http_Match_StringMatcher_Exact:
+		routeMatch.PathSpecifier = &envoy_route.RouteMatch_Path{
+			Path: path.GetExact(),
+		}
+	case *mesh_proto.TrafficRoute_Http_Match_StringMatcher_Regex:
+		routeMatch.PathSpecifier = &envoy_route.RouteMatch_SafeRegex{
+			SafeRegex: regexMatcher(path.GetRegex()),
+		}
+	case *mesh_proto.TrafficRoute_Http_Match_StringMatcher_Prefix:
+		routeMatch.PathSpecifier = &envoy_route.RouteMatch_Prefix{
+			Prefix: path.GetPrefix(),
+		}
+	default:
+		routeMatch.PathSpecifier = &envoy_route.RouteMatch_Prefix{
+			Prefix: "/",
+		}
+	}
+	if c.match.GetMethod() != nil {
+		routeMatch.Headers = append(routeMatch.Headers, headerMatcher(":method", c.match.GetMethod()))
+	}
+	var names []string
+	for name := range c.match.GetHeaders() {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		routeMatch.Headers = append(routeMatch.Headers, headerMatcher(name, c.match.GetHeaders()[name]))
+	}
+	return routeMatch
+}
+
+func headerMatcher(name string, matcher *mesh_proto.TrafficRoute_Http_Match_StringMatcher) *envoy_route.HeaderMatcher {
+	headerMatcher := &envoy_route.HeaderMatcher{
+		Name: name,
+	}
+	switch matcher.GetMatcherType().(type) {
+	case *mesh_proto.TrafficRoute_Http_Match_StringMatcher_Prefix:
+		headerMatcher.HeaderMatchSpecifier = &envoy_route.HeaderMatcher_PrefixMatch{
+			PrefixMatch: matcher.GetPrefix(),
+		}
+	case *mesh_proto.TrafficRoute_Http_Match_StringMatcher_Regex:
+		headerMatcher.HeaderMatchSpecifier = &envoy_route.HeaderMatcher_SafeRegexMatch{
+			SafeRegexMatch: regexMatcher(matcher.GetRegex()),
+		}
+	case *mesh_proto.TrafficRoute_Http_Match_StringMatcher_Exact:
+		headerMatcher.HeaderMatchSpecifier = &envoy_route.HeaderMatcher_ExactMatch{
+			ExactMatch: matcher.GetExact(),
+		}
+	default:
+		headerMatcher.HeaderMatchSpecifier = &envoy_route.HeaderMatcher_PresentMatch{
+			PresentMatch: true,
+		}
+	}
+	return headerMatcher
+}
+
+func regexMatcher(regex string) *envoy_type_matcher.RegexMatcher {
+	return &envoy_type_matcher.RegexMatcher{
+		EngineType: &envoy_type_matcher.RegexMatcher_GoogleRe2{
+			GoogleRe2: &envoy_type_matcher.RegexMatcher_GoogleRE2{},
+		},
+		Regex: regex,
+	}
+}
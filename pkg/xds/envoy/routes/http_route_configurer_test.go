@@ -0,0 +1,134 @@
+package routes_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+
+	. "github.com/Kong/kuma/pkg/xds/envoy/routes"
+
+	mesh_proto "github.com/Kong/kuma/api/mesh/v1alpha1"
+	util_proto "github.com/Kong/kuma/pkg/util/proto"
+	envoy_common "github.com/Kong/kuma/pkg/xds/envoy"
+)
+
+var _ = Describe("HttpRouteConfigurer", func() {
+
+	type testCase struct {
+		match    string
+		clusters []envoy_common.ClusterInfo
+		expected string
+	}
+
+	DescribeTable("should generate proper Envoy config",
+		func(given testCase) {
+			// setup
+			match := &mesh_proto.TrafficRoute_Http_Match{}
+			Expect(util_proto.FromYAML([]byte(given.match), match)).To(Succeed())
+
+			// when
+			virtualHost, err := NewVirtualHostBuilder().
+				Configure(HttpRoute(match, given.clusters...)).
+				Configure(DefaultRoute(envoy_common.ClusterInfo{Name: "backend"})).
+				Build()
+			// then
+			Expect(err).ToNot(HaveOccurred())
+
+			// when
+			actual, err := util_proto.ToYAML(virtualHost)
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			// and
+			Expect(actual).To(MatchYAML(given.expected))
+		},
+		Entry("path prefix", testCase{
+			match: `
+            path:
+              prefix: /api/v2
+`,
+			clusters: []envoy_common.ClusterInfo{
+				{Name: "backend{version=v2}", Weight: 100},
+			},
+			expected: `
+            routes:
+            - match:
+                prefix: /api/v2
+              route:
+                cluster: backend{version=v2}
+            - match:
+                prefix: /
+              route:
+                cluster: backend
+`,
+		}),
+		Entry("exact path and method", testCase{
+			match: `
+            path:
+              exact: /invoices
+            method:
+              exact: GET
+`,
+			clusters: []envoy_common.ClusterInfo{
+				{Name: "backend{version=v1}", Weight: 30},
+				{Name: "backend{version=v2}", Weight: 70},
+			},
+			expected: `
+            routes:
+            - match:
+                path: /invoices
+                headers:
+                - name: :method
+                  exactMatch: GET
+              route:
+                weightedClusters:
+                  clusters:
+                  - name: backend{version=v1}
+                    weight: 30
+                  - name: backend{version=v2}
+                    weight: 70
+            - match:
+                prefix: /
+              route:
+                cluster: backend
+`,
+		}),
+		Entry("regex path and headers", testCase{
+			match: `
+            path:
+              regex: /users/[0-9]+
+            headers:
+              x-canary:
+                exact: "true"
+              x-region:
+                prefix: eu-
+              x-user:
+                regex: ^admin.*
+`,
+			clusters: []envoy_common.ClusterInfo{
+				{Name: "backend{version=canary}", Weight: 100},
+			},
+			expected: `
+            routes:
+            - match:
+                safeRegex:
+                  googleRe2: {}
+                  regex: /users/[0-9]+
+                headers:
+                - name: x-canary
+                  exactMatch: "true"
+                - name: x-region
+                  prefixMatch: eu-
+                - name: x-user
+                  safeRegexMatch:
+                    googleRe2: {}
+                    regex: ^admin.*
+              route:
+                cluster: backend{version=canary}
+            - match:
+                prefix: /
+              route:
+                cluster: backend
+`,
+		}),
+	)
+})
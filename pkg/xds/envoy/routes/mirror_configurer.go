@@ -0,0 +1,65 @@
+package routes
+
+import (
+	"math"
+
+	envoy_core "github.com/envoyproxy/go-control-plane/envoy/api/v2/core"
+	envoy_route "github.com/envoyproxy/go-control-plane/envoy/api/v2/route"
+	envoy_type "github.com/envoyproxy/go-control-plane/envoy/type"
+	"github.com/golang/protobuf/ptypes/wrappers"
+
+	envoy_common "github.com/Kong/kuma/pkg/xds/envoy"
+)
+
+// Mirror makes all Routes of a VirtualHost send a copy of requests to a given cluster.
+func Mirror(cluster *envoy_common.ClusterInfo, percentage *wrappers.DoubleValue) VirtualHostBuilderOpt {
+	return VirtualHostBuilderOptFunc(func(config *VirtualHostBuilderConfig) {
+		if cluster != nil {
+			config.Add(&MirrorConfigurer{
+				cluster:    *cluster,
+				percentage: percentage,
+			})
+		}
+	})
+}
+
+type MirrorConfigurer struct {
+	cluster    envoy_common.ClusterInfo
+	percentage *wrappers.DoubleValue
+}
+
+func (c MirrorConfigurer) Configure(virtualHost *envoy_route.VirtualHost) error {
+	for _, route := range virtualHost.Routes {
+		routeAction := route.GetRoute()
+		if routeAction == nil {
+			continue
+		}
+		routeAction.RequestMirrorPolicy = &envoy_route.RouteAction_RequestMirrorPolicy{
+			Cluster: c.cluster.Name,
+			RuntimeFraction: &envoy_core.RuntimeFractionalPercent{
+				DefaultValue: mirrorFraction(c.percentage),
+			},
+		}
+	}
+	return nil
+}
+
+func mirrorFraction(percentage *wrappers.DoubleValue) *envoy_type.FractionalPercent {
+	if percentage == nil {
+		return &envoy_type.FractionalPercent{
+			Numerator:   100,
+			Denominator: envoy_type.FractionalPercent_HUNDRED,
+		}
+	}
+	value := percentage.GetValue()
+	if math.Floor(value) == value {
+		return &envoy_type.FractionalPercent{
+			Numerator:   uint32(value),
+			Denominator: envoy_type.FractionalPercent_HUNDRED,
+		}
+	}
+	return &envoy_type.FractionalPercent{
+		Numerator:   uint32(math.Round(value * 10000)),
+		Denominator: envoy_type.FractionalPercent_MILLION,
+	}
+}
@@ -0,0 +1,93 @@
+package routes_test
+
+import (
+	"github.com/golang/protobuf/ptypes/wrappers"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+
+	. "github.com/Kong/kuma/pkg/xds/envoy/routes"
+
+	util_proto "github.com/Kong/kuma/pkg/util/proto"
+	envoy_common "github.com/Kong/kuma/pkg/xds/envoy"
+)
+
+var _ = Describe("MirrorConfigurer", func() {
+
+	type testCase struct {
+		cluster    *envoy_common.ClusterInfo
+		percentage *wrappers.DoubleValue
+		expected   string
+	}
+
+	DescribeTable("should generate proper Envoy config",
+		func(given testCase) {
+			// when
+			virtualHost, err := NewVirtualHostBuilder().
+				Configure(CommonVirtualHost("backend")).
+				Configure(DefaultRoute(envoy_common.ClusterInfo{Name: "backend"})).
+				Configure(Mirror(given.cluster, given.percentage)).
+				Build()
+			// then
+			Expect(err).ToNot(HaveOccurred())
+
+			// when
+			actual, err := util_proto.ToYAML(virtualHost)
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			// and
+			Expect(actual).To(MatchYAML(given.expected))
+		},
+		Entry("without mirror", testCase{
+			cluster: nil,
+			expected: `
+            name: backend
+            domains:
+            - '*'
+            routes:
+            - match:
+                prefix: /
+              route:
+                cluster: backend
+`,
+		}),
+		Entry("with mirror of all requests", testCase{
+			cluster: &envoy_common.ClusterInfo{Name: "backend{version=v2}"},
+			expected: `
+            name: backend
+            domains:
+            - '*'
+            routes:
+            - match:
+                prefix: /
+              route:
+                cluster: backend
+                requestMirrorPolicy:
+                  cluster: backend{version=v2}
+                  runtimeFraction:
+                    defaultValue:
+                      numerator: 100
+`,
+		}),
+		Entry("with mirror of a fraction of requests", testCase{
+			cluster:    &envoy_common.ClusterInfo{Name: "backend{version=v2}"},
+			percentage: &wrappers.DoubleValue{Value: 12.5},
+			expected: `
+            name: backend
+            domains:
+            - '*'
+            routes:
+            - match:
+                prefix: /
+              route:
+                cluster: backend
+                requestMirrorPolicy:
+                  cluster: backend{version=v2}
+                  runtimeFraction:
+                    defaultValue:
+                      denominator: MILLION
+                      numerator: 125000
+`,
+		}),
+	)
+})
@@ -0,0 +1,65 @@
+package routes
+
+import (
+	"strings"
+
+	envoy_route "github.com/envoyproxy/go-control-plane/envoy/api/v2/route"
+
+	mesh_core "github.com/Kong/kuma/pkg/core/resources/apis/mesh"
+)
+
+// defaultRetryOn is a list of conditions under which a request is retried unless a Retry policy says otherwise.
+const defaultRetryOn = "gateway-error,connect-failure,refused-stream"
+
+func Retry(retry *mesh_core.RetryResource) VirtualHostBuilderOpt {
+	return VirtualHostBuilderOptFunc(func(config *VirtualHostBuilderConfig) {
+		if retry != nil && retry.HasHttpRetries() {
+			config.Add(&RetryConfigurer{
+				retry: retry,
+			})
+		}
+	})
+}
+
+type RetryConfigurer struct {
+	retry *mesh_core.RetryResource
+}
+
+func (c RetryConfigurer) Configure(virtualHost *envoy_route.VirtualHost) error {
+	conf := c.retry.Spec.GetConf().GetHttp()
+	policy := &envoy_route.RetryPolicy{
+		RetryOn:              c.retryOn(),
+		NumRetries:           conf.GetNumRetries(),
+		PerTryTimeout:        conf.GetPerTryTimeout(),
+		RetriableStatusCodes: conf.GetRetriableStatusCodes(),
+	}
+	if conf.GetBackOff() != nil {
+		policy.RetryBackOff = &envoy_route.RetryPolicy_RetryBackOff{
+			BaseInterval: conf.GetBackOff().GetBaseInterval(),
+			MaxInterval:  conf.GetBackOff().GetMaxInterval(),
+		}
+	}
+	virtualHost.RetryPolicy = policy
+	return nil
+}
+
+func (c RetryConfigurer) retryOn() string {
+	conf := c.retry.Spec.GetConf().GetHttp()
+	conditions := conf.GetRetryOn()
+	if len(conditions) == 0 {
+		conditions = strings.Split(defaultRetryOn, ",")
+	}
+	if len(conf.GetRetriableStatusCodes()) > 0 && !contains(conditions, "retriable-status-codes") {
+		conditions = append(conditions, "retriable-status-codes")
+	}
+	return strings.Join(conditions, ",")
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,102 @@
+package routes_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+
+	. "github.com/Kong/kuma/pkg/xds/envoy/routes"
+
+	mesh_core "github.com/Kong/kuma/pkg/core/resources/apis/mesh"
+	util_proto "github.com/Kong/kuma/pkg/util/proto"
+)
+
+var _ = Describe("RetryConfigurer", func() {
+
+	type testCase struct {
+		retry    string
+		expected string
+	}
+
+	DescribeTable("should generate proper Envoy config",
+		func(given testCase) {
+			// setup
+			retry := &mesh_core.RetryResource{}
+			Expect(util_proto.FromYAML([]byte(given.retry), &retry.Spec)).To(Succeed())
+
+			// when
+			virtualHost, err := NewVirtualHostBuilder().
+				Configure(CommonVirtualHost("backend")).
+				Configure(Retry(retry)).
+				Build()
+			// then
+			Expect(err).ToNot(HaveOccurred())
+
+			// when
+			actual, err := util_proto.ToYAML(virtualHost)
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			// and
+			Expect(actual).To(MatchYAML(given.expected))
+		},
+		Entry("without http retries", testCase{
+			retry: `
+            conf:
+              tcp:
+                maxConnectAttempts: 5
+`,
+			expected: `
+            name: backend
+            domains:
+            - '*'
+`,
+		}),
+		Entry("with default retry conditions", testCase{
+			retry: `
+            conf:
+              http:
+                numRetries: 3
+`,
+			expected: `
+            name: backend
+            domains:
+            - '*'
+            retryPolicy:
+              numRetries: 3
+              retryOn: gateway-error,connect-failure,refused-stream
+`,
+		}),
+		Entry("with all http retry settings", testCase{
+			retry: `
+            conf:
+              http:
+                numRetries: 5
+                perTryTimeout: 0.2s
+                backOff:
+                  baseInterval: 0.02s
+                  maxInterval: 1s
+                retryOn:
+                - 5xx
+                - reset
+                retriableStatusCodes:
+                - 503
+                - 504
+`,
+			expected: `
+            name: backend
+            domains:
+            - '*'
+            retryPolicy:
+              numRetries: 5
+              perTryTimeout: 0.200s
+              retryBackOff:
+                baseInterval: 0.020s
+                maxInterval: 1s
+              retryOn: 5xx,reset,retriable-status-codes
+              retriableStatusCodes:
+              - 503
+              - 504
+`,
+		}),
+	)
+})
@@ -0,0 +1,39 @@
+package routes
+
+import (
+	envoy_route "github.com/envoyproxy/go-control-plane/envoy/api/v2/route"
+
+	mesh_core "github.com/Kong/kuma/pkg/core/resources/apis/mesh"
+)
+
+// Timeout applies HTTP timeouts to all Routes of a VirtualHost.
+func Timeout(timeout *mesh_core.TimeoutResource) VirtualHostBuilderOpt {
+	return VirtualHostBuilderOptFunc(func(config *VirtualHostBuilderConfig) {
+		if timeout != nil && timeout.HasHttpTimeouts() {
+			config.Add(&TimeoutConfigurer{
+				timeout: timeout,
+			})
+		}
+	})
+}
+
+type TimeoutConfigurer struct {
+	timeout *mesh_core.TimeoutResource
+}
+
+func (c TimeoutConfigurer) Configure(virtualHost *envoy_route.VirtualHost) error {
+	conf := c.timeout.Spec.GetConf().GetHttp()
+	for _, route := range virtualHost.Routes {
+		routeAction := route.GetRoute()
+		if routeAction == nil {
+			continue
+		}
+		if conf.GetRequestTimeout() != nil {
+			routeAction.Timeout = conf.GetRequestTimeout()
+		}
+		if conf.GetIdleTimeout() != nil {
+			routeAction.IdleTimeout = conf.GetIdleTimeout()
+		}
+	}
+	return nil
+}
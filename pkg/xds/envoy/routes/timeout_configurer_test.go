@@ -0,0 +1,81 @@
+package routes_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+
+	. "github.com/Kong/kuma/pkg/xds/envoy/routes"
+
+	mesh_core "github.com/Kong/kuma/pkg/core/resources/apis/mesh"
+	util_proto "github.com/Kong/kuma/pkg/util/proto"
+	envoy_common "github.com/Kong/kuma/pkg/xds/envoy"
+)
+
+var _ = Describe("TimeoutConfigurer", func() {
+
+	type testCase struct {
+		timeout  string
+		expected string
+	}
+
+	DescribeTable("should generate proper Envoy config",
+		func(given testCase) {
+			// setup
+			timeout := &mesh_core.TimeoutResource{}
+			Expect(util_proto.FromYAML([]byte(given.timeout), &timeout.Spec)).To(Succeed())
+
+			// when
+			virtualHost, err := NewVirtualHostBuilder().
+				Configure(CommonVirtualHost("backend")).
+				Configure(DefaultRoute(envoy_common.ClusterInfo{Name: "backend"})).
+				Configure(Timeout(timeout)).
+				Build()
+			// then
+			Expect(err).ToNot(HaveOccurred())
+
+			// when
+			actual, err := util_proto.ToYAML(virtualHost)
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			// and
+			Expect(actual).To(MatchYAML(given.expected))
+		},
+		Entry("without http timeouts", testCase{
+			timeout: `
+            conf:
+              connectTimeout: 2s
+`,
+			expected: `
+            name: backend
+            domains:
+            - '*'
+            routes:
+            - match:
+                prefix: /
+              route:
+                cluster: backend
+`,
+		}),
+		Entry("with http timeouts", testCase{
+			timeout: `
+            conf:
+              http:
+                requestTimeout: 30s
+                idleTimeout: 300s
+`,
+			expected: `
+            name: backend
+            domains:
+            - '*'
+            routes:
+            - match:
+                prefix: /
+              route:
+                cluster: backend
+                idleTimeout: 300s
+                timeout: 30s
+`,
+		}),
+	)
+})
@@ -8,6 +8,7 @@ import (
 	"github.com/golang/protobuf/ptypes/wrappers"
 
 	core_xds "github.com/Kong/kuma/pkg/core/xds"
+	sds_external_service "github.com/Kong/kuma/pkg/sds/provider/externalservice"
 	"github.com/Kong/kuma/pkg/sds/server"
 	util_xds "github.com/Kong/kuma/pkg/util/xds"
 	xds_context "github.com/Kong/kuma/pkg/xds/context"
@@ -40,6 +41,43 @@ func CreateUpstreamTlsContext(ctx xds_context.Context, metadata *core_xds.Datapl
 	}, nil
 }
 
+// CreateUpstreamTlsContextOutsideMesh creates a TLS context for connections
+// to an ExternalService, i.e. a service that is not a part of the mesh
+// and therefore doesn't use mesh certificates.
+//
+// TLS material of the ExternalService is served over SDS.
+func CreateUpstreamTlsContextOutsideMesh(ctx xds_context.Context, metadata *core_xds.DataplaneMetadata, externalService *core_xds.ExternalService, sni string) (*envoy_auth.UpstreamTlsContext, error) {
+	if !externalService.TLSEnabled {
+		return nil, nil
+	}
+	if externalService.ServerName != "" {
+		sni = externalService.ServerName
+	}
+	commonTlsContext := &envoy_auth.CommonTlsContext{}
+	if externalService.HasCaCert() {
+		caSecret, err := sdsSecretConfig(ctx, sds_external_service.CaResource(externalService.Name), metadata)
+		if err != nil {
+			return nil, err
+		}
+		commonTlsContext.ValidationContextType = &envoy_auth.CommonTlsContext_ValidationContextSdsSecretConfig{
+			ValidationContextSdsSecretConfig: caSecret,
+		}
+	}
+	if externalService.HasClientCert() {
+		clientCertSecret, err := sdsSecretConfig(ctx, sds_external_service.ClientCertResource(externalService.Name), metadata)
+		if err != nil {
+			return nil, err
+		}
+		commonTlsContext.TlsCertificateSdsSecretConfigs = []*envoy_auth.SdsSecretConfig{
+			clientCertSecret,
+		}
+	}
+	return &envoy_auth.UpstreamTlsContext{
+		CommonTlsContext: commonTlsContext,
+		Sni:              sni,
+	}, nil
+}
+
 func CreateCommonTlsContext(ctx xds_context.Context, metadata *core_xds.DataplaneMetadata) (*envoy_auth.CommonTlsContext, error) {
 	meshCaSecret, err := sdsSecretConfig(ctx, server.MeshCaResource, metadata)
 	if err != nil {
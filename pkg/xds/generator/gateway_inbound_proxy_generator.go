@@ -0,0 +1,90 @@
+package generator
+
+import (
+	"github.com/pkg/errors"
+
+	mesh_core "github.com/Kong/kuma/pkg/core/resources/apis/mesh"
+	"github.com/Kong/kuma/pkg/core/validators"
+	model "github.com/Kong/kuma/pkg/core/xds"
+	xds_context "github.com/Kong/kuma/pkg/xds/context"
+
+	envoy_common "github.com/Kong/kuma/pkg/xds/envoy"
+	envoy_clusters "github.com/Kong/kuma/pkg/xds/envoy/clusters"
+	envoy_listeners "github.com/Kong/kuma/pkg/xds/envoy/listeners"
+	envoy_names "github.com/Kong/kuma/pkg/xds/envoy/names"
+)
+
+// GatewayInboundProxyGenerator generates inbound listeners that accept traffic
+// from clients outside of the mesh as well as from other dataplanes in the mesh.
+//
+// When mTLS is enabled, TLS connections are handled by the same filter chain as in InboundProxyGenerator,
+// i.e. client certificates are required and TrafficPermissions are enforced.
+// Plain text connections are accepted from any client, since non-mesh clients have no identity in the mesh.
+type GatewayInboundProxyGenerator struct {
+}
+
+func (g GatewayInboundProxyGenerator) Generate(ctx xds_context.Context, proxy *model.Proxy) ([]*model.Resource, error) {
+	endpoints, err := proxy.Dataplane.Spec.Networking.GetInboundInterfaces()
+	if err != nil {
+		return nil, err
+	}
+	if len(endpoints) == 0 {
+		return nil, nil
+	}
+	resources := &model.ResourceSet{}
+	for i, endpoint := range endpoints {
+		// generate CDS resource
+		localClusterName := envoy_names.GetLocalClusterName(endpoint.WorkloadPort)
+		resources.Add(&model.Resource{
+			Name:     localClusterName,
+			Version:  "",
+			Resource: envoy_clusters.CreateLocalCluster(localClusterName, "127.0.0.1", endpoint.WorkloadPort),
+			Origin:   mesh_core.OriginInbound,
+		})
+
+		// generate LDS resource
+		iface := proxy.Dataplane.Spec.Networking.Inbound[i]
+		service := iface.GetService()
+		protocol := mesh_core.ParseProtocol(iface.GetProtocol())
+		inboundListenerName := envoy_names.GetInboundListenerName(endpoint.DataplaneIP, endpoint.DataplanePort)
+		listenerBuilder := envoy_listeners.NewListenerBuilder().
+			Configure(envoy_listeners.InboundListener(inboundListenerName, endpoint.DataplaneIP, endpoint.DataplanePort))
+		if ctx.Mesh.Resource.Spec.GetMtls().GetEnabled() {
+			listenerBuilder.
+				Configure(envoy_listeners.TLSInspector()).
+				Configure(envoy_listeners.FilterChain(
+					inboundFilterChain(ctx, proxy, iface, endpoint, inboundListenerName, localClusterName).
+						Configure(envoy_listeners.MatchTransportProtocol("tls")),
+				))
+		}
+		filterChainBuilder := envoy_listeners.NewFilterChainBuilder()
+		switch protocol {
+		case mesh_core.ProtocolHTTP:
+			filterChainBuilder.
+				Configure(envoy_listeners.HttpConnectionManager(localClusterName)).
+				Configure(envoy_listeners.HttpInboundAccessLog(proxy.Id.Mesh, service, proxy.InboundLogs[endpoint], proxy)).
+				Configure(envoy_listeners.Tracing(proxy.TracingBackend)).
+				Configure(envoy_listeners.HttpInboundRoute(service, envoy_common.ClusterInfo{Name: localClusterName}))
+		case mesh_core.ProtocolTCP:
+			fallthrough
+		default:
+			filterChainBuilder.
+				Configure(envoy_listeners.TcpProxy(localClusterName, envoy_common.ClusterInfo{Name: localClusterName})).
+				Configure(envoy_listeners.NetworkInboundAccessLog(proxy.Id.Mesh, service, proxy.InboundLogs[endpoint], proxy))
+		}
+		inboundListener, err := listenerBuilder.
+			Configure(envoy_listeners.FilterChain(filterChainBuilder)).
+			Configure(envoy_listeners.TransparentProxying(proxy.Dataplane.Spec.Networking.GetTransparentProxying())).
+			Build()
+		if err != nil {
+			return nil, errors.Wrapf(err, "%s: could not generate listener %s", validators.RootedAt("dataplane").Field("networking").Field("inbound").Index(i), inboundListenerName)
+		}
+		resources.Add(&model.Resource{
+			Name:     inboundListenerName,
+			Version:  "",
+			Resource: inboundListener,
+			Origin:   mesh_core.OriginInbound,
+		})
+	}
+	return resources.List(), nil
+}
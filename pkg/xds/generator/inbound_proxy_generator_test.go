@@ -157,4 +157,187 @@ var _ = Describe("InboundProxyGenerator", func() {
 			envoyConfigFile: "4-envoy-config.golden.yaml",
 		}),
 	)
+
+	It("should restrict HTTP requests according to HTTP rules of TrafficPermission", func() {
+		// setup
+		gen := &generator.InboundProxyGenerator{}
+		ctx := xds_context.Context{
+			ControlPlane: &xds_context.ControlPlaneContext{
+				SdsLocation: "kuma-system:5677",
+				SdsTlsCert:  []byte("12345"),
+			},
+			Mesh: xds_context.MeshContext{
+				Resource: &mesh_core.MeshResource{
+					Spec: mesh_proto.Mesh{
+						Mtls: &mesh_proto.Mesh_Mtls{
+							Enabled: true,
+						},
+					},
+				},
+			},
+		}
+		dp := `
+        networking:
+          address: 192.168.0.1
+          inbound:
+          - port: 80
+            servicePort: 8080
+            tags:
+              service: ledger
+              protocol: http`
+		dataplane := mesh_proto.Dataplane{}
+		Expect(util_proto.FromYAML([]byte(dp), &dataplane)).To(Succeed())
+
+		permission := `
+        sources:
+        - match:
+            service: billing
+        destinations:
+        - match:
+            service: ledger
+        http:
+        - pathPrefix: /invoices
+          methods:
+          - GET`
+		permissionSpec := mesh_proto.TrafficPermission{}
+		Expect(util_proto.FromYAML([]byte(permission), &permissionSpec)).To(Succeed())
+
+		proxy := &model.Proxy{
+			Id: model.ProxyId{Name: "side-car"},
+			Dataplane: &mesh_core.DataplaneResource{
+				Meta: &test_model.ResourceMeta{
+					Version: "1",
+				},
+				Spec: dataplane,
+			},
+			TrafficPermissions: model.TrafficPermissionMap{
+				mesh_proto.InboundInterface{
+					DataplaneIP:   "192.168.0.1",
+					DataplanePort: 80,
+					WorkloadPort:  8080,
+				}: &mesh_core.TrafficPermissionResource{
+					Meta: &test_model.ResourceMeta{
+						Name: "billing-to-ledger",
+						Mesh: "default",
+					},
+					Spec: permissionSpec,
+				},
+			},
+			Metadata: &model.DataplaneMetadata{},
+		}
+
+		// when
+		rs, err := gen.Generate(ctx, proxy)
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+
+		// when
+		resp, err := model.ResourceList(rs).ToDeltaDiscoveryResponse()
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		// when
+		actual, err := util_proto.ToYAML(resp)
+		// then
+		Expect(err).ToNot(HaveOccurred())
+
+		expected, err := ioutil.ReadFile(filepath.Join("testdata", "inbound-proxy", "http-rbac.envoy.golden.yaml"))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(actual).To(MatchYAML(expected))
+	})
+
+	It("should log inbound traffic according to TrafficLog", func() {
+		// setup
+		gen := &generator.InboundProxyGenerator{}
+		ctx := xds_context.Context{
+			ControlPlane: &xds_context.ControlPlaneContext{
+				SdsLocation: "kuma-system:5677",
+				SdsTlsCert:  []byte("12345"),
+			},
+			Mesh: xds_context.MeshContext{
+				Resource: &mesh_core.MeshResource{
+					Spec: mesh_proto.Mesh{
+						Mtls: &mesh_proto.Mesh_Mtls{
+							Enabled: true,
+						},
+					},
+				},
+			},
+		}
+		dp := `
+        networking:
+          address: 192.168.0.1
+          inbound:
+          - port: 80
+            servicePort: 8080
+            tags:
+              service: ledger
+              protocol: http
+          - port: 5432
+            servicePort: 15432
+            tags:
+              service: postgres`
+		dataplane := mesh_proto.Dataplane{}
+		Expect(util_proto.FromYAML([]byte(dp), &dataplane)).To(Succeed())
+
+		proxy := &model.Proxy{
+			Id: model.ProxyId{Mesh: "default", Name: "side-car"},
+			Dataplane: &mesh_core.DataplaneResource{
+				Meta: &test_model.ResourceMeta{
+					Version: "1",
+				},
+				Spec: dataplane,
+			},
+			InboundLogs: model.InboundLogMap{
+				mesh_proto.InboundInterface{
+					DataplaneIP:   "192.168.0.1",
+					DataplanePort: 80,
+					WorkloadPort:  8080,
+				}: &model.AccessLog{
+					Backend: &mesh_proto.LoggingBackend{
+						Name: "file",
+						Type: &mesh_proto.LoggingBackend_File_{
+							File: &mesh_proto.LoggingBackend_File{
+								Path: "/var/log",
+							},
+						},
+					},
+				},
+				mesh_proto.InboundInterface{
+					DataplaneIP:   "192.168.0.1",
+					DataplanePort: 5432,
+					WorkloadPort:  15432,
+				}: &model.AccessLog{
+					Backend: &mesh_proto.LoggingBackend{
+						Name: "logstash",
+						Type: &mesh_proto.LoggingBackend_Tcp_{
+							Tcp: &mesh_proto.LoggingBackend_Tcp{
+								Address: "logstash:1234",
+							},
+						},
+					},
+				},
+			},
+			Metadata: &model.DataplaneMetadata{},
+		}
+
+		// when
+		rs, err := gen.Generate(ctx, proxy)
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+
+		// when
+		resp, err := model.ResourceList(rs).ToDeltaDiscoveryResponse()
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		// when
+		actual, err := util_proto.ToYAML(resp)
+		// then
+		Expect(err).ToNot(HaveOccurred())
+
+		expected, err := ioutil.ReadFile(filepath.Join("testdata", "inbound-proxy", "access-log.envoy.golden.yaml"))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(actual).To(MatchYAML(expected))
+	})
 })
@@ -0,0 +1,154 @@
+package modifications
+
+import (
+	envoy_api "github.com/envoyproxy/go-control-plane/envoy/api/v2"
+	envoy_listener "github.com/envoyproxy/go-control-plane/envoy/api/v2/listener"
+	envoy_hcm "github.com/envoyproxy/go-control-plane/envoy/config/filter/network/http_connection_manager/v2"
+	"github.com/golang/protobuf/proto"
+	"github.com/pkg/errors"
+
+	mesh_proto "github.com/Kong/kuma/api/mesh/v1alpha1"
+	mesh_core "github.com/Kong/kuma/pkg/core/resources/apis/mesh"
+	model "github.com/Kong/kuma/pkg/core/xds"
+	envoy_listeners "github.com/Kong/kuma/pkg/xds/envoy/listeners"
+)
+
+func applyNetworkFilterModification(resources []*model.Resource, mod *mesh_proto.ProxyTemplate_Modifications_NetworkFilter) error {
+	if err := checkFilterOperation(mod.GetOperation()); err != nil {
+		return err
+	}
+	match := mod.GetMatch()
+	var added *envoy_listener.Filter
+	if isAddition(mod.GetOperation()) {
+		added = &envoy_listener.Filter{}
+		if err := fromYAML(mod.GetValue(), added); err != nil {
+			return err
+		}
+	}
+	for _, filterChain := range filterChainsOf(resources, match.GetListenerName(), match.GetOrigin()) {
+		filters := make([]proto.Message, len(filterChain.Filters))
+		for i, filter := range filterChain.Filters {
+			filters[i] = filter
+		}
+		filters, err := modifyFilters(filters, match.GetName(), mod.GetOperation(), mod.GetValue(), added, func(filter proto.Message) string {
+			return filter.(*envoy_listener.Filter).Name
+		})
+		if err != nil {
+			return err
+		}
+		filterChain.Filters = make([]*envoy_listener.Filter, len(filters))
+		for i, filter := range filters {
+			filterChain.Filters[i] = filter.(*envoy_listener.Filter)
+		}
+	}
+	return nil
+}
+
+func applyHttpFilterModification(resources []*model.Resource, mod *mesh_proto.ProxyTemplate_Modifications_HttpFilter) error {
+	if err := checkFilterOperation(mod.GetOperation()); err != nil {
+		return err
+	}
+	match := mod.GetMatch()
+	var added *envoy_hcm.HttpFilter
+	if isAddition(mod.GetOperation()) {
+		added = &envoy_hcm.HttpFilter{}
+		if err := fromYAML(mod.GetValue(), added); err != nil {
+			return err
+		}
+	}
+	for _, filterChain := range filterChainsOf(resources, match.GetListenerName(), match.GetOrigin()) {
+		err := envoy_listeners.UpdateHTTPConnectionManager(filterChain, func(manager *envoy_hcm.HttpConnectionManager) error {
+			filters := make([]proto.Message, len(manager.HttpFilters))
+			for i, filter := range manager.HttpFilters {
+				filters[i] = filter
+			}
+			filters, err := modifyFilters(filters, match.GetName(), mod.GetOperation(), mod.GetValue(), added, func(filter proto.Message) string {
+				return filter.(*envoy_hcm.HttpFilter).Name
+			})
+			if err != nil {
+				return err
+			}
+			manager.HttpFilters = make([]*envoy_hcm.HttpFilter, len(filters))
+			for i, filter := range filters {
+				manager.HttpFilters[i] = filter.(*envoy_hcm.HttpFilter)
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func checkFilterOperation(operation string) error {
+	switch operation {
+	case mesh_core.OpAddFirst, mesh_core.OpAddLast, mesh_core.OpAddBefore, mesh_core.OpAddAfter, mesh_core.OpPatch, mesh_core.OpRemove:
+		return nil
+	default:
+		return errors.Errorf("unknown operation %q", operation)
+	}
+}
+
+func isAddition(operation string) bool {
+	switch operation {
+	case mesh_core.OpAddFirst, mesh_core.OpAddLast, mesh_core.OpAddBefore, mesh_core.OpAddAfter:
+		return true
+	default:
+		return false
+	}
+}
+
+// filterChainsOf returns filter chains of listeners that match given criteria.
+func filterChainsOf(resources []*model.Resource, listenerName string, origin string) []*envoy_listener.FilterChain {
+	var filterChains []*envoy_listener.FilterChain
+	for _, resource := range resources {
+		listener, ok := resource.Resource.(*envoy_api.Listener)
+		if !ok || !matches(listenerName, resource.Name) || !matches(origin, resource.Origin) {
+			continue
+		}
+		filterChains = append(filterChains, listener.FilterChains...)
+	}
+	return filterChains
+}
+
+// modifyFilters applies an operation to a list of filters, either network or HTTP ones.
+func modifyFilters(filters []proto.Message, name string, operation string, value string, added proto.Message, nameOf func(proto.Message) string) ([]proto.Message, error) {
+	switch operation {
+	case mesh_core.OpAddFirst:
+		return append([]proto.Message{proto.Clone(added)}, filters...), nil
+	case mesh_core.OpAddLast:
+		return append(filters, proto.Clone(added)), nil
+	case mesh_core.OpAddBefore, mesh_core.OpAddAfter:
+		var result []proto.Message
+		for _, filter := range filters {
+			if nameOf(filter) == name && operation == mesh_core.OpAddBefore {
+				result = append(result, proto.Clone(added))
+			}
+			result = append(result, filter)
+			if nameOf(filter) == name && operation == mesh_core.OpAddAfter {
+				result = append(result, proto.Clone(added))
+			}
+		}
+		return result, nil
+	case mesh_core.OpPatch:
+		for _, filter := range filters {
+			if matches(name, nameOf(filter)) {
+				if err := patch(filter, value); err != nil {
+					return nil, err
+				}
+			}
+		}
+		return filters, nil
+	case mesh_core.OpRemove:
+		var result []proto.Message
+		for _, filter := range filters {
+			if !matches(name, nameOf(filter)) {
+				result = append(result, filter)
+			}
+		}
+		return result, nil
+	default:
+		return filters, nil
+	}
+}
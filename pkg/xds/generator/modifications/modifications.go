@@ -0,0 +1,46 @@
+// Package modifications applies modifications of a ProxyTemplate
+// on top of xDS resources generated for a dataplane.
+package modifications
+
+import (
+	"github.com/pkg/errors"
+
+	mesh_proto "github.com/Kong/kuma/api/mesh/v1alpha1"
+	model "github.com/Kong/kuma/pkg/core/xds"
+)
+
+// Apply applies given modifications in order and returns modified resources.
+func Apply(resources []*model.Resource, modifications []*mesh_proto.ProxyTemplate_Modifications) ([]*model.Resource, error) {
+	for i, modification := range modifications {
+		var err error
+		var field string
+		switch mod := modification.GetType().(type) {
+		case *mesh_proto.ProxyTemplate_Modifications_Cluster_:
+			field = "cluster"
+			resources, err = applyClusterModification(resources, mod.Cluster)
+		case *mesh_proto.ProxyTemplate_Modifications_Listener_:
+			field = "listener"
+			resources, err = applyListenerModification(resources, mod.Listener)
+		case *mesh_proto.ProxyTemplate_Modifications_NetworkFilter_:
+			field = "networkFilter"
+			err = applyNetworkFilterModification(resources, mod.NetworkFilter)
+		case *mesh_proto.ProxyTemplate_Modifications_HttpFilter_:
+			field = "httpFilter"
+			err = applyHttpFilterModification(resources, mod.HttpFilter)
+		case *mesh_proto.ProxyTemplate_Modifications_VirtualHost_:
+			field = "virtualHost"
+			err = applyVirtualHostModification(resources, mod.VirtualHost)
+		default:
+			return nil, errors.Errorf("modifications[%d]: unknown type of modification", i)
+		}
+		if err != nil {
+			return nil, errors.Wrapf(err, "modifications[%d].%s", i, field)
+		}
+	}
+	return resources, nil
+}
+
+// matches returns true if a given value matches a criteria. An empty criteria matches any value.
+func matches(criteria string, value string) bool {
+	return criteria == "" || criteria == value
+}
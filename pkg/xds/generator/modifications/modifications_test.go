@@ -0,0 +1,231 @@
+package modifications_test
+
+import (
+	"time"
+
+	envoy_api "github.com/envoyproxy/go-control-plane/envoy/api/v2"
+	"github.com/golang/protobuf/ptypes"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+
+	mesh_proto "github.com/Kong/kuma/api/mesh/v1alpha1"
+	mesh_core "github.com/Kong/kuma/pkg/core/resources/apis/mesh"
+	model "github.com/Kong/kuma/pkg/core/xds"
+	util_proto "github.com/Kong/kuma/pkg/util/proto"
+	envoy_common "github.com/Kong/kuma/pkg/xds/envoy"
+	envoy_listeners "github.com/Kong/kuma/pkg/xds/envoy/listeners"
+	. "github.com/Kong/kuma/pkg/xds/generator/modifications"
+)
+
+var _ = Describe("Apply()", func() {
+
+	var resources []*model.Resource
+
+	BeforeEach(func() {
+		listener, err := envoy_listeners.NewListenerBuilder().
+			Configure(envoy_listeners.InboundListener("inbound:192.168.0.1:8080", "192.168.0.1", 8080)).
+			Configure(envoy_listeners.FilterChain(envoy_listeners.NewFilterChainBuilder().
+				Configure(envoy_listeners.HttpConnectionManager("localhost:8080")).
+				Configure(envoy_listeners.HttpInboundRoute("backend", envoy_common.ClusterInfo{Name: "localhost:8080"})))).
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+		resources = []*model.Resource{
+			{
+				Name:     "localhost:8080",
+				Origin:   mesh_core.OriginInbound,
+				Resource: &envoy_api.Cluster{Name: "localhost:8080", ConnectTimeout: ptypes.DurationProto(5 * time.Second)},
+			},
+			{
+				Name:     listener.Name,
+				Origin:   mesh_core.OriginInbound,
+				Resource: listener,
+			},
+		}
+	})
+
+	type testCase struct {
+		modifications string
+		expected      string
+	}
+
+	DescribeTable("should modify resources",
+		func(given testCase) {
+			// given
+			conf := &mesh_proto.ProxyTemplate_Conf{}
+			Expect(util_proto.FromYAML([]byte(given.modifications), conf)).To(Succeed())
+
+			// when
+			actual, err := Apply(resources, conf.Modifications)
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			// when
+			actualYAML := ""
+			for _, resource := range actual {
+				res, err := util_proto.ToYAML(resource.Resource)
+				Expect(err).ToNot(HaveOccurred())
+				actualYAML += "---\n" + string(res)
+			}
+			// then
+			Expect(actualYAML).To(Equal(given.expected))
+		},
+		Entry("add and remove clusters", testCase{
+			modifications: `
+            modifications:
+            - cluster:
+                operation: remove
+                match:
+                  origin: inbound
+            - cluster:
+                operation: add
+                value: |
+                  name: tracing
+                  connectTimeout: 1s
+            - listener:
+                operation: remove
+`,
+			expected: `---
+connectTimeout: 1s
+name: tracing
+`,
+		}),
+		Entry("patch listeners and HTTP filters", testCase{
+			modifications: `
+            modifications:
+            - cluster:
+                operation: remove
+            - listener:
+                operation: patch
+                match:
+                  name: inbound:192.168.0.1:8080
+                value: |
+                  perConnectionBufferLimitBytes: 32768
+            - httpFilter:
+                operation: addBefore
+                match:
+                  name: envoy.router
+                  origin: inbound
+                value: |
+                  name: envoy.cors
+            - virtualHost:
+                operation: patch
+                match:
+                  name: backend
+                value: |
+                  domains:
+                  - backend.mesh
+`,
+			expected: `---
+address:
+  socketAddress:
+    address: 192.168.0.1
+    portValue: 8080
+filterChains:
+- filters:
+  - name: envoy.http_connection_manager
+    typedConfig:
+      '@type': type.googleapis.com/envoy.config.filter.network.http_connection_manager.v2.HttpConnectionManager
+      httpFilters:
+      - name: envoy.cors
+      - name: envoy.router
+      routeConfig:
+        name: inbound:backend
+        requestHeadersToRemove:
+        - x-kuma-tags
+        validateClusters: true
+        virtualHosts:
+        - domains:
+          - backend.mesh
+          name: backend
+          routes:
+          - match:
+              prefix: /
+            route:
+              cluster: localhost:8080
+      statPrefix: localhost_8080
+name: inbound:192.168.0.1:8080
+perConnectionBufferLimitBytes: 32768
+trafficDirection: INBOUND
+`,
+		}),
+		Entry("remove network filters of matching listeners only", testCase{
+			modifications: `
+            modifications:
+            - cluster:
+                operation: remove
+            - networkFilter:
+                operation: remove
+                match:
+                  origin: outbound
+`,
+			expected: `---
+address:
+  socketAddress:
+    address: 192.168.0.1
+    portValue: 8080
+filterChains:
+- filters:
+  - name: envoy.http_connection_manager
+    typedConfig:
+      '@type': type.googleapis.com/envoy.config.filter.network.http_connection_manager.v2.HttpConnectionManager
+      httpFilters:
+      - name: envoy.router
+      routeConfig:
+        name: inbound:backend
+        requestHeadersToRemove:
+        - x-kuma-tags
+        validateClusters: true
+        virtualHosts:
+        - domains:
+          - '*'
+          name: backend
+          routes:
+          - match:
+              prefix: /
+            route:
+              cluster: localhost:8080
+      statPrefix: localhost_8080
+name: inbound:192.168.0.1:8080
+trafficDirection: INBOUND
+`,
+		}),
+	)
+
+	type errorTestCase struct {
+		modifications string
+		expectedErr   string
+	}
+
+	DescribeTable("should reject invalid modifications",
+		func(given errorTestCase) {
+			// given
+			conf := &mesh_proto.ProxyTemplate_Conf{}
+			Expect(util_proto.FromYAML([]byte(given.modifications), conf)).To(Succeed())
+
+			// when
+			_, err := Apply(resources, conf.Modifications)
+
+			// then
+			Expect(err).To(MatchError(given.expectedErr))
+		},
+		Entry("unknown operation", errorTestCase{
+			modifications: `
+            modifications:
+            - networkFilter:
+                operation: add
+`,
+			expectedErr: `modifications[0].networkFilter: unknown operation "add"`,
+		}),
+		Entry("patch that makes a resource invalid", errorTestCase{
+			modifications: `
+            modifications:
+            - cluster:
+                operation: patch
+                value: |
+                  name: ""
+`,
+			expectedErr: `modifications[0].cluster: patched resource is not valid: invalid Cluster.Name: value length must be at least 1 bytes`,
+		}),
+	)
+})
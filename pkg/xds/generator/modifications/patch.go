@@ -0,0 +1,64 @@
+package modifications
+
+import (
+	"bytes"
+
+	jsonpatch "github.com/evanphx/json-patch"
+	"github.com/ghodss/yaml"
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/golang/protobuf/proto"
+	"github.com/pkg/errors"
+
+	util_proto "github.com/Kong/kuma/pkg/util/proto"
+)
+
+// fromYAML parses a given value into a message and validates it.
+func fromYAML(value string, message proto.Message) error {
+	json, err := yaml.YAMLToJSON([]byte(value))
+	if err != nil {
+		return errors.Wrap(err, "value is not valid")
+	}
+	if err := fromJSON(json, message); err != nil {
+		return errors.Wrap(err, "value is not valid")
+	}
+	return validate(message)
+}
+
+// patch applies a JSON merge patch written in YAML to a given message in place.
+func patch(message proto.Message, value string) error {
+	patchJson, err := yaml.YAMLToJSON([]byte(value))
+	if err != nil {
+		return errors.Wrap(err, "value is not valid")
+	}
+	messageJson, err := util_proto.ToJSON(message)
+	if err != nil {
+		return err
+	}
+	patchedJson, err := jsonpatch.MergePatch(messageJson, patchJson)
+	if err != nil {
+		return errors.Wrap(err, "could not apply a patch")
+	}
+	patched := proto.Clone(message)
+	patched.Reset()
+	if err := fromJSON(patchedJson, patched); err != nil {
+		return errors.Wrap(err, "could not apply a patch")
+	}
+	if err := validate(patched); err != nil {
+		return errors.Wrap(err, "patched resource is not valid")
+	}
+	message.Reset()
+	proto.Merge(message, patched)
+	return nil
+}
+
+// fromJSON rejects unknown fields, so that typos in modifications are not silently ignored.
+func fromJSON(json []byte, message proto.Message) error {
+	return (&jsonpb.Unmarshaler{}).Unmarshal(bytes.NewReader(json), message)
+}
+
+func validate(message proto.Message) error {
+	if v, ok := message.(interface{ Validate() error }); ok {
+		return v.Validate()
+	}
+	return nil
+}
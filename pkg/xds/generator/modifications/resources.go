@@ -0,0 +1,94 @@
+package modifications
+
+import (
+	envoy_api "github.com/envoyproxy/go-control-plane/envoy/api/v2"
+	"github.com/golang/protobuf/proto"
+	"github.com/pkg/errors"
+
+	mesh_proto "github.com/Kong/kuma/api/mesh/v1alpha1"
+	mesh_core "github.com/Kong/kuma/pkg/core/resources/apis/mesh"
+	model "github.com/Kong/kuma/pkg/core/xds"
+)
+
+func applyClusterModification(resources []*model.Resource, mod *mesh_proto.ProxyTemplate_Modifications_Cluster) ([]*model.Resource, error) {
+	match := mod.GetMatch()
+	matchesCluster := func(resource *model.Resource) bool {
+		_, ok := resource.Resource.(*envoy_api.Cluster)
+		return ok && matches(match.GetName(), resource.Name) && matches(match.GetOrigin(), resource.Origin)
+	}
+	switch mod.GetOperation() {
+	case mesh_core.OpAdd:
+		cluster := &envoy_api.Cluster{}
+		if err := fromYAML(mod.GetValue(), cluster); err != nil {
+			return nil, err
+		}
+		return addResource(resources, &model.Resource{Name: cluster.Name, Resource: cluster}), nil
+	case mesh_core.OpPatch:
+		for _, resource := range resources {
+			if matchesCluster(resource) {
+				if err := patch(resource.Resource, mod.GetValue()); err != nil {
+					return nil, err
+				}
+			}
+		}
+		return resources, nil
+	case mesh_core.OpRemove:
+		return removeResources(resources, matchesCluster), nil
+	default:
+		return nil, errors.Errorf("unknown operation %q", mod.GetOperation())
+	}
+}
+
+func applyListenerModification(resources []*model.Resource, mod *mesh_proto.ProxyTemplate_Modifications_Listener) ([]*model.Resource, error) {
+	match := mod.GetMatch()
+	matchesListener := func(resource *model.Resource) bool {
+		_, ok := resource.Resource.(*envoy_api.Listener)
+		return ok && matches(match.GetName(), resource.Name) && matches(match.GetOrigin(), resource.Origin)
+	}
+	switch mod.GetOperation() {
+	case mesh_core.OpAdd:
+		listener := &envoy_api.Listener{}
+		if err := fromYAML(mod.GetValue(), listener); err != nil {
+			return nil, err
+		}
+		return addResource(resources, &model.Resource{Name: listener.Name, Resource: listener}), nil
+	case mesh_core.OpPatch:
+		for _, resource := range resources {
+			if matchesListener(resource) {
+				if err := patch(resource.Resource, mod.GetValue()); err != nil {
+					return nil, err
+				}
+			}
+		}
+		return resources, nil
+	case mesh_core.OpRemove:
+		return removeResources(resources, matchesListener), nil
+	default:
+		return nil, errors.Errorf("unknown operation %q", mod.GetOperation())
+	}
+}
+
+// addResource adds a resource or replaces a resource of the same type and name.
+func addResource(resources []*model.Resource, added *model.Resource) []*model.Resource {
+	for i, resource := range resources {
+		if resource.Name == added.Name && sameType(resource.Resource, added.Resource) {
+			resources[i] = added
+			return resources
+		}
+	}
+	return append(resources, added)
+}
+
+func removeResources(resources []*model.Resource, predicate func(*model.Resource) bool) []*model.Resource {
+	var result []*model.Resource
+	for _, resource := range resources {
+		if !predicate(resource) {
+			result = append(result, resource)
+		}
+	}
+	return result
+}
+
+func sameType(a, b model.ResourcePayload) bool {
+	return proto.MessageName(a) == proto.MessageName(b)
+}
@@ -0,0 +1,83 @@
+package modifications
+
+import (
+	envoy_api "github.com/envoyproxy/go-control-plane/envoy/api/v2"
+	envoy_route "github.com/envoyproxy/go-control-plane/envoy/api/v2/route"
+	envoy_hcm "github.com/envoyproxy/go-control-plane/envoy/config/filter/network/http_connection_manager/v2"
+	"github.com/golang/protobuf/proto"
+	"github.com/pkg/errors"
+
+	mesh_proto "github.com/Kong/kuma/api/mesh/v1alpha1"
+	mesh_core "github.com/Kong/kuma/pkg/core/resources/apis/mesh"
+	model "github.com/Kong/kuma/pkg/core/xds"
+	envoy_listeners "github.com/Kong/kuma/pkg/xds/envoy/listeners"
+)
+
+func applyVirtualHostModification(resources []*model.Resource, mod *mesh_proto.ProxyTemplate_Modifications_VirtualHost) error {
+	match := mod.GetMatch()
+	var modify func(*envoy_api.RouteConfiguration) error
+	switch mod.GetOperation() {
+	case mesh_core.OpAdd:
+		added := &envoy_route.VirtualHost{}
+		if err := fromYAML(mod.GetValue(), added); err != nil {
+			return err
+		}
+		modify = func(routeConfiguration *envoy_api.RouteConfiguration) error {
+			routeConfiguration.VirtualHosts = append(routeConfiguration.VirtualHosts, proto.Clone(added).(*envoy_route.VirtualHost))
+			return nil
+		}
+	case mesh_core.OpPatch:
+		modify = func(routeConfiguration *envoy_api.RouteConfiguration) error {
+			for _, virtualHost := range routeConfiguration.VirtualHosts {
+				if matches(match.GetName(), virtualHost.Name) {
+					if err := patch(virtualHost, mod.GetValue()); err != nil {
+						return err
+					}
+				}
+			}
+			return nil
+		}
+	case mesh_core.OpRemove:
+		modify = func(routeConfiguration *envoy_api.RouteConfiguration) error {
+			var virtualHosts []*envoy_route.VirtualHost
+			for _, virtualHost := range routeConfiguration.VirtualHosts {
+				if !matches(match.GetName(), virtualHost.Name) {
+					virtualHosts = append(virtualHosts, virtualHost)
+				}
+			}
+			routeConfiguration.VirtualHosts = virtualHosts
+			return nil
+		}
+	default:
+		return errors.Errorf("unknown operation %q", mod.GetOperation())
+	}
+
+	for _, resource := range resources {
+		if !matches(match.GetOrigin(), resource.Origin) {
+			continue
+		}
+		switch r := resource.Resource.(type) {
+		case *envoy_api.RouteConfiguration:
+			if matches(match.GetRouteConfigurationName(), r.Name) {
+				if err := modify(r); err != nil {
+					return err
+				}
+			}
+		case *envoy_api.Listener:
+			// route configurations can also be embedded into HTTP connection managers
+			for _, filterChain := range r.FilterChains {
+				err := envoy_listeners.UpdateHTTPConnectionManager(filterChain, func(manager *envoy_hcm.HttpConnectionManager) error {
+					routeConfiguration := manager.GetRouteConfig()
+					if routeConfiguration == nil || !matches(match.GetRouteConfigurationName(), routeConfiguration.Name) {
+						return nil
+					}
+					return modify(routeConfiguration)
+				})
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
@@ -4,6 +4,7 @@ import (
 	"io/ioutil"
 	"path/filepath"
 
+	"github.com/golang/protobuf/ptypes/wrappers"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/ginkgo/extensions/table"
 	. "github.com/onsi/gomega"
@@ -147,19 +148,23 @@ var _ = Describe("OutboundProxyGenerator", func() {
 					},
 				},
 				Logs: model.LogMap{
-					"api-http": &mesh_proto.LoggingBackend{
-						Name: "file",
-						Type: &mesh_proto.LoggingBackend_File_{
-							File: &mesh_proto.LoggingBackend_File{
-								Path: "/var/log",
+					"api-http": &model.AccessLog{
+						Backend: &mesh_proto.LoggingBackend{
+							Name: "file",
+							Type: &mesh_proto.LoggingBackend_File_{
+								File: &mesh_proto.LoggingBackend_File{
+									Path: "/var/log",
+								},
 							},
 						},
 					},
-					"api-tcp": &mesh_proto.LoggingBackend{
-						Name: "elk",
-						Type: &mesh_proto.LoggingBackend_Tcp_{
-							Tcp: &mesh_proto.LoggingBackend_Tcp{
-								Address: "logstash:1234",
+					"api-tcp": &model.AccessLog{
+						Backend: &mesh_proto.LoggingBackend{
+							Name: "elk",
+							Type: &mesh_proto.LoggingBackend_Tcp_{
+								Tcp: &mesh_proto.LoggingBackend_Tcp{
+									Address: "logstash:1234",
+								},
 							},
 						},
 					},
@@ -324,6 +329,1054 @@ var _ = Describe("OutboundProxyGenerator", func() {
 		Expect(actual).To(MatchYAML(expected))
 	})
 
+	It("should inject faults on the source side", func() {
+		// setup
+		gen := &generator.OutboundProxyGenerator{}
+		dp := `
+        networking:
+          outbound:
+          - port: 18080
+            service: backend
+          - port: 54321
+            service: db`
+
+		dataplane := mesh_proto.Dataplane{}
+		Expect(util_proto.FromYAML([]byte(dp), &dataplane)).To(Succeed())
+
+		faultInjection := func(protocol string) *mesh_proto.FaultInjection {
+			fi := &mesh_proto.FaultInjection{}
+			Expect(util_proto.FromYAML([]byte(`
+            sources:
+            - match:
+                service: web
+            destinations:
+            - match:
+                service: '*'
+                protocol: `+protocol+`
+            conf:
+              side: source
+              delay:
+                percentage: 50
+                value: 5s
+              abort:
+                percentage: 10
+`), fi)).To(Succeed())
+			if protocol == mesh_core.ProtocolHTTP {
+				fi.Conf.Abort.HttpStatus = &wrappers.UInt32Value{Value: 503}
+			}
+			return fi
+		}
+
+		proxy := &model.Proxy{
+			Id: model.ProxyId{Name: "side-car", Mesh: "default"},
+			Dataplane: &mesh_core.DataplaneResource{
+				Meta: &test_model.ResourceMeta{
+					Version: "1",
+				},
+				Spec: dataplane,
+			},
+			TrafficRoutes: model.RouteMap{
+				"backend": &mesh_core.TrafficRouteResource{
+					Spec: mesh_proto.TrafficRoute{
+						Conf: []*mesh_proto.TrafficRoute_WeightedDestination{{
+							Weight:      100,
+							Destination: mesh_proto.MatchService("backend"),
+						}},
+					},
+				},
+				"db": &mesh_core.TrafficRouteResource{
+					Spec: mesh_proto.TrafficRoute{
+						Conf: []*mesh_proto.TrafficRoute_WeightedDestination{{
+							Weight:      100,
+							Destination: mesh_proto.MatchService("db"),
+						}},
+					},
+				},
+			},
+			OutboundSelectors: model.DestinationMap{
+				"backend": model.TagSelectorSet{
+					{"service": "backend"},
+				},
+				"db": model.TagSelectorSet{
+					{"service": "db"},
+				},
+			},
+			OutboundTargets: model.EndpointMap{
+				"backend": []model.Endpoint{
+					{Target: "192.168.0.1", Port: 8082, Tags: map[string]string{"service": "backend", "protocol": "http"}},
+				},
+				"db": []model.Endpoint{
+					{Target: "192.168.0.2", Port: 5432, Tags: map[string]string{"service": "db"}},
+				},
+			},
+			OutboundFaultInjections: model.OutboundFaultInjectionMap{
+				"backend": faultInjection(mesh_core.ProtocolHTTP),
+				"db":      faultInjection(mesh_core.ProtocolTCP),
+			},
+			Metadata: &model.DataplaneMetadata{},
+		}
+
+		// when
+		rs, err := gen.Generate(plainCtx, proxy)
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+
+		// when
+		resp, err := model.ResourceList(rs).ToDeltaDiscoveryResponse()
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		// when
+		actual, err := util_proto.ToYAML(resp)
+		// then
+		Expect(err).ToNot(HaveOccurred())
+
+		expected, err := ioutil.ReadFile(filepath.Join("testdata", "outbound-proxy", "fault-injection.envoy.golden.yaml"))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(actual).To(MatchYAML(expected))
+	})
+
+	It("should limit bandwidth of TCP connections on the source side", func() {
+		// setup
+		gen := &generator.OutboundProxyGenerator{}
+		dp := `
+        networking:
+          outbound:
+          - port: 54321
+            service: db`
+
+		dataplane := mesh_proto.Dataplane{}
+		Expect(util_proto.FromYAML([]byte(dp), &dataplane)).To(Succeed())
+
+		faultInjection := &mesh_proto.FaultInjection{}
+		Expect(util_proto.FromYAML([]byte(`
+        sources:
+        - match:
+            service: web
+        destinations:
+        - match:
+            service: db
+            protocol: tcp
+        conf:
+          side: source
+          responseBandwidth:
+            percentage: 20
+            limit: 100kbps
+`), faultInjection)).To(Succeed())
+
+		proxy := &model.Proxy{
+			Id: model.ProxyId{Name: "side-car", Mesh: "default"},
+			Dataplane: &mesh_core.DataplaneResource{
+				Meta: &test_model.ResourceMeta{
+					Mesh:    "default",
+					Name:    "side-car",
+					Version: "1",
+				},
+				Spec: dataplane,
+			},
+			TrafficRoutes: model.RouteMap{
+				"db": &mesh_core.TrafficRouteResource{
+					Spec: mesh_proto.TrafficRoute{
+						Conf: []*mesh_proto.TrafficRoute_WeightedDestination{{
+							Weight:      100,
+							Destination: mesh_proto.MatchService("db"),
+						}},
+					},
+				},
+			},
+			OutboundSelectors: model.DestinationMap{
+				"db": model.TagSelectorSet{
+					{"service": "db"},
+				},
+			},
+			OutboundTargets: model.EndpointMap{
+				"db": []model.Endpoint{
+					{Target: "192.168.0.2", Port: 5432, Tags: map[string]string{"service": "db"}},
+				},
+			},
+			OutboundFaultInjections: model.OutboundFaultInjectionMap{
+				"db": faultInjection,
+			},
+			Metadata: &model.DataplaneMetadata{},
+		}
+
+		// when
+		rs, err := gen.Generate(plainCtx, proxy)
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+
+		// when
+		resp, err := model.ResourceList(rs).ToDeltaDiscoveryResponse()
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		// when
+		actual, err := util_proto.ToYAML(resp)
+		// then
+		Expect(err).ToNot(HaveOccurred())
+
+		expected, err := ioutil.ReadFile(filepath.Join("testdata", "outbound-proxy", "fault-injection-bandwidth.envoy.golden.yaml"))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(actual).To(MatchYAML(expected))
+	})
+
+	It("should not generate clusters of HTTP rules and a mirror of a TCP service", func() {
+		// setup
+		gen := &generator.OutboundProxyGenerator{}
+		dataplane := mesh_proto.Dataplane{}
+		Expect(util_proto.FromYAML([]byte(`
+        networking:
+          outbound:
+          - port: 54321
+            service: db`), &dataplane)).To(Succeed())
+
+		route := mesh_proto.TrafficRoute{}
+		Expect(util_proto.FromYAML([]byte(`
+        conf:
+        - weight: 100
+          destination:
+            service: db
+        http:
+        - match:
+            path:
+              prefix: /api
+          destination:
+          - weight: 100
+            destination:
+              service: db
+              version: v2
+        mirror:
+          percentage: 50
+          destination:
+            service: db
+            version: shadow`), &route)).To(Succeed())
+
+		proxy := &model.Proxy{
+			Id: model.ProxyId{Name: "side-car", Mesh: "default"},
+			Dataplane: &mesh_core.DataplaneResource{
+				Meta: &test_model.ResourceMeta{
+					Version: "1",
+				},
+				Spec: dataplane,
+			},
+			TrafficRoutes: model.RouteMap{
+				"db": &mesh_core.TrafficRouteResource{
+					Spec: route,
+				},
+			},
+			OutboundTargets: model.EndpointMap{
+				"db": []model.Endpoint{
+					{Target: "192.168.0.4", Port: 5432, Tags: map[string]string{"service": "db", "version": "v1"}},
+					{Target: "192.168.0.5", Port: 5432, Tags: map[string]string{"service": "db", "version": "v2", "protocol": "http"}},
+					{Target: "192.168.0.6", Port: 5432, Tags: map[string]string{"service": "db", "version": "shadow", "protocol": "http"}},
+				},
+			},
+			Metadata: &model.DataplaneMetadata{},
+		}
+
+		// when
+		rs, err := gen.Generate(plainCtx, proxy)
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		// and
+		var names []string
+		for _, r := range rs {
+			names = append(names, r.Name)
+		}
+		Expect(names).To(ConsistOf("db", "db", "outbound:127.0.0.1:54321"))
+	})
+
+	It("should generate HTTP routes from TrafficRoute HTTP rules", func() {
+		// setup
+		gen := &generator.OutboundProxyGenerator{}
+		dp := `
+        networking:
+          outbound:
+          - port: 18080
+            service: backend
+          - port: 54321
+            service: db`
+
+		dataplane := mesh_proto.Dataplane{}
+		Expect(util_proto.FromYAML([]byte(dp), &dataplane)).To(Succeed())
+
+		route := `
+        conf:
+        - weight: 100
+          destination:
+            service: backend
+            version: v1
+        http:
+        - match:
+            path:
+              prefix: /api/v2
+          destination:
+          - weight: 100
+            destination:
+              service: backend
+              version: v2
+        - match:
+            method:
+              exact: GET
+            headers:
+              x-canary:
+                exact: "true"
+          destination:
+          - weight: 50
+            destination:
+              service: backend
+              version: v2
+          - weight: 50
+            destination:
+              service: backend
+              version: canary`
+		httpRoute := mesh_proto.TrafficRoute{}
+		Expect(util_proto.FromYAML([]byte(route), &httpRoute)).To(Succeed())
+
+		proxy := &model.Proxy{
+			Id: model.ProxyId{Name: "side-car", Mesh: "default"},
+			Dataplane: &mesh_core.DataplaneResource{
+				Meta: &test_model.ResourceMeta{
+					Version: "1",
+				},
+				Spec: dataplane,
+			},
+			TrafficRoutes: model.RouteMap{
+				"backend": &mesh_core.TrafficRouteResource{
+					Spec: httpRoute,
+				},
+				"db": &mesh_core.TrafficRouteResource{
+					Spec: mesh_proto.TrafficRoute{
+						Conf: []*mesh_proto.TrafficRoute_WeightedDestination{{
+							Weight:      100,
+							Destination: mesh_proto.MatchService("db"),
+						}},
+						// HTTP rules are ignored for TCP services
+						Http: httpRoute.Http,
+					},
+				},
+			},
+			OutboundTargets: model.EndpointMap{
+				"backend": []model.Endpoint{
+					{Target: "192.168.0.1", Port: 8081, Tags: map[string]string{"service": "backend", "version": "v1", "protocol": "http"}},
+					{Target: "192.168.0.2", Port: 8082, Tags: map[string]string{"service": "backend", "version": "v2", "protocol": "http"}},
+					{Target: "192.168.0.3", Port: 8083, Tags: map[string]string{"service": "backend", "version": "canary", "protocol": "http"}},
+				},
+				"db": []model.Endpoint{
+					{Target: "192.168.0.4", Port: 5432, Tags: map[string]string{"service": "db"}},
+				},
+			},
+			Metadata: &model.DataplaneMetadata{},
+		}
+
+		// when
+		rs, err := gen.Generate(plainCtx, proxy)
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+
+		// when
+		resp, err := model.ResourceList(rs).ToDeltaDiscoveryResponse()
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		// when
+		actual, err := util_proto.ToYAML(resp)
+		// then
+		Expect(err).ToNot(HaveOccurred())
+
+		expected, err := ioutil.ReadFile(filepath.Join("testdata", "outbound-proxy", "http-routes.envoy.golden.yaml"))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(actual).To(MatchYAML(expected))
+	})
+
+	It("should apply Retry policies to HTTP and TCP outbound interfaces", func() {
+		// setup
+		gen := &generator.OutboundProxyGenerator{}
+		dp := `
+        networking:
+          outbound:
+          - port: 18080
+            service: backend
+          - port: 54321
+            service: db`
+
+		dataplane := mesh_proto.Dataplane{}
+		Expect(util_proto.FromYAML([]byte(dp), &dataplane)).To(Succeed())
+
+		retry := `
+        conf:
+          http:
+            numRetries: 3
+            perTryTimeout: 0.5s
+            retriableStatusCodes:
+            - 503
+          tcp:
+            maxConnectAttempts: 5`
+		retrySpec := mesh_proto.Retry{}
+		Expect(util_proto.FromYAML([]byte(retry), &retrySpec)).To(Succeed())
+
+		proxy := &model.Proxy{
+			Id: model.ProxyId{Name: "side-car", Mesh: "default"},
+			Dataplane: &mesh_core.DataplaneResource{
+				Meta: &test_model.ResourceMeta{
+					Version: "1",
+				},
+				Spec: dataplane,
+			},
+			TrafficRoutes: model.RouteMap{
+				"backend": &mesh_core.TrafficRouteResource{
+					Spec: mesh_proto.TrafficRoute{
+						Conf: []*mesh_proto.TrafficRoute_WeightedDestination{{
+							Weight:      100,
+							Destination: mesh_proto.MatchService("backend"),
+						}},
+					},
+				},
+				"db": &mesh_core.TrafficRouteResource{
+					Spec: mesh_proto.TrafficRoute{
+						Conf: []*mesh_proto.TrafficRoute_WeightedDestination{{
+							Weight:      100,
+							Destination: mesh_proto.MatchService("db"),
+						}},
+					},
+				},
+			},
+			OutboundTargets: model.EndpointMap{
+				"backend": []model.Endpoint{
+					{Target: "192.168.0.1", Port: 8081, Tags: map[string]string{"service": "backend", "protocol": "http"}},
+				},
+				"db": []model.Endpoint{
+					{Target: "192.168.0.4", Port: 5432, Tags: map[string]string{"service": "db"}},
+				},
+			},
+			Retries: model.RetryMap{
+				"backend": &mesh_core.RetryResource{
+					Spec: retrySpec,
+				},
+				"db": &mesh_core.RetryResource{
+					Spec: retrySpec,
+				},
+			},
+			Metadata: &model.DataplaneMetadata{},
+		}
+
+		// when
+		rs, err := gen.Generate(plainCtx, proxy)
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+
+		// when
+		resp, err := model.ResourceList(rs).ToDeltaDiscoveryResponse()
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		// when
+		actual, err := util_proto.ToYAML(resp)
+		// then
+		Expect(err).ToNot(HaveOccurred())
+
+		expected, err := ioutil.ReadFile(filepath.Join("testdata", "outbound-proxy", "retries.envoy.golden.yaml"))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(actual).To(MatchYAML(expected))
+	})
+
+	It("should apply Timeout policies to HTTP and TCP outbound interfaces", func() {
+		// setup
+		gen := &generator.OutboundProxyGenerator{}
+		dp := `
+        networking:
+          outbound:
+          - port: 18080
+            service: backend
+          - port: 54321
+            service: db`
+
+		dataplane := mesh_proto.Dataplane{}
+		Expect(util_proto.FromYAML([]byte(dp), &dataplane)).To(Succeed())
+
+		timeout := `
+        conf:
+          connectTimeout: 2s
+          http:
+            requestTimeout: 30s
+            idleTimeout: 300s
+          tcp:
+            idleTimeout: 3600s`
+		timeoutSpec := mesh_proto.Timeout{}
+		Expect(util_proto.FromYAML([]byte(timeout), &timeoutSpec)).To(Succeed())
+
+		proxy := &model.Proxy{
+			Id: model.ProxyId{Name: "side-car", Mesh: "default"},
+			Dataplane: &mesh_core.DataplaneResource{
+				Meta: &test_model.ResourceMeta{
+					Version: "1",
+				},
+				Spec: dataplane,
+			},
+			TrafficRoutes: model.RouteMap{
+				"backend": &mesh_core.TrafficRouteResource{
+					Spec: mesh_proto.TrafficRoute{
+						Conf: []*mesh_proto.TrafficRoute_WeightedDestination{{
+							Weight:      100,
+							Destination: mesh_proto.MatchService("backend"),
+						}},
+					},
+				},
+				"db": &mesh_core.TrafficRouteResource{
+					Spec: mesh_proto.TrafficRoute{
+						Conf: []*mesh_proto.TrafficRoute_WeightedDestination{{
+							Weight:      100,
+							Destination: mesh_proto.MatchService("db"),
+						}},
+					},
+				},
+			},
+			OutboundTargets: model.EndpointMap{
+				"backend": []model.Endpoint{
+					{Target: "192.168.0.1", Port: 8081, Tags: map[string]string{"service": "backend", "protocol": "http"}},
+				},
+				"db": []model.Endpoint{
+					{Target: "192.168.0.4", Port: 5432, Tags: map[string]string{"service": "db"}},
+				},
+			},
+			Timeouts: model.TimeoutMap{
+				"backend": &mesh_core.TimeoutResource{
+					Spec: timeoutSpec,
+				},
+				"db": &mesh_core.TimeoutResource{
+					Spec: timeoutSpec,
+				},
+			},
+			Metadata: &model.DataplaneMetadata{},
+		}
+
+		// when
+		rs, err := gen.Generate(plainCtx, proxy)
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+
+		// when
+		resp, err := model.ResourceList(rs).ToDeltaDiscoveryResponse()
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		// when
+		actual, err := util_proto.ToYAML(resp)
+		// then
+		Expect(err).ToNot(HaveOccurred())
+
+		expected, err := ioutil.ReadFile(filepath.Join("testdata", "outbound-proxy", "timeouts.envoy.golden.yaml"))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(actual).To(MatchYAML(expected))
+	})
+
+	It("should apply CircuitBreaker policies to outbound clusters", func() {
+		// setup
+		gen := &generator.OutboundProxyGenerator{}
+		dp := `
+        networking:
+          outbound:
+          - port: 18080
+            service: backend
+          - port: 54321
+            service: db`
+
+		dataplane := mesh_proto.Dataplane{}
+		Expect(util_proto.FromYAML([]byte(dp), &dataplane)).To(Succeed())
+
+		circuitBreaker := `
+        conf:
+          thresholds:
+            maxConnections: 1024
+            maxPendingRequests: 128
+          outlierDetection:
+            consecutive5xx: 5
+            baseEjectionTime: 30s`
+		circuitBreakerSpec := mesh_proto.CircuitBreaker{}
+		Expect(util_proto.FromYAML([]byte(circuitBreaker), &circuitBreakerSpec)).To(Succeed())
+
+		proxy := &model.Proxy{
+			Id: model.ProxyId{Name: "side-car", Mesh: "default"},
+			Dataplane: &mesh_core.DataplaneResource{
+				Meta: &test_model.ResourceMeta{
+					Version: "1",
+				},
+				Spec: dataplane,
+			},
+			TrafficRoutes: model.RouteMap{
+				"backend": &mesh_core.TrafficRouteResource{
+					Spec: mesh_proto.TrafficRoute{
+						Conf: []*mesh_proto.TrafficRoute_WeightedDestination{{
+							Weight:      100,
+							Destination: mesh_proto.MatchService("backend"),
+						}},
+					},
+				},
+				"db": &mesh_core.TrafficRouteResource{
+					Spec: mesh_proto.TrafficRoute{
+						Conf: []*mesh_proto.TrafficRoute_WeightedDestination{{
+							Weight:      100,
+							Destination: mesh_proto.MatchService("db"),
+						}},
+					},
+				},
+			},
+			OutboundTargets: model.EndpointMap{
+				"backend": []model.Endpoint{
+					{Target: "192.168.0.1", Port: 8081, Tags: map[string]string{"service": "backend", "protocol": "http"}},
+				},
+				"db": []model.Endpoint{
+					{Target: "192.168.0.4", Port: 5432, Tags: map[string]string{"service": "db"}},
+				},
+			},
+			CircuitBreakers: model.CircuitBreakerMap{
+				"backend": &mesh_core.CircuitBreakerResource{
+					Spec: circuitBreakerSpec,
+				},
+				"db": &mesh_core.CircuitBreakerResource{
+					Spec: circuitBreakerSpec,
+				},
+			},
+			Metadata: &model.DataplaneMetadata{},
+		}
+
+		// when
+		rs, err := gen.Generate(plainCtx, proxy)
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+
+		// when
+		resp, err := model.ResourceList(rs).ToDeltaDiscoveryResponse()
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		// when
+		actual, err := util_proto.ToYAML(resp)
+		// then
+		Expect(err).ToNot(HaveOccurred())
+
+		expected, err := ioutil.ReadFile(filepath.Join("testdata", "outbound-proxy", "circuit-breakers.envoy.golden.yaml"))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(actual).To(MatchYAML(expected))
+	})
+
+	It("should use HTTP active health checks only if configured explicitly", func() {
+		// setup
+		gen := &generator.OutboundProxyGenerator{}
+		dp := `
+        networking:
+          outbound:
+          - port: 18080
+            service: backend
+          - port: 54321
+            service: db`
+
+		dataplane := mesh_proto.Dataplane{}
+		Expect(util_proto.FromYAML([]byte(dp), &dataplane)).To(Succeed())
+
+		httpHealthCheck := `
+        conf:
+          activeChecks:
+            interval: 10s
+            timeout: 2s
+            unhealthyThreshold: 3
+            healthyThreshold: 1
+            http:
+              path: /health`
+		httpHealthCheckSpec := mesh_proto.HealthCheck{}
+		Expect(util_proto.FromYAML([]byte(httpHealthCheck), &httpHealthCheckSpec)).To(Succeed())
+
+		tcpHealthCheck := `
+        conf:
+          activeChecks:
+            interval: 10s
+            timeout: 2s
+            unhealthyThreshold: 3
+            healthyThreshold: 1`
+		tcpHealthCheckSpec := mesh_proto.HealthCheck{}
+		Expect(util_proto.FromYAML([]byte(tcpHealthCheck), &tcpHealthCheckSpec)).To(Succeed())
+
+		proxy := &model.Proxy{
+			Id: model.ProxyId{Name: "side-car", Mesh: "default"},
+			Dataplane: &mesh_core.DataplaneResource{
+				Meta: &test_model.ResourceMeta{
+					Version: "1",
+				},
+				Spec: dataplane,
+			},
+			TrafficRoutes: model.RouteMap{
+				"backend": &mesh_core.TrafficRouteResource{
+					Spec: mesh_proto.TrafficRoute{
+						Conf: []*mesh_proto.TrafficRoute_WeightedDestination{{
+							Weight:      100,
+							Destination: mesh_proto.MatchService("backend"),
+						}},
+					},
+				},
+				"db": &mesh_core.TrafficRouteResource{
+					Spec: mesh_proto.TrafficRoute{
+						Conf: []*mesh_proto.TrafficRoute_WeightedDestination{{
+							Weight:      100,
+							Destination: mesh_proto.MatchService("db"),
+						}},
+					},
+				},
+			},
+			OutboundTargets: model.EndpointMap{
+				"backend": []model.Endpoint{
+					{Target: "192.168.0.1", Port: 8081, Tags: map[string]string{"service": "backend", "protocol": "http"}},
+				},
+				"db": []model.Endpoint{
+					{Target: "192.168.0.4", Port: 5432, Tags: map[string]string{"service": "db", "protocol": "http"}},
+				},
+			},
+			HealthChecks: model.HealthCheckMap{
+				"backend": &mesh_core.HealthCheckResource{
+					Spec: httpHealthCheckSpec,
+				},
+				"db": &mesh_core.HealthCheckResource{
+					Spec: tcpHealthCheckSpec,
+				},
+			},
+			Metadata: &model.DataplaneMetadata{},
+		}
+
+		// when
+		rs, err := gen.Generate(plainCtx, proxy)
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+
+		// when
+		resp, err := model.ResourceList(rs).ToDeltaDiscoveryResponse()
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		// when
+		actual, err := util_proto.ToYAML(resp)
+		// then
+		Expect(err).ToNot(HaveOccurred())
+
+		expected, err := ioutil.ReadFile(filepath.Join("testdata", "outbound-proxy", "health-checks.envoy.golden.yaml"))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(actual).To(MatchYAML(expected))
+	})
+
+	It("should generate DNS clusters for ExternalServices", func() {
+		// setup
+		gen := &generator.OutboundProxyGenerator{}
+		dp := `
+        networking:
+          outbound:
+          - port: 18080
+            service: backend
+          - port: 18081
+            service: httpbin`
+
+		dataplane := mesh_proto.Dataplane{}
+		Expect(util_proto.FromYAML([]byte(dp), &dataplane)).To(Succeed())
+
+		proxy := &model.Proxy{
+			Id: model.ProxyId{Name: "side-car", Mesh: "default"},
+			Dataplane: &mesh_core.DataplaneResource{
+				Meta: &test_model.ResourceMeta{
+					Version: "1",
+				},
+				Spec: dataplane,
+			},
+			TrafficRoutes: model.RouteMap{
+				"backend": &mesh_core.TrafficRouteResource{
+					Spec: mesh_proto.TrafficRoute{
+						Conf: []*mesh_proto.TrafficRoute_WeightedDestination{{
+							Weight:      100,
+							Destination: mesh_proto.MatchService("backend"),
+						}},
+					},
+				},
+				"httpbin": &mesh_core.TrafficRouteResource{
+					Spec: mesh_proto.TrafficRoute{
+						Conf: []*mesh_proto.TrafficRoute_WeightedDestination{{
+							Weight:      100,
+							Destination: mesh_proto.MatchService("httpbin"),
+						}},
+					},
+				},
+			},
+			OutboundTargets: model.EndpointMap{
+				"backend": []model.Endpoint{
+					{Target: "192.168.0.1", Port: 8081, Tags: map[string]string{"service": "backend"}},
+				},
+				"httpbin": []model.Endpoint{
+					{
+						Target: "httpbin.org",
+						Port:   443,
+						Tags:   map[string]string{"service": "httpbin", "protocol": "http"},
+						ExternalService: &model.ExternalService{
+							Name:         "httpbin",
+							TLSEnabled:   true,
+							CaCertSecret: "httpbin-ca",
+						},
+					},
+				},
+			},
+			Metadata: &model.DataplaneMetadata{},
+		}
+
+		// when
+		rs, err := gen.Generate(mtlsCtx, proxy)
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+
+		// when
+		resp, err := model.ResourceList(rs).ToDeltaDiscoveryResponse()
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		// when
+		actual, err := util_proto.ToYAML(resp)
+		// then
+		Expect(err).ToNot(HaveOccurred())
+
+		expected, err := ioutil.ReadFile(filepath.Join("testdata", "outbound-proxy", "external-services.envoy.golden.yaml"))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(actual).To(MatchYAML(expected))
+	})
+
+	It("should group endpoints into localities when locality-aware load balancing is enabled", func() {
+		// setup
+		gen := &generator.OutboundProxyGenerator{}
+		ctx := xds_context.Context{
+			ControlPlane: &xds_context.ControlPlaneContext{},
+			Mesh: xds_context.MeshContext{
+				Resource: &mesh_core.MeshResource{
+					Meta: meta,
+					Spec: mesh_proto.Mesh{
+						Routing: &mesh_proto.Routing{
+							LocalityAwareLoadBalancing: true,
+						},
+					},
+				},
+			},
+		}
+		dp := `
+        networking:
+          inbound:
+          - interface: 192.168.0.1:80:8080
+            tags:
+              service: web
+              region: eu
+              zone: eu-west-1
+          outbound:
+          - port: 18080
+            service: backend`
+
+		dataplane := mesh_proto.Dataplane{}
+		Expect(util_proto.FromYAML([]byte(dp), &dataplane)).To(Succeed())
+
+		proxy := &model.Proxy{
+			Id: model.ProxyId{Name: "side-car", Mesh: "default"},
+			Dataplane: &mesh_core.DataplaneResource{
+				Meta: &test_model.ResourceMeta{
+					Version: "1",
+				},
+				Spec: dataplane,
+			},
+			TrafficRoutes: model.RouteMap{
+				"backend": &mesh_core.TrafficRouteResource{
+					Spec: mesh_proto.TrafficRoute{
+						Conf: []*mesh_proto.TrafficRoute_WeightedDestination{{
+							Weight:      100,
+							Destination: mesh_proto.MatchService("backend"),
+						}},
+					},
+				},
+			},
+			OutboundTargets: model.EndpointMap{
+				"backend": []model.Endpoint{
+					{Target: "192.168.0.2", Port: 8081, Tags: map[string]string{"service": "backend", "region": "us", "zone": "us-east-1"}},
+					{Target: "192.168.0.3", Port: 8081, Tags: map[string]string{"service": "backend", "region": "eu", "zone": "eu-west-2"}},
+					{Target: "192.168.0.4", Port: 8081, Tags: map[string]string{"service": "backend", "region": "eu", "zone": "eu-west-1"}},
+				},
+			},
+			Metadata: &model.DataplaneMetadata{},
+		}
+
+		// when
+		rs, err := gen.Generate(ctx, proxy)
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+
+		// when
+		resp, err := model.ResourceList(rs).ToDeltaDiscoveryResponse()
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		// when
+		actual, err := util_proto.ToYAML(resp)
+		// then
+		Expect(err).ToNot(HaveOccurred())
+
+		expected, err := ioutil.ReadFile(filepath.Join("testdata", "outbound-proxy", "locality-aware.envoy.golden.yaml"))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(actual).To(MatchYAML(expected))
+	})
+
+	It("should apply load balancers of TrafficRoutes to HTTP and TCP outbound interfaces", func() {
+		// setup
+		gen := &generator.OutboundProxyGenerator{}
+		dp := `
+        networking:
+          outbound:
+          - port: 18080
+            service: backend
+          - port: 54321
+            service: db`
+
+		dataplane := mesh_proto.Dataplane{}
+		Expect(util_proto.FromYAML([]byte(dp), &dataplane)).To(Succeed())
+
+		backendRoute := `
+        conf:
+        - weight: 100
+          destination:
+            service: backend
+        loadBalancer:
+          ringHash:
+            hashFunction: MURMUR_HASH_2
+          hashPolicies:
+          - cookie:
+              name: session
+              ttl: 3600s`
+		backendRouteSpec := mesh_proto.TrafficRoute{}
+		Expect(util_proto.FromYAML([]byte(backendRoute), &backendRouteSpec)).To(Succeed())
+
+		dbRoute := `
+        conf:
+        - weight: 100
+          destination:
+            service: db
+        loadBalancer:
+          maglev: {}
+          hashPolicies:
+          - sourceIp: {}`
+		dbRouteSpec := mesh_proto.TrafficRoute{}
+		Expect(util_proto.FromYAML([]byte(dbRoute), &dbRouteSpec)).To(Succeed())
+
+		proxy := &model.Proxy{
+			Id: model.ProxyId{Name: "side-car", Mesh: "default"},
+			Dataplane: &mesh_core.DataplaneResource{
+				Meta: &test_model.ResourceMeta{
+					Version: "1",
+				},
+				Spec: dataplane,
+			},
+			TrafficRoutes: model.RouteMap{
+				"backend": &mesh_core.TrafficRouteResource{
+					Spec: backendRouteSpec,
+				},
+				"db": &mesh_core.TrafficRouteResource{
+					Spec: dbRouteSpec,
+				},
+			},
+			OutboundTargets: model.EndpointMap{
+				"backend": []model.Endpoint{
+					{Target: "192.168.0.1", Port: 8081, Tags: map[string]string{"service": "backend", "protocol": "http"}},
+				},
+				"db": []model.Endpoint{
+					{Target: "192.168.0.4", Port: 5432, Tags: map[string]string{"service": "db"}},
+				},
+			},
+			Metadata: &model.DataplaneMetadata{},
+		}
+
+		// when
+		rs, err := gen.Generate(plainCtx, proxy)
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+
+		// when
+		resp, err := model.ResourceList(rs).ToDeltaDiscoveryResponse()
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		// when
+		actual, err := util_proto.ToYAML(resp)
+		// then
+		Expect(err).ToNot(HaveOccurred())
+
+		expected, err := ioutil.ReadFile(filepath.Join("testdata", "outbound-proxy", "load-balancers.envoy.golden.yaml"))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(actual).To(MatchYAML(expected))
+	})
+
+	It("should mirror HTTP requests to a destination of TrafficRoute mirror", func() {
+		// setup
+		gen := &generator.OutboundProxyGenerator{}
+		dp := `
+        networking:
+          outbound:
+          - port: 18080
+            service: backend`
+
+		dataplane := mesh_proto.Dataplane{}
+		Expect(util_proto.FromYAML([]byte(dp), &dataplane)).To(Succeed())
+
+		route := `
+        conf:
+        - weight: 100
+          destination:
+            service: backend
+            version: v1
+        mirror:
+          destination:
+            service: backend
+            version: v2
+          percentage: 25`
+		routeSpec := mesh_proto.TrafficRoute{}
+		Expect(util_proto.FromYAML([]byte(route), &routeSpec)).To(Succeed())
+
+		proxy := &model.Proxy{
+			Id: model.ProxyId{Name: "side-car", Mesh: "default"},
+			Dataplane: &mesh_core.DataplaneResource{
+				Meta: &test_model.ResourceMeta{
+					Version: "1",
+				},
+				Spec: dataplane,
+			},
+			TrafficRoutes: model.RouteMap{
+				"backend": &mesh_core.TrafficRouteResource{
+					Spec: routeSpec,
+				},
+			},
+			OutboundTargets: model.EndpointMap{
+				"backend": []model.Endpoint{
+					{Target: "192.168.0.1", Port: 8081, Tags: map[string]string{"service": "backend", "version": "v1", "protocol": "http"}},
+					{Target: "192.168.0.2", Port: 8082, Tags: map[string]string{"service": "backend", "version": "v2", "protocol": "http"}},
+				},
+			},
+			Metadata: &model.DataplaneMetadata{},
+		}
+
+		// when
+		rs, err := gen.Generate(plainCtx, proxy)
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+
+		// when
+		resp, err := model.ResourceList(rs).ToDeltaDiscoveryResponse()
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		// when
+		actual, err := util_proto.ToYAML(resp)
+		// then
+		Expect(err).ToNot(HaveOccurred())
+
+		expected, err := ioutil.ReadFile(filepath.Join("testdata", "outbound-proxy", "mirror.envoy.golden.yaml"))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(actual).To(MatchYAML(expected))
+	})
+
 	Describe("fail when a user-defined configuration (Dataplane, TrafficRoute, etc) is not valid", func() {
 
 		type testCase struct {
@@ -3,7 +3,9 @@ package generator
 import (
 	"net"
 
+	mesh_core "github.com/Kong/kuma/pkg/core/resources/apis/mesh"
 	core_xds "github.com/Kong/kuma/pkg/core/xds"
+	"github.com/Kong/kuma/pkg/envoy/metrics"
 	xds_context "github.com/Kong/kuma/pkg/xds/context"
 
 	envoy_clusters "github.com/Kong/kuma/pkg/xds/envoy/clusters"
@@ -12,13 +14,17 @@ import (
 )
 
 // PrometheusEndpointGenerator generates an inbound Envoy listener
-// that forwards HTTP requests into the `/stats/prometheus`
-// endpoint of the Envoy Admin API.
+// that forwards HTTP requests into the metrics merger of `kuma-dp`,
+// which serves metrics of Envoy, i.e. the `/stats/prometheus` endpoint
+// of the Envoy Admin API, merged with metrics of `kuma-dp` itself.
 //
 // When generating such a listener, it's important not to overshadow
 // a port that is already in use by the application or other Envoy listeners.
 // In the latter case we prefer not generate Prometheus endpoint at all
 // rather than introduce undeterministic behaviour.
+//
+// If a Dataplane declares applications with their own Prometheus metrics,
+// metrics of those applications are merged as well.
 type PrometheusEndpointGenerator struct {
 }
 
@@ -50,21 +56,32 @@ func (g PrometheusEndpointGenerator) Generate(ctx xds_context.Context, proxy *co
 		return nil, nil
 	}
 
-	adminPort := proxy.Metadata.GetAdminPort()
-	// We assume that Admin API must be available on a loopback interface (while users
-	// can override the default value `127.0.0.1` in the Bootstrap Server section of `kuma-cp` config,
-	// the only reasonable alternative is `0.0.0.0`).
-	// In contrast to `AdminPort`, we shouldn't trust `AdminAddress` from the Envoy node metadata
-	// since it would allow a malicious user to manipulate that value and use Prometheus endpoint
-	// as a gateway to another host.
-	adminAddress := "127.0.0.1"
-	envoyAdminClusterName := envoy_names.GetEnvoyAdminClusterName()
+	// Admin port is known only if Envoy has been started by `kuma-dp`, which also starts the metrics merger.
+	// The metrics merger scrapes Envoy Admin API on its own.
+	mergerClusterName := envoy_names.GetMetricsMergerClusterName()
+	mergerSocketPath := metrics.MergerSocketPath(proxy.Dataplane.Meta.GetName(), proxy.Dataplane.Meta.GetMesh())
 	prometheusListenerName := envoy_names.GetPrometheusListenerName()
 
+	cluster := &core_xds.Resource{
+		Name:     mergerClusterName,
+		Version:  "",
+		Resource: envoy_clusters.CreatePipeCluster(mergerClusterName, mergerSocketPath),
+		Origin:   mesh_core.OriginPrometheus,
+	}
+	var applications []metrics.Application
+	for _, app := range prometheusEndpoint.Applications {
+		path := app.Path
+		if path == "" {
+			path = metrics.DefaultApplicationPath
+		}
+		applications = append(applications, metrics.Application{Name: app.Name, Port: app.Port, Path: path})
+	}
+	endpoint := envoy_listeners.MergedPrometheusEndpoint(prometheusListenerName, prometheusEndpoint.Path, mergerClusterName, applications)
+
 	listener, err := envoy_listeners.NewListenerBuilder().
 		Configure(envoy_listeners.InboundListener(prometheusListenerName, prometheusEndpointAddress, prometheusEndpoint.Port)).
 		Configure(envoy_listeners.FilterChain(envoy_listeners.NewFilterChainBuilder().
-			Configure(envoy_listeners.PrometheusEndpoint(prometheusListenerName, prometheusEndpoint.Path, envoyAdminClusterName)))).
+			Configure(endpoint))).
 		Configure(envoy_listeners.TransparentProxying(proxy.Dataplane.Spec.Networking.GetTransparentProxying())).
 		Build()
 	if err != nil {
@@ -72,16 +89,13 @@ func (g PrometheusEndpointGenerator) Generate(ctx xds_context.Context, proxy *co
 	}
 	return []*core_xds.Resource{
 		// CDS resource
-		&core_xds.Resource{
-			Name:     envoyAdminClusterName,
-			Version:  "",
-			Resource: envoy_clusters.CreateLocalCluster(envoyAdminClusterName, adminAddress, adminPort),
-		},
+		cluster,
 		// LDS resource
 		&core_xds.Resource{
 			Name:     prometheusListenerName,
 			Version:  "",
 			Resource: listener,
+			Origin:   mesh_core.OriginPrometheus,
 		},
 	}, nil
 }
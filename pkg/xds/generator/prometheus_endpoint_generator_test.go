@@ -183,7 +183,7 @@ var _ = Describe("PrometheusEndpointGenerator", func() {
 
 			Expect(actual).To(MatchYAML(given.expected))
 		},
-		Entry("should support a Dataplane without custom metrics configuration", testCase{
+		Entry("should forward requests to the metrics merger of kuma-dp even when a Dataplane declares no applications", testCase{
 			ctx: xds_context.Context{
 				Mesh: xds_context.MeshContext{
 					Resource: &mesh_core.MeshResource{
@@ -215,21 +215,20 @@ var _ = Describe("PrometheusEndpointGenerator", func() {
 			},
 			expected: `
             resources:
-            - name: kuma:envoy:admin
+            - name: kuma:metrics:merger
               resource:
                 '@type': type.googleapis.com/envoy.api.v2.Cluster
                 connectTimeout: 5s
                 loadAssignment:
-                  clusterName: kuma:envoy:admin
+                  clusterName: kuma:metrics:merger
                   endpoints:
                   - lbEndpoints:
                     - endpoint:
                         address:
-                          socketAddress:
-                            address: 127.0.0.1
-                            portValue: 9902
-                name: kuma:envoy:admin
-                altStatName: kuma_envoy_admin
+                          pipe:
+                            path: /tmp/kuma-metrics-merger-backend-01-demo.sock
+                name: kuma:metrics:merger
+                altStatName: kuma_metrics_merger
                 type: STATIC
             - name: kuma:metrics:prometheus
               resource:
@@ -254,9 +253,11 @@ var _ = Describe("PrometheusEndpointGenerator", func() {
                           routes:
                           - match:
                               prefix: /non-standard-path
+                            requestHeadersToRemove:
+                            - x-kuma-metrics-applications
                             route:
-                              cluster: kuma:envoy:admin
-                              prefixRewrite: /stats/prometheus
+                              cluster: kuma:metrics:merger
+                              prefixRewrite: /
                       statPrefix: kuma_metrics_prometheus
                 name: kuma:metrics:prometheus
 `,
@@ -301,21 +302,20 @@ var _ = Describe("PrometheusEndpointGenerator", func() {
 			},
 			expected: `
             resources:
-            - name: kuma:envoy:admin
+            - name: kuma:metrics:merger
               resource:
                 '@type': type.googleapis.com/envoy.api.v2.Cluster
                 connectTimeout: 5s
                 loadAssignment:
-                  clusterName: kuma:envoy:admin
+                  clusterName: kuma:metrics:merger
                   endpoints:
                   - lbEndpoints:
                     - endpoint:
                         address:
-                          socketAddress:
-                            address: 127.0.0.1
-                            portValue: 9902
-                name: kuma:envoy:admin
-                altStatName: kuma_envoy_admin
+                          pipe:
+                            path: /tmp/kuma-metrics-merger-backend-01-demo.sock
+                name: kuma:metrics:merger
+                altStatName: kuma_metrics_merger
                 type: STATIC
             - name: kuma:metrics:prometheus
               resource:
@@ -340,9 +340,103 @@ var _ = Describe("PrometheusEndpointGenerator", func() {
                           routes:
                           - match:
                               prefix: /even-more-non-standard-path
+                            requestHeadersToRemove:
+                            - x-kuma-metrics-applications
                             route:
-                              cluster: kuma:envoy:admin
-                              prefixRewrite: /stats/prometheus
+                              cluster: kuma:metrics:merger
+                              prefixRewrite: /
+                      statPrefix: kuma_metrics_prometheus
+                name: kuma:metrics:prometheus
+`,
+		}),
+		Entry("should forward requests to the metrics merger of kuma-dp when a Dataplane declares applications", testCase{
+			ctx: xds_context.Context{
+				Mesh: xds_context.MeshContext{
+					Resource: &mesh_core.MeshResource{
+						Meta: &test_model.ResourceMeta{
+							Name: "demo",
+						},
+						Spec: mesh_proto.Mesh{
+							Metrics: &mesh_proto.Metrics{
+								Prometheus: &mesh_proto.Metrics_Prometheus{
+									Port: 1234,
+									Path: "/non-standard-path",
+								},
+							},
+						},
+					},
+				},
+			},
+			proxy: &model.Proxy{
+				Id: model.ProxyId{Name: "demo.backend-01"},
+				Dataplane: &mesh_core.DataplaneResource{
+					Meta: &test_model.ResourceMeta{
+						Name: "backend-01",
+						Mesh: "demo",
+					},
+					Spec: mesh_proto.Dataplane{
+						Metrics: &mesh_proto.Metrics{
+							Prometheus: &mesh_proto.Metrics_Prometheus{
+								Applications: []*mesh_proto.Metrics_Prometheus_Application{
+									{Name: "backend", Port: 8081},
+									{Name: "sidecar", Port: 9090, Path: "/stats"},
+								},
+							},
+						},
+					},
+				},
+				Metadata: &core_xds.DataplaneMetadata{
+					AdminPort: 9902,
+				},
+			},
+			expected: `
+            resources:
+            - name: kuma:metrics:merger
+              resource:
+                '@type': type.googleapis.com/envoy.api.v2.Cluster
+                connectTimeout: 5s
+                loadAssignment:
+                  clusterName: kuma:metrics:merger
+                  endpoints:
+                  - lbEndpoints:
+                    - endpoint:
+                        address:
+                          pipe:
+                            path: /tmp/kuma-metrics-merger-backend-01-demo.sock
+                name: kuma:metrics:merger
+                altStatName: kuma_metrics_merger
+                type: STATIC
+            - name: kuma:metrics:prometheus
+              resource:
+                '@type': type.googleapis.com/envoy.api.v2.Listener
+                trafficDirection: INBOUND
+                address:
+                  socketAddress:
+                    address: 0.0.0.0
+                    portValue: 1234
+                filterChains:
+                - filters:
+                  - name: envoy.http_connection_manager
+                    typedConfig:
+                      '@type': type.googleapis.com/envoy.config.filter.network.http_connection_manager.v2.HttpConnectionManager
+                      httpFilters:
+                      - name: envoy.router
+                      routeConfig:
+                        virtualHosts:
+                        - domains:
+                          - '*'
+                          name: envoy_admin
+                          routes:
+                          - match:
+                              prefix: /non-standard-path
+                            requestHeadersToAdd:
+                            - append: false
+                              header:
+                                key: x-kuma-metrics-applications
+                                value: '[{"name":"backend","port":8081,"path":"/metrics"},{"name":"sidecar","port":9090,"path":"/stats"}]'
+                            route:
+                              cluster: kuma:metrics:merger
+                              prefixRewrite: /
                       statPrefix: kuma_metrics_prometheus
                 name: kuma:metrics:prometheus
 `,
@@ -3,12 +3,14 @@ package generator
 import (
 	"fmt"
 
+	"github.com/golang/protobuf/ptypes/wrappers"
 	"github.com/pkg/errors"
 
 	kuma_mesh "github.com/Kong/kuma/api/mesh/v1alpha1"
 	mesh_core "github.com/Kong/kuma/pkg/core/resources/apis/mesh"
 	"github.com/Kong/kuma/pkg/core/validators"
 	model "github.com/Kong/kuma/pkg/core/xds"
+	"github.com/Kong/kuma/pkg/envoy/faults"
 	util_envoy "github.com/Kong/kuma/pkg/util/envoy"
 	xds_context "github.com/Kong/kuma/pkg/xds/context"
 
@@ -18,16 +20,18 @@ import (
 	envoy_listeners "github.com/Kong/kuma/pkg/xds/envoy/listeners"
 	envoy_names "github.com/Kong/kuma/pkg/xds/envoy/names"
 	envoy_routes "github.com/Kong/kuma/pkg/xds/envoy/routes"
+	"github.com/Kong/kuma/pkg/xds/generator/modifications"
 )
 
 type TemplateProxyGenerator struct {
 	ProxyTemplate *kuma_mesh.ProxyTemplate
+	Profiles      ProfileRegistry
 }
 
 func (g *TemplateProxyGenerator) Generate(ctx xds_context.Context, proxy *model.Proxy) ([]*model.Resource, error) {
 	resources := make([]*model.Resource, 0, len(g.ProxyTemplate.GetConf().GetImports())+1)
 	for i, name := range g.ProxyTemplate.GetConf().GetImports() {
-		generator := &ProxyTemplateProfileSource{ProfileName: name}
+		generator := &ProxyTemplateProfileSource{ProfileName: name, Profiles: g.Profiles}
 		if rs, err := generator.Generate(ctx, proxy); err != nil {
 			return nil, fmt.Errorf("imports[%d]{name=%q}: %s", i, name, err)
 		} else {
@@ -40,6 +44,10 @@ func (g *TemplateProxyGenerator) Generate(ctx xds_context.Context, proxy *model.
 	} else {
 		resources = append(resources, rs...)
 	}
+	resources, err := modifications.Apply(resources, g.ProxyTemplate.GetConf().GetModifications())
+	if err != nil {
+		return nil, fmt.Errorf("modifications: %s", err)
+	}
 	return resources, nil
 }
 
@@ -64,22 +72,13 @@ func (s *ProxyTemplateRawSource) Generate(_ xds_context.Context, proxy *model.Pr
 	return resources, nil
 }
 
-var predefinedProfiles = make(map[string]ResourceGenerator)
-
-func NewDefaultProxyProfile() ResourceGenerator {
-	return CompositeResourceGenerator{PrometheusEndpointGenerator{}, TransparentProxyGenerator{}, InboundProxyGenerator{}, OutboundProxyGenerator{}}
-}
-
-func init() {
-	predefinedProfiles[mesh_core.ProfileDefaultProxy] = NewDefaultProxyProfile()
-}
-
 type ProxyTemplateProfileSource struct {
 	ProfileName string
+	Profiles    ProfileRegistry
 }
 
 func (s *ProxyTemplateProfileSource) Generate(ctx xds_context.Context, proxy *model.Proxy) ([]*model.Resource, error) {
-	g, ok := predefinedProfiles[s.ProfileName]
+	g, ok := s.Profiles.Get(s.ProfileName)
 	if !ok {
 		return nil, fmt.Errorf("profile{name=%q}: unknown profile", s.ProfileName)
 	}
@@ -105,33 +104,13 @@ func (g InboundProxyGenerator) Generate(ctx xds_context.Context, proxy *model.Pr
 			Name:     localClusterName,
 			Version:  "",
 			Resource: envoy_clusters.CreateLocalCluster(localClusterName, "127.0.0.1", endpoint.WorkloadPort),
+			Origin:   mesh_core.OriginInbound,
 		})
 
 		// generate LDS resource
 		iface := proxy.Dataplane.Spec.Networking.Inbound[i]
-		service := iface.GetService()
-		protocol := mesh_core.ParseProtocol(iface.GetProtocol())
 		inboundListenerName := envoy_names.GetInboundListenerName(endpoint.DataplaneIP, endpoint.DataplanePort)
-		filterChainBuilder := func() *envoy_listeners.FilterChainBuilder {
-			filterChainBuilder := envoy_listeners.NewFilterChainBuilder()
-			switch protocol {
-			case mesh_core.ProtocolHTTP:
-				// configuration for HTTP case
-				filterChainBuilder.
-					Configure(envoy_listeners.HttpConnectionManager(localClusterName)).
-					Configure(envoy_listeners.FaultInjection(proxy.FaultInjections[endpoint])).
-					Configure(envoy_listeners.Tracing(proxy.TracingBackend)).
-					Configure(envoy_listeners.HttpInboundRoute(service, envoy_common.ClusterInfo{Name: localClusterName}))
-			case mesh_core.ProtocolTCP:
-				fallthrough
-			default:
-				// configuration for non-HTTP cases
-				filterChainBuilder.Configure(envoy_listeners.TcpProxy(localClusterName, envoy_common.ClusterInfo{Name: localClusterName}))
-			}
-			return filterChainBuilder.
-				Configure(envoy_listeners.ServerSideMTLS(ctx, proxy.Metadata)).
-				Configure(envoy_listeners.NetworkRBAC(inboundListenerName, ctx.Mesh.Resource.Spec.GetMtls().GetEnabled(), proxy.TrafficPermissions[endpoint]))
-		}()
+		filterChainBuilder := inboundFilterChain(ctx, proxy, iface, endpoint, inboundListenerName, localClusterName)
 		inboundListener, err := envoy_listeners.NewListenerBuilder().
 			Configure(envoy_listeners.InboundListener(inboundListenerName, endpoint.DataplaneIP, endpoint.DataplanePort)).
 			Configure(envoy_listeners.FilterChain(filterChainBuilder)).
@@ -144,11 +123,40 @@ func (g InboundProxyGenerator) Generate(ctx xds_context.Context, proxy *model.Pr
 			Name:     inboundListenerName,
 			Version:  "",
 			Resource: inboundListener,
+			Origin:   mesh_core.OriginInbound,
 		})
 	}
 	return resources.List(), nil
 }
 
+// inboundFilterChain configures a filter chain that accepts traffic from other dataplanes in the mesh.
+func inboundFilterChain(ctx xds_context.Context, proxy *model.Proxy, iface *kuma_mesh.Dataplane_Networking_Inbound, endpoint kuma_mesh.InboundInterface, inboundListenerName string, localClusterName string) *envoy_listeners.FilterChainBuilder {
+	service := iface.GetService()
+	protocol := mesh_core.ParseProtocol(iface.GetProtocol())
+	filterChainBuilder := envoy_listeners.NewFilterChainBuilder()
+	switch protocol {
+	case mesh_core.ProtocolHTTP:
+		// configuration for HTTP case
+		filterChainBuilder.
+			Configure(envoy_listeners.HttpConnectionManager(localClusterName)).
+			Configure(envoy_listeners.HttpInboundAccessLog(proxy.Id.Mesh, service, proxy.InboundLogs[endpoint], proxy)).
+			Configure(envoy_listeners.FaultInjection(proxy.FaultInjections[endpoint])).
+			Configure(envoy_listeners.Tracing(proxy.TracingBackend)).
+			Configure(envoy_listeners.HttpInboundRoute(service, envoy_common.ClusterInfo{Name: localClusterName})).
+			Configure(envoy_listeners.HttpRBAC(ctx.Mesh.Resource.Spec.GetMtls().GetEnabled(), proxy.TrafficPermissions[endpoint]))
+	case mesh_core.ProtocolTCP:
+		fallthrough
+	default:
+		// configuration for non-HTTP cases
+		filterChainBuilder.
+			Configure(envoy_listeners.TcpProxy(localClusterName, envoy_common.ClusterInfo{Name: localClusterName})).
+			Configure(envoy_listeners.NetworkInboundAccessLog(proxy.Id.Mesh, service, proxy.InboundLogs[endpoint], proxy))
+	}
+	return filterChainBuilder.
+		Configure(envoy_listeners.ServerSideMTLS(ctx, proxy.Metadata)).
+		Configure(envoy_listeners.NetworkRBAC(inboundListenerName, ctx.Mesh.Resource.Spec.GetMtls().GetEnabled(), proxy.TrafficPermissions[endpoint]))
+}
+
 type OutboundProxyGenerator struct {
 }
 
@@ -172,44 +180,73 @@ func (g OutboundProxyGenerator) Generate(ctx xds_context.Context, proxy *model.P
 		}
 
 		// determine the list of destination clusters
-		clusters, err := g.determineClusters(ctx, proxy, route)
+		clusters, err := g.determineClusters(route, validators.RootedAt("conf"), route.Spec.Conf)
+		if err != nil {
+			return nil, err
+		}
+		httpRoutes, err := g.determineHttpRoutes(route)
+		if err != nil {
+			return nil, err
+		}
+		mirrorCluster, err := g.determineMirrorCluster(route)
 		if err != nil {
 			return nil, err
 		}
 
+		// protocol is inferred from endpoints of default destinations,
+		// HTTP rules and a mirror are ignored unless the service is HTTP
+		protocol := InferServiceProtocol(g.endpointsOf(proxy, clusters))
+		if protocol != mesh_core.ProtocolHTTP {
+			httpRoutes, mirrorCluster = nil, nil
+		}
+
 		// generate CDS and EDS resources
-		edsResources, endpoints, err := g.generateEds(ctx, proxy, clusters)
+		edsResources, err := g.generateEds(ctx, proxy, g.allClusters(clusters, httpRoutes, mirrorCluster), route.Spec.GetLoadBalancer(), proxy.Timeouts[outbound.Service])
 		if err != nil {
 			return nil, err
 		}
 		resources.Add(edsResources...)
 
-		protocol := InferServiceProtocol(endpoints)
-
 		// generate LDS resource
 		outboundListenerName := envoy_names.GetOutboundListenerName(ofaces[i].DataplaneIP, ofaces[i].DataplanePort)
 		outboundRouteName := envoy_names.GetOutboundRouteName(outbound.Service)
 		destinationService := outbound.Service
 
+		tcpFilterChainBuilder := func() *envoy_listeners.FilterChainBuilder {
+			return envoy_listeners.NewFilterChainBuilder().
+				Configure(envoy_listeners.TcpProxy(outbound.Service, clusters...)).
+				Configure(envoy_listeners.NetworkAccessLog(meshName, sourceService, destinationService, proxy.Logs[outbound.Service], proxy)).
+				Configure(envoy_listeners.Retry(proxy.Retries[outbound.Service])).
+				Configure(envoy_listeners.Timeout(proxy.Timeouts[outbound.Service])).
+				Configure(envoy_listeners.HashPolicies(route.Spec.GetLoadBalancer()))
+		}
+		var bandwidthLimited bool
 		filterChainBuilder := func() *envoy_listeners.FilterChainBuilder {
-			filterChainBuilder := envoy_listeners.NewFilterChainBuilder()
 			switch protocol {
 			case mesh_core.ProtocolHTTP:
 				// configuration for HTTP case
-				filterChainBuilder.
+				return envoy_listeners.NewFilterChainBuilder().
 					Configure(envoy_listeners.HttpConnectionManager(outbound.Service)).
 					Configure(envoy_listeners.Tracing(proxy.TracingBackend)).
 					Configure(envoy_listeners.HttpAccessLog(meshName, sourceService, destinationService, proxy.Logs[outbound.Service], proxy)).
+					Configure(envoy_listeners.FaultInjection(outboundFaultInjection(proxy, outbound.Service, mesh_core.ProtocolHTTP))).
 					Configure(envoy_listeners.HttpOutboundRoute(outboundRouteName))
 			case mesh_core.ProtocolTCP:
 				fallthrough
 			default:
 				// configuration for non-HTTP cases
-				filterChainBuilder.
-					Configure(envoy_listeners.TcpProxy(outbound.Service, clusters...)).
-					Configure(envoy_listeners.NetworkAccessLog(meshName, sourceService, destinationService, proxy.Logs[outbound.Service], proxy))
+				faultInjection := outboundFaultInjection(proxy, outbound.Service, mesh_core.ProtocolTCP)
+				filterChainBuilder := tcpFilterChainBuilder()
+				if faultInjection.GetConf().GetResponseBandwidth() != nil {
+					// connections pass through the bandwidth limiter of kuma-dp
+					// on their way to the listener that forwards them to destinations
+					bandwidthLimited = true
+					filterChainBuilder = envoy_listeners.NewFilterChainBuilder().
+						Configure(envoy_listeners.TcpProxy(envoy_names.GetBandwidthLimiterClusterName(outboundListenerName), envoy_common.ClusterInfo{Name: envoy_names.GetBandwidthLimiterClusterName(outboundListenerName)}))
+				}
+				return filterChainBuilder.
+					Configure(envoy_listeners.NetworkFaultInjection(outbound.Service, outboundListenerName, faultInjection))
 			}
-			return filterChainBuilder
 		}()
 		listener, err := envoy_listeners.NewListenerBuilder().
 			Configure(envoy_listeners.OutboundListener(outboundListenerName, ofaces[i].DataplaneIP, ofaces[i].DataplanePort)).
@@ -222,10 +259,18 @@ func (g OutboundProxyGenerator) Generate(ctx xds_context.Context, proxy *model.P
 		resources.Add(&model.Resource{
 			Name:     outboundListenerName,
 			Resource: listener,
+			Origin:   mesh_core.OriginOutbound,
 		})
+		if bandwidthLimited {
+			limiterResources, err := g.generateBandwidthLimiter(proxy, outboundListenerName, tcpFilterChainBuilder())
+			if err != nil {
+				return nil, errors.Wrapf(err, "%s: could not generate bandwidth limiter of listener %s", validators.RootedAt("dataplane").Field("networking").Field("outbound").Index(i), outboundListenerName)
+			}
+			resources.Add(limiterResources...)
+		}
 
 		// generate RDS resources
-		rdsResources, err := g.generateRds(protocol, outbound.Service, outboundRouteName, clusters, proxy.Dataplane.Spec.Tags())
+		rdsResources, err := g.generateRds(protocol, outbound.Service, outboundRouteName, clusters, httpRoutes, mirrorCluster, route.Spec.GetMirror().GetPercentage(), route.Spec.GetLoadBalancer(), proxy.Retries[outbound.Service], proxy.Timeouts[outbound.Service], proxy.Dataplane.Spec.Tags())
 		if err != nil {
 			return nil, err
 		}
@@ -235,11 +280,74 @@ func (g OutboundProxyGenerator) Generate(ctx xds_context.Context, proxy *model.P
 	return resources.List(), nil
 }
 
-func (_ OutboundProxyGenerator) determineClusters(ctx xds_context.Context, proxy *model.Proxy, route *mesh_core.TrafficRouteResource) (clusters []envoy_common.ClusterInfo, err error) {
-	for j, destination := range route.Spec.Conf {
+// generateBandwidthLimiter generates a cluster that points to the bandwidth limiter of kuma-dp
+// and a listener that accepts connections throttled by the limiter and forwards them to destinations.
+// Since the listener accepts connections on a Unix socket, hash policies based on a source IP
+// have no effect on such connections.
+func (_ OutboundProxyGenerator) generateBandwidthLimiter(proxy *model.Proxy, outboundListenerName string, filterChainBuilder *envoy_listeners.FilterChainBuilder) ([]*model.Resource, error) {
+	dataplaneName, meshName := proxy.Dataplane.Meta.GetName(), proxy.Dataplane.Meta.GetMesh()
+	clusterName := envoy_names.GetBandwidthLimiterClusterName(outboundListenerName)
+	listenerName := envoy_names.GetThrottledListenerName(outboundListenerName)
+	listener, err := envoy_listeners.NewListenerBuilder().
+		Configure(envoy_listeners.PipeListener(listenerName, faults.ThrottledListenerSocketPath(dataplaneName, meshName, outboundListenerName))).
+		Configure(envoy_listeners.FilterChain(filterChainBuilder)).
+		Build()
+	if err != nil {
+		return nil, err
+	}
+	return []*model.Resource{
+		{
+			Name:     clusterName,
+			Resource: envoy_clusters.CreatePipeCluster(clusterName, faults.BandwidthLimiterSocketPath(dataplaneName, meshName, outboundListenerName)),
+			Origin:   mesh_core.OriginOutbound,
+		},
+		{
+			Name:     listenerName,
+			Resource: listener,
+			Origin:   mesh_core.OriginOutbound,
+		},
+	}, nil
+}
+
+// outboundFaultInjection returns FaultInjection applied on the source side of a given outbound
+// unless it is meant for a destination of another protocol.
+func outboundFaultInjection(proxy *model.Proxy, service string, protocol string) *kuma_mesh.FaultInjection {
+	faultInjection := proxy.OutboundFaultInjections[service]
+	if faultInjection.DestinationProtocol() != protocol {
+		return nil
+	}
+	return faultInjection
+}
+
+// httpRoute holds destination clusters for HTTP requests that match given criteria.
+type httpRoute struct {
+	match    *kuma_mesh.TrafficRoute_Http_Match
+	clusters []envoy_common.ClusterInfo
+}
+
+func (g OutboundProxyGenerator) determineHttpRoutes(route *mesh_core.TrafficRouteResource) (httpRoutes []httpRoute, err error) {
+	for i, http := range route.Spec.Http {
+		clusters, err := g.determineClusters(route, validators.RootedAt("http").Index(i).Field("destination"), http.Destination)
+		if err != nil {
+			return nil, err
+		}
+		if len(clusters) == 0 {
+			// all destinations have 0 weight
+			continue
+		}
+		httpRoutes = append(httpRoutes, httpRoute{
+			match:    http.Match,
+			clusters: clusters,
+		})
+	}
+	return
+}
+
+func (_ OutboundProxyGenerator) determineClusters(route *mesh_core.TrafficRouteResource, path validators.PathBuilder, destinations []*kuma_mesh.TrafficRoute_WeightedDestination) (clusters []envoy_common.ClusterInfo, err error) {
+	for j, destination := range destinations {
 		service, ok := destination.Destination[kuma_mesh.ServiceTag]
 		if !ok {
-			return nil, errors.Errorf("trafficroute{name=%q}.%s: mandatory tag %q is missing: %v", route.GetMeta().GetName(), validators.RootedAt("conf").Index(j).Field("destination"), kuma_mesh.ServiceTag, destination.Destination)
+			return nil, errors.Errorf("trafficroute{name=%q}.%s: mandatory tag %q is missing: %v", route.GetMeta().GetName(), path.Index(j).Field("destination"), kuma_mesh.ServiceTag, destination.Destination)
 		}
 		if destination.Weight == 0 {
 			// Envoy doesn't support 0 weight
@@ -254,39 +362,134 @@ func (_ OutboundProxyGenerator) determineClusters(ctx xds_context.Context, proxy
 	return
 }
 
-func (_ OutboundProxyGenerator) generateEds(ctx xds_context.Context, proxy *model.Proxy, clusters []envoy_common.ClusterInfo) (resources []*model.Resource, allEndpoints []model.Endpoint, _ error) {
+// determineMirrorCluster returns a cluster that receives a copy of HTTP requests, if any.
+func (_ OutboundProxyGenerator) determineMirrorCluster(route *mesh_core.TrafficRouteResource) (*envoy_common.ClusterInfo, error) {
+	mirror := route.Spec.GetMirror()
+	if mirror == nil {
+		return nil, nil
+	}
+	service, ok := mirror.Destination[kuma_mesh.ServiceTag]
+	if !ok {
+		return nil, errors.Errorf("trafficroute{name=%q}.%s: mandatory tag %q is missing: %v", route.GetMeta().GetName(), validators.RootedAt("mirror").Field("destination"), kuma_mesh.ServiceTag, mirror.Destination)
+	}
+	return &envoy_common.ClusterInfo{
+		Name: envoy_names.GetDestinationClusterName(service, mirror.Destination),
+		Tags: mirror.Destination,
+	}, nil
+}
+
+// allClusters returns a list of unique clusters referenced by default destinations, by HTTP routes or by a mirror.
+func (_ OutboundProxyGenerator) allClusters(clusters []envoy_common.ClusterInfo, httpRoutes []httpRoute, mirror *envoy_common.ClusterInfo) []envoy_common.ClusterInfo {
+	var all []envoy_common.ClusterInfo
+	seen := map[string]bool{}
+	add := func(clusters []envoy_common.ClusterInfo) {
+		for _, cluster := range clusters {
+			if seen[cluster.Name] {
+				continue
+			}
+			seen[cluster.Name] = true
+			all = append(all, cluster)
+		}
+	}
+	add(clusters)
+	for _, route := range httpRoutes {
+		add(route.clusters)
+	}
+	if mirror != nil {
+		add([]envoy_common.ClusterInfo{*mirror})
+	}
+	return all
+}
+
+// endpointsOf returns endpoints of given clusters.
+func (_ OutboundProxyGenerator) endpointsOf(proxy *model.Proxy, clusters []envoy_common.ClusterInfo) []model.Endpoint {
+	var endpoints []model.Endpoint
+	for _, cluster := range clusters {
+		endpoints = append(endpoints, clusterEndpoints(proxy, cluster)...)
+	}
+	return endpoints
+}
+
+func clusterEndpoints(proxy *model.Proxy, cluster envoy_common.ClusterInfo) []model.Endpoint {
+	return model.EndpointList(proxy.OutboundTargets[cluster.Tags[kuma_mesh.ServiceTag]]).Filter(kuma_mesh.MatchTags(cluster.Tags))
+}
+
+func (_ OutboundProxyGenerator) generateEds(ctx xds_context.Context, proxy *model.Proxy, clusters []envoy_common.ClusterInfo, loadBalancer *kuma_mesh.TrafficRoute_LoadBalancer, timeout *mesh_core.TimeoutResource) (resources []*model.Resource, _ error) {
 	for _, cluster := range clusters {
 		serviceName := cluster.Tags[kuma_mesh.ServiceTag]
 		healthCheck := proxy.HealthChecks[serviceName]
-		edsCluster, err := envoy_clusters.CreateEdsCluster(ctx, cluster.Name, proxy.Metadata)
+		circuitBreaker := proxy.CircuitBreakers[serviceName]
+		endpoints := clusterEndpoints(proxy, cluster)
+		if len(endpoints) > 0 && endpoints[0].IsExternalService() {
+			// endpoints of an ExternalService are resolved by Envoy using DNS
+			dnsCluster, err := envoy_clusters.CreateDnsCluster(ctx, cluster.Name, proxy.Metadata, endpoints, timeout)
+			if err != nil {
+				return nil, err
+			}
+			resources = append(resources, &model.Resource{
+				Name:     cluster.Name,
+				Resource: envoy_clusters.ClusterWithLoadBalancer(envoy_clusters.ClusterWithCircuitBreaker(envoy_clusters.ClusterWithHealthChecks(dnsCluster, healthCheck, serviceName), circuitBreaker), loadBalancer),
+				Origin:   mesh_core.OriginOutbound,
+			})
+			continue
+		}
+		edsCluster, err := envoy_clusters.CreateEdsCluster(ctx, cluster.Name, proxy.Metadata, timeout)
 		if err != nil {
-			return nil, nil, err
+			return nil, err
 		}
 		resources = append(resources, &model.Resource{
 			Name:     cluster.Name,
-			Resource: envoy_clusters.ClusterWithHealthChecks(edsCluster, healthCheck),
+			Resource: envoy_clusters.ClusterWithLoadBalancer(envoy_clusters.ClusterWithCircuitBreaker(envoy_clusters.ClusterWithHealthChecks(edsCluster, healthCheck, serviceName), circuitBreaker), loadBalancer),
+			Origin:   mesh_core.OriginOutbound,
 		})
-		endpoints := model.EndpointList(proxy.OutboundTargets[serviceName]).Filter(kuma_mesh.MatchTags(cluster.Tags))
+		loadAssignment := envoy_endpoints.CreateClusterLoadAssignment(cluster.Name, endpoints)
+		if ctx.Mesh.Resource.Spec.GetRouting().GetLocalityAwareLoadBalancing() {
+			loadAssignment = envoy_endpoints.CreateLocalityAwareClusterLoadAssignment(cluster.Name, endpoints, dataplaneLocality(proxy.Dataplane))
+		}
 		resources = append(resources, &model.Resource{
 			Name:     cluster.Name,
-			Resource: envoy_endpoints.CreateClusterLoadAssignment(cluster.Name, endpoints),
+			Resource: loadAssignment,
+			Origin:   mesh_core.OriginOutbound,
 		})
-		allEndpoints = append(allEndpoints, endpoints...)
 	}
 	return
 }
 
-func (_ OutboundProxyGenerator) generateRds(protocol mesh_core.Protocol, service string, outboundRouteName string, clusters []envoy_common.ClusterInfo, tags kuma_mesh.MultiValueTagSet) ([]*model.Resource, error) {
+// dataplaneLocality returns locality of a given dataplane according to tags of its inbound interfaces.
+func dataplaneLocality(dataplane *mesh_core.DataplaneResource) model.Locality {
+	tags := dataplane.Spec.Tags()
+	locality := model.Locality{}
+	if regions := tags.Values(kuma_mesh.RegionTag); len(regions) > 0 {
+		locality.Region = regions[0]
+	}
+	if zones := tags.Values(kuma_mesh.ZoneTag); len(zones) > 0 {
+		locality.Zone = zones[0]
+	}
+	return locality
+}
+
+func (_ OutboundProxyGenerator) generateRds(protocol mesh_core.Protocol, service string, outboundRouteName string, clusters []envoy_common.ClusterInfo, httpRoutes []httpRoute, mirror *envoy_common.ClusterInfo, mirrorPercentage *wrappers.DoubleValue, loadBalancer *kuma_mesh.TrafficRoute_LoadBalancer, retry *mesh_core.RetryResource, timeout *mesh_core.TimeoutResource, tags kuma_mesh.MultiValueTagSet) ([]*model.Resource, error) {
 	resources := &model.ResourceSet{}
 	switch protocol {
 	case mesh_core.ProtocolHTTP:
 		// generate RDS resource
+		virtualHostBuilder := envoy_routes.NewVirtualHostBuilder().
+			Configure(envoy_routes.CommonVirtualHost(service)).
+			Configure(envoy_routes.Retry(retry))
+		for _, route := range httpRoutes {
+			virtualHostBuilder.Configure(envoy_routes.HttpRoute(route.match, route.clusters...))
+		}
+		// configurers after DefaultRoute update all Routes of a VirtualHost,
+		// so they must be applied once DefaultRoute and HttpRoutes have been added
+		virtualHostBuilder.
+			Configure(envoy_routes.DefaultRoute(clusters...)).
+			Configure(envoy_routes.Timeout(timeout)).
+			Configure(envoy_routes.HashPolicies(loadBalancer)).
+			Configure(envoy_routes.Mirror(mirror, mirrorPercentage))
 		routeConfiguration, err := envoy_routes.NewRouteConfigurationBuilder().
 			Configure(envoy_routes.CommonRouteConfiguration(outboundRouteName)).
 			Configure(envoy_routes.TagsHeader(tags)).
-			Configure(envoy_routes.VirtualHost(envoy_routes.NewVirtualHostBuilder().
-				Configure(envoy_routes.CommonVirtualHost(service)).
-				Configure(envoy_routes.DefaultRoute(clusters...)))).
+			Configure(envoy_routes.VirtualHost(virtualHostBuilder)).
 			Build()
 		if err != nil {
 			return nil, err
@@ -294,6 +497,7 @@ func (_ OutboundProxyGenerator) generateRds(protocol mesh_core.Protocol, service
 		resources.Add(&model.Resource{
 			Name:     outboundRouteName,
 			Resource: routeConfiguration,
+			Origin:   mesh_core.OriginOutbound,
 		})
 	}
 	return resources.List(), nil
@@ -324,11 +528,13 @@ func (_ TransparentProxyGenerator) Generate(ctx xds_context.Context, proxy *mode
 			Name:     "catch_all",
 			Version:  proxy.Dataplane.Meta.GetVersion(),
 			Resource: listener,
+			Origin:   mesh_core.OriginTransparent,
 		},
 		&model.Resource{
 			Name:     "pass_through",
 			Version:  proxy.Dataplane.Meta.GetVersion(),
 			Resource: envoy_clusters.CreatePassThroughCluster("pass_through"),
+			Origin:   mesh_core.OriginTransparent,
 		},
 	}, nil
 }
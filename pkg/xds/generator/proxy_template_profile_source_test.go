@@ -33,6 +33,7 @@ var _ = Describe("ProxyTemplateProfileSource", func() {
 			// setup
 			gen := &generator.ProxyTemplateProfileSource{
 				ProfileName: given.profile,
+				Profiles:    generator.NewProfileRegistry(),
 			}
 
 			// given
@@ -398,5 +399,144 @@ var _ = Describe("ProxyTemplateProfileSource", func() {
 			// Prometheus endpoint does not overshadow port of outbound listener
 			envoyConfigFile: "2-envoy-config.golden.yaml",
 		}),
+		Entry("should support pre-defined `gateway-proxy` profile; prometheus_metrics=true", testCase{
+			mesh: `
+            mtls:
+              enabled: true
+            metrics:
+              prometheus:
+                port: 1234
+                path: /non-standard-path
+`,
+			dataplane: `
+            networking:
+              address: 192.168.0.1
+              inbound:
+                - port: 80
+                  servicePort: 8080
+                  tags:
+                    service: backend
+                    protocol: http
+              outbound:
+              - port: 54321
+                service: db
+              - port: 59200
+                service: elastic
+`,
+			profile:         mesh_core.ProfileGatewayProxy,
+			envoyConfigFile: "5-envoy-config.golden.yaml",
+		}),
+		Entry("should support pre-defined `egress-only` profile; prometheus_metrics=true", testCase{
+			mesh: `
+            mtls:
+              enabled: true
+            metrics:
+              prometheus:
+                port: 1234
+                path: /non-standard-path
+`,
+			dataplane: `
+            networking:
+              address: 192.168.0.1
+              inbound:
+                - port: 80
+                  servicePort: 8080
+                  tags:
+                    service: backend
+                    protocol: http
+              outbound:
+              - port: 54321
+                service: db
+              - port: 59200
+                service: elastic
+`,
+			profile:         mesh_core.ProfileEgressOnly,
+			envoyConfigFile: "6-envoy-config.golden.yaml",
+		}),
+		Entry("should support pre-defined `minimal` profile; prometheus_metrics=true", testCase{
+			mesh: `
+            mtls:
+              enabled: true
+            metrics:
+              prometheus:
+                port: 1234
+                path: /non-standard-path
+`,
+			dataplane: `
+            networking:
+              address: 192.168.0.1
+              inbound:
+                - port: 80
+                  servicePort: 8080
+                  tags:
+                    service: backend
+                    protocol: http
+              outbound:
+              - port: 54321
+                service: db
+              - port: 59200
+                service: elastic
+`,
+			profile:         mesh_core.ProfileMinimal,
+			envoyConfigFile: "7-envoy-config.golden.yaml",
+		}),
 	)
 })
+
+var _ = Describe("ProfileRegistry", func() {
+
+	var profiles generator.ProfileRegistry
+
+	BeforeEach(func() {
+		profiles = generator.NewProfileRegistry()
+	})
+
+	AfterEach(func() {
+		mesh_core.UseProfileRegistry(generator.NewProfileRegistry())
+	})
+
+	It("should make a custom profile available to ProxyTemplates", func() {
+		// given
+		custom := generator.CompositeResourceGenerator{generator.OutboundProxyGenerator{}}
+		mesh_core.UseProfileRegistry(profiles)
+
+		// when
+		err := profiles.Register("custom-profile", custom)
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		// and
+		Expect(profiles.Names()).To(Equal([]string{"default-proxy", "gateway-proxy", "egress-only", "minimal", "custom-profile"}))
+
+		// when
+		proxyTemplate := &mesh_core.ProxyTemplateResource{
+			Spec: mesh_proto.ProxyTemplate{
+				Selectors: []*mesh_proto.Selector{{Match: mesh_proto.TagSelector{"service": "*"}}},
+				Conf: &mesh_proto.ProxyTemplate_Conf{
+					Imports: []string{"custom-profile"},
+				},
+			},
+		}
+		// then
+		Expect(proxyTemplate.Validate()).To(Succeed())
+
+		// when
+		gen := &generator.TemplateProxyGenerator{
+			ProxyTemplate: &proxyTemplate.Spec,
+			Profiles:      profiles,
+		}
+		_, err = gen.Generate(xds_context.Context{}, &model.Proxy{
+			Dataplane: &mesh_core.DataplaneResource{},
+		})
+		// then
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("should not allow to override a pre-defined profile", func() {
+		// when
+		err := profiles.Register(mesh_core.ProfileDefaultProxy, generator.CompositeResourceGenerator{})
+
+		// then
+		Expect(err).To(MatchError(`profile with name="default-proxy" has already been registered`))
+	})
+})
@@ -0,0 +1,108 @@
+package generator
+
+import (
+	"context"
+	"sync"
+
+	"github.com/pkg/errors"
+
+	mesh_core "github.com/Kong/kuma/pkg/core/resources/apis/mesh"
+)
+
+// ProfileRegistry holds profiles that ProxyTemplates can import, i.e. named generators of Envoy resources.
+type ProfileRegistry interface {
+	mesh_core.ProfileRegistry
+	// Register makes a given generator available to ProxyTemplates under a given name.
+	Register(name string, generator ResourceGenerator) error
+	// Get returns a generator of a profile with a given name.
+	Get(name string) (ResourceGenerator, bool)
+}
+
+// NewProfileRegistry returns a registry with built-in profiles.
+func NewProfileRegistry() ProfileRegistry {
+	return &profileRegistry{
+		names: append([]string(nil), mesh_core.BuiltinProfiles...),
+		generators: map[string]ResourceGenerator{
+			mesh_core.ProfileDefaultProxy: NewDefaultProxyProfile(),
+			mesh_core.ProfileGatewayProxy: NewGatewayProxyProfile(),
+			mesh_core.ProfileEgressOnly:   NewEgressOnlyProfile(),
+			mesh_core.ProfileMinimal:      NewMinimalProfile(),
+		},
+	}
+}
+
+var _ ProfileRegistry = &profileRegistry{}
+
+type profileRegistry struct {
+	sync.RWMutex
+	names      []string
+	generators map[string]ResourceGenerator
+}
+
+func (r *profileRegistry) Register(name string, generator ResourceGenerator) error {
+	if name == "" {
+		return errors.New("profile name cannot be empty")
+	}
+	r.Lock()
+	defer r.Unlock()
+	if _, exists := r.generators[name]; exists {
+		return errors.Errorf("profile with name=%q has already been registered", name)
+	}
+	r.names = append(r.names, name)
+	r.generators[name] = generator
+	return nil
+}
+
+func (r *profileRegistry) Get(name string) (ResourceGenerator, bool) {
+	r.RLock()
+	defer r.RUnlock()
+	generator, ok := r.generators[name]
+	return generator, ok
+}
+
+func (r *profileRegistry) Names() []string {
+	r.RLock()
+	defer r.RUnlock()
+	return append([]string(nil), r.names...)
+}
+
+func (r *profileRegistry) Has(name string) bool {
+	_, ok := r.Get(name)
+	return ok
+}
+
+type profilesKey struct{}
+
+// NewProfilesContext returns Runtime extensions with a given registry of profiles,
+// so that plugins can register their own profiles at Control Plane start up.
+func NewProfilesContext(ctx context.Context, profiles ProfileRegistry) context.Context {
+	return context.WithValue(ctx, profilesKey{}, profiles)
+}
+
+// FromProfilesContext returns a registry of profiles from Runtime extensions.
+func FromProfilesContext(ctx context.Context) (profiles ProfileRegistry, ok bool) {
+	profiles, ok = ctx.Value(profilesKey{}).(ProfileRegistry)
+	return
+}
+
+// NewDefaultProxyProfile generates configuration of a regular mesh member.
+func NewDefaultProxyProfile() ResourceGenerator {
+	return CompositeResourceGenerator{PrometheusEndpointGenerator{}, TransparentProxyGenerator{}, InboundProxyGenerator{}, OutboundProxyGenerator{}}
+}
+
+// NewGatewayProxyProfile generates configuration of a dataplane that exposes its inbound listeners
+// to clients outside of the mesh in plain text, while mTLS clients of the mesh are still subject to TrafficPermissions.
+func NewGatewayProxyProfile() ResourceGenerator {
+	return CompositeResourceGenerator{PrometheusEndpointGenerator{}, TransparentProxyGenerator{}, GatewayInboundProxyGenerator{}, OutboundProxyGenerator{}}
+}
+
+// NewEgressOnlyProfile generates configuration of a dataplane that only makes outbound calls,
+// e.g. of a batch job.
+func NewEgressOnlyProfile() ResourceGenerator {
+	return CompositeResourceGenerator{PrometheusEndpointGenerator{}, TransparentProxyGenerator{}, OutboundProxyGenerator{}}
+}
+
+// NewMinimalProfile generates configuration of a regular mesh member without the Prometheus endpoint.
+func NewMinimalProfile() ResourceGenerator {
+	return CompositeResourceGenerator{TransparentProxyGenerator{}, InboundProxyGenerator{}, OutboundProxyGenerator{}}
+}
@@ -30,6 +30,7 @@ var _ = Describe("TemplateProxyGenerator", func() {
 				// setup
 				gen := &generator.TemplateProxyGenerator{
 					ProxyTemplate: given.template,
+					Profiles:      generator.NewProfileRegistry(),
 				}
 				ctx := xds_context.Context{
 					ControlPlane: &xds_context.ControlPlaneContext{
@@ -112,6 +113,7 @@ var _ = Describe("TemplateProxyGenerator", func() {
 				Expect(util_proto.FromYAML(ptBytes, &proxyTemplate)).To(Succeed())
 				gen := &generator.TemplateProxyGenerator{
 					ProxyTemplate: &proxyTemplate,
+					Profiles:      generator.NewProfileRegistry(),
 				}
 
 				// given
@@ -181,6 +183,22 @@ var _ = Describe("TemplateProxyGenerator", func() {
 				proxyTemplateFile: "1-proxy-template.input.yaml",
 				envoyConfigFile:   "1-envoy-config.golden.yaml",
 			}),
+			Entry("should apply modifications to resources generated by pre-defined profiles", testCase{
+				dataplane: `
+                networking:
+                  transparentProxying:
+                    redirectPort: 15001
+                  address: 192.168.0.1
+                  inbound:
+                    - port: 80
+                      servicePort: 8080
+                      tags:
+                        service: backend
+                        protocol: http
+`,
+				proxyTemplateFile: "2-proxy-template.input.yaml",
+				envoyConfigFile:   "2-envoy-config.golden.yaml",
+			}),
 		)
 
 	})
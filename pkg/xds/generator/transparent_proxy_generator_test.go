@@ -81,12 +81,14 @@ var _ = Describe("TransparentProxyGenerator", func() {
 						},
 					},
 				},
-				Logs: map[model.ServiceName]*mesh_proto.LoggingBackend{ // to show that is not picked
+				Logs: model.LogMap{ // to show that is not picked
 					"some-service": {
-						Name: "file",
-						Type: &mesh_proto.LoggingBackend_File_{
-							File: &mesh_proto.LoggingBackend_File{
-								Path: "/var/log",
+						Backend: &mesh_proto.LoggingBackend{
+							Name: "file",
+							Type: &mesh_proto.LoggingBackend_File_{
+								File: &mesh_proto.LoggingBackend_File{
+									Path: "/var/log",
+								},
 							},
 						},
 					},
@@ -137,12 +139,14 @@ var _ = Describe("TransparentProxyGenerator", func() {
 						},
 					},
 				},
-				Logs: map[model.ServiceName]*mesh_proto.LoggingBackend{ // to show that is is not picked
+				Logs: model.LogMap{ // to show that is is not picked
 					"pass_through": {
-						Name: "file",
-						Type: &mesh_proto.LoggingBackend_File_{
-							File: &mesh_proto.LoggingBackend_File{
-								Path: "/var/log",
+						Backend: &mesh_proto.LoggingBackend{
+							Name: "file",
+							Type: &mesh_proto.LoggingBackend_File_{
+								File: &mesh_proto.LoggingBackend_File{
+									Path: "/var/log",
+								},
 							},
 						},
 					},
@@ -0,0 +1,300 @@
+package history
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+
+	core_xds "github.com/Kong/kuma/pkg/core/xds"
+
+	envoy_cache "github.com/envoyproxy/go-control-plane/pkg/cache"
+)
+
+var (
+	now = time.Now
+)
+
+const (
+	ResourceAdded    = "added"
+	ResourceRemoved  = "removed"
+	ResourceModified = "modified"
+)
+
+// SnapshotRecord is a snapshot of xDS configuration that has been pushed to a proxy.
+type SnapshotRecord struct {
+	// Version is a sequence number of a snapshot among snapshots of the same proxy.
+	Version int
+	// Time when a snapshot has been pushed.
+	Time time.Time
+	// DataplaneVersion is a version of the Dataplane resource a snapshot has been generated for.
+	DataplaneVersion string
+	// Causes are changes of inputs since the previous reconciliation of the same proxy, i.e. the change that triggered a push.
+	Causes []ResourceChange
+	// Changes of xDS resources since the previous snapshot of the same proxy.
+	Changes []ResourceChange
+	// Snapshot that has been pushed.
+	Snapshot envoy_cache.Snapshot
+}
+
+// ResourceChange describes how a single resource has changed between two snapshots.
+type ResourceChange struct {
+	Type   string `json:"type"`
+	Name   string `json:"name"`
+	Change string `json:"change"`
+}
+
+// InputKey identifies an input of a snapshot, e.g. a policy or endpoints of a service.
+type InputKey struct {
+	Type string
+	Name string
+}
+
+// Inputs are versions of everything a snapshot has been generated from.
+type Inputs map[InputKey]string
+
+// SnapshotHistory keeps a limited number of the most recent snapshots of every proxy.
+type SnapshotHistory interface {
+	// Record adds a snapshot to the history of a proxy unless it is the same as the latest one.
+	Record(proxyId core_xds.ProxyId, dataplaneVersion string, inputs Inputs, snapshot envoy_cache.Snapshot)
+	// Disconnected marks a proxy as disconnected, its history is dropped once retention period is over.
+	Disconnected(proxyId core_xds.ProxyId)
+	// Proxies returns proxies that have at least one snapshot in the history.
+	Proxies() []core_xds.ProxyId
+	// Records returns snapshots of a proxy from the oldest to the latest.
+	Records(proxyId core_xds.ProxyId) []SnapshotRecord
+}
+
+// NewSnapshotHistory returns a history that keeps up to a given number of snapshots per proxy
+// for as long as a proxy is connected and a given retention period after it has disconnected.
+// History is disabled if size is 0.
+func NewSnapshotHistory(size int, retention time.Duration) SnapshotHistory {
+	return &snapshotHistory{
+		size:      size,
+		retention: retention,
+		proxies:   make(map[string]*proxyHistory),
+	}
+}
+
+var _ SnapshotHistory = &snapshotHistory{}
+
+type snapshotHistory struct {
+	size      int
+	retention time.Duration
+
+	mu      sync.RWMutex // protects access to the fields below
+	proxies map[string]*proxyHistory
+}
+
+type proxyHistory struct {
+	proxyId core_xds.ProxyId
+
+	mu             sync.Mutex // protects access to the fields below
+	lastVersion    int
+	lastInputs     Inputs
+	records        []SnapshotRecord
+	disconnectedAt time.Time
+	dropped        bool
+}
+
+func (h *snapshotHistory) Record(proxyId core_xds.ProxyId, dataplaneVersion string, inputs Inputs, snapshot envoy_cache.Snapshot) {
+	if h.size == 0 {
+		return
+	}
+	proxy := h.lockProxy(proxyId)
+	defer proxy.mu.Unlock()
+
+	proxy.disconnectedAt = time.Time{}
+	causes := changesOfInputs(proxy.lastInputs, inputs)
+	proxy.lastInputs = inputs
+
+	var previous envoy_cache.Snapshot
+	if len(proxy.records) > 0 {
+		previous = proxy.records[len(proxy.records)-1].Snapshot
+	}
+	changes := Changes(previous, snapshot)
+	if len(proxy.records) > 0 && len(changes) == 0 {
+		return
+	}
+	proxy.lastVersion++
+	proxy.records = append(proxy.records, SnapshotRecord{
+		Version:          proxy.lastVersion,
+		Time:             now(),
+		DataplaneVersion: dataplaneVersion,
+		Causes:           causes,
+		Changes:          changes,
+		Snapshot:         snapshot,
+	})
+	if len(proxy.records) > h.size {
+		proxy.records = append([]SnapshotRecord(nil), proxy.records[len(proxy.records)-h.size:]...)
+	}
+}
+
+// lockProxy returns a locked history of a proxy, the global lock is held only to look it up in the map.
+func (h *snapshotHistory) lockProxy(proxyId core_xds.ProxyId) *proxyHistory {
+	for {
+		h.mu.RLock()
+		proxy, ok := h.proxies[proxyId.String()]
+		h.mu.RUnlock()
+		if !ok {
+			h.mu.Lock()
+			// history grows only when a new proxy is added, so expired history is pruned here as well
+			h.prune()
+			if proxy, ok = h.proxies[proxyId.String()]; !ok {
+				proxy = &proxyHistory{proxyId: proxyId}
+				h.proxies[proxyId.String()] = proxy
+			}
+			h.mu.Unlock()
+		}
+		proxy.mu.Lock()
+		if !proxy.dropped {
+			return proxy
+		}
+		// history has been dropped concurrently, look up again
+		proxy.mu.Unlock()
+	}
+}
+
+func (h *snapshotHistory) Disconnected(proxyId core_xds.ProxyId) {
+	if h.size == 0 {
+		return
+	}
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if proxy, ok := h.proxies[proxyId.String()]; ok {
+		proxy.mu.Lock()
+		proxy.disconnectedAt = now()
+		proxy.mu.Unlock()
+	}
+	h.prune()
+}
+
+// prune drops history of proxies that have been disconnected for longer than retention period.
+// Must be called with the global lock held.
+func (h *snapshotHistory) prune() {
+	for key, proxy := range h.proxies {
+		proxy.mu.Lock()
+		if !proxy.disconnectedAt.IsZero() && now().Sub(proxy.disconnectedAt) >= h.retention {
+			proxy.dropped = true
+			delete(h.proxies, key)
+		}
+		proxy.mu.Unlock()
+	}
+}
+
+func (h *snapshotHistory) Proxies() []core_xds.ProxyId {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.prune()
+
+	proxies := make([]core_xds.ProxyId, 0, len(h.proxies))
+	for _, proxy := range h.proxies {
+		proxies = append(proxies, proxy.proxyId)
+	}
+	sort.Slice(proxies, func(i, j int) bool {
+		return proxies[i].String() < proxies[j].String()
+	})
+	return proxies
+}
+
+func (h *snapshotHistory) Records(proxyId core_xds.ProxyId) []SnapshotRecord {
+	h.mu.Lock()
+	h.prune()
+	proxy, ok := h.proxies[proxyId.String()]
+	h.mu.Unlock()
+	if !ok {
+		return nil
+	}
+	proxy.mu.Lock()
+	defer proxy.mu.Unlock()
+	return append([]SnapshotRecord(nil), proxy.records...)
+}
+
+// changesOfInputs returns inputs that have been added, removed or modified between two reconciliations.
+func changesOfInputs(from, to Inputs) []ResourceChange {
+	var changes []ResourceChange
+	for key, version := range to {
+		fromVersion, ok := from[key]
+		switch {
+		case !ok:
+			changes = append(changes, ResourceChange{Type: key.Type, Name: key.Name, Change: ResourceAdded})
+		case fromVersion != version:
+			changes = append(changes, ResourceChange{Type: key.Type, Name: key.Name, Change: ResourceModified})
+		}
+	}
+	for key := range from {
+		if _, ok := to[key]; !ok {
+			changes = append(changes, ResourceChange{Type: key.Type, Name: key.Name, Change: ResourceRemoved})
+		}
+	}
+	sort.Slice(changes, func(i, j int) bool {
+		if changes[i].Type != changes[j].Type {
+			return changes[i].Type < changes[j].Type
+		}
+		return changes[i].Name < changes[j].Name
+	})
+	return changes
+}
+
+// Changes returns xDS resources that have been added, removed or modified between two snapshots.
+// Resources of a type are compared only if the version of that type differs between snapshots,
+// which is the case for snapshots versioned by the reconciler.
+func Changes(from, to envoy_cache.Snapshot) []ResourceChange {
+	var changes []ResourceChange
+	toResources := resourcesOf(to)
+	for i, fromResources := range resourcesOf(from) {
+		if fromResources.version == toResources[i].version {
+			continue
+		}
+		typ, fromItems, toItems := fromResources.typ, fromResources.items, toResources[i].items
+		for _, name := range names(fromItems, toItems) {
+			fromItem, inFrom := fromItems[name]
+			toItem, inTo := toItems[name]
+			switch {
+			case !inFrom:
+				changes = append(changes, ResourceChange{Type: typ, Name: name, Change: ResourceAdded})
+			case !inTo:
+				changes = append(changes, ResourceChange{Type: typ, Name: name, Change: ResourceRemoved})
+			case !proto.Equal(fromItem, toItem):
+				changes = append(changes, ResourceChange{Type: typ, Name: name, Change: ResourceModified})
+			}
+		}
+	}
+	return changes
+}
+
+type typedResources struct {
+	typ     string
+	version string
+	items   map[string]envoy_cache.Resource
+}
+
+// resourcesOf returns resources of a snapshot grouped by type in a stable order.
+func resourcesOf(snapshot envoy_cache.Snapshot) []typedResources {
+	return []typedResources{
+		{typ: "listeners", version: snapshot.Listeners.Version, items: snapshot.Listeners.Items},
+		{typ: "routes", version: snapshot.Routes.Version, items: snapshot.Routes.Items},
+		{typ: "clusters", version: snapshot.Clusters.Version, items: snapshot.Clusters.Items},
+		{typ: "endpoints", version: snapshot.Endpoints.Version, items: snapshot.Endpoints.Items},
+		{typ: "secrets", version: snapshot.Secrets.Version, items: snapshot.Secrets.Items},
+	}
+}
+
+// names returns sorted names of resources in either of given sets.
+func names(sets ...map[string]envoy_cache.Resource) []string {
+	unique := map[string]bool{}
+	for _, set := range sets {
+		for name := range set {
+			unique[name] = true
+		}
+	}
+	var names []string
+	for name := range unique {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
@@ -0,0 +1,211 @@
+package history
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	core_xds "github.com/Kong/kuma/pkg/core/xds"
+
+	envoy "github.com/envoyproxy/go-control-plane/envoy/api/v2"
+	envoy_cache "github.com/envoyproxy/go-control-plane/pkg/cache"
+)
+
+func clustersSnapshot(version string, clusters ...*envoy.Cluster) envoy_cache.Snapshot {
+	var resources []envoy_cache.Resource
+	for _, cluster := range clusters {
+		resources = append(resources, cluster)
+	}
+	return envoy_cache.Snapshot{
+		Clusters: envoy_cache.NewResources(version, resources),
+	}
+}
+
+var _ = Describe("SnapshotHistory", func() {
+
+	proxyId := core_xds.ProxyId{Mesh: "demo", Name: "backend-01"}
+
+	var backupNow func() time.Time
+	var clock time.Time
+
+	BeforeEach(func() {
+		backupNow = now
+		clock = time.Date(2020, 5, 1, 14, 0, 0, 0, time.UTC)
+		now = func() time.Time {
+			clock = clock.Add(time.Minute)
+			return clock
+		}
+	})
+	AfterEach(func() {
+		now = backupNow
+	})
+
+	It("should record only snapshots that have changed", func() {
+		// given
+		history := NewSnapshotHistory(10, time.Hour)
+
+		// when
+		history.Record(proxyId, "1", nil, clustersSnapshot("v1", &envoy.Cluster{Name: "web"}))
+		history.Record(proxyId, "1", nil, clustersSnapshot("v1", &envoy.Cluster{Name: "web"}))
+		history.Record(proxyId, "2", nil, clustersSnapshot("v2", &envoy.Cluster{Name: "web", AltStatName: "changed"}, &envoy.Cluster{Name: "db"}))
+
+		// then
+		records := history.Records(proxyId)
+		Expect(records).To(HaveLen(2))
+		// and
+		Expect(records[0].Version).To(Equal(1))
+		Expect(records[0].Time).To(Equal(time.Date(2020, 5, 1, 14, 1, 0, 0, time.UTC)))
+		Expect(records[0].DataplaneVersion).To(Equal("1"))
+		Expect(records[0].Changes).To(Equal([]ResourceChange{
+			{Type: "clusters", Name: "web", Change: ResourceAdded},
+		}))
+		// and
+		Expect(records[1].Version).To(Equal(2))
+		Expect(records[1].Time).To(Equal(time.Date(2020, 5, 1, 14, 2, 0, 0, time.UTC)))
+		Expect(records[1].DataplaneVersion).To(Equal("2"))
+		Expect(records[1].Changes).To(Equal([]ResourceChange{
+			{Type: "clusters", Name: "db", Change: ResourceAdded},
+			{Type: "clusters", Name: "web", Change: ResourceModified},
+		}))
+		// and
+		Expect(history.Proxies()).To(Equal([]core_xds.ProxyId{proxyId}))
+	})
+
+	It("should keep only a given number of the most recent snapshots", func() {
+		// given
+		history := NewSnapshotHistory(2, time.Hour)
+
+		// when
+		history.Record(proxyId, "1", nil, clustersSnapshot("v1", &envoy.Cluster{Name: "web"}))
+		history.Record(proxyId, "2", nil, clustersSnapshot("v2", &envoy.Cluster{Name: "db"}))
+		history.Record(proxyId, "3", nil, envoy_cache.Snapshot{})
+
+		// then
+		records := history.Records(proxyId)
+		Expect(records).To(HaveLen(2))
+		Expect(records[0].Version).To(Equal(2))
+		Expect(records[1].Version).To(Equal(3))
+		Expect(records[1].Changes).To(Equal([]ResourceChange{
+			{Type: "clusters", Name: "db", Change: ResourceRemoved},
+		}))
+	})
+
+	It("should not record anything when disabled", func() {
+		// given
+		history := NewSnapshotHistory(0, time.Hour)
+
+		// when
+		history.Record(proxyId, "1", nil, clustersSnapshot("v1", &envoy.Cluster{Name: "web"}))
+
+		// then
+		Expect(history.Records(proxyId)).To(BeEmpty())
+		Expect(history.Proxies()).To(BeEmpty())
+	})
+
+	It("should record changes of inputs that triggered a push", func() {
+		// given
+		history := NewSnapshotHistory(10, time.Hour)
+		route := InputKey{Type: "TrafficRoute", Name: "route-1"}
+		endpoints := InputKey{Type: "Endpoints", Name: "web"}
+		dataplane := InputKey{Type: "Dataplane", Name: "backend-01"}
+
+		// when
+		history.Record(proxyId, "1", Inputs{dataplane: "1", route: "1"}, clustersSnapshot("v1", &envoy.Cluster{Name: "web"}))
+		// and inputs change without changing a snapshot
+		history.Record(proxyId, "2", Inputs{dataplane: "2", route: "1"}, clustersSnapshot("v1", &envoy.Cluster{Name: "web"}))
+		// and inputs change and a snapshot changes
+		history.Record(proxyId, "2", Inputs{dataplane: "2", route: "2", endpoints: "abc"}, clustersSnapshot("v2", &envoy.Cluster{Name: "db"}))
+
+		// then
+		records := history.Records(proxyId)
+		Expect(records).To(HaveLen(2))
+		// and
+		Expect(records[0].Causes).To(Equal([]ResourceChange{
+			{Type: "Dataplane", Name: "backend-01", Change: ResourceAdded},
+			{Type: "TrafficRoute", Name: "route-1", Change: ResourceAdded},
+		}))
+		// and only changes since the previous reconciliation are recorded
+		Expect(records[1].Causes).To(Equal([]ResourceChange{
+			{Type: "Endpoints", Name: "web", Change: ResourceAdded},
+			{Type: "TrafficRoute", Name: "route-1", Change: ResourceModified},
+		}))
+	})
+
+	It("should drop history of a proxy once retention period after disconnect is over", func() {
+		// given
+		history := NewSnapshotHistory(10, 5*time.Minute)
+		otherProxyId := core_xds.ProxyId{Mesh: "demo", Name: "web-01"}
+
+		// when
+		history.Record(proxyId, "1", nil, clustersSnapshot("v1", &envoy.Cluster{Name: "web"}))
+		history.Record(otherProxyId, "1", nil, clustersSnapshot("v1", &envoy.Cluster{Name: "backend"}))
+		// and
+		history.Disconnected(proxyId)
+
+		// then history is still available within retention period
+		Expect(history.Records(proxyId)).To(HaveLen(1))
+		Expect(history.Proxies()).To(HaveLen(2))
+
+		// when
+		clock = clock.Add(10 * time.Minute)
+		history.Disconnected(otherProxyId)
+
+		// then
+		Expect(history.Records(proxyId)).To(BeEmpty())
+		Expect(history.Proxies()).To(Equal([]core_xds.ProxyId{otherProxyId}))
+	})
+
+	It("should drop history of a proxy once retention period is over even if no other proxy disconnects", func() {
+		// given
+		history := NewSnapshotHistory(10, 5*time.Minute)
+		otherProxyId := core_xds.ProxyId{Mesh: "demo", Name: "web-01"}
+
+		// when
+		history.Record(proxyId, "1", nil, clustersSnapshot("v1", &envoy.Cluster{Name: "web"}))
+		history.Record(otherProxyId, "1", nil, clustersSnapshot("v1", &envoy.Cluster{Name: "backend"}))
+		// and
+		history.Disconnected(proxyId)
+		// and
+		clock = clock.Add(10 * time.Minute)
+
+		// then
+		Expect(history.Proxies()).To(Equal([]core_xds.ProxyId{otherProxyId}))
+		Expect(history.Records(proxyId)).To(BeEmpty())
+	})
+
+	It("should drop history of a proxy once retention period is over when another proxy connects", func() {
+		// given
+		history := NewSnapshotHistory(10, 5*time.Minute)
+		otherProxyId := core_xds.ProxyId{Mesh: "demo", Name: "web-01"}
+
+		// when
+		history.Record(proxyId, "1", nil, clustersSnapshot("v1", &envoy.Cluster{Name: "web"}))
+		history.Disconnected(proxyId)
+		// and
+		clock = clock.Add(10 * time.Minute)
+		// and
+		history.Record(otherProxyId, "1", nil, clustersSnapshot("v1", &envoy.Cluster{Name: "backend"}))
+
+		// then
+		Expect(history.(*snapshotHistory).proxies).To(HaveLen(1))
+		Expect(history.(*snapshotHistory).proxies).To(HaveKey(otherProxyId.String()))
+	})
+
+	It("should keep history of a proxy that has reconnected", func() {
+		// given
+		history := NewSnapshotHistory(10, 5*time.Minute)
+
+		// when
+		history.Record(proxyId, "1", nil, clustersSnapshot("v1", &envoy.Cluster{Name: "web"}))
+		history.Disconnected(proxyId)
+		// and
+		history.Record(proxyId, "1", nil, clustersSnapshot("v1", &envoy.Cluster{Name: "web"}))
+		// and
+		clock = clock.Add(10 * time.Minute)
+		history.Disconnected(core_xds.ProxyId{Mesh: "demo", Name: "web-01"})
+
+		// then
+		Expect(history.Records(proxyId)).To(HaveLen(1))
+	})
+})
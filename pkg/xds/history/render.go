@@ -0,0 +1,163 @@
+package history
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ghodss/yaml"
+	"github.com/pkg/errors"
+	"github.com/pmezard/go-difflib/difflib"
+
+	util_proto "github.com/Kong/kuma/pkg/util/proto"
+
+	envoy_cache "github.com/envoyproxy/go-control-plane/pkg/cache"
+)
+
+const LatestVersion = "latest"
+
+// Redacted replaces values of sensitive fields, e.g. private keys, in rendered resources.
+const Redacted = "[redacted]"
+
+// sensitiveFields are fields of xDS resources that must never be rendered, e.g. `TlsCertificate.private_key`.
+var sensitiveFields = map[string]bool{
+	"privateKey": true,
+	"password":   true,
+}
+
+// ToMap returns resources of a snapshot grouped by type and name in a form suitable for rendering as YAML or JSON.
+// Sensitive fields are redacted.
+func ToMap(snapshot envoy_cache.Snapshot) (map[string]map[string]interface{}, error) {
+	out := map[string]map[string]interface{}{}
+	for _, resources := range resourcesOf(snapshot) {
+		items := map[string]interface{}{}
+		for name, item := range resources.items {
+			obj, err := toRedactedMap(item)
+			if err != nil {
+				return nil, errors.Wrapf(err, "could not render %s %q", resources.typ, name)
+			}
+			items[name] = obj
+		}
+		out[resources.typ] = items
+	}
+	return out, nil
+}
+
+// Diff returns a unified diff of resources that have changed between two snapshots.
+// Resources are compared by type and name, if resourceName is not empty only resources with that name are compared.
+func Diff(from, to SnapshotRecord, resourceName string) (string, error) {
+	var buf strings.Builder
+	for _, change := range Changes(from.Snapshot, to.Snapshot) {
+		if resourceName != "" && change.Name != resourceName {
+			continue
+		}
+		fromLines, err := resourceLines(from.Snapshot, change.Type, change.Name)
+		if err != nil {
+			return "", err
+		}
+		toLines, err := resourceLines(to.Snapshot, change.Type, change.Name)
+		if err != nil {
+			return "", err
+		}
+		fromFile := fmt.Sprintf("v%d/%s/%s", from.Version, change.Type, change.Name)
+		if change.Change == ResourceAdded {
+			fromFile = "/dev/null"
+		}
+		toFile := fmt.Sprintf("v%d/%s/%s", to.Version, change.Type, change.Name)
+		if change.Change == ResourceRemoved {
+			toFile = "/dev/null"
+		}
+		diff, err := difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+			A:        fromLines,
+			B:        toLines,
+			FromFile: fromFile,
+			ToFile:   toFile,
+			Context:  3,
+		})
+		if err != nil {
+			return "", err
+		}
+		buf.WriteString(diff)
+	}
+	return buf.String(), nil
+}
+
+func resourceLines(snapshot envoy_cache.Snapshot, typ string, name string) ([]string, error) {
+	for _, resources := range resourcesOf(snapshot) {
+		if resources.typ != typ {
+			continue
+		}
+		item, ok := resources.items[name]
+		if !ok {
+			return nil, nil
+		}
+		obj, err := toRedactedMap(item)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not render %s %q", typ, name)
+		}
+		content, err := yaml.Marshal(obj)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not render %s %q", typ, name)
+		}
+		return difflib.SplitLines(strings.TrimSuffix(string(content), "\n")), nil
+	}
+	return nil, nil
+}
+
+func toRedactedMap(item envoy_cache.Resource) (map[string]interface{}, error) {
+	obj, err := util_proto.ToMap(item)
+	if err != nil {
+		return nil, err
+	}
+	redact(obj)
+	return obj, nil
+}
+
+// redact replaces values of sensitive fields at any depth of a rendered resource.
+func redact(obj interface{}) {
+	switch obj := obj.(type) {
+	case map[string]interface{}:
+		for key, value := range obj {
+			if sensitiveFields[key] {
+				obj[key] = Redacted
+				continue
+			}
+			redact(value)
+		}
+	case []interface{}:
+		for _, value := range obj {
+			redact(value)
+		}
+	}
+}
+
+// Select returns a snapshot identified by a selector, which is either a version,
+// "latest" or time in RFC3339 format that selects a snapshot that was in effect at that time.
+// Records are expected to be ordered from the oldest to the latest.
+func Select(records []SnapshotRecord, selector string) (SnapshotRecord, bool, error) {
+	if selector == LatestVersion {
+		if len(records) == 0 {
+			return SnapshotRecord{}, false, nil
+		}
+		return records[len(records)-1], true, nil
+	}
+	if version, err := strconv.Atoi(selector); err == nil {
+		for _, record := range records {
+			if record.Version == version {
+				return record, true, nil
+			}
+		}
+		return SnapshotRecord{}, false, nil
+	}
+	at, err := time.Parse(time.RFC3339, selector)
+	if err != nil {
+		return SnapshotRecord{}, false, errors.Errorf("snapshot must be selected either by a version, %q or time in RFC3339 format, got %q", LatestVersion, selector)
+	}
+	for i := len(records) - 1; i >= 0; i-- {
+		if !records[i].Time.After(at) {
+			return records[i], true, nil
+		}
+	}
+	return SnapshotRecord{}, false, nil
+}
@@ -0,0 +1,189 @@
+package history_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+
+	. "github.com/Kong/kuma/pkg/xds/history"
+
+	envoy "github.com/envoyproxy/go-control-plane/envoy/api/v2"
+	envoy_auth "github.com/envoyproxy/go-control-plane/envoy/api/v2/auth"
+	envoy_core "github.com/envoyproxy/go-control-plane/envoy/api/v2/core"
+	envoy_cache "github.com/envoyproxy/go-control-plane/pkg/cache"
+)
+
+var _ = Describe("ToMap()", func() {
+	It("should group resources by type and name", func() {
+		// given
+		snapshot := envoy_cache.Snapshot{
+			Clusters: envoy_cache.NewResources("v1", []envoy_cache.Resource{&envoy.Cluster{Name: "web", AltStatName: "web"}}),
+		}
+
+		// when
+		actual, err := ToMap(snapshot)
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(actual).To(Equal(map[string]map[string]interface{}{
+			"listeners": {},
+			"routes":    {},
+			"clusters": {
+				"web": map[string]interface{}{"name": "web", "altStatName": "web"},
+			},
+			"endpoints": {},
+			"secrets":   {},
+		}))
+	})
+
+	It("should redact private keys", func() {
+		// given
+		snapshot := envoy_cache.Snapshot{
+			Secrets: envoy_cache.NewResources("v1", []envoy_cache.Resource{&envoy_auth.Secret{
+				Name: "identity_cert",
+				Type: &envoy_auth.Secret_TlsCertificate{
+					TlsCertificate: &envoy_auth.TlsCertificate{
+						CertificateChain: &envoy_core.DataSource{
+							Specifier: &envoy_core.DataSource_InlineBytes{InlineBytes: []byte("CERT")},
+						},
+						PrivateKey: &envoy_core.DataSource{
+							Specifier: &envoy_core.DataSource_InlineBytes{InlineBytes: []byte("KEY")},
+						},
+					},
+				},
+			}}),
+		}
+
+		// when
+		actual, err := ToMap(snapshot)
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(actual["secrets"]).To(Equal(map[string]interface{}{
+			"identity_cert": map[string]interface{}{
+				"name": "identity_cert",
+				"tlsCertificate": map[string]interface{}{
+					"certificateChain": map[string]interface{}{"inlineBytes": "Q0VSVA=="},
+					"privateKey":       Redacted,
+				},
+			},
+		}))
+	})
+})
+
+var _ = Describe("Diff()", func() {
+
+	from := SnapshotRecord{
+		Version: 1,
+		Snapshot: envoy_cache.Snapshot{
+			Clusters: envoy_cache.NewResources("v1", []envoy_cache.Resource{
+				&envoy.Cluster{Name: "web", AltStatName: "web"},
+				&envoy.Cluster{Name: "db"},
+			}),
+		},
+	}
+	to := SnapshotRecord{
+		Version: 2,
+		Snapshot: envoy_cache.Snapshot{
+			Clusters: envoy_cache.NewResources("v2", []envoy_cache.Resource{
+				&envoy.Cluster{Name: "web", AltStatName: "frontend"},
+			}),
+			Listeners: envoy_cache.NewResources("v2", []envoy_cache.Resource{
+				&envoy.Listener{Name: "inbound"},
+			}),
+		},
+	}
+
+	It("should diff resources by name", func() {
+		// when
+		actual, err := Diff(from, to, "")
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(actual).To(Equal(`--- /dev/null
++++ v2/listeners/inbound
+@@ -0,0 +1 @@
++name: inbound
+--- v1/clusters/db
++++ /dev/null
+@@ -1 +0,0 @@
+-name: db
+--- v1/clusters/web
++++ v2/clusters/web
+@@ -1,2 +1,2 @@
+-altStatName: web
++altStatName: frontend
+ name: web
+`))
+	})
+
+	It("should diff only resources with a given name", func() {
+		// when
+		actual, err := Diff(from, to, "db")
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(actual).To(Equal(`--- v1/clusters/db
++++ /dev/null
+@@ -1 +0,0 @@
+-name: db
+`))
+	})
+
+	It("should return empty diff for the same snapshots", func() {
+		// when
+		actual, err := Diff(from, from, "")
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(actual).To(BeEmpty())
+	})
+})
+
+var _ = Describe("Select()", func() {
+
+	records := []SnapshotRecord{
+		{Version: 3, Time: time.Date(2020, 5, 1, 14, 0, 0, 0, time.UTC)},
+		{Version: 4, Time: time.Date(2020, 5, 1, 14, 5, 0, 0, time.UTC)},
+	}
+
+	DescribeTable("should select a snapshot",
+		func(selector string, expectedVersion int) {
+			// when
+			record, ok, err := Select(records, selector)
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			Expect(ok).To(BeTrue())
+			Expect(record.Version).To(Equal(expectedVersion))
+		},
+		Entry("by version", "3", 3),
+		Entry("latest", "latest", 4),
+		Entry("by exact time", "2020-05-01T14:05:00Z", 4),
+		Entry("by time in between", "2020-05-01T14:02:00Z", 3),
+		Entry("by time in another timezone", "2020-05-01T16:07:00+02:00", 4),
+	)
+
+	DescribeTable("should not find a snapshot",
+		func(selector string) {
+			// when
+			_, ok, err := Select(records, selector)
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			Expect(ok).To(BeFalse())
+		},
+		Entry("by unknown version", "1"),
+		Entry("by time before the oldest snapshot", "2020-05-01T13:59:59Z"),
+	)
+
+	It("should reject invalid selector", func() {
+		// when
+		_, _, err := Select(records, "yesterday")
+
+		// then
+		Expect(err).To(MatchError(`snapshot must be selected either by a version, "latest" or time in RFC3339 format, got "yesterday"`))
+	})
+})
@@ -0,0 +1,13 @@
+package rest_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestXdsHistoryRest(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Rest xDS History Suite")
+}
@@ -0,0 +1,212 @@
+package rest
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/emicklei/go-restful"
+	"github.com/ghodss/yaml"
+
+	"github.com/Kong/kuma/pkg/core"
+	core_xds "github.com/Kong/kuma/pkg/core/xds"
+	xds_history "github.com/Kong/kuma/pkg/xds/history"
+)
+
+var logger = core.Log.WithName("xds-history-ws")
+
+type historyWebservice struct {
+	history xds_history.SnapshotHistory
+}
+
+// NewWebservice exposes the history of xDS snapshots pushed to Dataplanes.
+//
+// Even though sensitive fields of xDS resources, e.g. private keys, are redacted, snapshots reveal
+// the whole configuration of a Dataplane, that is why the webservice is meant to be served by the Admin Server only.
+func NewWebservice(history xds_history.SnapshotHistory) *restful.WebService {
+	historyWs := historyWebservice{
+		history: history,
+	}
+	return historyWs.createWs()
+}
+
+func (h *historyWebservice) createWs() *restful.WebService {
+	ws := new(restful.WebService)
+	ws.Path("/snapshots")
+	ws.Route(ws.GET("").To(h.listProxies).
+		Doc("List Dataplanes that have snapshots in the history").
+		Produces("text/yaml").
+		Returns(200, "OK", nil))
+	ws.Route(ws.GET("/{mesh}/{name}").To(h.listSnapshots).
+		Doc("List snapshots of a Dataplane").
+		Param(ws.PathParameter("mesh", "Name of a mesh").DataType("string")).
+		Param(ws.PathParameter("name", "Name of a dataplane").DataType("string")).
+		Produces("text/yaml").
+		Returns(200, "OK", nil).
+		Returns(404, "Not found", nil))
+	ws.Route(ws.GET("/{mesh}/{name}/diff").To(h.diffSnapshots).
+		Doc("Diff two snapshots of a Dataplane by resource name").
+		Param(ws.PathParameter("mesh", "Name of a mesh").DataType("string")).
+		Param(ws.PathParameter("name", "Name of a dataplane").DataType("string")).
+		Param(ws.QueryParameter("from", "Version, \"latest\" or time in RFC3339 format").DataType("string")).
+		Param(ws.QueryParameter("to", "Version, \"latest\" or time in RFC3339 format").DataType("string")).
+		Param(ws.QueryParameter("resource", "Name of a resource").DataType("string")).
+		Produces("text/plain").
+		Returns(200, "OK", nil).
+		Returns(400, "Bad request", nil).
+		Returns(404, "Not found", nil))
+	ws.Route(ws.GET("/{mesh}/{name}/{snapshot}").To(h.getSnapshot).
+		Doc("Retrieve resources of a snapshot of a Dataplane").
+		Param(ws.PathParameter("mesh", "Name of a mesh").DataType("string")).
+		Param(ws.PathParameter("name", "Name of a dataplane").DataType("string")).
+		Param(ws.PathParameter("snapshot", "Version, \"latest\" or time in RFC3339 format").DataType("string")).
+		Produces("text/yaml").
+		Returns(200, "OK", nil).
+		Returns(400, "Bad request", nil).
+		Returns(404, "Not found", nil))
+	return ws
+}
+
+type proxySnapshots struct {
+	Mesh          string    `json:"mesh"`
+	Name          string    `json:"name"`
+	LatestVersion int       `json:"latestVersion"`
+	Time          time.Time `json:"time"`
+}
+
+type snapshotSummary struct {
+	Version          int                          `json:"version"`
+	Time             time.Time                    `json:"time"`
+	DataplaneVersion string                       `json:"dataplaneVersion"`
+	Causes           []xds_history.ResourceChange `json:"causes"`
+	Changes          []xds_history.ResourceChange `json:"changes"`
+}
+
+type snapshotView struct {
+	Mesh             string                            `json:"mesh"`
+	Name             string                            `json:"name"`
+	Version          int                               `json:"version"`
+	Time             time.Time                         `json:"time"`
+	DataplaneVersion string                            `json:"dataplaneVersion"`
+	Resources        map[string]map[string]interface{} `json:"resources"`
+}
+
+func (h *historyWebservice) listProxies(_ *restful.Request, response *restful.Response) {
+	proxies := []proxySnapshots{}
+	for _, proxyId := range h.history.Proxies() {
+		record, ok, _ := xds_history.Select(h.history.Records(proxyId), xds_history.LatestVersion)
+		if !ok {
+			continue
+		}
+		proxies = append(proxies, proxySnapshots{Mesh: proxyId.Mesh, Name: proxyId.Name, LatestVersion: record.Version, Time: record.Time})
+	}
+	writeYAML(response, proxies)
+}
+
+func (h *historyWebservice) listSnapshots(request *restful.Request, response *restful.Response) {
+	_, records, ok := h.records(request, response)
+	if !ok {
+		return
+	}
+	summaries := []snapshotSummary{}
+	for _, record := range records {
+		summaries = append(summaries, snapshotSummary{
+			Version:          record.Version,
+			Time:             record.Time,
+			DataplaneVersion: record.DataplaneVersion,
+			Causes:           record.Causes,
+			Changes:          record.Changes,
+		})
+	}
+	writeYAML(response, summaries)
+}
+
+func (h *historyWebservice) diffSnapshots(request *restful.Request, response *restful.Response) {
+	proxyId, records, ok := h.records(request, response)
+	if !ok {
+		return
+	}
+	from, ok := selectSnapshot(response, records, request.QueryParameter("from"))
+	if !ok {
+		return
+	}
+	to, ok := selectSnapshot(response, records, request.QueryParameter("to"))
+	if !ok {
+		return
+	}
+	diff, err := xds_history.Diff(from, to, request.QueryParameter("resource"))
+	if err != nil {
+		logger.Error(err, "failed to diff snapshots", "proxy", proxyId, "from", from.Version, "to", to.Version)
+		http.Error(response, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	response.Header().Set(restful.HEADER_ContentType, "text/plain")
+	if _, err := response.Write([]byte(diff)); err != nil {
+		logger.Error(err, "could not write the response")
+	}
+}
+
+func (h *historyWebservice) getSnapshot(request *restful.Request, response *restful.Response) {
+	proxyId, records, ok := h.records(request, response)
+	if !ok {
+		return
+	}
+	record, ok := selectSnapshot(response, records, request.PathParameter("snapshot"))
+	if !ok {
+		return
+	}
+	resources, err := xds_history.ToMap(record.Snapshot)
+	if err != nil {
+		logger.Error(err, "failed to render a snapshot", "proxy", proxyId, "version", record.Version)
+		http.Error(response, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	writeYAML(response, snapshotView{
+		Mesh:             proxyId.Mesh,
+		Name:             proxyId.Name,
+		Version:          record.Version,
+		Time:             record.Time,
+		DataplaneVersion: record.DataplaneVersion,
+		Resources:        resources,
+	})
+}
+
+func (h *historyWebservice) records(request *restful.Request, response *restful.Response) (core_xds.ProxyId, []xds_history.SnapshotRecord, bool) {
+	proxyId := core_xds.ProxyId{Mesh: request.PathParameter("mesh"), Name: request.PathParameter("name")}
+	records := h.history.Records(proxyId)
+	if len(records) == 0 {
+		http.Error(response, fmt.Sprintf("there are no snapshots of a proxy %q in the history", proxyId.String()), http.StatusNotFound)
+		return proxyId, nil, false
+	}
+	return proxyId, records, true
+}
+
+func selectSnapshot(response *restful.Response, records []xds_history.SnapshotRecord, selector string) (xds_history.SnapshotRecord, bool) {
+	if selector == "" {
+		http.Error(response, "snapshot must be selected either by a version, \"latest\" or time in RFC3339 format", http.StatusBadRequest)
+		return xds_history.SnapshotRecord{}, false
+	}
+	record, ok, err := xds_history.Select(records, selector)
+	if err != nil {
+		http.Error(response, err.Error(), http.StatusBadRequest)
+		return xds_history.SnapshotRecord{}, false
+	}
+	if !ok {
+		http.Error(response, fmt.Sprintf("snapshot %q is not in the history", selector), http.StatusNotFound)
+		return xds_history.SnapshotRecord{}, false
+	}
+	return record, true
+}
+
+func writeYAML(response *restful.Response, obj interface{}) {
+	content, err := yaml.Marshal(obj)
+	if err != nil {
+		logger.Error(err, "failed to render a response")
+		http.Error(response, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	response.Header().Set(restful.HEADER_ContentType, "text/yaml")
+	if _, err := response.Write(content); err != nil {
+		logger.Error(err, "could not write the response")
+	}
+}
@@ -0,0 +1,119 @@
+package rest_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	"github.com/emicklei/go-restful"
+	envoy "github.com/envoyproxy/go-control-plane/envoy/api/v2"
+	envoy_cache "github.com/envoyproxy/go-control-plane/pkg/cache"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+
+	core_xds "github.com/Kong/kuma/pkg/core/xds"
+	xds_history "github.com/Kong/kuma/pkg/xds/history"
+	"github.com/Kong/kuma/pkg/xds/history/rest"
+)
+
+var _ = Describe("xDS History WS", func() {
+
+	var container *restful.Container
+
+	BeforeEach(func() {
+		history := xds_history.NewSnapshotHistory(10, time.Hour)
+		proxyId := core_xds.ProxyId{Mesh: "demo", Name: "backend-01"}
+		route := xds_history.InputKey{Type: "TrafficRoute", Name: "route-1"}
+		history.Record(proxyId, "1", xds_history.Inputs{route: "1"}, envoy_cache.Snapshot{
+			Clusters: envoy_cache.NewResources("v1", []envoy_cache.Resource{&envoy.Cluster{Name: "web"}}),
+		})
+		history.Record(proxyId, "2", xds_history.Inputs{route: "2"}, envoy_cache.Snapshot{
+			Clusters: envoy_cache.NewResources("v2", []envoy_cache.Resource{&envoy.Cluster{Name: "web", AltStatName: "web"}}),
+		})
+		container = restful.NewContainer()
+		container.Add(rest.NewWebservice(history))
+	})
+
+	get := func(url string) *httptest.ResponseRecorder {
+		recorder := httptest.NewRecorder()
+		container.ServeHTTP(recorder, httptest.NewRequest("GET", url, nil))
+		return recorder
+	}
+
+	It("should list proxies with snapshots", func() {
+		// when
+		resp := get("/snapshots")
+
+		// then
+		Expect(resp.Code).To(Equal(http.StatusOK))
+		Expect(resp.Body.String()).To(ContainSubstring("latestVersion: 2"))
+		Expect(resp.Body.String()).To(ContainSubstring("name: backend-01"))
+	})
+
+	It("should list snapshots of a proxy", func() {
+		// when
+		resp := get("/snapshots/demo/backend-01")
+
+		// then
+		Expect(resp.Code).To(Equal(http.StatusOK))
+		Expect(resp.Body.String()).To(ContainSubstring("version: 1"))
+		Expect(resp.Body.String()).To(ContainSubstring("version: 2"))
+		Expect(resp.Body.String()).To(ContainSubstring(`- causes:
+  - change: modified
+    name: route-1
+    type: TrafficRoute
+  changes:
+  - change: modified
+    name: web
+    type: clusters
+`))
+	})
+
+	It("should render a snapshot as YAML", func() {
+		// when
+		resp := get("/snapshots/demo/backend-01/latest")
+
+		// then
+		Expect(resp.Code).To(Equal(http.StatusOK))
+		Expect(resp.Header().Get("Content-Type")).To(Equal("text/yaml"))
+		Expect(resp.Body.String()).To(ContainSubstring(`dataplaneVersion: "2"`))
+		Expect(resp.Body.String()).To(ContainSubstring(`
+resources:
+  clusters:
+    web:
+      altStatName: web
+      name: web
+`))
+	})
+
+	It("should diff two snapshots", func() {
+		// when
+		resp := get("/snapshots/demo/backend-01/diff?from=1&to=latest")
+
+		// then
+		Expect(resp.Code).To(Equal(http.StatusOK))
+		Expect(resp.Body.String()).To(Equal(`--- v1/clusters/web
++++ v2/clusters/web
+@@ -1 +1,2 @@
++altStatName: web
+ name: web
+`))
+	})
+
+	DescribeTable("should reject invalid requests",
+		func(url string, expectedCode int, expectedBody string) {
+			// when
+			resp := get(url)
+
+			// then
+			Expect(resp.Code).To(Equal(expectedCode))
+			Expect(resp.Body.String()).To(Equal(expectedBody))
+		},
+		Entry("unknown proxy", "/snapshots/demo/web-01/latest", http.StatusNotFound, "there are no snapshots of a proxy \"demo.web-01\" in the history\n"),
+		Entry("unknown version", "/snapshots/demo/backend-01/7", http.StatusNotFound, "snapshot \"7\" is not in the history\n"),
+		Entry("invalid selector", "/snapshots/demo/backend-01/yesterday", http.StatusBadRequest, "snapshot must be selected either by a version, \"latest\" or time in RFC3339 format, got \"yesterday\"\n"),
+		Entry("diff without from", "/snapshots/demo/backend-01/diff?to=2", http.StatusBadRequest, "snapshot must be selected either by a version, \"latest\" or time in RFC3339 format\n"),
+		Entry("unknown path", "/snapshots/demo", http.StatusNotFound, "404: Page Not Found"),
+	)
+})
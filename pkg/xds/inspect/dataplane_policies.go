@@ -0,0 +1,190 @@
+// Package inspect explains which policies are selected for a dataplane and which dataplanes a policy is selected for.
+package inspect
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	mesh_proto "github.com/Kong/kuma/api/mesh/v1alpha1"
+	"github.com/Kong/kuma/pkg/api-server/types"
+	"github.com/Kong/kuma/pkg/core/faultinjections"
+	"github.com/Kong/kuma/pkg/core/policy"
+	mesh_core "github.com/Kong/kuma/pkg/core/resources/apis/mesh"
+	core_manager "github.com/Kong/kuma/pkg/core/resources/manager"
+	core_store "github.com/Kong/kuma/pkg/core/resources/store"
+	xds_topology "github.com/Kong/kuma/pkg/xds/topology"
+)
+
+// meshPolicies holds all policies of a mesh that can be selected for a dataplane.
+type meshPolicies struct {
+	permissions     []policy.ConnectionPolicy
+	logs            []policy.ConnectionPolicy
+	healthChecks    []policy.ConnectionPolicy
+	routes          []*mesh_core.TrafficRouteResource
+	faultInjections []*mesh_core.FaultInjectionResource
+	traces          []policy.DataplanePolicy
+	proxyTemplates  []policy.DataplanePolicy
+}
+
+func listMeshPolicies(ctx context.Context, manager core_manager.ReadOnlyResourceManager, mesh string) (*meshPolicies, error) {
+	policies := &meshPolicies{}
+
+	routes := &mesh_core.TrafficRouteResourceList{}
+	if err := manager.List(ctx, routes, core_store.ListByMesh(mesh)); err != nil {
+		return nil, errors.Wrap(err, "could not retrieve traffic routes")
+	}
+	policies.routes = routes.Items
+
+	permissions := &mesh_core.TrafficPermissionResourceList{}
+	if err := manager.List(ctx, permissions, core_store.ListByMesh(mesh)); err != nil {
+		return nil, errors.Wrap(err, "could not retrieve traffic permissions")
+	}
+	for _, permission := range permissions.Items {
+		policies.permissions = append(policies.permissions, permission)
+	}
+
+	logs := &mesh_core.TrafficLogResourceList{}
+	if err := manager.List(ctx, logs, core_store.ListByMesh(mesh)); err != nil {
+		return nil, errors.Wrap(err, "could not retrieve traffic logs")
+	}
+	for _, log := range logs.Items {
+		policies.logs = append(policies.logs, log)
+	}
+
+	healthChecks := &mesh_core.HealthCheckResourceList{}
+	if err := manager.List(ctx, healthChecks, core_store.ListByMesh(mesh)); err != nil {
+		return nil, errors.Wrap(err, "could not retrieve health checks")
+	}
+	for _, healthCheck := range healthChecks.Items {
+		policies.healthChecks = append(policies.healthChecks, healthCheck)
+	}
+
+	faultInjections := &mesh_core.FaultInjectionResourceList{}
+	if err := manager.List(ctx, faultInjections, core_store.ListByMesh(mesh)); err != nil {
+		return nil, errors.Wrap(err, "could not retrieve fault injections")
+	}
+	policies.faultInjections = faultInjections.Items
+
+	traces := &mesh_core.TrafficTraceResourceList{}
+	if err := manager.List(ctx, traces, core_store.ListByMesh(mesh)); err != nil {
+		return nil, errors.Wrap(err, "could not retrieve traffic traces")
+	}
+	for _, trace := range traces.Items {
+		policies.traces = append(policies.traces, trace)
+	}
+
+	proxyTemplates := &mesh_core.ProxyTemplateResourceList{}
+	if err := manager.List(ctx, proxyTemplates, core_store.ListByMesh(mesh)); err != nil {
+		return nil, errors.Wrap(err, "could not retrieve proxy templates")
+	}
+	for _, proxyTemplate := range proxyTemplates.Items {
+		policies.proxyTemplates = append(policies.proxyTemplates, proxyTemplate)
+	}
+	return policies, nil
+}
+
+// DataplanePolicies selects policies for every interface of a given Dataplane
+// the same way they are selected to generate Envoy configuration.
+func DataplanePolicies(ctx context.Context, manager core_manager.ReadOnlyResourceManager, dataplane *mesh_core.DataplaneResource) (*types.DataplanePolicies, error) {
+	policies, err := listMeshPolicies(ctx, manager, dataplane.GetMeta().GetMesh())
+	if err != nil {
+		return nil, err
+	}
+	return policies.selectFor(dataplane)
+}
+
+func (p *meshPolicies) selectFor(dataplane *mesh_core.DataplaneResource) (*types.DataplanePolicies, error) {
+	result := &types.DataplanePolicies{
+		Mesh:     dataplane.GetMeta().GetMesh(),
+		Name:     dataplane.GetMeta().GetName(),
+		Inbound:  []types.InboundPolicies{},
+		Outbound: []types.OutboundPolicies{},
+	}
+
+	inboundPermissions, err := policy.SelectRankedInboundConnectionPolicies(dataplane, p.permissions)
+	if err != nil {
+		return nil, err
+	}
+	inboundLogs, err := policy.SelectRankedInboundConnectionPolicies(dataplane, p.logs)
+	if err != nil {
+		return nil, err
+	}
+	inboundFaultInjections, err := policy.SelectRankedInboundConnectionPolicies(dataplane, faultinjections.DestinationSidePolicies(p.faultInjections))
+	if err != nil {
+		return nil, err
+	}
+	ifaces, err := dataplane.Spec.GetNetworking().GetInboundInterfaces()
+	if err != nil {
+		return nil, err
+	}
+	for i, inbound := range dataplane.Spec.GetNetworking().GetInbound() {
+		iface := ifaces[i]
+		result.Inbound = append(result.Inbound, types.InboundPolicies{
+			Interface:         iface.String(),
+			Service:           inbound.GetService(),
+			TrafficPermission: inboundMatch(inboundPermissions, iface),
+			TrafficLog:        inboundMatch(inboundLogs, iface),
+			FaultInjection:    inboundMatch(inboundFaultInjections, iface),
+		})
+	}
+
+	routes := make([]policy.ConnectionPolicy, len(p.routes))
+	for i, route := range p.routes {
+		routes[i] = route
+	}
+	outboundRoutes := policy.SelectRankedOutboundConnectionPolicies(dataplane, routes)
+	outboundLogs := policy.SelectRankedOutboundConnectionPolicies(dataplane, p.logs)
+	outboundFaultInjections := policy.SelectRankedOutboundConnectionPolicies(dataplane, faultinjections.SourceSidePolicies(p.faultInjections))
+	// health checks are selected for services reachable via routes rather than for outbound interfaces
+	destinations := xds_topology.BuildDestinationMap(dataplane, xds_topology.BuildRouteMap(dataplane, p.routes))
+	healthChecks := policy.SelectRankedConnectionPolicies(dataplane, policy.ToServicesOf(destinations), p.healthChecks)
+	ofaces, err := dataplane.Spec.GetNetworking().GetOutboundInterfaces()
+	if err != nil {
+		return nil, err
+	}
+	for i, outbound := range dataplane.Spec.GetNetworking().GetOutbound() {
+		result.Outbound = append(result.Outbound, types.OutboundPolicies{
+			Interface:      ofaces[i].String(),
+			Service:        outbound.Service,
+			TrafficRoute:   outboundMatch(outboundRoutes, outbound.Service),
+			TrafficLog:     outboundMatch(outboundLogs, outbound.Service),
+			HealthCheck:    outboundMatch(healthChecks, outbound.Service),
+			FaultInjection: outboundMatch(outboundFaultInjections, outbound.Service),
+		})
+	}
+
+	if trace, rank := policy.SelectRankedDataplanePolicy(dataplane, p.traces); trace != nil {
+		result.TrafficTrace = newPolicyMatch(trace.GetMeta().GetName(), rank)
+	}
+	if proxyTemplate, rank := policy.SelectRankedDataplanePolicy(dataplane, p.proxyTemplates); proxyTemplate != nil {
+		result.ProxyTemplate = newPolicyMatch(proxyTemplate.GetMeta().GetName(), rank)
+	}
+	return result, nil
+}
+
+func inboundMatch(policies policy.InboundRankedConnectionPolicyMap, iface mesh_proto.InboundInterface) *types.PolicyMatch {
+	ranked, ok := policies[iface]
+	if !ok {
+		return nil
+	}
+	return newPolicyMatch(ranked.Policy.GetMeta().GetName(), ranked.Rank)
+}
+
+func outboundMatch(policies policy.OutboundRankedConnectionPolicyMap, service string) *types.PolicyMatch {
+	ranked, ok := policies[service]
+	if !ok {
+		return nil
+	}
+	return newPolicyMatch(ranked.Policy.GetMeta().GetName(), ranked.Rank)
+}
+
+func newPolicyMatch(name string, rank mesh_proto.TagSelectorRank) *types.PolicyMatch {
+	return &types.PolicyMatch{
+		Name: name,
+		Rank: types.PolicyRank{
+			ExactMatches:    rank.ExactMatches,
+			WildcardMatches: rank.WildcardMatches,
+		},
+	}
+}
@@ -0,0 +1,143 @@
+package inspect_test
+
+import (
+	"context"
+	"encoding/json"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	mesh_core "github.com/Kong/kuma/pkg/core/resources/apis/mesh"
+	core_manager "github.com/Kong/kuma/pkg/core/resources/manager"
+	core_model "github.com/Kong/kuma/pkg/core/resources/model"
+	core_store "github.com/Kong/kuma/pkg/core/resources/store"
+	plugins_memory "github.com/Kong/kuma/pkg/plugins/resources/memory"
+	util_proto "github.com/Kong/kuma/pkg/util/proto"
+	"github.com/Kong/kuma/pkg/xds/inspect"
+)
+
+var _ = Describe("DataplanePolicies()", func() {
+
+	var store core_store.ResourceStore
+
+	create := func(resource core_model.Resource, name string, spec string) {
+		Expect(util_proto.FromYAML([]byte(spec), resource.GetSpec())).To(Succeed())
+		Expect(store.Create(context.Background(), resource, core_store.CreateByKey(name, "demo"))).To(Succeed())
+	}
+
+	BeforeEach(func() {
+		store = plugins_memory.NewStore()
+
+		create(&mesh_core.TrafficPermissionResource{}, "allow-all", `
+        sources:
+        - match:
+            service: '*'
+        destinations:
+        - match:
+            service: '*'`)
+		create(&mesh_core.TrafficPermissionResource{}, "allow-web", `
+        sources:
+        - match:
+            service: web
+        destinations:
+        - match:
+            service: backend
+            version: v1`)
+		create(&mesh_core.TrafficRouteResource{}, "route-db", `
+        sources:
+        - match:
+            service: '*'
+        destinations:
+        - match:
+            service: db
+        conf:
+        - weight: 100
+          destination:
+            service: db`)
+		create(&mesh_core.HealthCheckResource{}, "db-health", `
+        sources:
+        - match:
+            service: backend
+        destinations:
+        - match:
+            service: db
+        conf:
+          activeChecks:
+            interval: 10s
+            timeout: 2s
+            unhealthyThreshold: 3
+            healthyThreshold: 1`)
+		create(&mesh_core.FaultInjectionResource{}, "slow-db", `
+        sources:
+        - match:
+            service: backend
+        destinations:
+        - match:
+            service: db
+            protocol: tcp
+        conf:
+          side: source
+          delay:
+            percentage: 50
+            value: 1s`)
+		create(&mesh_core.TrafficTraceResource{}, "trace-backend", `
+        selectors:
+        - match:
+            service: backend`)
+	})
+
+	It("should list selected policies with their ranks", func() {
+		// given
+		dataplane := &mesh_core.DataplaneResource{}
+		create(dataplane, "backend-01", `
+        networking:
+          address: 192.168.0.1
+          inbound:
+          - port: 80
+            servicePort: 8080
+            tags:
+              service: backend
+              version: v1
+          outbound:
+          - port: 54321
+            service: db
+          - port: 59200
+            service: elastic`)
+
+		// when
+		policies, err := inspect.DataplanePolicies(context.Background(), core_manager.NewResourceManager(store), dataplane)
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		// when
+		actual, err := json.Marshal(policies)
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(actual).To(MatchJSON(`
+        {
+          "mesh": "demo",
+          "name": "backend-01",
+          "inbound": [
+            {
+              "interface": "192.168.0.1:80:8080",
+              "service": "backend",
+              "trafficPermission": {"name": "allow-web", "rank": {"exactMatches": 2, "wildcardMatches": 0}}
+            }
+          ],
+          "outbound": [
+            {
+              "interface": "127.0.0.1:54321",
+              "service": "db",
+              "trafficRoute": {"name": "route-db", "rank": {"exactMatches": 1, "wildcardMatches": 1}},
+              "healthCheck": {"name": "db-health", "rank": {"exactMatches": 2, "wildcardMatches": 0}},
+              "faultInjection": {"name": "slow-db", "rank": {"exactMatches": 2, "wildcardMatches": 0}}
+            },
+            {
+              "interface": "127.0.0.1:59200",
+              "service": "elastic"
+            }
+          ],
+          "trafficTrace": {"name": "trace-backend", "rank": {"exactMatches": 1, "wildcardMatches": 0}}
+        }`))
+	})
+})
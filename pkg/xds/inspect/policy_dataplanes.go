@@ -0,0 +1,205 @@
+package inspect
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/Kong/kuma/pkg/api-server/types"
+	"github.com/Kong/kuma/pkg/core/policy"
+	mesh_core "github.com/Kong/kuma/pkg/core/resources/apis/mesh"
+	core_manager "github.com/Kong/kuma/pkg/core/resources/manager"
+	core_model "github.com/Kong/kuma/pkg/core/resources/model"
+	core_store "github.com/Kong/kuma/pkg/core/resources/store"
+)
+
+// PolicyTypes lists types of policies that can be looked up by PolicyDataplanes.
+var PolicyTypes = []core_model.ResourceType{
+	mesh_core.TrafficPermissionType,
+	mesh_core.TrafficLogType,
+	mesh_core.TrafficRouteType,
+	mesh_core.HealthCheckType,
+	mesh_core.FaultInjectionType,
+	mesh_core.TrafficTraceType,
+	mesh_core.ProxyTemplateType,
+}
+
+// IsPolicyType returns true if policies of a given type can be looked up by PolicyDataplanes.
+func IsPolicyType(resourceType core_model.ResourceType) bool {
+	for _, policyType := range PolicyTypes {
+		if policyType == resourceType {
+			return true
+		}
+	}
+	return false
+}
+
+// PolicyDataplanes evaluates a given policy against every Dataplane in a mesh.
+//
+// A policy is winning on an interface of a Dataplane if it is the one selected to generate
+// Envoy configuration. A policy is shadowed if it matches an interface but another, more specific
+// policy of the same type is selected instead.
+func PolicyDataplanes(ctx context.Context, manager core_manager.ReadOnlyResourceManager, resourceType core_model.ResourceType, mesh string, name string) (*types.PolicyDataplanes, error) {
+	if !IsPolicyType(resourceType) {
+		return nil, errors.Errorf("policies of type %q cannot be inspected", resourceType)
+	}
+	policies, err := listMeshPolicies(ctx, manager, mesh)
+	if err != nil {
+		return nil, err
+	}
+	candidate, ok := policies.only(resourceType, name)
+	if !ok {
+		return nil, core_store.ErrorResourceNotFound(resourceType, name, mesh)
+	}
+	dataplanes := &mesh_core.DataplaneResourceList{}
+	if err := manager.List(ctx, dataplanes, core_store.ListByMesh(mesh)); err != nil {
+		return nil, errors.Wrap(err, "could not retrieve dataplanes")
+	}
+
+	result := &types.PolicyDataplanes{
+		Mesh:     mesh,
+		Type:     string(resourceType),
+		Name:     name,
+		Winning:  []types.PolicyAttachment{},
+		Shadowed: []types.PolicyAttachment{},
+	}
+	for _, dataplane := range dataplanes.Items {
+		selected, err := policies.selectFor(dataplane)
+		if err != nil {
+			return nil, err
+		}
+		matched, err := candidate.selectFor(dataplane)
+		if err != nil {
+			return nil, err
+		}
+		// both selections are made for the same Dataplane, so interfaces come in the same order
+		winners := attachmentsOf(selected, resourceType)
+		for i, match := range attachmentsOf(matched, resourceType) {
+			if match.match == nil {
+				continue
+			}
+			attachment := types.PolicyAttachment{
+				Dataplane: dataplane.GetMeta().GetName(),
+				Direction: match.direction,
+				Interface: match.iface,
+				Service:   match.service,
+				Rank:      match.match.Rank,
+			}
+			if winner := winners[i].match; winner != nil && winner.Name != name {
+				attachment.ShadowedBy = winner
+				result.Shadowed = append(result.Shadowed, attachment)
+			} else {
+				result.Winning = append(result.Winning, attachment)
+			}
+		}
+	}
+	return result, nil
+}
+
+// only returns a copy of mesh policies where policies of a given type are narrowed down to a single policy.
+// Policies of other types are kept since selection of some policies depends on others,
+// e.g. HealthChecks are selected for destinations of TrafficRoutes.
+func (p *meshPolicies) only(resourceType core_model.ResourceType, name string) (*meshPolicies, bool) {
+	candidate := *p
+	switch resourceType {
+	case mesh_core.TrafficPermissionType:
+		candidate.permissions = connectionPolicyNamed(p.permissions, name)
+		return &candidate, len(candidate.permissions) > 0
+	case mesh_core.TrafficLogType:
+		candidate.logs = connectionPolicyNamed(p.logs, name)
+		return &candidate, len(candidate.logs) > 0
+	case mesh_core.HealthCheckType:
+		candidate.healthChecks = connectionPolicyNamed(p.healthChecks, name)
+		return &candidate, len(candidate.healthChecks) > 0
+	case mesh_core.TrafficRouteType:
+		candidate.routes = nil
+		for _, route := range p.routes {
+			if route.GetMeta().GetName() == name {
+				candidate.routes = append(candidate.routes, route)
+			}
+		}
+		return &candidate, len(candidate.routes) > 0
+	case mesh_core.FaultInjectionType:
+		candidate.faultInjections = nil
+		for _, faultInjection := range p.faultInjections {
+			if faultInjection.GetMeta().GetName() == name {
+				candidate.faultInjections = append(candidate.faultInjections, faultInjection)
+			}
+		}
+		return &candidate, len(candidate.faultInjections) > 0
+	case mesh_core.TrafficTraceType:
+		candidate.traces = dataplanePolicyNamed(p.traces, name)
+		return &candidate, len(candidate.traces) > 0
+	case mesh_core.ProxyTemplateType:
+		candidate.proxyTemplates = dataplanePolicyNamed(p.proxyTemplates, name)
+		return &candidate, len(candidate.proxyTemplates) > 0
+	}
+	return nil, false
+}
+
+func connectionPolicyNamed(policies []policy.ConnectionPolicy, name string) []policy.ConnectionPolicy {
+	for _, p := range policies {
+		if p.GetMeta().GetName() == name {
+			return []policy.ConnectionPolicy{p}
+		}
+	}
+	return nil
+}
+
+func dataplanePolicyNamed(policies []policy.DataplanePolicy, name string) []policy.DataplanePolicy {
+	for _, p := range policies {
+		if p.GetMeta().GetName() == name {
+			return []policy.DataplanePolicy{p}
+		}
+	}
+	return nil
+}
+
+// attachment is a place in a Dataplane where a policy of a given type can be selected.
+type attachment struct {
+	direction string
+	iface     string
+	service   string
+	match     *types.PolicyMatch
+}
+
+func attachmentsOf(policies *types.DataplanePolicies, resourceType core_model.ResourceType) []attachment {
+	var attachments []attachment
+	for _, inbound := range policies.Inbound {
+		var match *types.PolicyMatch
+		switch resourceType {
+		case mesh_core.TrafficPermissionType:
+			match = inbound.TrafficPermission
+		case mesh_core.TrafficLogType:
+			match = inbound.TrafficLog
+		case mesh_core.FaultInjectionType:
+			match = inbound.FaultInjection
+		default:
+			continue
+		}
+		attachments = append(attachments, attachment{direction: types.InboundDirection, iface: inbound.Interface, service: inbound.Service, match: match})
+	}
+	for _, outbound := range policies.Outbound {
+		var match *types.PolicyMatch
+		switch resourceType {
+		case mesh_core.TrafficRouteType:
+			match = outbound.TrafficRoute
+		case mesh_core.TrafficLogType:
+			match = outbound.TrafficLog
+		case mesh_core.HealthCheckType:
+			match = outbound.HealthCheck
+		case mesh_core.FaultInjectionType:
+			match = outbound.FaultInjection
+		default:
+			continue
+		}
+		attachments = append(attachments, attachment{direction: types.OutboundDirection, iface: outbound.Interface, service: outbound.Service, match: match})
+	}
+	switch resourceType {
+	case mesh_core.TrafficTraceType:
+		attachments = append(attachments, attachment{match: policies.TrafficTrace})
+	case mesh_core.ProxyTemplateType:
+		attachments = append(attachments, attachment{match: policies.ProxyTemplate})
+	}
+	return attachments
+}
@@ -0,0 +1,236 @@
+package inspect_test
+
+import (
+	"context"
+	"encoding/json"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+
+	mesh_core "github.com/Kong/kuma/pkg/core/resources/apis/mesh"
+	core_manager "github.com/Kong/kuma/pkg/core/resources/manager"
+	core_model "github.com/Kong/kuma/pkg/core/resources/model"
+	core_store "github.com/Kong/kuma/pkg/core/resources/store"
+	plugins_memory "github.com/Kong/kuma/pkg/plugins/resources/memory"
+	util_proto "github.com/Kong/kuma/pkg/util/proto"
+	"github.com/Kong/kuma/pkg/xds/inspect"
+)
+
+var _ = Describe("PolicyDataplanes()", func() {
+
+	var store core_store.ResourceStore
+
+	create := func(resource core_model.Resource, name string, spec string) {
+		Expect(util_proto.FromYAML([]byte(spec), resource.GetSpec())).To(Succeed())
+		Expect(store.Create(context.Background(), resource, core_store.CreateByKey(name, "demo"))).To(Succeed())
+	}
+
+	BeforeEach(func() {
+		store = plugins_memory.NewStore()
+
+		create(&mesh_core.TrafficPermissionResource{}, "allow-all", `
+        sources:
+        - match:
+            service: '*'
+        destinations:
+        - match:
+            service: '*'`)
+		create(&mesh_core.TrafficPermissionResource{}, "allow-web", `
+        sources:
+        - match:
+            service: web
+        destinations:
+        - match:
+            service: backend
+            version: v1`)
+		create(&mesh_core.TrafficRouteResource{}, "route-db", `
+        sources:
+        - match:
+            service: '*'
+        destinations:
+        - match:
+            service: db
+        conf:
+        - weight: 100
+          destination:
+            service: db`)
+		create(&mesh_core.HealthCheckResource{}, "db-health", `
+        sources:
+        - match:
+            service: '*'
+        destinations:
+        - match:
+            service: db
+        conf:
+          activeChecks:
+            interval: 10s
+            timeout: 2s
+            unhealthyThreshold: 3
+            healthyThreshold: 1`)
+		create(&mesh_core.TrafficTraceResource{}, "trace-all", `
+        selectors:
+        - match:
+            service: '*'`)
+
+		create(&mesh_core.DataplaneResource{}, "backend-01", `
+        networking:
+          address: 192.168.0.1
+          inbound:
+          - port: 80
+            servicePort: 8080
+            tags:
+              service: backend
+              version: v1
+          outbound:
+          - port: 54321
+            service: db`)
+		create(&mesh_core.DataplaneResource{}, "web-01", `
+        networking:
+          address: 192.168.0.2
+          inbound:
+          - port: 80
+            servicePort: 8080
+            tags:
+              service: web
+          outbound:
+          - port: 54321
+            service: db
+          - port: 54322
+            service: backend`)
+	})
+
+	type testCase struct {
+		resourceType core_model.ResourceType
+		name         string
+		expected     string
+	}
+
+	DescribeTable("should list dataplanes where a policy is winning and where it is shadowed",
+		func(given testCase) {
+			// when
+			dataplanes, err := inspect.PolicyDataplanes(context.Background(), core_manager.NewResourceManager(store), given.resourceType, "demo", given.name)
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			// when
+			actual, err := json.Marshal(dataplanes)
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			Expect(actual).To(MatchJSON(given.expected))
+		},
+		Entry("TrafficPermission shadowed by a more specific one", testCase{
+			resourceType: mesh_core.TrafficPermissionType,
+			name:         "allow-all",
+			expected: `
+            {
+              "mesh": "demo",
+              "type": "TrafficPermission",
+              "name": "allow-all",
+              "winning": [
+                {
+                  "dataplane": "web-01",
+                  "direction": "inbound",
+                  "interface": "192.168.0.2:80:8080",
+                  "service": "web",
+                  "rank": {"exactMatches": 0, "wildcardMatches": 1}
+                }
+              ],
+              "shadowed": [
+                {
+                  "dataplane": "backend-01",
+                  "direction": "inbound",
+                  "interface": "192.168.0.1:80:8080",
+                  "service": "backend",
+                  "rank": {"exactMatches": 0, "wildcardMatches": 1},
+                  "shadowedBy": {"name": "allow-web", "rank": {"exactMatches": 2, "wildcardMatches": 0}}
+                }
+              ]
+            }`,
+		}),
+		Entry("TrafficPermission that is more specific", testCase{
+			resourceType: mesh_core.TrafficPermissionType,
+			name:         "allow-web",
+			expected: `
+            {
+              "mesh": "demo",
+              "type": "TrafficPermission",
+              "name": "allow-web",
+              "winning": [
+                {
+                  "dataplane": "backend-01",
+                  "direction": "inbound",
+                  "interface": "192.168.0.1:80:8080",
+                  "service": "backend",
+                  "rank": {"exactMatches": 2, "wildcardMatches": 0}
+                }
+              ],
+              "shadowed": []
+            }`,
+		}),
+		Entry("HealthCheck", testCase{
+			resourceType: mesh_core.HealthCheckType,
+			name:         "db-health",
+			expected: `
+            {
+              "mesh": "demo",
+              "type": "HealthCheck",
+              "name": "db-health",
+              "winning": [
+                {
+                  "dataplane": "backend-01",
+                  "direction": "outbound",
+                  "interface": "127.0.0.1:54321",
+                  "service": "db",
+                  "rank": {"exactMatches": 1, "wildcardMatches": 1}
+                },
+                {
+                  "dataplane": "web-01",
+                  "direction": "outbound",
+                  "interface": "127.0.0.1:54321",
+                  "service": "db",
+                  "rank": {"exactMatches": 1, "wildcardMatches": 1}
+                }
+              ],
+              "shadowed": []
+            }`,
+		}),
+		Entry("TrafficTrace", testCase{
+			resourceType: mesh_core.TrafficTraceType,
+			name:         "trace-all",
+			expected: `
+            {
+              "mesh": "demo",
+              "type": "TrafficTrace",
+              "name": "trace-all",
+              "winning": [
+                {
+                  "dataplane": "backend-01",
+                  "rank": {"exactMatches": 0, "wildcardMatches": 1}
+                },
+                {
+                  "dataplane": "web-01",
+                  "rank": {"exactMatches": 0, "wildcardMatches": 1}
+                }
+              ],
+              "shadowed": []
+            }`,
+		}),
+	)
+
+	It("should return an error for a missing policy", func() {
+		// when
+		_, err := inspect.PolicyDataplanes(context.Background(), core_manager.NewResourceManager(store), mesh_core.TrafficPermissionType, "demo", "non-existing")
+
+		// then
+		Expect(err).To(MatchError(core_store.ErrorResourceNotFound(mesh_core.TrafficPermissionType, "non-existing", "demo")))
+	})
+
+	It("should return an error for a resource that is not a policy", func() {
+		// when
+		_, err := inspect.PolicyDataplanes(context.Background(), core_manager.NewResourceManager(store), mesh_core.DataplaneType, "demo", "backend-01")
+
+		// then
+		Expect(err).To(MatchError(`policies of type "Dataplane" cannot be inspected`))
+	})
+})
@@ -5,6 +5,7 @@ import (
 	"time"
 
 	envoy_xds "github.com/envoyproxy/go-control-plane/pkg/server"
+	"github.com/pkg/errors"
 
 	mesh_proto "github.com/Kong/kuma/api/mesh/v1alpha1"
 	"github.com/Kong/kuma/pkg/core"
@@ -12,14 +13,20 @@ import (
 	"github.com/Kong/kuma/pkg/core/logs"
 	"github.com/Kong/kuma/pkg/core/permissions"
 	mesh_core "github.com/Kong/kuma/pkg/core/resources/apis/mesh"
+	core_manager "github.com/Kong/kuma/pkg/core/resources/manager"
 	core_model "github.com/Kong/kuma/pkg/core/resources/model"
 	core_store "github.com/Kong/kuma/pkg/core/resources/store"
 	core_runtime "github.com/Kong/kuma/pkg/core/runtime"
+	secret_manager "github.com/Kong/kuma/pkg/core/secrets/manager"
 	"github.com/Kong/kuma/pkg/core/xds"
+	envoy_admin "github.com/Kong/kuma/pkg/envoy/admin"
+	sds_server "github.com/Kong/kuma/pkg/sds/server"
 	util_watchdog "github.com/Kong/kuma/pkg/util/watchdog"
 	util_xds "github.com/Kong/kuma/pkg/util/xds"
 	xds_bootstrap "github.com/Kong/kuma/pkg/xds/bootstrap"
 	xds_context "github.com/Kong/kuma/pkg/xds/context"
+	xds_generator "github.com/Kong/kuma/pkg/xds/generator"
+	xds_history "github.com/Kong/kuma/pkg/xds/history"
 	xds_sync "github.com/Kong/kuma/pkg/xds/sync"
 	xds_template "github.com/Kong/kuma/pkg/xds/template"
 	xds_topology "github.com/Kong/kuma/pkg/xds/topology"
@@ -30,11 +37,15 @@ var (
 )
 
 func SetupServer(rt core_runtime.Runtime) error {
-	reconciler := DefaultReconciler(rt)
+	history := rt.SnapshotHistory()
+	reconciler, err := DefaultReconciler(rt, history)
+	if err != nil {
+		return err
+	}
 
 	metadataTracker := NewDataplaneMetadataTracker()
 
-	tracker, err := DefaultDataplaneSyncTracker(rt, reconciler, metadataTracker)
+	tracker, err := DefaultDataplaneSyncTracker(rt, reconciler, history, metadataTracker)
 	if err != nil {
 		return err
 	}
@@ -45,9 +56,16 @@ func SetupServer(rt core_runtime.Runtime) error {
 	}
 
 	srv := NewServer(rt.XDS().Cache(), callbacks)
+
+	authenticator, err := sds_server.DefaultAuthenticator(rt)
+	if err != nil {
+		return err
+	}
+	envoyAdmin := envoy_admin.NewServer(rt.EnvoyAdmin(), authenticator)
+
 	return rt.Add(
-		// xDS gRPC API
-		&grpcServer{srv, rt.Config().XdsServer.GrpcPort, rt.Config().XdsServer.TlsCertFile, rt.Config().XdsServer.TlsKeyFile},
+		// xDS gRPC API and Envoy Admin streams of kuma-dp
+		&grpcServer{srv, envoyAdmin, rt.Config().XdsServer.GrpcPort, rt.Config().XdsServer.TlsCertFile, rt.Config().XdsServer.TlsKeyFile},
 		// diagnostics server
 		&diagnosticsServer{rt.Config().XdsServer.DiagnosticsPort},
 		// bootstrap server
@@ -58,22 +76,33 @@ func SetupServer(rt core_runtime.Runtime) error {
 	)
 }
 
-func DefaultReconciler(rt core_runtime.Runtime) SnapshotReconciler {
+func DefaultReconciler(rt core_runtime.Runtime, history xds_history.SnapshotHistory) (SnapshotReconciler, error) {
+	profiles, ok := xds_generator.FromProfilesContext(rt.Extensions())
+	if !ok {
+		return nil, errors.Errorf("ProxyTemplate profiles have not been configured")
+	}
 	return &reconciler{
 		&templateSnapshotGenerator{
 			ProxyTemplateResolver: &simpleProxyTemplateResolver{
 				ReadOnlyResourceManager: rt.ReadOnlyResourceManager(),
 				DefaultProxyTemplate:    xds_template.DefaultProxyTemplate,
 			},
+			Profiles: profiles,
 		},
 		&simpleSnapshotCacher{rt.XDS().Hasher(), rt.XDS().Cache()},
-	}
+		history,
+	}, nil
 }
 
-func DefaultDataplaneSyncTracker(rt core_runtime.Runtime, reconciler SnapshotReconciler, metadataTracker *DataplaneMetadataTracker) (envoy_xds.Callbacks, error) {
+func DefaultDataplaneSyncTracker(rt core_runtime.Runtime, reconciler SnapshotReconciler, history xds_history.SnapshotHistory, metadataTracker *DataplaneMetadataTracker) (envoy_xds.Callbacks, error) {
 	permissionsMatcher := permissions.TrafficPermissionsMatcher{ResourceManager: rt.ReadOnlyResourceManager()}
 	logsMatcher := logs.TrafficLogsMatcher{ResourceManager: rt.ReadOnlyResourceManager()}
 	faultInjectionMatcher := faultinjections.FaultInjectionMatcher{ResourceManager: rt.ReadOnlyResourceManager()}
+	// existence of Secrets referenced by ExternalServices is checked for every Dataplane on every tick
+	secretManager := secret_manager.NewReadOnlySecretManager(rt.SecretManager())
+	if rt.Config().Store.Cache.Enabled {
+		secretManager = core_manager.NewCachedManager(secretManager, rt.Config().Store.Cache.ExpirationTime)
+	}
 	envoyCpCtx, err := xds_context.BuildControlPlaneContext(rt.Config())
 	if err != nil {
 		return nil, err
@@ -117,7 +146,7 @@ func DefaultDataplaneSyncTracker(rt core_runtime.Runtime, reconciler SnapshotRec
 				destinations := xds_topology.BuildDestinationMap(dataplane, routes)
 
 				// resolve all endpoints that match given selectors
-				outbound, err := xds_topology.GetOutboundTargets(ctx, dataplane, destinations, rt.ReadOnlyResourceManager())
+				outbound, err := xds_topology.GetOutboundTargets(ctx, mesh, dataplane, destinations, rt.ReadOnlyResourceManager(), secretManager)
 				if err != nil {
 					return err
 				}
@@ -127,6 +156,21 @@ func DefaultDataplaneSyncTracker(rt core_runtime.Runtime, reconciler SnapshotRec
 					return err
 				}
 
+				circuitBreakers, err := xds_topology.GetCircuitBreakers(ctx, dataplane, destinations, rt.ReadOnlyResourceManager())
+				if err != nil {
+					return err
+				}
+
+				retries, err := xds_topology.GetRetries(ctx, dataplane, rt.ReadOnlyResourceManager())
+				if err != nil {
+					return err
+				}
+
+				timeouts, err := xds_topology.GetTimeouts(ctx, dataplane, rt.ReadOnlyResourceManager())
+				if err != nil {
+					return err
+				}
+
 				trafficTrace, err := xds_topology.GetTrafficTrace(ctx, dataplane, rt.ReadOnlyResourceManager())
 				if err != nil {
 					return err
@@ -146,30 +190,48 @@ func DefaultDataplaneSyncTracker(rt core_runtime.Runtime, reconciler SnapshotRec
 					return err
 				}
 
+				matchedInboundLogs, err := logsMatcher.MatchInbound(ctx, dataplane)
+				if err != nil {
+					return err
+				}
+
 				faultInjection, err := faultInjectionMatcher.Match(ctx, dataplane)
 				if err != nil {
 					return err
 				}
 
+				outboundFaultInjection, err := faultInjectionMatcher.MatchOutbound(ctx, dataplane, outbound)
+				if err != nil {
+					return err
+				}
+
 				proxy := xds.Proxy{
-					Id:                 proxyID,
-					Dataplane:          dataplane,
-					TrafficPermissions: matchedPermissions,
-					TrafficRoutes:      routes,
-					OutboundSelectors:  destinations,
-					OutboundTargets:    outbound,
-					HealthChecks:       healthChecks,
-					Logs:               matchedLogs,
-					TrafficTrace:       trafficTrace,
-					TracingBackend:     tracingBackend,
-					Metadata:           metadataTracker.Metadata(streamId),
-					FaultInjections:    faultInjection,
+					Id:                      proxyID,
+					Dataplane:               dataplane,
+					TrafficPermissions:      matchedPermissions,
+					TrafficRoutes:           routes,
+					OutboundSelectors:       destinations,
+					OutboundTargets:         outbound,
+					HealthChecks:            healthChecks,
+					CircuitBreakers:         circuitBreakers,
+					Logs:                    matchedLogs,
+					InboundLogs:             matchedInboundLogs,
+					TrafficTrace:            trafficTrace,
+					TracingBackend:          tracingBackend,
+					Metadata:                metadataTracker.Metadata(streamId),
+					FaultInjections:         faultInjection,
+					OutboundFaultInjections: outboundFaultInjection,
+					Retries:                 retries,
+					Timeouts:                timeouts,
 				}
 				return reconciler.Reconcile(envoyCtx, &proxy)
 			},
 			OnError: func(err error) {
 				log.Error(err, "OnTick() failed")
 			},
+			OnStop: func() {
+				history.Disconnected(xds.FromResourceKey(key))
+			},
 		}
 	}), nil
 }
@@ -6,6 +6,7 @@ import (
 
 	envoy "github.com/envoyproxy/go-control-plane/envoy/api/v2"
 	envoy_core "github.com/envoyproxy/go-control-plane/envoy/api/v2/core"
+	envoy_cache "github.com/envoyproxy/go-control-plane/pkg/cache"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 
@@ -17,6 +18,7 @@ import (
 	"github.com/Kong/kuma/pkg/core/xds"
 	test_runtime "github.com/Kong/kuma/pkg/test/runtime"
 	xds_context "github.com/Kong/kuma/pkg/xds/context"
+	xds_history "github.com/Kong/kuma/pkg/xds/history"
 	. "github.com/Kong/kuma/pkg/xds/server"
 )
 
@@ -61,7 +63,10 @@ var _ = Describe("Components", func() {
 			reconciler := eventSnapshotReconciler{}
 			reconciler.events = make(chan event)
 			// and
-			tracker, err := DefaultDataplaneSyncTracker(runtime, &reconciler, NewDataplaneMetadataTracker())
+			history := xds_history.NewSnapshotHistory(10, 0)
+			history.Record(xds.ProxyId{Mesh: "demo", Name: "example"}, "", nil, envoy_cache.Snapshot{})
+			// and
+			tracker, err := DefaultDataplaneSyncTracker(runtime, &reconciler, history, NewDataplaneMetadataTracker())
 			Expect(err).ToNot(HaveOccurred())
 
 			// given
@@ -124,6 +129,22 @@ var _ = Describe("Components", func() {
 			By("simulating Envoy disconnecting from the Control Plane")
 			// and
 			tracker.OnStreamClosed(streamID)
+			// and
+			stopCh := make(chan struct{})
+			defer close(stopCh)
+			go func() {
+				for {
+					select {
+					case <-reconciler.events:
+					case <-stopCh:
+						return
+					}
+				}
+			}()
+
+			By("waiting for snapshot history of the Dataplane to be dropped")
+			// expect
+			Eventually(history.Proxies, "1s", "1ms").Should(BeEmpty())
 
 			close(done)
 		}, 10)
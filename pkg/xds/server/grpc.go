@@ -10,11 +10,16 @@ import (
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials"
 
+	mesh_proto "github.com/Kong/kuma/api/mesh/v1alpha1"
 	"github.com/Kong/kuma/pkg/core"
 	"github.com/Kong/kuma/pkg/core/runtime/component"
 )
 
-const grpcMaxConcurrentStreams = 1000000
+const (
+	grpcMaxConcurrentStreams = 1000000
+	// Envoy config dumps of large meshes might not fit into the default limit of 4MB
+	grpcMaxRecvMsgSize = 32 * 1024 * 1024
+)
 
 var (
 	grpcServerLog = core.Log.WithName("xds-server").WithName("grpc")
@@ -22,6 +27,7 @@ var (
 
 type grpcServer struct {
 	server      envoy_xds.Server
+	envoyAdmin  mesh_proto.EnvoyAdminServiceServer
 	port        int
 	tlsCertFile string
 	tlsKeyFile  string
@@ -35,6 +41,7 @@ var (
 func (s *grpcServer) Start(stop <-chan struct{}) error {
 	var grpcOptions []grpc.ServerOption
 	grpcOptions = append(grpcOptions, grpc.MaxConcurrentStreams(grpcMaxConcurrentStreams))
+	grpcOptions = append(grpcOptions, grpc.MaxRecvMsgSize(grpcMaxRecvMsgSize))
 	useTLS := s.tlsCertFile != ""
 	if useTLS {
 		creds, err := credentials.NewServerTLSFromFile(s.tlsCertFile, s.tlsKeyFile)
@@ -52,6 +59,7 @@ func (s *grpcServer) Start(stop <-chan struct{}) error {
 
 	// register services
 	envoy_discovery.RegisterAggregatedDiscoveryServiceServer(grpcServer, s.server)
+	mesh_proto.RegisterEnvoyAdminServiceServer(grpcServer, s.envoyAdmin)
 
 	errChan := make(chan error)
 	go func() {
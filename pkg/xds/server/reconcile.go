@@ -1,12 +1,18 @@
 package server
 
 import (
+	"encoding/json"
+	"hash/fnv"
+	"strconv"
+
 	"github.com/golang/protobuf/proto"
 
 	"github.com/Kong/kuma/pkg/core"
+	core_model "github.com/Kong/kuma/pkg/core/resources/model"
 	model "github.com/Kong/kuma/pkg/core/xds"
 	xds_context "github.com/Kong/kuma/pkg/xds/context"
 	"github.com/Kong/kuma/pkg/xds/generator"
+	xds_history "github.com/Kong/kuma/pkg/xds/history"
 
 	envoy "github.com/envoyproxy/go-control-plane/envoy/api/v2"
 	envoy_auth "github.com/envoyproxy/go-control-plane/envoy/api/v2/auth"
@@ -28,13 +34,18 @@ var _ SnapshotReconciler = &reconciler{}
 type reconciler struct {
 	generator snapshotGenerator
 	cacher    snapshotCacher
+	history   xds_history.SnapshotHistory
 }
 
 func (r *reconciler) Clear(proxyId *model.ProxyId) error {
 	// cache.Clear() operation does not push a new (empty) configuration to Envoy.
 	// That is why instead of calling cache.Clear() we set configuration to an empty Snapshot.
 	// This fake value will be removed from cache on Envoy disconnect.
-	return r.cacher.Cache(&envoy_core.Node{Id: proxyId.String()}, envoy_cache.Snapshot{})
+	if err := r.cacher.Cache(&envoy_core.Node{Id: proxyId.String()}, envoy_cache.Snapshot{}); err != nil {
+		return err
+	}
+	r.history.Record(*proxyId, "", nil, envoy_cache.Snapshot{})
+	return nil
 }
 
 func (r *reconciler) Reconcile(ctx xds_context.Context, proxy *model.Proxy) error {
@@ -57,10 +68,81 @@ func (r *reconciler) Reconcile(ctx xds_context.Context, proxy *model.Proxy) erro
 	snapshot = r.autoVersion(previous, snapshot)
 	if err := r.cacher.Cache(node, snapshot); err != nil {
 		reconcileLog.Error(err, "failed to store snapshot", "snapshot", snapshot, "proxy", proxy)
+		return nil
 	}
+	r.history.Record(proxy.Id, proxy.Dataplane.GetMeta().GetVersion(), inputsOf(ctx, proxy), snapshot)
 	return nil
 }
 
+// inputsOf returns versions of everything a snapshot of a proxy is generated from,
+// so that the history can tell which change has triggered a push.
+// Inputs that are not resources on their own, e.g. endpoints of a service, are versioned by a hash of their content.
+func inputsOf(ctx xds_context.Context, proxy *model.Proxy) xds_history.Inputs {
+	inputs := xds_history.Inputs{}
+	addResource := func(resource core_model.Resource) {
+		inputs[xds_history.InputKey{Type: string(resource.GetType()), Name: resource.GetMeta().GetName()}] = resource.GetMeta().GetVersion()
+	}
+	addContent := func(typ string, name string, content interface{}) {
+		inputs[xds_history.InputKey{Type: typ, Name: name}] = hashOf(content)
+	}
+	if proxy.Dataplane != nil {
+		addResource(proxy.Dataplane)
+	}
+	if ctx.Mesh.Resource != nil {
+		addResource(ctx.Mesh.Resource)
+	}
+	if proxy.TrafficTrace != nil {
+		addResource(proxy.TrafficTrace)
+	}
+	for _, permission := range proxy.TrafficPermissions {
+		addResource(permission)
+	}
+	for _, route := range proxy.TrafficRoutes {
+		addResource(route)
+	}
+	for _, healthCheck := range proxy.HealthChecks {
+		addResource(healthCheck)
+	}
+	for _, circuitBreaker := range proxy.CircuitBreakers {
+		addResource(circuitBreaker)
+	}
+	for _, retry := range proxy.Retries {
+		addResource(retry)
+	}
+	for _, timeout := range proxy.Timeouts {
+		addResource(timeout)
+	}
+	for service, endpoints := range proxy.OutboundTargets {
+		addContent("Endpoints", service, endpoints)
+	}
+	for service, log := range proxy.Logs {
+		addContent("AccessLog", "outbound:"+service, log)
+	}
+	for iface, log := range proxy.InboundLogs {
+		addContent("AccessLog", "inbound:"+iface.String(), log)
+	}
+	for service, faultInjection := range proxy.OutboundFaultInjections {
+		addContent("FaultInjection", "outbound:"+service, faultInjection)
+	}
+	for iface, faultInjection := range proxy.FaultInjections {
+		addContent("FaultInjection", "inbound:"+iface.String(), faultInjection)
+	}
+	if proxy.Metadata != nil {
+		addContent("DataplaneMetadata", proxy.Id.Name, proxy.Metadata)
+	}
+	return inputs
+}
+
+func hashOf(content interface{}) string {
+	bytes, err := json.Marshal(content)
+	if err != nil {
+		return ""
+	}
+	hash := fnv.New64a()
+	_, _ = hash.Write(bytes)
+	return strconv.FormatUint(hash.Sum64(), 16)
+}
+
 func (r *reconciler) autoVersion(old envoy_cache.Snapshot, new envoy_cache.Snapshot) envoy_cache.Snapshot {
 	new.Listeners = reuseVersion(old.Listeners, new.Listeners)
 	new.Routes = reuseVersion(old.Routes, new.Routes)
@@ -96,12 +178,13 @@ type snapshotGenerator interface {
 
 type templateSnapshotGenerator struct {
 	ProxyTemplateResolver proxyTemplateResolver
+	Profiles              generator.ProfileRegistry
 }
 
 func (s *templateSnapshotGenerator) GenerateSnapshot(ctx xds_context.Context, proxy *model.Proxy) (envoy_cache.Snapshot, error) {
 	template := s.ProxyTemplateResolver.GetTemplate(proxy)
 
-	gen := generator.TemplateProxyGenerator{ProxyTemplate: template}
+	gen := generator.TemplateProxyGenerator{ProxyTemplate: template, Profiles: s.Profiles}
 
 	rs, err := gen.Generate(ctx, proxy)
 	if err != nil {
@@ -3,6 +3,7 @@ package server
 import (
 	"fmt"
 	"sync/atomic"
+	"time"
 
 	envoy "github.com/envoyproxy/go-control-plane/envoy/api/v2"
 	envoy_auth "github.com/envoyproxy/go-control-plane/envoy/api/v2/auth"
@@ -15,6 +16,7 @@ import (
 	xds_model "github.com/Kong/kuma/pkg/core/xds"
 	test_model "github.com/Kong/kuma/pkg/test/resources/model"
 	xds_context "github.com/Kong/kuma/pkg/xds/context"
+	xds_history "github.com/Kong/kuma/pkg/xds/history"
 )
 
 var _ = Describe("Reconcile", func() {
@@ -80,11 +82,13 @@ var _ = Describe("Reconcile", func() {
 			snapshots <- envoy_cache.Snapshot{} // new Dataplane configuration
 
 			// setup
+			history := xds_history.NewSnapshotHistory(10, time.Hour)
 			r := &reconciler{
 				snapshotGeneratorFunc(func(ctx xds_context.Context, proxy *xds_model.Proxy) (envoy_cache.Snapshot, error) {
 					return <-snapshots, nil
 				}),
 				&simpleSnapshotCacher{xdsContext.Hasher(), xdsContext.Cache()},
+				history,
 			}
 
 			// given
@@ -159,6 +163,29 @@ var _ = Describe("Reconcile", func() {
 			Expect(snapshot.Clusters.Version).To(Equal("v8"))
 			Expect(snapshot.Endpoints.Version).To(Equal("v9"))
 			Expect(snapshot.Secrets.Version).To(Equal("v10"))
+
+			By("verifying that only changed snapshots were recorded in the history")
+			// when
+			records := history.Records(proxy.Id)
+			// then
+			Expect(records).To(HaveLen(2))
+			// and
+			Expect(records[0].Version).To(Equal(1))
+			Expect(records[0].DataplaneVersion).To(Equal("abcdefg"))
+			Expect(records[0].Causes).To(Equal([]xds_history.ResourceChange{
+				{Type: "Dataplane", Name: "example", Change: xds_history.ResourceAdded},
+			}))
+			Expect(records[0].Changes).To(ConsistOf(
+				xds_history.ResourceChange{Type: "listeners", Name: "listener", Change: xds_history.ResourceAdded},
+				xds_history.ResourceChange{Type: "routes", Name: "route", Change: xds_history.ResourceAdded},
+				xds_history.ResourceChange{Type: "clusters", Name: "cluster", Change: xds_history.ResourceAdded},
+				xds_history.ResourceChange{Type: "endpoints", Name: "endpoint", Change: xds_history.ResourceAdded},
+				xds_history.ResourceChange{Type: "secrets", Name: "secret", Change: xds_history.ResourceAdded},
+			))
+			// and
+			Expect(records[1].Version).To(Equal(2))
+			Expect(records[1].Changes).To(HaveLen(5))
+			Expect(records[1].Snapshot.Listeners.Version).To(Equal("v6"))
 		})
 	})
 })
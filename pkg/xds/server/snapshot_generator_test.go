@@ -16,6 +16,7 @@ import (
 	util_cache "github.com/Kong/kuma/pkg/util/cache"
 	util_proto "github.com/Kong/kuma/pkg/util/proto"
 	xds_context "github.com/Kong/kuma/pkg/xds/context"
+	"github.com/Kong/kuma/pkg/xds/generator"
 	"github.com/Kong/kuma/pkg/xds/template"
 )
 
@@ -27,6 +28,7 @@ var _ = Describe("Reconcile", func() {
 				ReadOnlyResourceManager: manager.NewResourceManager(memory.NewStore()),
 				DefaultProxyTemplate:    template.DefaultProxyTemplate,
 			},
+			Profiles: generator.NewProfileRegistry(),
 		}
 
 		It("Generate Snapshot per Envoy Node", func() {
@@ -0,0 +1,166 @@
+package topology_test
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	. "github.com/Kong/kuma/pkg/xds/topology"
+
+	mesh_proto "github.com/Kong/kuma/api/mesh/v1alpha1"
+	mesh_core "github.com/Kong/kuma/pkg/core/resources/apis/mesh"
+	core_manager "github.com/Kong/kuma/pkg/core/resources/manager"
+	core_model "github.com/Kong/kuma/pkg/core/resources/model"
+	core_store "github.com/Kong/kuma/pkg/core/resources/store"
+	core_xds "github.com/Kong/kuma/pkg/core/xds"
+	memory_resources "github.com/Kong/kuma/pkg/plugins/resources/memory"
+	test_model "github.com/Kong/kuma/pkg/test/resources/model"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/wrappers"
+)
+
+var _ = Describe("CircuitBreaker", func() {
+
+	var ctx context.Context
+	var rm core_manager.ResourceManager
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		rm = core_manager.NewResourceManager(memory_resources.NewStore())
+	})
+
+	Describe("GetCircuitBreakers()", func() {
+
+		It("should pick the best matching CircuitBreaker for each destination service", func() {
+			// given
+			mesh := &mesh_core.MeshResource{ // mesh that is relevant to this test case
+				Meta: &test_model.ResourceMeta{
+					Mesh: "demo",
+					Name: "demo",
+				},
+			}
+			otherMesh := &mesh_core.MeshResource{ // mesh that is irrelevant to this test case
+				Meta: &test_model.ResourceMeta{
+					Mesh: "default",
+					Name: "default",
+				},
+			}
+			backend := &mesh_core.DataplaneResource{ // dataplane that is a source of traffic
+				Meta: &test_model.ResourceMeta{
+					Mesh: "demo",
+					Name: "backend",
+				},
+				Spec: mesh_proto.Dataplane{
+					Networking: &mesh_proto.Dataplane_Networking{
+						Address: "192.168.0.1",
+						Inbound: []*mesh_proto.Dataplane_Networking_Inbound{
+							{
+								Tags:        map[string]string{"service": "backend", "region": "eu"},
+								Port:        8080,
+								ServicePort: 18080,
+							},
+							{
+								Tags:        map[string]string{"service": "frontend", "region": "eu"},
+								Port:        7070,
+								ServicePort: 17070,
+							},
+						},
+						Outbound: []*mesh_proto.Dataplane_Networking_Outbound{
+							{Service: "redis", Port: 10001},
+							{Service: "elastic", Port: 10002},
+						},
+					},
+				},
+			}
+			destinations := core_xds.DestinationMap{
+				"redis":   core_xds.TagSelectorSet{mesh_proto.MatchService("redis")},
+				"elastic": core_xds.TagSelectorSet{mesh_proto.MatchService("elastic")},
+			}
+			circuitBreakerRedis := &mesh_core.CircuitBreakerResource{ // circuit breaker for `redis` service
+				Meta: &test_model.ResourceMeta{
+					Mesh: "demo",
+					Name: "circuit-breaker-redis",
+				},
+				Spec: mesh_proto.CircuitBreaker{
+					Sources: []*mesh_proto.Selector{
+						{Match: mesh_proto.TagSelector{"service": "frontend"}},
+						{Match: mesh_proto.TagSelector{"service": "backend"}},
+					},
+					Destinations: []*mesh_proto.Selector{
+						{Match: mesh_proto.TagSelector{"service": "redis"}},
+					},
+					Conf: &mesh_proto.CircuitBreaker_Conf{
+						Thresholds: &mesh_proto.CircuitBreaker_Conf_Thresholds{
+							MaxConnections: &wrappers.UInt32Value{Value: 64},
+						},
+					},
+				},
+			}
+			circuitBreakerElastic := &mesh_core.CircuitBreakerResource{ // circuit breaker for `elastic` service
+				Meta: &test_model.ResourceMeta{
+					Mesh: "demo",
+					Name: "circuit-breaker-elastic",
+				},
+				Spec: mesh_proto.CircuitBreaker{
+					Sources: []*mesh_proto.Selector{
+						{Match: mesh_proto.TagSelector{"service": "*"}},
+					},
+					Destinations: []*mesh_proto.Selector{
+						{Match: mesh_proto.TagSelector{"service": "elastic"}},
+					},
+					Conf: &mesh_proto.CircuitBreaker_Conf{
+						OutlierDetection: &mesh_proto.CircuitBreaker_Conf_OutlierDetection{
+							Consecutive_5Xx:  &wrappers.UInt32Value{Value: 1},
+							BaseEjectionTime: ptypes.DurationProto(6 * time.Second),
+						},
+					},
+				},
+			}
+			circuitBreakerEverything := &mesh_core.CircuitBreakerResource{ // circuit breaker for any service
+				Meta: &test_model.ResourceMeta{
+					Mesh: "default", // other mesh
+					Name: "circuit-breaker-everything",
+				},
+				Spec: mesh_proto.CircuitBreaker{
+					Sources: []*mesh_proto.Selector{
+						{Match: mesh_proto.TagSelector{"service": "*"}},
+					},
+					Destinations: []*mesh_proto.Selector{
+						{Match: mesh_proto.TagSelector{"service": "*"}},
+					},
+					Conf: &mesh_proto.CircuitBreaker_Conf{
+						OutlierDetection: &mesh_proto.CircuitBreaker_Conf_OutlierDetection{
+							Consecutive_5Xx:  &wrappers.UInt32Value{Value: 20},
+							BaseEjectionTime: ptypes.DurationProto(30 * time.Second),
+						},
+					},
+				},
+			}
+			for _, resource := range []core_model.Resource{mesh, backend, circuitBreakerRedis, circuitBreakerElastic, otherMesh, circuitBreakerEverything} {
+				// when
+				err := rm.Create(ctx, resource, core_store.CreateBy(core_model.MetaToResourceKey(resource.GetMeta())))
+				// then
+				Expect(err).ToNot(HaveOccurred())
+			}
+
+			// when
+			circuitBreakers, err := GetCircuitBreakers(ctx, backend, destinations, rm)
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			// and
+			Expect(circuitBreakers).To(HaveLen(2))
+			// and
+			Expect(circuitBreakers).To(HaveKey("redis"))
+			Expect(circuitBreakers["redis"].Meta.GetName()).To(Equal(circuitBreakerRedis.Meta.GetName()))
+			Expect(circuitBreakers["redis"].Spec).To(Equal(circuitBreakerRedis.Spec))
+			// and
+			Expect(circuitBreakers).To(HaveKey("elastic"))
+			Expect(circuitBreakers["elastic"].Meta.GetName()).To(Equal(circuitBreakerElastic.Meta.GetName()))
+			Expect(circuitBreakers["elastic"].Spec).To(Equal(circuitBreakerElastic.Spec))
+		})
+	})
+})
@@ -3,15 +3,29 @@ package topology
 import (
 	"context"
 
+	"github.com/pkg/errors"
+
 	mesh_proto "github.com/Kong/kuma/api/mesh/v1alpha1"
+	"github.com/Kong/kuma/pkg/core"
+	"github.com/Kong/kuma/pkg/core/permissions"
 	mesh_core "github.com/Kong/kuma/pkg/core/resources/apis/mesh"
+	core_system "github.com/Kong/kuma/pkg/core/resources/apis/system"
 	core_manager "github.com/Kong/kuma/pkg/core/resources/manager"
 	core_store "github.com/Kong/kuma/pkg/core/resources/store"
 	core_xds "github.com/Kong/kuma/pkg/core/xds"
 )
 
-// GetOutboundTargets resolves all endpoints reachable from a given dataplane.
-func GetOutboundTargets(ctx context.Context, dataplane *mesh_core.DataplaneResource, destinations core_xds.DestinationMap, manager core_manager.ReadOnlyResourceManager) (core_xds.EndpointMap, error) {
+var outboundLog = core.Log.WithName("topology").WithName("outbound")
+
+// GetOutboundTargets resolves all endpoints reachable from a given dataplane,
+// including endpoints of ExternalServices.
+//
+// If mTLS is enabled, only ExternalServices permitted by TrafficPermissions are reachable.
+// Without mTLS, TrafficPermissions are not in effect and every ExternalService of a Mesh is reachable.
+//
+// Since endpoints are resolved for every dataplane on every tick, Secrets referenced by ExternalServices
+// are listed at most once per call, preferably through a cached manager.
+func GetOutboundTargets(ctx context.Context, mesh *mesh_core.MeshResource, dataplane *mesh_core.DataplaneResource, destinations core_xds.DestinationMap, manager core_manager.ReadOnlyResourceManager, secretManager core_manager.ReadOnlyResourceManager) (core_xds.EndpointMap, error) {
 	if len(destinations) == 0 {
 		return nil, nil
 	}
@@ -19,7 +33,116 @@ func GetOutboundTargets(ctx context.Context, dataplane *mesh_core.DataplaneResou
 	if err := manager.List(ctx, dataplanes, core_store.ListByMesh(dataplane.Meta.GetMesh())); err != nil {
 		return nil, err
 	}
-	return BuildEndpointMap(destinations, dataplanes.Items), nil
+	externalServices := &mesh_core.ExternalServiceResourceList{}
+	if err := manager.List(ctx, externalServices, core_store.ListByMesh(dataplane.Meta.GetMesh())); err != nil {
+		return nil, err
+	}
+	permissionsMatcher := permissions.TrafficPermissionsMatcher{ResourceManager: manager}
+	reachable, err := permissionsMatcher.ReachableExternalServices(ctx, mesh, dataplane, externalServices.Items)
+	if err != nil {
+		return nil, err
+	}
+	outbound := BuildEndpointMap(destinations, dataplanes.Items)
+	var secrets map[string]bool
+	for _, externalService := range reachable {
+		service := externalService.Spec.GetService()
+		if !matchesAny(destinations[service], externalService.Spec.GetTags()) {
+			continue
+		}
+		if secrets == nil {
+			if secrets, err = secretNames(ctx, dataplane.Meta.GetMesh(), secretManager); err != nil {
+				return nil, err
+			}
+		}
+		endpoint, err := buildExternalServiceEndpoint(externalService, secrets)
+		if err != nil {
+			outboundLog.Info("ExternalService is misconfigured. Ignoring.", "externalService", externalService.GetMeta(), "reason", err.Error())
+			continue
+		}
+		if err := checkSharedCluster(outbound[service], *endpoint); err != nil {
+			outboundLog.Info("ExternalService conflicts with other endpoints of the same service. Ignoring.", "externalService", externalService.GetMeta(), "reason", err.Error())
+			continue
+		}
+		outbound[service] = append(outbound[service], *endpoint)
+	}
+	return outbound, nil
+}
+
+// secretNames returns names of all Secrets in a given Mesh.
+func secretNames(ctx context.Context, mesh string, secretManager core_manager.ReadOnlyResourceManager) (map[string]bool, error) {
+	secrets := &core_system.SecretResourceList{}
+	if err := secretManager.List(ctx, secrets, core_store.ListByMesh(mesh)); err != nil {
+		return nil, errors.Wrap(err, "could not list secrets")
+	}
+	names := make(map[string]bool, len(secrets.Items))
+	for _, secret := range secrets.Items {
+		names[secret.Meta.GetName()] = true
+	}
+	return names, nil
+}
+
+// buildExternalServiceEndpoint creates an endpoint of a given ExternalService.
+func buildExternalServiceEndpoint(externalService *mesh_core.ExternalServiceResource, secrets map[string]bool) (*core_xds.Endpoint, error) {
+	host, port, err := externalService.Spec.GetNetworking().GetHostAndPort()
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid address of ExternalService %q", externalService.Meta.GetName())
+	}
+	tls := externalService.Spec.GetNetworking().GetTls()
+	settings := &core_xds.ExternalService{
+		Name:             externalService.Meta.GetName(),
+		TLSEnabled:       tls.GetEnabled(),
+		ServerName:       tls.GetServerName(),
+		CaCertSecret:     tls.GetCaCertSecret(),
+		ClientCertSecret: tls.GetClientCertSecret(),
+		ClientKeySecret:  tls.GetClientKeySecret(),
+	}
+	if settings.TLSEnabled && !settings.HasCaCert() {
+		return nil, errors.Errorf("TLS is enabled but there is no CA certificate to verify ExternalService %q", externalService.Meta.GetName())
+	}
+	// TLS material is served over SDS, here we only make sure that referenced secrets exist
+	for _, secret := range []string{settings.CaCertSecret, settings.ClientCertSecret, settings.ClientKeySecret} {
+		if secret == "" {
+			continue
+		}
+		if !secrets[secret] {
+			return nil, errors.Errorf("secret %q referenced by ExternalService %q does not exist", secret, externalService.Meta.GetName())
+		}
+	}
+	return &core_xds.Endpoint{
+		Target:          host,
+		Port:            port,
+		Tags:            externalService.Spec.GetTags(),
+		ExternalService: settings,
+	}, nil
+}
+
+// checkSharedCluster verifies that an endpoint of an ExternalService can be put into the same cluster
+// as other endpoints of a service, since type and TLS settings of a cluster are shared by all its endpoints.
+func checkSharedCluster(endpoints []core_xds.Endpoint, endpoint core_xds.Endpoint) error {
+	for _, other := range endpoints {
+		if !other.IsExternalService() {
+			return errors.Errorf("service is already provided by Dataplanes")
+		}
+		if !sameTLS(*other.ExternalService, *endpoint.ExternalService) {
+			return errors.Errorf("TLS settings are different from ExternalService %q", other.ExternalService.Name)
+		}
+	}
+	return nil
+}
+
+// sameTLS returns true if ExternalServices have the same TLS settings.
+func sameTLS(one, another core_xds.ExternalService) bool {
+	one.Name, another.Name = "", ""
+	return one == another
+}
+
+func matchesAny(selectors core_xds.TagSelectorSet, tags map[string]string) bool {
+	for _, selector := range selectors {
+		if selector.Matches(tags) {
+			return true
+		}
+	}
+	return false
 }
 
 // BuildEndpointMap creates a map of all endpoints that match given selectors.
@@ -35,14 +158,7 @@ func BuildEndpointMap(destinations core_xds.DestinationMap, dataplanes []*mesh_c
 			if !ok {
 				continue
 			}
-			matches := false
-			for _, selector := range selectors {
-				if selector.Matches(inbound.Tags) {
-					matches = true
-					break
-				}
-			}
-			if !matches {
+			if !matchesAny(selectors, inbound.Tags) {
 				continue
 			}
 			iface, err := dataplane.Spec.Networking.GetInboundInterfaceByIdx(i)
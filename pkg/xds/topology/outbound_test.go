@@ -11,9 +11,13 @@ import (
 
 	mesh_proto "github.com/Kong/kuma/api/mesh/v1alpha1"
 	mesh_core "github.com/Kong/kuma/pkg/core/resources/apis/mesh"
+	core_system "github.com/Kong/kuma/pkg/core/resources/apis/system"
 	core_manager "github.com/Kong/kuma/pkg/core/resources/manager"
 	core_model "github.com/Kong/kuma/pkg/core/resources/model"
 	core_store "github.com/Kong/kuma/pkg/core/resources/store"
+	secret_cipher "github.com/Kong/kuma/pkg/core/secrets/cipher"
+	secret_manager "github.com/Kong/kuma/pkg/core/secrets/manager"
+	secret_store "github.com/Kong/kuma/pkg/core/secrets/store"
 	core_xds "github.com/Kong/kuma/pkg/core/xds"
 	memory_resources "github.com/Kong/kuma/pkg/plugins/resources/memory"
 	test_model "github.com/Kong/kuma/pkg/test/resources/model"
@@ -22,11 +26,15 @@ import (
 var _ = Describe("TrafficRoute", func() {
 
 	var ctx context.Context
+	var memStore core_store.ResourceStore
 	var rm core_manager.ResourceManager
+	var secretManager secret_manager.SecretManager
 
 	BeforeEach(func() {
 		ctx = context.Background()
-		rm = core_manager.NewResourceManager(memory_resources.NewStore())
+		memStore = memory_resources.NewStore()
+		rm = core_manager.NewResourceManager(memStore)
+		secretManager = secret_manager.NewSecretManager(secret_store.NewSecretStore(memStore), secret_cipher.None())
 	})
 
 	Describe("GetOutboundTargets()", func() {
@@ -180,7 +188,7 @@ var _ = Describe("TrafficRoute", func() {
 			}
 
 			// when
-			targets, err := GetOutboundTargets(ctx, backend, destinations, rm)
+			targets, err := GetOutboundTargets(ctx, mesh, backend, destinations, rm, secret_manager.NewReadOnlySecretManager(secretManager))
 
 			// then
 			Expect(err).ToNot(HaveOccurred())
@@ -194,6 +202,410 @@ var _ = Describe("TrafficRoute", func() {
 				{Target: "192.168.0.6", Port: 9200, Tags: map[string]string{"service": "elastic", "region": "us"}},
 			}))
 		})
+
+		It("should include endpoints of matching ExternalServices", func() {
+			// given
+			mesh := &mesh_core.MeshResource{
+				Meta: &test_model.ResourceMeta{
+					Mesh: "demo",
+					Name: "demo",
+				},
+			}
+			backend := &mesh_core.DataplaneResource{ // dataplane that is a source of traffic
+				Meta: &test_model.ResourceMeta{
+					Mesh: "demo",
+					Name: "backend",
+				},
+				Spec: mesh_proto.Dataplane{
+					Networking: &mesh_proto.Dataplane_Networking{
+						Address: "192.168.0.1",
+						Inbound: []*mesh_proto.Dataplane_Networking_Inbound{
+							{
+								Tags:        map[string]string{"service": "backend"},
+								Port:        8080,
+								ServicePort: 18080,
+							},
+						},
+						Outbound: []*mesh_proto.Dataplane_Networking_Outbound{
+							{Service: "httpbin", Port: 10001},
+						},
+					},
+				},
+			}
+			httpbin := &mesh_core.ExternalServiceResource{ // external service that must become a target
+				Meta: &test_model.ResourceMeta{
+					Mesh: "demo",
+					Name: "httpbin",
+				},
+				Spec: mesh_proto.ExternalService{
+					Networking: &mesh_proto.ExternalService_Networking{
+						Address: "httpbin.org:443",
+						Tls: &mesh_proto.ExternalService_Networking_TLS{
+							Enabled:      true,
+							CaCertSecret: "httpbin-ca",
+						},
+					},
+					Tags: map[string]string{"service": "httpbin", "protocol": "http"},
+				},
+			}
+			postgres := &mesh_core.ExternalServiceResource{ // external service that must not become a target
+				Meta: &test_model.ResourceMeta{
+					Mesh: "demo",
+					Name: "postgres",
+				},
+				Spec: mesh_proto.ExternalService{
+					Networking: &mesh_proto.ExternalService_Networking{
+						Address: "10.0.0.10:5432",
+					},
+					Tags: map[string]string{"service": "postgres"},
+				},
+			}
+			destinations := core_xds.DestinationMap{
+				"httpbin": []mesh_proto.TagSelector{
+					{"service": "httpbin"},
+				},
+			}
+			for _, resource := range []core_model.Resource{mesh, backend, httpbin, postgres} {
+				// when
+				err := rm.Create(ctx, resource, core_store.CreateBy(core_model.MetaToResourceKey(resource.GetMeta())))
+				// then
+				Expect(err).ToNot(HaveOccurred())
+			}
+			// and
+			secret := &core_system.SecretResource{}
+			secret.Spec.Value = []byte("CA")
+			err := secretManager.Create(ctx, secret, core_store.CreateBy(core_model.ResourceKey{Mesh: "demo", Name: "httpbin-ca"}))
+			Expect(err).ToNot(HaveOccurred())
+
+			// when
+			targets, err := GetOutboundTargets(ctx, mesh, backend, destinations, rm, secret_manager.NewReadOnlySecretManager(secretManager))
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			// and
+			Expect(targets).To(HaveLen(1))
+			// and
+			Expect(targets).To(HaveKeyWithValue("httpbin", []core_xds.Endpoint{
+				{
+					Target: "httpbin.org",
+					Port:   443,
+					Tags:   map[string]string{"service": "httpbin", "protocol": "http"},
+					ExternalService: &core_xds.ExternalService{
+						Name:         "httpbin",
+						TLSEnabled:   true,
+						CaCertSecret: "httpbin-ca",
+					},
+				},
+			}))
+		})
+
+		DescribeTable("should enforce TrafficPermissions on ExternalServices only if mTLS is enabled",
+			func(mtls bool, expected []string) {
+				// given
+				mesh := &mesh_core.MeshResource{
+					Meta: &test_model.ResourceMeta{
+						Mesh: "demo",
+						Name: "demo",
+					},
+					Spec: mesh_proto.Mesh{
+						Mtls: &mesh_proto.Mesh_Mtls{
+							Enabled: mtls,
+							Ca: &mesh_proto.CertificateAuthority{
+								Type: &mesh_proto.CertificateAuthority_Builtin_{
+									Builtin: &mesh_proto.CertificateAuthority_Builtin{},
+								},
+							},
+						},
+					},
+				}
+				backend := &mesh_core.DataplaneResource{ // dataplane that is a source of traffic
+					Meta: &test_model.ResourceMeta{
+						Mesh: "demo",
+						Name: "backend",
+					},
+					Spec: mesh_proto.Dataplane{
+						Networking: &mesh_proto.Dataplane_Networking{
+							Address: "192.168.0.1",
+							Inbound: []*mesh_proto.Dataplane_Networking_Inbound{
+								{
+									Tags:        map[string]string{"service": "backend"},
+									Port:        8080,
+									ServicePort: 18080,
+								},
+							},
+						},
+					},
+				}
+				permission := &mesh_core.TrafficPermissionResource{ // permission that lets backend reach only httpbin
+					Meta: &test_model.ResourceMeta{
+						Mesh: "demo",
+						Name: "backend-to-httpbin",
+					},
+					Spec: mesh_proto.TrafficPermission{
+						Sources: []*mesh_proto.Selector{
+							{Match: map[string]string{"service": "backend"}},
+						},
+						Destinations: []*mesh_proto.Selector{
+							{Match: map[string]string{"service": "httpbin"}},
+						},
+					},
+				}
+				resources := []core_model.Resource{mesh, backend, permission}
+				for _, service := range []string{"httpbin", "postgres"} {
+					resources = append(resources, &mesh_core.ExternalServiceResource{
+						Meta: &test_model.ResourceMeta{
+							Mesh: "demo",
+							Name: service,
+						},
+						Spec: mesh_proto.ExternalService{
+							Networking: &mesh_proto.ExternalService_Networking{
+								Address: service + ".example.com:443",
+							},
+							Tags: map[string]string{"service": service},
+						},
+					})
+				}
+				for _, resource := range resources {
+					// when
+					err := rm.Create(ctx, resource, core_store.CreateBy(core_model.MetaToResourceKey(resource.GetMeta())))
+					// then
+					Expect(err).ToNot(HaveOccurred())
+				}
+
+				// when
+				targets, err := GetOutboundTargets(ctx, mesh, backend, core_xds.DestinationMap{
+					"httpbin":  []mesh_proto.TagSelector{{"service": "httpbin"}},
+					"postgres": []mesh_proto.TagSelector{{"service": "postgres"}},
+				}, rm, secret_manager.NewReadOnlySecretManager(secretManager))
+
+				// then
+				Expect(err).ToNot(HaveOccurred())
+				// and
+				var actual []string
+				for service := range targets {
+					actual = append(actual, service)
+				}
+				Expect(actual).To(ConsistOf(expected))
+			},
+			Entry("with mTLS only permitted ExternalServices are reachable", true, []string{"httpbin"}),
+			Entry("without mTLS every ExternalService is reachable", false, []string{"httpbin", "postgres"}),
+		)
+
+		It("should ignore ExternalServices that cannot share a cluster with other endpoints of the same service", func() {
+			// given
+			mesh := &mesh_core.MeshResource{
+				Meta: &test_model.ResourceMeta{
+					Mesh: "demo",
+					Name: "demo",
+				},
+			}
+			backend := &mesh_core.DataplaneResource{ // dataplane that is a source of traffic
+				Meta: &test_model.ResourceMeta{
+					Mesh: "demo",
+					Name: "backend",
+				},
+				Spec: mesh_proto.Dataplane{
+					Networking: &mesh_proto.Dataplane_Networking{
+						Address: "192.168.0.1",
+						Inbound: []*mesh_proto.Dataplane_Networking_Inbound{
+							{
+								Tags:        map[string]string{"service": "backend"},
+								Port:        8080,
+								ServicePort: 18080,
+							},
+						},
+					},
+				},
+			}
+			redis := &mesh_core.DataplaneResource{ // dataplane that must become a target
+				Meta: &test_model.ResourceMeta{
+					Mesh: "demo",
+					Name: "redis",
+				},
+				Spec: mesh_proto.Dataplane{
+					Networking: &mesh_proto.Dataplane_Networking{
+						Address: "192.168.0.2",
+						Inbound: []*mesh_proto.Dataplane_Networking_Inbound{
+							{
+								Tags:        map[string]string{"service": "redis"},
+								Port:        6379,
+								ServicePort: 16379,
+							},
+						},
+					},
+				},
+			}
+			externalRedis := &mesh_core.ExternalServiceResource{ // external service that conflicts with a dataplane
+				Meta: &test_model.ResourceMeta{
+					Mesh: "demo",
+					Name: "external-redis",
+				},
+				Spec: mesh_proto.ExternalService{
+					Networking: &mesh_proto.ExternalService_Networking{
+						Address: "redis.example.com:6379",
+					},
+					Tags: map[string]string{"service": "redis"},
+				},
+			}
+			api1 := &mesh_core.ExternalServiceResource{ // external service that must become a target
+				Meta: &test_model.ResourceMeta{
+					Mesh: "demo",
+					Name: "api-1",
+				},
+				Spec: mesh_proto.ExternalService{
+					Networking: &mesh_proto.ExternalService_Networking{
+						Address: "api-1.example.com:443",
+						Tls: &mesh_proto.ExternalService_Networking_TLS{
+							Enabled:      true,
+							CaCertSecret: "api-ca",
+						},
+					},
+					Tags: map[string]string{"service": "api"},
+				},
+			}
+			api2 := &mesh_core.ExternalServiceResource{ // external service with different TLS settings
+				Meta: &test_model.ResourceMeta{
+					Mesh: "demo",
+					Name: "api-2",
+				},
+				Spec: mesh_proto.ExternalService{
+					Networking: &mesh_proto.ExternalService_Networking{
+						Address: "api-2.example.com:80",
+					},
+					Tags: map[string]string{"service": "api"},
+				},
+			}
+			destinations := core_xds.DestinationMap{
+				"redis": []mesh_proto.TagSelector{{"service": "redis"}},
+				"api":   []mesh_proto.TagSelector{{"service": "api"}},
+			}
+			for _, resource := range []core_model.Resource{mesh, backend, redis, externalRedis, api1, api2} {
+				// when
+				err := rm.Create(ctx, resource, core_store.CreateBy(core_model.MetaToResourceKey(resource.GetMeta())))
+				// then
+				Expect(err).ToNot(HaveOccurred())
+			}
+			// and
+			secret := &core_system.SecretResource{}
+			secret.Spec.Value = []byte("CA")
+			err := secretManager.Create(ctx, secret, core_store.CreateBy(core_model.ResourceKey{Mesh: "demo", Name: "api-ca"}))
+			Expect(err).ToNot(HaveOccurred())
+
+			// when
+			targets, err := GetOutboundTargets(ctx, mesh, backend, destinations, rm, secret_manager.NewReadOnlySecretManager(secretManager))
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			// and
+			Expect(targets).To(HaveLen(2))
+			// and
+			Expect(targets).To(HaveKeyWithValue("redis", []core_xds.Endpoint{
+				{Target: "192.168.0.2", Port: 6379, Tags: map[string]string{"service": "redis"}},
+			}))
+			Expect(targets).To(HaveKeyWithValue("api", []core_xds.Endpoint{
+				{
+					Target: "api-1.example.com",
+					Port:   443,
+					Tags:   map[string]string{"service": "api"},
+					ExternalService: &core_xds.ExternalService{
+						Name:         "api-1",
+						TLSEnabled:   true,
+						CaCertSecret: "api-ca",
+					},
+				},
+			}))
+		})
+
+		It("should skip an ExternalService when a secret referenced by it is missing", func() {
+			// given
+			mesh := &mesh_core.MeshResource{
+				Meta: &test_model.ResourceMeta{
+					Mesh: "demo",
+					Name: "demo",
+				},
+			}
+			backend := &mesh_core.DataplaneResource{
+				Meta: &test_model.ResourceMeta{
+					Mesh: "demo",
+					Name: "backend",
+				},
+			}
+			httpbin := &mesh_core.ExternalServiceResource{
+				Meta: &test_model.ResourceMeta{
+					Mesh: "demo",
+					Name: "httpbin",
+				},
+				Spec: mesh_proto.ExternalService{
+					Networking: &mesh_proto.ExternalService_Networking{
+						Address: "httpbin.org:443",
+						Tls: &mesh_proto.ExternalService_Networking_TLS{
+							Enabled:      true,
+							CaCertSecret: "httpbin-ca",
+						},
+					},
+					Tags: map[string]string{"service": "httpbin"},
+				},
+			}
+			for _, resource := range []core_model.Resource{mesh, httpbin} {
+				err := rm.Create(ctx, resource, core_store.CreateBy(core_model.MetaToResourceKey(resource.GetMeta())))
+				Expect(err).ToNot(HaveOccurred())
+			}
+
+			// when
+			targets, err := GetOutboundTargets(ctx, mesh, backend, core_xds.DestinationMap{
+				"httpbin": []mesh_proto.TagSelector{{"service": "httpbin"}},
+			}, rm, secret_manager.NewReadOnlySecretManager(secretManager))
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			// and
+			Expect(targets).To(BeEmpty())
+		})
+		It("should skip an ExternalService that originates TLS without a CA certificate", func() {
+			// given
+			mesh := &mesh_core.MeshResource{
+				Meta: &test_model.ResourceMeta{
+					Mesh: "demo",
+					Name: "demo",
+				},
+			}
+			backend := &mesh_core.DataplaneResource{
+				Meta: &test_model.ResourceMeta{
+					Mesh: "demo",
+					Name: "backend",
+				},
+			}
+			httpbin := &mesh_core.ExternalServiceResource{
+				Meta: &test_model.ResourceMeta{
+					Mesh: "demo",
+					Name: "httpbin",
+				},
+				Spec: mesh_proto.ExternalService{
+					Networking: &mesh_proto.ExternalService_Networking{
+						Address: "httpbin.org:443",
+						Tls: &mesh_proto.ExternalService_Networking_TLS{
+							Enabled: true,
+						},
+					},
+					Tags: map[string]string{"service": "httpbin"},
+				},
+			}
+			// store an ExternalService created before a CA certificate became required, i.e. bypass validation
+			for _, resource := range []core_model.Resource{mesh, httpbin} {
+				err := memStore.Create(ctx, resource, core_store.CreateBy(core_model.MetaToResourceKey(resource.GetMeta())))
+				Expect(err).ToNot(HaveOccurred())
+			}
+
+			// when
+			targets, err := GetOutboundTargets(ctx, mesh, backend, core_xds.DestinationMap{
+				"httpbin": []mesh_proto.TagSelector{{"service": "httpbin"}},
+			}, rm, secret_manager.NewReadOnlySecretManager(secretManager))
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			// and
+			Expect(targets).To(BeEmpty())
+		})
 	})
 
 	Describe("BuildEndpointMap()", func() {
@@ -0,0 +1,44 @@
+package topology
+
+import (
+	"context"
+
+	"github.com/Kong/kuma/pkg/core/policy"
+	mesh_core "github.com/Kong/kuma/pkg/core/resources/apis/mesh"
+	core_manager "github.com/Kong/kuma/pkg/core/resources/manager"
+	core_store "github.com/Kong/kuma/pkg/core/resources/store"
+	core_xds "github.com/Kong/kuma/pkg/core/xds"
+)
+
+// GetRetries resolves all Retries applicable to a given Dataplane.
+func GetRetries(ctx context.Context, dataplane *mesh_core.DataplaneResource, manager core_manager.ReadOnlyResourceManager) (core_xds.RetryMap, error) {
+	if len(dataplane.Spec.Networking.GetOutbound()) == 0 {
+		return nil, nil
+	}
+	retries := &mesh_core.RetryResourceList{}
+	if err := manager.List(ctx, retries, core_store.ListByMesh(dataplane.Meta.GetMesh())); err != nil {
+		return nil, err
+	}
+	return BuildRetryMap(dataplane, retries.Items), nil
+}
+
+// BuildRetryMap picks a single the most specific Retry for each outbound interface of a given Dataplane.
+func BuildRetryMap(dataplane *mesh_core.DataplaneResource, retries []*mesh_core.RetryResource) core_xds.RetryMap {
+	if len(retries) == 0 {
+		return nil
+	}
+	policies := make([]policy.ConnectionPolicy, len(retries))
+	for i, retry := range retries {
+		policies[i] = retry
+	}
+
+	policyMap := policy.SelectOutboundConnectionPolicies(dataplane, policies)
+
+	retryMap := core_xds.RetryMap{}
+	for _, oface := range dataplane.Spec.Networking.GetOutbound() {
+		if policy, exists := policyMap[oface.Service]; exists {
+			retryMap[oface.Service] = policy.(*mesh_core.RetryResource)
+		}
+	}
+	return retryMap
+}
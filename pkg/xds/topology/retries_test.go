@@ -0,0 +1,235 @@
+package topology_test
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+
+	. "github.com/Kong/kuma/pkg/xds/topology"
+
+	mesh_proto "github.com/Kong/kuma/api/mesh/v1alpha1"
+	mesh_core "github.com/Kong/kuma/pkg/core/resources/apis/mesh"
+	core_manager "github.com/Kong/kuma/pkg/core/resources/manager"
+	core_model "github.com/Kong/kuma/pkg/core/resources/model"
+	core_store "github.com/Kong/kuma/pkg/core/resources/store"
+	core_xds "github.com/Kong/kuma/pkg/core/xds"
+	memory_resources "github.com/Kong/kuma/pkg/plugins/resources/memory"
+	test_model "github.com/Kong/kuma/pkg/test/resources/model"
+
+	"github.com/golang/protobuf/ptypes/wrappers"
+)
+
+var _ = Describe("Retry", func() {
+
+	var ctx context.Context
+	var rm core_manager.ResourceManager
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		rm = core_manager.NewResourceManager(memory_resources.NewStore())
+	})
+
+	Describe("GetRetries()", func() {
+
+		It("should pick the best matching Retry for each outbound interface", func() {
+			// given
+			mesh := &mesh_core.MeshResource{ // mesh that is relevant to this test case
+				Meta: &test_model.ResourceMeta{
+					Mesh: "demo",
+					Name: "demo",
+				},
+			}
+			otherMesh := &mesh_core.MeshResource{ // mesh that is irrelevant to this test case
+				Meta: &test_model.ResourceMeta{
+					Mesh: "default",
+					Name: "default",
+				},
+			}
+			backend := &mesh_core.DataplaneResource{ // dataplane that is a source of traffic
+				Meta: &test_model.ResourceMeta{
+					Mesh: "demo",
+					Name: "backend",
+				},
+				Spec: mesh_proto.Dataplane{
+					Networking: &mesh_proto.Dataplane_Networking{
+						Address: "192.168.0.1",
+						Inbound: []*mesh_proto.Dataplane_Networking_Inbound{
+							{
+								Tags:        map[string]string{"service": "backend", "region": "eu"},
+								Port:        8080,
+								ServicePort: 18080,
+							},
+						},
+						Outbound: []*mesh_proto.Dataplane_Networking_Outbound{
+							{Service: "redis", Port: 10001},
+							{Service: "elastic", Port: 10002},
+						},
+					},
+				},
+			}
+			retryRedis := &mesh_core.RetryResource{ // retries for `redis` service
+				Meta: &test_model.ResourceMeta{
+					Mesh: "demo",
+					Name: "retry-redis",
+				},
+				Spec: mesh_proto.Retry{
+					Sources: []*mesh_proto.Selector{
+						{Match: mesh_proto.TagSelector{"service": "backend"}},
+					},
+					Destinations: []*mesh_proto.Selector{
+						{Match: mesh_proto.TagSelector{"service": "redis"}},
+					},
+					Conf: &mesh_proto.Retry_Conf{
+						Tcp: &mesh_proto.Retry_Conf_Tcp{
+							MaxConnectAttempts: 3,
+						},
+					},
+				},
+			}
+			retryEverything := &mesh_core.RetryResource{ // retries for any service
+				Meta: &test_model.ResourceMeta{
+					Mesh: "default", // other mesh
+					Name: "retry-everything",
+				},
+				Spec: mesh_proto.Retry{
+					Sources: []*mesh_proto.Selector{
+						{Match: mesh_proto.TagSelector{"service": "*"}},
+					},
+					Destinations: []*mesh_proto.Selector{
+						{Match: mesh_proto.TagSelector{"service": "*"}},
+					},
+					Conf: &mesh_proto.Retry_Conf{
+						Http: &mesh_proto.Retry_Conf_Http{
+							NumRetries: &wrappers.UInt32Value{Value: 5},
+						},
+					},
+				},
+			}
+			for _, resource := range []core_model.Resource{mesh, backend, retryRedis, otherMesh, retryEverything} {
+				// when
+				err := rm.Create(ctx, resource, core_store.CreateBy(core_model.MetaToResourceKey(resource.GetMeta())))
+				// then
+				Expect(err).ToNot(HaveOccurred())
+			}
+
+			// when
+			retries, err := GetRetries(ctx, backend, rm)
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			// and
+			Expect(retries).To(HaveLen(1))
+			// and
+			Expect(retries).To(HaveKey("redis"))
+			Expect(retries["redis"].Meta.GetName()).To(Equal(retryRedis.Meta.GetName()))
+			Expect(retries["redis"].Spec).To(Equal(retryRedis.Spec))
+		})
+	})
+
+	Describe("BuildRetryMap()", func() {
+		type testCase struct {
+			dataplane *mesh_core.DataplaneResource
+			retries   []*mesh_core.RetryResource
+			expected  map[core_xds.ServiceName]string
+		}
+		DescribeTable("should correctly pick a single the most specific Retry for each outbound interface",
+			func(given testCase) {
+				// when
+				retries := BuildRetryMap(given.dataplane, given.retries)
+				// then
+				actual := map[core_xds.ServiceName]string{}
+				for service, retry := range retries {
+					actual[service] = retry.Meta.GetName()
+				}
+				Expect(actual).To(Equal(given.expected))
+			},
+			Entry("Dataplane without outbound interfaces", testCase{
+				dataplane: &mesh_core.DataplaneResource{},
+				retries:   nil,
+				expected:  map[core_xds.ServiceName]string{},
+			}),
+			Entry("if an outbound interface has no matching Retries, none should be used", testCase{
+				dataplane: &mesh_core.DataplaneResource{
+					Spec: mesh_proto.Dataplane{
+						Networking: &mesh_proto.Dataplane_Networking{
+							Inbound: []*mesh_proto.Dataplane_Networking_Inbound{
+								{Tags: map[string]string{"service": "backend"}},
+							},
+							Outbound: []*mesh_proto.Dataplane_Networking_Outbound{
+								{Service: "redis"},
+							},
+						},
+					},
+				},
+				retries: []*mesh_core.RetryResource{
+					{
+						Meta: &test_model.ResourceMeta{
+							Name: "web-to-redis",
+						},
+						Spec: mesh_proto.Retry{
+							Sources: []*mesh_proto.Selector{
+								{Match: mesh_proto.TagSelector{"service": "web"}},
+							},
+							Destinations: []*mesh_proto.Selector{
+								{Match: mesh_proto.TagSelector{"service": "redis"}},
+							},
+						},
+					},
+				},
+				expected: map[core_xds.ServiceName]string{},
+			}),
+			Entry("Retry with a `source` selector by 2 tags should win over a Retry with a `source` selector by 1 tag", testCase{
+				dataplane: &mesh_core.DataplaneResource{
+					Spec: mesh_proto.Dataplane{
+						Networking: &mesh_proto.Dataplane_Networking{
+							Inbound: []*mesh_proto.Dataplane_Networking_Inbound{
+								{Tags: map[string]string{"service": "backend", "region": "eu"}},
+							},
+							Outbound: []*mesh_proto.Dataplane_Networking_Outbound{
+								{Service: "redis"},
+								{Service: "elastic"},
+							},
+						},
+					},
+				},
+				retries: []*mesh_core.RetryResource{
+					{
+						Meta: &test_model.ResourceMeta{
+							Name:         "less-specific",
+							CreationTime: time.Unix(1, 0),
+						},
+						Spec: mesh_proto.Retry{
+							Sources: []*mesh_proto.Selector{
+								{Match: mesh_proto.TagSelector{"service": "backend"}},
+							},
+							Destinations: []*mesh_proto.Selector{
+								{Match: mesh_proto.TagSelector{"service": "*"}},
+							},
+						},
+					},
+					{
+						Meta: &test_model.ResourceMeta{
+							Name:         "more-specific",
+							CreationTime: time.Unix(0, 0),
+						},
+						Spec: mesh_proto.Retry{
+							Sources: []*mesh_proto.Selector{
+								{Match: mesh_proto.TagSelector{"service": "backend", "region": "eu"}},
+							},
+							Destinations: []*mesh_proto.Selector{
+								{Match: mesh_proto.TagSelector{"service": "redis"}},
+							},
+						},
+					},
+				},
+				expected: map[core_xds.ServiceName]string{
+					"redis":   "more-specific",
+					"elastic": "less-specific",
+				},
+			}),
+		)
+	})
+})
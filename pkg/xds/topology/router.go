@@ -91,14 +91,14 @@ func BuildDestinationMap(dataplane *mesh_core.DataplaneResource, routes core_xds
 	for _, oface := range dataplane.Spec.Networking.GetOutbound() {
 		route, ok := routes[oface.Service]
 		if ok {
-			for _, destination := range route.Spec.Conf {
-				service, ok := destination.Destination[mesh_proto.ServiceTag]
+			for _, destination := range routeDestinations(route) {
+				service, ok := destination[mesh_proto.ServiceTag]
 				if !ok {
 					// ignore destinations without a `service` tag
 					// TODO(yskopets): consider adding a metric for this
 					continue
 				}
-				destinations[service] = destinations[service].Add(mesh_proto.MatchTags(destination.Destination))
+				destinations[service] = destinations[service].Add(mesh_proto.MatchTags(destination))
 			}
 		} else {
 			destinations[oface.Service] = destinations[oface.Service].Add(mesh_proto.MatchService(oface.Service))
@@ -106,3 +106,21 @@ func BuildDestinationMap(dataplane *mesh_core.DataplaneResource, routes core_xds
 	}
 	return destinations
 }
+
+// routeDestinations returns all destinations referenced by a given TrafficRoute,
+// including destinations of HTTP rules and a mirror destination.
+func routeDestinations(route *mesh_core.TrafficRouteResource) []map[string]string {
+	var destinations []map[string]string
+	for _, destination := range route.Spec.Conf {
+		destinations = append(destinations, destination.Destination)
+	}
+	for _, http := range route.Spec.Http {
+		for _, destination := range http.Destination {
+			destinations = append(destinations, destination.Destination)
+		}
+	}
+	if mirror := route.Spec.GetMirror(); mirror != nil {
+		destinations = append(destinations, mirror.Destination)
+	}
+	return destinations
+}
@@ -720,6 +720,90 @@ var _ = Describe("TrafficRoute", func() {
 					},
 				},
 			}),
+			Entry("Dataplane with outbound interfaces and TrafficRoutes with HTTP rules", testCase{
+				dataplane: &mesh_core.DataplaneResource{
+					Spec: mesh_proto.Dataplane{
+						Networking: &mesh_proto.Dataplane_Networking{
+							Outbound: []*mesh_proto.Dataplane_Networking_Outbound{
+								{Service: "backend", Port: 10001},
+							},
+						},
+					},
+				},
+				routes: core_xds.RouteMap{
+					"backend": &mesh_core.TrafficRouteResource{
+						Spec: mesh_proto.TrafficRoute{
+							Conf: []*mesh_proto.TrafficRoute_WeightedDestination{
+								{
+									Weight:      100,
+									Destination: mesh_proto.TagSelector{"service": "backend", "version": "v1"},
+								},
+							},
+							Http: []*mesh_proto.TrafficRoute_Http{
+								{
+									Destination: []*mesh_proto.TrafficRoute_WeightedDestination{
+										{
+											Weight:      100,
+											Destination: mesh_proto.TagSelector{"service": "backend-api", "version": "v2"},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+				expected: core_xds.DestinationMap{
+					"backend": []mesh_proto.TagSelector{
+						{"service": "backend", "version": "v1"},
+					},
+					"backend-api": []mesh_proto.TagSelector{
+						{"service": "backend-api", "version": "v2"},
+					},
+				},
+			}),
+			Entry("Dataplane with outbound interfaces and TrafficRoutes with HTTP rules and mirror", testCase{
+				dataplane: &mesh_core.DataplaneResource{
+					Spec: mesh_proto.Dataplane{
+						Networking: &mesh_proto.Dataplane_Networking{
+							Outbound: []*mesh_proto.Dataplane_Networking_Outbound{
+								{Service: "backend", Port: 10001},
+							},
+						},
+					},
+				},
+				routes: core_xds.RouteMap{
+					"backend": &mesh_core.TrafficRouteResource{
+						Spec: mesh_proto.TrafficRoute{
+							Conf: []*mesh_proto.TrafficRoute_WeightedDestination{
+								{
+									Weight:      100,
+									Destination: mesh_proto.TagSelector{"service": "backend", "version": "v1"},
+								},
+							},
+							Http: []*mesh_proto.TrafficRoute_Http{
+								{
+									Destination: []*mesh_proto.TrafficRoute_WeightedDestination{
+										{
+											Weight:      100,
+											Destination: mesh_proto.TagSelector{"service": "backend", "version": "v2"},
+										},
+									},
+								},
+							},
+							Mirror: &mesh_proto.TrafficRoute_Mirror{
+								Destination: mesh_proto.TagSelector{"service": "backend", "version": "v3"},
+							},
+						},
+					},
+				},
+				expected: core_xds.DestinationMap{
+					"backend": []mesh_proto.TagSelector{
+						{"service": "backend", "version": "v1"},
+						{"service": "backend", "version": "v2"},
+						{"service": "backend", "version": "v3"},
+					},
+				},
+			}),
 		)
 	})
 })
@@ -0,0 +1,44 @@
+package topology
+
+import (
+	"context"
+
+	"github.com/Kong/kuma/pkg/core/policy"
+	mesh_core "github.com/Kong/kuma/pkg/core/resources/apis/mesh"
+	core_manager "github.com/Kong/kuma/pkg/core/resources/manager"
+	core_store "github.com/Kong/kuma/pkg/core/resources/store"
+	core_xds "github.com/Kong/kuma/pkg/core/xds"
+)
+
+// GetTimeouts resolves all Timeouts applicable to a given Dataplane.
+func GetTimeouts(ctx context.Context, dataplane *mesh_core.DataplaneResource, manager core_manager.ReadOnlyResourceManager) (core_xds.TimeoutMap, error) {
+	if len(dataplane.Spec.Networking.GetOutbound()) == 0 {
+		return nil, nil
+	}
+	timeouts := &mesh_core.TimeoutResourceList{}
+	if err := manager.List(ctx, timeouts, core_store.ListByMesh(dataplane.Meta.GetMesh())); err != nil {
+		return nil, err
+	}
+	return BuildTimeoutMap(dataplane, timeouts.Items), nil
+}
+
+// BuildTimeoutMap picks a single the most specific Timeout for each outbound interface of a given Dataplane.
+func BuildTimeoutMap(dataplane *mesh_core.DataplaneResource, timeouts []*mesh_core.TimeoutResource) core_xds.TimeoutMap {
+	if len(timeouts) == 0 {
+		return nil
+	}
+	policies := make([]policy.ConnectionPolicy, len(timeouts))
+	for i, timeout := range timeouts {
+		policies[i] = timeout
+	}
+
+	policyMap := policy.SelectOutboundConnectionPolicies(dataplane, policies)
+
+	timeoutMap := core_xds.TimeoutMap{}
+	for _, oface := range dataplane.Spec.Networking.GetOutbound() {
+		if policy, exists := policyMap[oface.Service]; exists {
+			timeoutMap[oface.Service] = policy.(*mesh_core.TimeoutResource)
+		}
+	}
+	return timeoutMap
+}
@@ -0,0 +1,127 @@
+package topology_test
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	. "github.com/Kong/kuma/pkg/xds/topology"
+
+	mesh_proto "github.com/Kong/kuma/api/mesh/v1alpha1"
+	mesh_core "github.com/Kong/kuma/pkg/core/resources/apis/mesh"
+	core_manager "github.com/Kong/kuma/pkg/core/resources/manager"
+	core_model "github.com/Kong/kuma/pkg/core/resources/model"
+	core_store "github.com/Kong/kuma/pkg/core/resources/store"
+	memory_resources "github.com/Kong/kuma/pkg/plugins/resources/memory"
+	test_model "github.com/Kong/kuma/pkg/test/resources/model"
+
+	"github.com/golang/protobuf/ptypes"
+)
+
+var _ = Describe("Timeout", func() {
+
+	var ctx context.Context
+	var rm core_manager.ResourceManager
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		rm = core_manager.NewResourceManager(memory_resources.NewStore())
+	})
+
+	Describe("GetTimeouts()", func() {
+
+		It("should pick the best matching Timeout for each outbound interface", func() {
+			// given
+			mesh := &mesh_core.MeshResource{ // mesh that is relevant to this test case
+				Meta: &test_model.ResourceMeta{
+					Mesh: "demo",
+					Name: "demo",
+				},
+			}
+			otherMesh := &mesh_core.MeshResource{ // mesh that is irrelevant to this test case
+				Meta: &test_model.ResourceMeta{
+					Mesh: "default",
+					Name: "default",
+				},
+			}
+			backend := &mesh_core.DataplaneResource{ // dataplane that is a source of traffic
+				Meta: &test_model.ResourceMeta{
+					Mesh: "demo",
+					Name: "backend",
+				},
+				Spec: mesh_proto.Dataplane{
+					Networking: &mesh_proto.Dataplane_Networking{
+						Address: "192.168.0.1",
+						Inbound: []*mesh_proto.Dataplane_Networking_Inbound{
+							{
+								Tags:        map[string]string{"service": "backend", "region": "eu"},
+								Port:        8080,
+								ServicePort: 18080,
+							},
+						},
+						Outbound: []*mesh_proto.Dataplane_Networking_Outbound{
+							{Service: "redis", Port: 10001},
+							{Service: "elastic", Port: 10002},
+						},
+					},
+				},
+			}
+			timeoutRedis := &mesh_core.TimeoutResource{ // timeouts for `redis` service
+				Meta: &test_model.ResourceMeta{
+					Mesh: "demo",
+					Name: "timeout-redis",
+				},
+				Spec: mesh_proto.Timeout{
+					Sources: []*mesh_proto.Selector{
+						{Match: mesh_proto.TagSelector{"service": "backend"}},
+					},
+					Destinations: []*mesh_proto.Selector{
+						{Match: mesh_proto.TagSelector{"service": "redis"}},
+					},
+					Conf: &mesh_proto.Timeout_Conf{
+						ConnectTimeout: ptypes.DurationProto(2 * time.Second),
+					},
+				},
+			}
+			timeoutEverything := &mesh_core.TimeoutResource{ // timeouts for any service
+				Meta: &test_model.ResourceMeta{
+					Mesh: "default", // other mesh
+					Name: "timeout-everything",
+				},
+				Spec: mesh_proto.Timeout{
+					Sources: []*mesh_proto.Selector{
+						{Match: mesh_proto.TagSelector{"service": "*"}},
+					},
+					Destinations: []*mesh_proto.Selector{
+						{Match: mesh_proto.TagSelector{"service": "*"}},
+					},
+					Conf: &mesh_proto.Timeout_Conf{
+						Http: &mesh_proto.Timeout_Conf_Http{
+							RequestTimeout: ptypes.DurationProto(time.Minute),
+						},
+					},
+				},
+			}
+			for _, resource := range []core_model.Resource{mesh, backend, timeoutRedis, otherMesh, timeoutEverything} {
+				// when
+				err := rm.Create(ctx, resource, core_store.CreateBy(core_model.MetaToResourceKey(resource.GetMeta())))
+				// then
+				Expect(err).ToNot(HaveOccurred())
+			}
+
+			// when
+			timeouts, err := GetTimeouts(ctx, backend, rm)
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			// and
+			Expect(timeouts).To(HaveLen(1))
+			// and
+			Expect(timeouts).To(HaveKey("redis"))
+			Expect(timeouts["redis"].Meta.GetName()).To(Equal(timeoutRedis.Meta.GetName()))
+			Expect(timeouts["redis"].Spec).To(Equal(timeoutRedis.Spec))
+		})
+	})
+})
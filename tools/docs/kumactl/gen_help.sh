@@ -49,9 +49,21 @@ gen_help kumactl get traffic-permissions
 gen_help kumactl get traffic-routes
 gen_help kumactl get traffic-traces
 gen_help kumactl get fault-injections
+gen_help kumactl get retries
+gen_help kumactl get timeouts
+gen_help kumactl get circuit-breakers
+gen_help kumactl get external-services
 gen_help kumactl delete
 gen_help kumactl inspect
 gen_help kumactl inspect dataplanes
+gen_help kumactl inspect dataplane
+gen_help kumactl inspect traffic-permission
+gen_help kumactl inspect traffic-log
+gen_help kumactl inspect traffic-route
+gen_help kumactl inspect healthcheck
+gen_help kumactl inspect fault-injection
+gen_help kumactl inspect traffic-trace
+gen_help kumactl inspect proxytemplate
 gen_help kumactl manage
 gen_help kumactl manage ca
 gen_help kumactl manage ca provided